@@ -0,0 +1,81 @@
+// Command import loads a CSV or ONIX 3.0 catalog file into the database,
+// using the same validation and batching as the imports API.
+//
+//	go run ./cmd/import -file backlist.csv
+//	go run ./cmd/import -file feed.xml -format onix
+package main
+
+import (
+	"bookstore-framework/configs"
+	"bookstore-framework/internal/imports"
+	"bookstore-framework/migrations"
+	"bookstore-framework/pkg"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func main() {
+	path := flag.String("file", "", "path of the CSV or ONIX file to import")
+	format := flag.String("format", "", "csv or onix, inferred from the file extension when empty")
+	flag.Parse()
+
+	if *path == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := configs.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config : %v", err)
+	}
+
+	db, err := pkg.ConnectDB(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	if err := migrations.Migrate(db); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Fatalf("Failed to open import file: %v", err)
+	}
+	defer file.Close()
+
+	ctx := context.Background()
+	importRepository := imports.NewImportRepository(db)
+	importService := imports.NewImportService(importRepository)
+
+	job, err := importService.Import(ctx, 0, *format, *path, file)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	fmt.Printf("Import job %d %s: %d rows, %d imported, %d rejected\n",
+		job.ID, job.Status, job.TotalRows, job.ImportedRows, job.FailedRows)
+	if job.Error != "" {
+		fmt.Printf("Error: %s\n", job.Error)
+	}
+
+	for page := 1; job.FailedRows > 0; page++ {
+		rowErrors, err := importService.GetJobErrors(ctx, job.ID, pkg.PaginationQuery{Page: page, Limit: 100})
+		if err != nil {
+			log.Fatalf("Failed to list import errors: %v", err)
+		}
+		for _, e := range rowErrors.Errors {
+			fmt.Printf("row %d %s: %s\n", e.Row, e.ISBN, e.Message)
+		}
+		if page >= rowErrors.Pagination.TotalPages {
+			break
+		}
+	}
+
+	if job.Status == imports.StatusFailed {
+		os.Exit(1)
+	}
+}
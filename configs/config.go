@@ -16,6 +16,17 @@ type Config struct {
 	SecretKey     string
 	TokenIssuer   string
 	TokenAudience string
+	// PaymentProvider selects the payment provider, "fake" for local development.
+	PaymentProvider      string
+	PaymentWebhookSecret string
+	// BlobStore selects where cover images are kept, "local" or "s3".
+	BlobStore   string
+	BlobDir     string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
 }
 
 func LoadConfig() (*Config, error) {
@@ -32,5 +43,16 @@ func LoadConfig() (*Config, error) {
 		SecretKey:     os.Getenv("SECRET_KEY"),
 		TokenIssuer:   os.Getenv("TOKEN_ISSUER"),
 		TokenAudience: os.Getenv("TOKEN_AUDIENCE"),
+
+		PaymentProvider:      os.Getenv("PAYMENT_PROVIDER"),
+		PaymentWebhookSecret: os.Getenv("PAYMENT_WEBHOOK_SECRET"),
+
+		BlobStore:   os.Getenv("BLOB_STORE"),
+		BlobDir:     os.Getenv("BLOB_DIR"),
+		S3Endpoint:  os.Getenv("S3_ENDPOINT"),
+		S3Region:    os.Getenv("S3_REGION"),
+		S3Bucket:    os.Getenv("S3_BUCKET"),
+		S3AccessKey: os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("S3_SECRET_KEY"),
 	}, nil
 }
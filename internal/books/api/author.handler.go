@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthorHandler struct {
+	authorService books.AuthorService
+}
+
+func NewAuthorHandler(authorService books.AuthorService) *AuthorHandler {
+	return &AuthorHandler{
+		authorService: authorService,
+	}
+}
+
+// CreateAuthor godoc
+// @Summary      Create an author
+// @Description  Add a new author (staff only)
+// @Tags         authors
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.AuthorRequest true "Author information"
+// @Success      201  {object}    pkg.Response{data=dto.AuthorResponse} "Author created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Access forbidden"
+// @Router       /authors [post]
+func (h *AuthorHandler) CreateAuthor(ctx *gin.Context) {
+	var req dto.AuthorRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.authorService.CreateAuthor(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Author created successfully", response)
+}
+
+// GetAuthors godoc
+// @Summary      List authors
+// @Description  List authors with an optional name filter
+// @Tags         authors
+// @Produce      json
+// @Param        page  query    int    false "Page number" default(1)
+// @Param        limit query    int    false "Page size" default(20)
+// @Param        name  query    string false "Filter by name"
+// @Success      200  {object}    pkg.Response{data=dto.AuthorListResponse} "Authors retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /authors [get]
+func (h *AuthorHandler) GetAuthors(ctx *gin.Context) {
+	var query dto.NameListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.authorService.GetAuthors(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Authors retrieve successfully", response)
+}
+
+// GetAuthor godoc
+// @Summary      Get an author
+// @Description  Get a single author by id
+// @Tags         authors
+// @Produce      json
+// @Param        id   path        int true "Author ID"
+// @Success      200  {object}    pkg.Response{data=dto.AuthorResponse} "Author retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Author not found"
+// @Router       /authors/{id} [get]
+func (h *AuthorHandler) GetAuthor(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid author id", err.Error())
+		return
+	}
+
+	response, err := h.authorService.GetAuthor(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Author retrieve successfully", response)
+}
+
+// GetBibliography godoc
+// @Summary      Get an author's bibliography
+// @Description  List every book the author contributed to, in any role
+// @Tags         authors
+// @Produce      json
+// @Param        id    path     int true  "Author ID"
+// @Param        page  query    int false "Page number" default(1)
+// @Param        limit query    int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.BibliographyResponse} "Bibliography retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Author not found"
+// @Router       /authors/{id}/books [get]
+func (h *AuthorHandler) GetBibliography(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid author id", err.Error())
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.authorService.GetBibliography(ctx.Request.Context(), id, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Bibliography retrieve successfully", response)
+}
+
+// UpdateAuthor godoc
+// @Summary      Update an author
+// @Description  Replace the details of an author (staff only)
+// @Tags         authors
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int               true "Author ID"
+// @Param        request body     dto.AuthorRequest true "Author information"
+// @Success      200  {object}    pkg.Response{data=dto.AuthorResponse} "Author updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Author not found"
+// @Router       /authors/{id} [put]
+func (h *AuthorHandler) UpdateAuthor(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid author id", err.Error())
+		return
+	}
+
+	var req dto.AuthorRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.authorService.UpdateAuthor(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Author updated successfully", response)
+}
+
+// DeleteAuthor godoc
+// @Summary      Delete an author
+// @Description  Remove an author that is not linked to any book (staff only)
+// @Tags         authors
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Author ID"
+// @Success      200  {object}    pkg.Response "Author deleted successfully"
+// @Failure      404  {object}    pkg.Response "Author not found"
+// @Failure      409  {object}    pkg.Response "Author is still linked to books"
+// @Router       /authors/{id} [delete]
+func (h *AuthorHandler) DeleteAuthor(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid author id", err.Error())
+		return
+	}
+
+	if err := h.authorService.DeleteAuthor(ctx.Request.Context(), id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Author deleted successfully", nil)
+}
@@ -0,0 +1,260 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookHandler struct {
+	bookService books.BookService
+}
+
+func NewBookHandler(bookService books.BookService) *BookHandler {
+	return &BookHandler{
+		bookService: bookService,
+	}
+}
+
+// CreateBook godoc
+// @Summary      Create a book
+// @Description  Add a new book to the catalog (staff only)
+// @Tags         books
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.BookRequest true "Book information"
+// @Success      201  {object}    pkg.Response{data=dto.BookResponse} "Book created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Access forbidden"
+// @Failure      409  {object}    pkg.Response "Duplicate ISBN"
+// @Router       /books [post]
+func (h *BookHandler) CreateBook(ctx *gin.Context) {
+	var req dto.BookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.bookService.CreateBook(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Book created successfully", response)
+}
+
+// GetBooks godoc
+// @Summary      List books
+// @Description  List books in the catalog with optional filters
+// @Tags         books
+// @Produce      json
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        limit    query    int    false "Page size" default(20)
+// @Param        title    query    string false "Filter by title"
+// @Param        language query    string false "Filter by language code"
+// @Param        category query    string false "Filter by category slug, including subcategories"
+// @Param        currency query    string false "Show prices in this currency"
+// @Success      200  {object}    pkg.Response{data=dto.BookListResponse} "Books retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /books [get]
+func (h *BookHandler) GetBooks(ctx *gin.Context) {
+	var query dto.BookListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.bookService.GetBooks(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Books retrieve successfully", response)
+}
+
+// SearchBooks godoc
+// @Summary      Search books
+// @Description  Full-text search over title, subtitle, authors, description and ISBN. Every word must match as a prefix, results are ranked by relevance with highlighted snippets
+// @Tags         books
+// @Produce      json
+// @Param        q        query    string true  "Search text"
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        limit    query    int    false "Page size" default(20)
+// @Param        language query    string false "Language code used for stemming and filtering"
+// @Param        category query    string false "Filter by category slug, including subcategories"
+// @Param        currency query    string false "Show prices in this currency"
+// @Success      200  {object}    pkg.Response{data=dto.BookSearchResponse} "Books retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Category not found"
+// @Router       /books/search [get]
+func (h *BookHandler) SearchBooks(ctx *gin.Context) {
+	var query dto.BookSearchQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.bookService.SearchBooks(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Books retrieve successfully", response)
+}
+
+// GetBook godoc
+// @Summary      Get a book
+// @Description  Get a single book by id
+// @Tags         books
+// @Produce      json
+// @Param        id       path     int    true  "Book ID"
+// @Param        currency query    string false "Show the price in this currency"
+// @Success      200  {object}    pkg.Response{data=dto.BookResponse} "Book retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /books/{id} [get]
+func (h *BookHandler) GetBook(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	var query dto.BookQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.bookService.GetBook(ctx.Request.Context(), id, query.Currency)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book retrieve successfully", response)
+}
+
+// GetBookByBarcode godoc
+// @Summary      Look up a book by barcode
+// @Description  Find a book by the EAN-13 scanned from its barcode. ISBN-10 and hyphenated ISBNs are accepted, and a trailing price add-on is ignored
+// @Tags         books
+// @Produce      json
+// @Param        ean  path        string true "EAN-13 or ISBN"
+// @Success      200  {object}    pkg.Response{data=dto.BookResponse} "Book retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid ISBN"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /books/by-barcode/{ean} [get]
+func (h *BookHandler) GetBookByBarcode(ctx *gin.Context) {
+	response, err := h.bookService.GetBookByBarcode(ctx.Request.Context(), ctx.Param("ean"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book retrieve successfully", response)
+}
+
+// UpdateBook godoc
+// @Summary      Update a book
+// @Description  Replace the details of a book (staff only)
+// @Tags         books
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int             true "Book ID"
+// @Param        request body     dto.BookRequest true "Book information"
+// @Success      200  {object}    pkg.Response{data=dto.BookResponse} "Book updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Failure      409  {object}    pkg.Response "Duplicate ISBN"
+// @Router       /books/{id} [put]
+func (h *BookHandler) UpdateBook(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	var req dto.BookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.bookService.UpdateBook(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book updated successfully", response)
+}
+
+// DeleteBook godoc
+// @Summary      Delete a book
+// @Description  Remove a book from the catalog (staff only)
+// @Tags         books
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Book ID"
+// @Success      200  {object}    pkg.Response "Book deleted successfully"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /books/{id} [delete]
+func (h *BookHandler) DeleteBook(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	if err := h.bookService.DeleteBook(ctx.Request.Context(), id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book deleted successfully", nil)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, books.ErrBookNotFound),
+		errors.Is(err, books.ErrAuthorNotFound),
+		errors.Is(err, books.ErrPublisherNotFound),
+		errors.Is(err, books.ErrCategoryNotFound),
+		errors.Is(err, books.ErrCoverNotFound),
+		errors.Is(err, books.ErrBookHasNoCover):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, books.ErrDuplicateContributor),
+		errors.Is(err, books.ErrEmptySearch),
+		errors.Is(err, books.ErrInvalidISBN),
+		errors.Is(err, books.ErrInvalidSlug),
+		errors.Is(err, books.ErrCategoryCycle),
+		errors.Is(err, books.ErrCurrencyNotAvailable),
+		errors.Is(err, books.ErrCoverDimensions),
+		errors.Is(err, books.ErrCoverCorrupt):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, books.ErrCoverTooLarge):
+		pkg.ErrorResponse(ctx, http.StatusRequestEntityTooLarge, err.Error(), nil)
+	case errors.Is(err, books.ErrCoverUnsupported):
+		pkg.ErrorResponse(ctx, http.StatusUnsupportedMediaType, err.Error(), nil)
+	case errors.Is(err, books.ErrDuplicateISBN),
+		errors.Is(err, books.ErrDuplicatePublisher),
+		errors.Is(err, books.ErrDuplicateSlug),
+		errors.Is(err, books.ErrAuthorHasBooks),
+		errors.Is(err, books.ErrPublisherHasBooks),
+		errors.Is(err, books.ErrCategoryHasChildren),
+		errors.Is(err, books.ErrCategoryHasBooks):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
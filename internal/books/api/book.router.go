@@ -0,0 +1,97 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/pricing"
+	recommendationsApi "bookstore-framework/internal/recommendations/api"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+	"bookstore-framework/pkg/blob"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func BooksRoutes(router *gin.RouterGroup, db *gorm.DB, store blob.BlobStore) {
+	bookRepository := books.NewBookRepository(db)
+	authorRepository := books.NewAuthorRepository(db)
+	publisherRepository := books.NewPublisherRepository(db)
+	categoryRepository := books.NewCategoryRepository(db)
+	pricingService := pricing.NewPricingService(pricing.NewPricingRepository(db))
+	bookService := books.NewBookService(bookRepository, authorRepository, publisherRepository, categoryRepository, pricingService)
+	bookHandler := NewBookHandler(bookService)
+	coverHandler := NewCoverHandler(books.NewCoverService(bookRepository, store))
+
+	router.GET("", bookHandler.GetBooks)
+	router.GET("/search", bookHandler.SearchBooks)
+	router.GET("/by-barcode/:ean", bookHandler.GetBookByBarcode)
+	// Views of logged in users feed the recommendations.
+	router.GET("/:id", middleware.OptionalJWTAuth(), recommendationsApi.RecordViews(db), bookHandler.GetBook)
+
+	staff := router.Group("")
+	staff.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	staff.POST("", bookHandler.CreateBook)
+	staff.PUT("/:id", bookHandler.UpdateBook)
+	staff.DELETE("/:id", bookHandler.DeleteBook)
+	staff.PUT("/:id/cover", coverHandler.UploadCover)
+	staff.DELETE("/:id/cover", coverHandler.DeleteCover)
+}
+
+func CoversRoutes(router *gin.RouterGroup, db *gorm.DB, store blob.BlobStore) {
+	coverService := books.NewCoverService(books.NewBookRepository(db), store)
+	coverHandler := NewCoverHandler(coverService)
+
+	router.GET("/*path", coverHandler.GetCover)
+}
+
+func AuthorsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	authorRepository := books.NewAuthorRepository(db)
+	bookRepository := books.NewBookRepository(db)
+	authorService := books.NewAuthorService(authorRepository, bookRepository)
+	authorHandler := NewAuthorHandler(authorService)
+
+	router.GET("", authorHandler.GetAuthors)
+	router.GET("/:id", authorHandler.GetAuthor)
+	router.GET("/:id/books", authorHandler.GetBibliography)
+
+	staff := router.Group("")
+	staff.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	staff.POST("", authorHandler.CreateAuthor)
+	staff.PUT("/:id", authorHandler.UpdateAuthor)
+	staff.DELETE("/:id", authorHandler.DeleteAuthor)
+}
+
+func PublishersRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	publisherRepository := books.NewPublisherRepository(db)
+	bookRepository := books.NewBookRepository(db)
+	publisherService := books.NewPublisherService(publisherRepository, bookRepository)
+	publisherHandler := NewPublisherHandler(publisherService)
+
+	router.GET("", publisherHandler.GetPublishers)
+	router.GET("/:id", publisherHandler.GetPublisher)
+	router.GET("/:id/books", publisherHandler.GetPublisherBooks)
+
+	staff := router.Group("")
+	staff.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	staff.POST("", publisherHandler.CreatePublisher)
+	staff.PUT("/:id", publisherHandler.UpdatePublisher)
+	staff.DELETE("/:id", publisherHandler.DeletePublisher)
+}
+
+func CategoriesRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	categoryRepository := books.NewCategoryRepository(db)
+	bookRepository := books.NewBookRepository(db)
+	categoryService := books.NewCategoryService(categoryRepository, bookRepository)
+	categoryHandler := NewCategoryHandler(categoryService)
+
+	router.GET("", categoryHandler.GetCategoryTree)
+	router.GET("/:slug", categoryHandler.GetCategory)
+	router.GET("/:slug/books", categoryHandler.GetCategoryBooks)
+
+	staff := router.Group("")
+	staff.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	staff.POST("", categoryHandler.CreateCategory)
+	staff.PUT("/:id", categoryHandler.UpdateCategory)
+	staff.POST("/:id/move", categoryHandler.MoveCategory)
+	staff.DELETE("/:id", categoryHandler.DeleteCategory)
+}
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	categoryService books.CategoryService
+}
+
+func NewCategoryHandler(categoryService books.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+// CreateCategory godoc
+// @Summary      Create a category
+// @Description  Add a category, optionally below a parent (staff only)
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.CategoryRequest true "Category information"
+// @Success      201  {object}    pkg.Response{data=dto.CategoryResponse} "Category created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Parent category not found"
+// @Failure      409  {object}    pkg.Response "Duplicate slug"
+// @Router       /categories [post]
+func (h *CategoryHandler) CreateCategory(ctx *gin.Context) {
+	var req dto.CategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.categoryService.CreateCategory(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Category created successfully", response)
+}
+
+// GetCategoryTree godoc
+// @Summary      Get the category tree
+// @Description  Get every category nested under its parent
+// @Tags         categories
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=[]dto.CategoryTreeResponse} "Categories retrieve successfully"
+// @Router       /categories [get]
+func (h *CategoryHandler) GetCategoryTree(ctx *gin.Context) {
+	response, err := h.categoryService.GetCategoryTree(ctx.Request.Context())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Categories retrieve successfully", response)
+}
+
+// GetCategory godoc
+// @Summary      Get a category
+// @Description  Get a category with its ancestors and subcategories
+// @Tags         categories
+// @Produce      json
+// @Param        slug path        string true "Category slug"
+// @Success      200  {object}    pkg.Response{data=dto.CategoryDetailResponse} "Category retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Category not found"
+// @Router       /categories/{slug} [get]
+func (h *CategoryHandler) GetCategory(ctx *gin.Context) {
+	response, err := h.categoryService.GetCategory(ctx.Request.Context(), ctx.Param("slug"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Category retrieve successfully", response)
+}
+
+// GetCategoryBooks godoc
+// @Summary      List books in a category
+// @Description  List books in the category and all of its descendants
+// @Tags         categories
+// @Produce      json
+// @Param        slug  path     string true  "Category slug"
+// @Param        page  query    int    false "Page number" default(1)
+// @Param        limit query    int    false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.CategoryBooksResponse} "Books retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Category not found"
+// @Router       /categories/{slug}/books [get]
+func (h *CategoryHandler) GetCategoryBooks(ctx *gin.Context) {
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.categoryService.GetCategoryBooks(ctx.Request.Context(), ctx.Param("slug"), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Books retrieve successfully", response)
+}
+
+// UpdateCategory godoc
+// @Summary      Update a category
+// @Description  Rename a category or change its slug (staff only)
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int                 true "Category ID"
+// @Param        request body     dto.CategoryRequest true "Category information"
+// @Success      200  {object}    pkg.Response{data=dto.CategoryResponse} "Category updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Category not found"
+// @Failure      409  {object}    pkg.Response "Duplicate slug"
+// @Router       /categories/{id} [put]
+func (h *CategoryHandler) UpdateCategory(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid category id", err.Error())
+		return
+	}
+
+	var req dto.CategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.categoryService.UpdateCategory(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Category updated successfully", response)
+}
+
+// MoveCategory godoc
+// @Summary      Move a category
+// @Description  Move a category and its whole subtree below another parent (staff only)
+// @Tags         categories
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int                     true "Category ID"
+// @Param        request body     dto.MoveCategoryRequest true "New parent"
+// @Success      200  {object}    pkg.Response{data=dto.CategoryResponse} "Category moved successfully"
+// @Failure      400  {object}    pkg.Response "Cannot move below itself"
+// @Failure      404  {object}    pkg.Response "Category not found"
+// @Router       /categories/{id}/move [post]
+func (h *CategoryHandler) MoveCategory(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid category id", err.Error())
+		return
+	}
+
+	var req dto.MoveCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.categoryService.MoveCategory(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Category moved successfully", response)
+}
+
+// DeleteCategory godoc
+// @Summary      Delete a category
+// @Description  Remove a category without subcategories or books (staff only)
+// @Tags         categories
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Category ID"
+// @Success      200  {object}    pkg.Response "Category deleted successfully"
+// @Failure      404  {object}    pkg.Response "Category not found"
+// @Failure      409  {object}    pkg.Response "Category is not empty"
+// @Router       /categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid category id", err.Error())
+		return
+	}
+
+	if err := h.categoryService.DeleteCategory(ctx.Request.Context(), id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Category deleted successfully", nil)
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// coverFormOverhead leaves room for the multipart headers around the image
+// when limiting the request body.
+const coverFormOverhead = 64 << 10
+
+type CoverHandler struct {
+	coverService books.CoverService
+}
+
+func NewCoverHandler(coverService books.CoverService) *CoverHandler {
+	return &CoverHandler{
+		coverService: coverService,
+	}
+}
+
+// UploadCover godoc
+// @Summary      Upload a book cover
+// @Description  Replace the cover of a book (staff only). The image type is detected from its content; JPEG, PNG and WebP up to 5 MB and 6000 pixels per side are accepted. Thumbnails are generated 160, 320 and 640 pixels wide
+// @Tags         books
+// @Security     BearerAuth
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      int  true "Book ID"
+// @Param        cover formData  file true "Cover image"
+// @Success      200  {object}    pkg.Response{data=dto.CoverResponse} "Cover uploaded successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Access forbidden"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Failure      413  {object}    pkg.Response "Cover image too large"
+// @Failure      415  {object}    pkg.Response "Unsupported image type"
+// @Router       /books/{id}/cover [put]
+func (h *CoverHandler) UploadCover(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, books.MaxCoverSize+coverFormOverhead)
+	file, err := ctx.FormFile("cover")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handleError(ctx, books.ErrCoverTooLarge)
+			return
+		}
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+	if file.Size > books.MaxCoverSize {
+		handleError(ctx, books.ErrCoverTooLarge)
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+	defer src.Close()
+	// Read one byte past the limit so the service can tell an oversized file.
+	data, err := io.ReadAll(io.LimitReader(src, books.MaxCoverSize+1))
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	var response *dto.CoverResponse
+	response, err = h.coverService.UploadCover(ctx.Request.Context(), id, data)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Cover uploaded successfully", response)
+}
+
+// DeleteCover godoc
+// @Summary      Delete a book cover
+// @Description  Remove the cover of a book and its thumbnails (staff only)
+// @Tags         books
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int  true "Book ID"
+// @Success      200  {object}    pkg.Response "Cover deleted successfully"
+// @Failure      403  {object}    pkg.Response "Access forbidden"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /books/{id}/cover [delete]
+func (h *CoverHandler) DeleteCover(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	if err := h.coverService.DeleteCover(ctx.Request.Context(), id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Cover deleted successfully", nil)
+}
+
+// GetCover godoc
+// @Summary      Get a cover image
+// @Description  Serve a cover or thumbnail by the URL in the book's cover. The URLs change with every upload, so responses may be cached indefinitely
+// @Tags         books
+// @Produce      image/jpeg,image/png,image/webp
+// @Param        path path        string true "Cover path"
+// @Success      200  {file}      file "Cover image"
+// @Success      304  "Not modified"
+// @Failure      404  {object}    pkg.Response "Cover not found"
+// @Router       /covers/{path} [get]
+func (h *CoverHandler) GetCover(ctx *gin.Context) {
+	// Keys are immutable, so the path itself identifies the content.
+	etag := strconv.Quote(strings.TrimPrefix(path.Clean(ctx.Param("path")), "/"))
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Header("ETag", etag)
+	if match := ctx.GetHeader("If-None-Match"); match != "" && (match == etag || match == "*") {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	object, err := h.coverService.GetImage(ctx.Request.Context(), ctx.Param("path"))
+	if err != nil {
+		ctx.Header("Cache-Control", "no-store")
+		ctx.Header("ETag", "")
+		handleError(ctx, err)
+		return
+	}
+	defer object.Body.Close()
+
+	ctx.DataFromReader(http.StatusOK, object.Size, object.ContentType, object.Body, nil)
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// AuthorRequest represents a create or update author request
+// @Description Author request payload
+type AuthorRequest struct {
+	Name      string `json:"name" binding:"required,max=255" example:"Patrick Rothfuss"`
+	Biography string `json:"biography" example:"American author of epic fantasy."`
+	Website   string `json:"website" binding:"omitempty,url,max=255" example:"https://www.patrickrothfuss.com"`
+}
+
+// NameListQuery represents the query string of the author and publisher list endpoints
+type NameListQuery struct {
+	pkg.PaginationQuery
+	Name string `form:"name"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type AuthorResponse struct {
+	ID         uint      `json:"id"`
+	Name       string    `json:"name"`
+	Biography  string    `json:"biography,omitempty"`
+	Website    string    `json:"website,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+type AuthorListResponse struct {
+	Authors    []AuthorResponse   `json:"authors"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
+
+type BibliographyResponse struct {
+	Author     AuthorResponse     `json:"author"`
+	Books      []BookResponse     `json:"books"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// BookRequest represents a create or update book request
+// @Description Book request payload, price is in minor currency units. The ISBN may be an ISBN-10 or ISBN-13, with or without hyphens, and is stored as a compact ISBN-13.
+// @Description tax_class selects the tax rates of the book, "book" when empty; use "ebook" for digital editions
+// @Description Until its release_date the book can be pre-ordered. A backorderable book can be ordered when out of stock
+type BookRequest struct {
+	Title           string              `json:"title" binding:"required,max=255" example:"The Name of the Wind"`
+	Subtitle        string              `json:"subtitle" binding:"max=255" example:"The Kingkiller Chronicle: Day One"`
+	ISBN            string              `json:"isbn" binding:"required,max=17" example:"9780756404741"`
+	SKU             string              `json:"sku" binding:"max=64" example:"9780756404741"`
+	Description     string              `json:"description" example:"The riveting first-person narrative of a young man..."`
+	Price           int64               `json:"price" binding:"gte=0" example:"1299"`
+	Currency        string              `json:"currency" binding:"omitempty,len=3,uppercase" example:"USD"`
+	TaxClass        string              `json:"tax_class" binding:"max=32" example:"book"`
+	Language        string              `json:"language" binding:"max=8" example:"en"`
+	PageCount       int                 `json:"page_count" binding:"gte=0" example:"662"`
+	PublicationDate string              `json:"publication_date" binding:"omitempty,datetime=2006-01-02" example:"2007-03-27"`
+	ReleaseDate     string              `json:"release_date" binding:"omitempty,datetime=2006-01-02" example:"2007-04-01"`
+	Backorderable   bool                `json:"backorderable" example:"false"`
+	PublisherID     *uint               `json:"publisher_id" example:"1"`
+	Authors         []BookAuthorRequest `json:"authors" binding:"dive"`
+	CategoryIDs     []uint              `json:"category_ids" example:"4,9"`
+}
+
+// BookAuthorRequest links an existing author to a book, contributors are
+// ordered as they appear in the request
+type BookAuthorRequest struct {
+	AuthorID uint   `json:"author_id" binding:"required" example:"1"`
+	Role     string `json:"role" binding:"omitempty,oneof=author editor translator illustrator" example:"author"`
+}
+
+// BookListQuery represents the query string of the book list endpoint
+type BookListQuery struct {
+	pkg.PaginationQuery
+	Title    string `form:"title"`
+	Language string `form:"language"`
+	Category string `form:"category"`
+	Currency string `form:"currency" binding:"omitempty,iso4217"`
+}
+
+// BookQuery represents the query string of the book endpoint
+type BookQuery struct {
+	Currency string `form:"currency" binding:"omitempty,iso4217"`
+}
+
+// BookSearchQuery represents the query string of the book search endpoint.
+// Language selects the stemming dictionary and restricts results to books in
+// that language.
+type BookSearchQuery struct {
+	pkg.PaginationQuery
+	Q        string `form:"q" binding:"required,max=200"`
+	Language string `form:"language" binding:"max=8"`
+	Category string `form:"category"`
+	Currency string `form:"currency" binding:"omitempty,iso4217"`
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type BookResponse struct {
+	ID              uint                 `json:"id"`
+	Title           string               `json:"title"`
+	Subtitle        string               `json:"subtitle,omitempty"`
+	ISBN            string               `json:"isbn"`
+	ISBN10          string               `json:"isbn_10,omitempty"`
+	ISBNDisplay     string               `json:"isbn_display"`
+	SKU             string               `json:"sku"`
+	Description     string               `json:"description,omitempty"`
+	Price           int64                `json:"price"`
+	Currency        string               `json:"currency"`
+	PriceConverted  bool                 `json:"price_converted,omitempty"`
+	TaxClass        string               `json:"tax_class"`
+	Language        string               `json:"language,omitempty"`
+	PageCount       int                  `json:"page_count,omitempty"`
+	PublicationDate string               `json:"publication_date,omitempty"`
+	ReleaseDate     string               `json:"release_date,omitempty"`
+	Preorder        bool                 `json:"preorder"`
+	Backorderable   bool                 `json:"backorderable"`
+	Publisher       *PublisherResponse   `json:"publisher,omitempty"`
+	Authors         []BookAuthorResponse `json:"authors"`
+	Categories      []CategoryResponse   `json:"categories"`
+	Rating          RatingResponse       `json:"rating"`
+	Cover           *CoverResponse       `json:"cover,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+	ModifiedAt      time.Time            `json:"modified_at"`
+}
+
+// RatingResponse summarizes the ratings of a book. Average is 0 without
+// reviews; Histogram counts the reviews by stars, from "1" to "5".
+type RatingResponse struct {
+	Average   float64     `json:"average"`
+	Count     int         `json:"count"`
+	Histogram map[int]int `json:"histogram"`
+}
+
+// CoverResponse links the cover image and its thumbnails. The URLs change
+// with every upload, so they may be cached indefinitely.
+type CoverResponse struct {
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Original string `json:"original"`
+	Small    string `json:"small"`
+	Medium   string `json:"medium"`
+	Large    string `json:"large"`
+}
+
+type BookAuthorResponse struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	Position int    `json:"position"`
+}
+
+type BookListResponse struct {
+	Books      []BookResponse     `json:"books"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
+
+// BookSearchResult is a ranked search hit, matched terms in the highlights
+// are wrapped in <mark> tags
+type BookSearchResult struct {
+	Book           BookResponse `json:"book"`
+	Rank           float64      `json:"rank"`
+	TitleHighlight string       `json:"title_highlight"`
+	Snippet        string       `json:"snippet,omitempty"`
+}
+
+type BookSearchResponse struct {
+	Results    []BookSearchResult `json:"results"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
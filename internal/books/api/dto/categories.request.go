@@ -0,0 +1,15 @@
+package dto
+
+// CategoryRequest represents a create or update category request
+// @Description Category request payload, the slug is derived from the name when omitted
+type CategoryRequest struct {
+	Name     string `json:"name" binding:"required,max=100" example:"Epic Fantasy"`
+	Slug     string `json:"slug" binding:"omitempty,max=120" example:"epic-fantasy"`
+	ParentID *uint  `json:"parent_id" example:"2"`
+}
+
+// MoveCategoryRequest represents a request to re-parent a category
+// @Description Move category payload, a null parent moves the category to the root
+type MoveCategoryRequest struct {
+	ParentID *uint `json:"parent_id" example:"2"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+type CategoryResponse struct {
+	ID       uint   `json:"id"`
+	ParentID *uint  `json:"parent_id,omitempty"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	Depth    int    `json:"depth"`
+}
+
+type CategoryTreeResponse struct {
+	CategoryResponse
+	Children []CategoryTreeResponse `json:"children"`
+}
+
+type CategoryDetailResponse struct {
+	CategoryResponse
+	Ancestors []CategoryResponse     `json:"ancestors"`
+	Children  []CategoryTreeResponse `json:"children"`
+}
+
+type CategoryBooksResponse struct {
+	Category   CategoryResponse   `json:"category"`
+	Books      []BookResponse     `json:"books"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+// PublisherRequest represents a create or update publisher request
+// @Description Publisher request payload
+type PublisherRequest struct {
+	Name    string `json:"name" binding:"required,max=255" example:"DAW Books"`
+	Website string `json:"website" binding:"omitempty,url,max=255" example:"https://www.dawbooks.com"`
+	Country string `json:"country" binding:"omitempty,len=2,uppercase" example:"US"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type PublisherResponse struct {
+	ID         uint      `json:"id"`
+	Name       string    `json:"name"`
+	Website    string    `json:"website,omitempty"`
+	Country    string    `json:"country,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+type PublisherListResponse struct {
+	Publishers []PublisherResponse `json:"publishers"`
+	Pagination pkg.PaginationMeta  `json:"pagination"`
+}
+
+type PublisherBooksResponse struct {
+	Publisher  PublisherResponse  `json:"publisher"`
+	Books      []BookResponse     `json:"books"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PublisherHandler struct {
+	publisherService books.PublisherService
+}
+
+func NewPublisherHandler(publisherService books.PublisherService) *PublisherHandler {
+	return &PublisherHandler{
+		publisherService: publisherService,
+	}
+}
+
+// CreatePublisher godoc
+// @Summary      Create a publisher
+// @Description  Add a new publisher (staff only)
+// @Tags         publishers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.PublisherRequest true "Publisher information"
+// @Success      201  {object}    pkg.Response{data=dto.PublisherResponse} "Publisher created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      409  {object}    pkg.Response "Duplicate publisher"
+// @Router       /publishers [post]
+func (h *PublisherHandler) CreatePublisher(ctx *gin.Context) {
+	var req dto.PublisherRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.publisherService.CreatePublisher(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Publisher created successfully", response)
+}
+
+// GetPublishers godoc
+// @Summary      List publishers
+// @Description  List publishers with an optional name filter
+// @Tags         publishers
+// @Produce      json
+// @Param        page  query    int    false "Page number" default(1)
+// @Param        limit query    int    false "Page size" default(20)
+// @Param        name  query    string false "Filter by name"
+// @Success      200  {object}    pkg.Response{data=dto.PublisherListResponse} "Publishers retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /publishers [get]
+func (h *PublisherHandler) GetPublishers(ctx *gin.Context) {
+	var query dto.NameListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.publisherService.GetPublishers(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Publishers retrieve successfully", response)
+}
+
+// GetPublisher godoc
+// @Summary      Get a publisher
+// @Description  Get a single publisher by id
+// @Tags         publishers
+// @Produce      json
+// @Param        id   path        int true "Publisher ID"
+// @Success      200  {object}    pkg.Response{data=dto.PublisherResponse} "Publisher retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Publisher not found"
+// @Router       /publishers/{id} [get]
+func (h *PublisherHandler) GetPublisher(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid publisher id", err.Error())
+		return
+	}
+
+	response, err := h.publisherService.GetPublisher(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Publisher retrieve successfully", response)
+}
+
+// GetPublisherBooks godoc
+// @Summary      List a publisher's books
+// @Description  List the books published by a publisher
+// @Tags         publishers
+// @Produce      json
+// @Param        id    path     int true  "Publisher ID"
+// @Param        page  query    int false "Page number" default(1)
+// @Param        limit query    int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.PublisherBooksResponse} "Books retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Publisher not found"
+// @Router       /publishers/{id}/books [get]
+func (h *PublisherHandler) GetPublisherBooks(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid publisher id", err.Error())
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.publisherService.GetPublisherBooks(ctx.Request.Context(), id, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Books retrieve successfully", response)
+}
+
+// UpdatePublisher godoc
+// @Summary      Update a publisher
+// @Description  Replace the details of a publisher (staff only)
+// @Tags         publishers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int                  true "Publisher ID"
+// @Param        request body     dto.PublisherRequest true "Publisher information"
+// @Success      200  {object}    pkg.Response{data=dto.PublisherResponse} "Publisher updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Publisher not found"
+// @Router       /publishers/{id} [put]
+func (h *PublisherHandler) UpdatePublisher(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid publisher id", err.Error())
+		return
+	}
+
+	var req dto.PublisherRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.publisherService.UpdatePublisher(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Publisher updated successfully", response)
+}
+
+// DeletePublisher godoc
+// @Summary      Delete a publisher
+// @Description  Remove a publisher that has no books (staff only)
+// @Tags         publishers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Publisher ID"
+// @Success      200  {object}    pkg.Response "Publisher deleted successfully"
+// @Failure      404  {object}    pkg.Response "Publisher not found"
+// @Failure      409  {object}    pkg.Response "Publisher is still linked to books"
+// @Router       /publishers/{id} [delete]
+func (h *PublisherHandler) DeletePublisher(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid publisher id", err.Error())
+		return
+	}
+
+	if err := h.publisherService.DeletePublisher(ctx.Request.Context(), id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Publisher deleted successfully", nil)
+}
@@ -0,0 +1,42 @@
+package books
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RoleAuthor      = "author"
+	RoleEditor      = "editor"
+	RoleTranslator  = "translator"
+	RoleIllustrator = "illustrator"
+)
+
+type Author struct {
+	ID         uint           `gorm:"primaryKey"`
+	Name       string         `gorm:"column:name;size:255;not null;index"`
+	Biography  string         `gorm:"column:biography;type:text"`
+	Website    string         `gorm:"column:website;size:255"`
+	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time      `gorm:"column:modified_at;autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
+
+func (Author) TableName() string {
+	return "authors"
+}
+
+// BookAuthor links a book to one of its contributors. The same author may
+// appear more than once on a book with different roles.
+type BookAuthor struct {
+	BookID   uint   `gorm:"column:book_id;primaryKey"`
+	AuthorID uint   `gorm:"column:author_id;primaryKey;index"`
+	Role     string `gorm:"column:role;size:20;primaryKey"`
+	Position int    `gorm:"column:position;not null;default:0"`
+	Author   Author `gorm:"foreignKey:AuthorID"`
+}
+
+func (BookAuthor) TableName() string {
+	return "book_authors"
+}
@@ -0,0 +1,105 @@
+package books
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type AuthorRepository interface {
+	Create(ctx context.Context, author *Author) (*Author, error)
+	FindAll(ctx context.Context, name string, offset, limit int) ([]Author, int64, error)
+	FindByID(ctx context.Context, id uint) (*Author, error)
+	CountByIDs(ctx context.Context, ids []uint) (int64, error)
+	CountBooks(ctx context.Context, id uint) (int64, error)
+	Update(ctx context.Context, author *Author) (*Author, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type authorRepository struct {
+	db *gorm.DB
+}
+
+func NewAuthorRepository(db *gorm.DB) AuthorRepository {
+	return &authorRepository{
+		db: db,
+	}
+}
+
+func (r *authorRepository) Create(ctx context.Context, author *Author) (*Author, error) {
+	result := r.db.WithContext(ctx).Create(author)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return author, nil
+}
+
+func (r *authorRepository) FindAll(ctx context.Context, name string, offset, limit int) ([]Author, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Author{})
+	if name != "" {
+		query = query.Where("name ILIKE ?", "%"+name+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var authors []Author
+	result := query.Order("name").Offset(offset).Limit(limit).Find(&authors)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return authors, total, nil
+}
+
+func (r *authorRepository) FindByID(ctx context.Context, id uint) (*Author, error) {
+	var author *Author
+	result := r.db.WithContext(ctx).First(&author, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return author, nil
+}
+
+func (r *authorRepository) CountByIDs(ctx context.Context, ids []uint) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&Author{}).Where("id IN ?", ids).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (r *authorRepository) CountBooks(ctx context.Context, id uint) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&BookAuthor{}).
+		Joins("JOIN books ON books.id = book_authors.book_id AND books.deleted_at IS NULL").
+		Where("book_authors.author_id = ?", id).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (r *authorRepository) Update(ctx context.Context, author *Author) (*Author, error) {
+	result := r.db.WithContext(ctx).Save(author)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return author, nil
+}
+
+func (r *authorRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&Author{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
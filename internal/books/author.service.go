@@ -0,0 +1,150 @@
+package books
+
+import (
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAuthorNotFound = errors.New("author not found")
+	ErrAuthorHasBooks = errors.New("author is still linked to books")
+)
+
+type AuthorService interface {
+	CreateAuthor(ctx context.Context, req dto.AuthorRequest) (*dto.AuthorResponse, error)
+	GetAuthors(ctx context.Context, query dto.NameListQuery) (*dto.AuthorListResponse, error)
+	GetAuthor(ctx context.Context, id uint) (*dto.AuthorResponse, error)
+	GetBibliography(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.BibliographyResponse, error)
+	UpdateAuthor(ctx context.Context, id uint, req dto.AuthorRequest) (*dto.AuthorResponse, error)
+	DeleteAuthor(ctx context.Context, id uint) error
+}
+
+type authorService struct {
+	authorRepo AuthorRepository
+	bookRepo   BookRepository
+}
+
+func NewAuthorService(authorRepo AuthorRepository, bookRepo BookRepository) AuthorService {
+	return &authorService{
+		authorRepo: authorRepo,
+		bookRepo:   bookRepo,
+	}
+}
+
+func (s *authorService) CreateAuthor(ctx context.Context, req dto.AuthorRequest) (*dto.AuthorResponse, error) {
+	author := &Author{
+		Name:      req.Name,
+		Biography: req.Biography,
+		Website:   req.Website,
+	}
+
+	created, err := s.authorRepo.Create(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToAuthorResponse(created), nil
+}
+
+func (s *authorService) GetAuthors(ctx context.Context, query dto.NameListQuery) (*dto.AuthorListResponse, error) {
+	authors, total, err := s.authorRepo.FindAll(ctx, query.Name, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.AuthorListResponse{
+		Authors:    make([]dto.AuthorResponse, 0, len(authors)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range authors {
+		response.Authors = append(response.Authors, *ToAuthorResponse(&authors[i]))
+	}
+
+	return response, nil
+}
+
+func (s *authorService) GetAuthor(ctx context.Context, id uint) (*dto.AuthorResponse, error) {
+	author, err := s.authorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateAuthorError(err)
+	}
+
+	return ToAuthorResponse(author), nil
+}
+
+func (s *authorService) GetBibliography(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.BibliographyResponse, error) {
+	author, err := s.authorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateAuthorError(err)
+	}
+
+	books, total, err := s.bookRepo.FindAll(ctx, BookFilter{
+		AuthorID: id,
+		Offset:   query.Offset(),
+		Limit:    query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.BibliographyResponse{
+		Author:     *ToAuthorResponse(author),
+		Books:      ToBookResponses(books),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}, nil
+}
+
+func (s *authorService) UpdateAuthor(ctx context.Context, id uint, req dto.AuthorRequest) (*dto.AuthorResponse, error) {
+	author, err := s.authorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateAuthorError(err)
+	}
+
+	author.Name = req.Name
+	author.Biography = req.Biography
+	author.Website = req.Website
+
+	updated, err := s.authorRepo.Update(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToAuthorResponse(updated), nil
+}
+
+func (s *authorService) DeleteAuthor(ctx context.Context, id uint) error {
+	count, err := s.authorRepo.CountBooks(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrAuthorHasBooks
+	}
+
+	if err := s.authorRepo.Delete(ctx, id); err != nil {
+		return translateAuthorError(err)
+	}
+	return nil
+}
+
+func translateAuthorError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrAuthorNotFound
+	}
+	return err
+}
+
+func ToAuthorResponse(author *Author) *dto.AuthorResponse {
+	return &dto.AuthorResponse{
+		ID:         author.ID,
+		Name:       author.Name,
+		Biography:  author.Biography,
+		Website:    author.Website,
+		CreatedAt:  author.CreatedAt,
+		ModifiedAt: author.ModifiedAt,
+	}
+}
@@ -0,0 +1,72 @@
+package books
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Tax classes group books that are taxed alike, tax rates are set for each
+// class and destination. Any other class name may be used as well.
+const (
+	TaxClassBook  = "book"
+	TaxClassEbook = "ebook"
+)
+
+// Book carries the rating aggregates of its reviews. They are read-only here:
+// saving a book never writes them, only reviews adjust them, by increments in
+// the transaction of the review, so concurrent reviews and edits all count.
+// The cover is read-only as well, it changes through SetCover only. CoverKey
+// is the blob key of the original image, empty for books without a cover.
+//
+// Until its ReleaseDate a book is on pre-order: it can be ordered whether or
+// not there is stock, and orders wait for the release. A backorderable book
+// can be ordered when it is out of stock, orders wait for copies to arrive.
+type Book struct {
+	ID              uint           `gorm:"primaryKey"`
+	Title           string         `gorm:"column:title;size:255;not null;index"`
+	Subtitle        string         `gorm:"column:subtitle;size:255"`
+	ISBN            string         `gorm:"column:isbn;size:17;uniqueIndex;not null"`
+	SKU             string         `gorm:"column:sku;size:64;uniqueIndex"`
+	Description     string         `gorm:"column:description;type:text"`
+	Price           int64          `gorm:"column:price;not null"`
+	Currency        string         `gorm:"column:currency;size:3;not null;default:USD"`
+	TaxClass        string         `gorm:"column:tax_class;size:32;not null;default:book"`
+	Language        string         `gorm:"column:language;size:8;index"`
+	PageCount       int            `gorm:"column:page_count"`
+	PublicationDate *time.Time     `gorm:"column:publication_date;type:date"`
+	ReleaseDate     *time.Time     `gorm:"column:release_date;type:date"`
+	Backorderable   bool           `gorm:"column:backorderable;not null;default:false"`
+	PublisherID     *uint          `gorm:"column:publisher_id;index"`
+	Publisher       *Publisher     `gorm:"foreignKey:PublisherID"`
+	Authors         []BookAuthor   `gorm:"foreignKey:BookID;constraint:OnDelete:CASCADE"`
+	Categories      []Category     `gorm:"many2many:book_categories;constraint:OnDelete:CASCADE"`
+	RatingCount     int            `gorm:"->;column:rating_count;not null;default:0"`
+	RatingSum       int            `gorm:"->;column:rating_sum;not null;default:0"`
+	Rating1Count    int            `gorm:"->;column:rating_1_count;not null;default:0"`
+	Rating2Count    int            `gorm:"->;column:rating_2_count;not null;default:0"`
+	Rating3Count    int            `gorm:"->;column:rating_3_count;not null;default:0"`
+	Rating4Count    int            `gorm:"->;column:rating_4_count;not null;default:0"`
+	Rating5Count    int            `gorm:"->;column:rating_5_count;not null;default:0"`
+	CoverKey        string         `gorm:"->;column:cover_key;size:255"`
+	CoverWidth      int            `gorm:"->;column:cover_width;not null;default:0"`
+	CoverHeight     int            `gorm:"->;column:cover_height;not null;default:0"`
+	CreatedAt       time.Time      `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt      time.Time      `gorm:"column:modified_at;autoUpdateTime"`
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
+
+func (Book) TableName() string {
+	return "books"
+}
+
+// IsPreorder reports whether the book is not released yet at now.
+func (b Book) IsPreorder(now time.Time) bool {
+	return b.ReleaseDate != nil && b.ReleaseDate.After(now)
+}
+
+// AcceptsBackorders reports whether orders for the book are taken beyond the
+// copies in stock.
+func (b Book) AcceptsBackorders(now time.Time) bool {
+	return b.Backorderable || b.IsPreorder(now)
+}
@@ -0,0 +1,305 @@
+package books
+
+import (
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type BookFilter struct {
+	Title       string
+	Language    string
+	AuthorID    uint
+	PublisherID uint
+	// CategoryPath matches books in the category and all of its descendants.
+	CategoryPath string
+	Offset       int
+	Limit        int
+}
+
+// BookSearchFilter describes a full-text query. Every term must match, each
+// as a prefix, after stemming with the dictionary of SearchLanguage.
+type BookSearchFilter struct {
+	Terms          []string
+	SearchLanguage string
+	Language       string
+	CategoryPath   string
+	Offset         int
+	Limit          int
+}
+
+type BookSearchHit struct {
+	Book           Book
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+type BookRepository interface {
+	Create(ctx context.Context, book *Book) (*Book, error)
+	FindAll(ctx context.Context, filter BookFilter) ([]Book, int64, error)
+	FindByID(ctx context.Context, id uint) (*Book, error)
+	FindBySKU(ctx context.Context, sku string) (*Book, error)
+	FindByISBN(ctx context.Context, isbn string) (*Book, error)
+	Search(ctx context.Context, filter BookSearchFilter) ([]BookSearchHit, int64, error)
+	Update(ctx context.Context, book *Book) (*Book, error)
+	Delete(ctx context.Context, id uint) error
+	// SetCover stores the blob key and size of the book's cover, an empty key
+	// removes it.
+	SetCover(ctx context.Context, id uint, key string, width, height int) error
+}
+
+type bookRepository struct {
+	db *gorm.DB
+}
+
+func NewBookRepository(db *gorm.DB) BookRepository {
+	return &bookRepository{
+		db: db,
+	}
+}
+
+func (r *bookRepository) Create(ctx context.Context, book *Book) (*Book, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Publisher", "Authors", "Categories").Create(book).Error; err != nil {
+			return err
+		}
+		if err := replaceBookAuthors(tx, book); err != nil {
+			return err
+		}
+		return replaceBookCategories(tx, book)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func (r *bookRepository) FindAll(ctx context.Context, filter BookFilter) ([]Book, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Book{})
+	if filter.Title != "" {
+		query = query.Where("title ILIKE ?", "%"+filter.Title+"%")
+	}
+	if filter.Language != "" {
+		query = query.Where("language = ?", filter.Language)
+	}
+	if filter.AuthorID != 0 {
+		query = query.Where("id IN (?)", r.db.Model(&BookAuthor{}).Select("book_id").Where("author_id = ?", filter.AuthorID))
+	}
+	if filter.PublisherID != 0 {
+		query = query.Where("publisher_id = ?", filter.PublisherID)
+	}
+	if filter.CategoryPath != "" {
+		query = query.Where("id IN (?)", r.booksInCategory(filter.CategoryPath))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var books []Book
+	result := preloadBook(query).Order("id").Offset(filter.Offset).Limit(filter.Limit).Find(&books)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return books, total, nil
+}
+
+func (r *bookRepository) FindByID(ctx context.Context, id uint) (*Book, error) {
+	var book *Book
+	result := preloadBook(r.db.WithContext(ctx)).First(&book, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return book, nil
+}
+
+func (r *bookRepository) FindBySKU(ctx context.Context, sku string) (*Book, error) {
+	var book *Book
+	result := r.db.WithContext(ctx).Where("sku = ?", sku).First(&book)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return book, nil
+}
+
+func (r *bookRepository) FindByISBN(ctx context.Context, isbn string) (*Book, error) {
+	var book *Book
+	result := preloadBook(r.db.WithContext(ctx)).Where("isbn = ?", isbn).First(&book)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return book, nil
+}
+
+// Search matches books against the search_vector column maintained by the
+// catalog migrations. Headlines are only computed for the requested page, as
+// Postgres evaluates them after the sort and limit.
+func (r *bookRepository) Search(ctx context.Context, filter BookSearchFilter) ([]BookSearchHit, int64, error) {
+	query := r.db.WithContext(ctx).
+		Table("books, (SELECT config, to_tsquery(config, ?) AS query FROM (SELECT catalog_search_config(?) AS config) c) q",
+			prefixQuery(filter.Terms), filter.SearchLanguage).
+		Where("books.search_vector @@ q.query").
+		Where("books.deleted_at IS NULL")
+	if filter.Language != "" {
+		query = query.Where("books.language = ?", filter.Language)
+	}
+	if filter.CategoryPath != "" {
+		query = query.Where("books.id IN (?)", r.booksInCategory(filter.CategoryPath))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		ID             uint
+		Rank           float64
+		TitleHighlight string
+		Snippet        string
+	}
+	err := query.Select(`books.id,
+		ts_rank(books.search_vector, q.query) AS rank,
+		ts_headline(q.config, books.title, q.query, 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS title_highlight,
+		ts_headline(q.config, coalesce(books.description, ''), q.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS snippet`).
+		Order("rank DESC, books.id").Offset(filter.Offset).Limit(filter.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return []BookSearchHit{}, total, nil
+	}
+
+	ids := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	var books []Book
+	if err := preloadBook(r.db.WithContext(ctx)).Find(&books, ids).Error; err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[uint]Book, len(books))
+	for _, book := range books {
+		byID[book.ID] = book
+	}
+
+	hits := make([]BookSearchHit, 0, len(rows))
+	for _, row := range rows {
+		book, ok := byID[row.ID]
+		if !ok {
+			continue
+		}
+		hits = append(hits, BookSearchHit{
+			Book:           book,
+			Rank:           row.Rank,
+			TitleHighlight: row.TitleHighlight,
+			Snippet:        row.Snippet,
+		})
+	}
+	return hits, total, nil
+}
+
+func (r *bookRepository) Update(ctx context.Context, book *Book) (*Book, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Publisher", "Authors", "Categories").Save(book).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("book_id = ?", book.ID).Delete(&BookAuthor{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("book_id = ?", book.ID).Delete(&BookCategory{}).Error; err != nil {
+			return err
+		}
+		if err := replaceBookAuthors(tx, book); err != nil {
+			return err
+		}
+		return replaceBookCategories(tx, book)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func (r *bookRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&Book{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *bookRepository) SetCover(ctx context.Context, id uint, key string, width, height int) error {
+	result := r.db.WithContext(ctx).Table(Book{}.TableName()).
+		Where("id = ? AND deleted_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"cover_key":    key,
+			"cover_width":  width,
+			"cover_height": height,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *bookRepository) booksInCategory(path string) *gorm.DB {
+	return r.db.Model(&BookCategory{}).
+		Select("book_categories.book_id").
+		Joins("JOIN categories ON categories.id = book_categories.category_id").
+		Where("categories.path LIKE ?", path+"%")
+}
+
+// prefixQuery builds a to_tsquery expression requiring every term as a
+// prefix. Terms must already be reduced to letters and digits.
+func prefixQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		parts = append(parts, term+":*")
+	}
+	return strings.Join(parts, " & ")
+}
+
+func preloadBook(db *gorm.DB) *gorm.DB {
+	return db.Preload("Publisher").
+		Preload("Authors", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Preload("Authors.Author").
+		Preload("Categories")
+}
+
+func replaceBookAuthors(tx *gorm.DB, book *Book) error {
+	if len(book.Authors) == 0 {
+		return nil
+	}
+	for i := range book.Authors {
+		book.Authors[i].BookID = book.ID
+	}
+	return tx.Omit("Author").Create(&book.Authors).Error
+}
+
+func replaceBookCategories(tx *gorm.DB, book *Book) error {
+	if len(book.Categories) == 0 {
+		return nil
+	}
+	links := make([]BookCategory, 0, len(book.Categories))
+	for _, c := range book.Categories {
+		links = append(links, BookCategory{BookID: book.ID, CategoryID: c.ID})
+	}
+	return tx.Create(&links).Error
+}
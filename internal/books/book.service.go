@@ -0,0 +1,502 @@
+package books
+
+import (
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/isbn"
+	"bookstore-framework/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+const (
+	dateLayout            = "2006-01-02"
+	defaultCurrency       = "USD"
+	defaultSearchLanguage = "en"
+	maxSearchTerms        = 10
+)
+
+var (
+	ErrBookNotFound         = errors.New("book not found")
+	ErrDuplicateISBN        = errors.New("a book with this ISBN or SKU already exists")
+	ErrInvalidISBN          = errors.New("invalid ISBN")
+	ErrDuplicateContributor = errors.New("an author can only be listed once per role")
+	ErrEmptySearch          = errors.New("search query must contain at least one letter or digit")
+	ErrCurrencyNotAvailable = errors.New("prices are not available in this currency")
+)
+
+type BookService interface {
+	CreateBook(ctx context.Context, req dto.BookRequest) (*dto.BookResponse, error)
+	GetBooks(ctx context.Context, query dto.BookListQuery) (*dto.BookListResponse, error)
+	// GetBook shows the book priced in currency, or at its catalog price when
+	// currency is empty. Lists and searches take the currency in their query.
+	GetBook(ctx context.Context, id uint, currency string) (*dto.BookResponse, error)
+	GetBookByBarcode(ctx context.Context, code string) (*dto.BookResponse, error)
+	SearchBooks(ctx context.Context, query dto.BookSearchQuery) (*dto.BookSearchResponse, error)
+	UpdateBook(ctx context.Context, id uint, req dto.BookRequest) (*dto.BookResponse, error)
+	DeleteBook(ctx context.Context, id uint) error
+}
+
+type bookService struct {
+	bookRepo       BookRepository
+	authorRepo     AuthorRepository
+	publisherRepo  PublisherRepository
+	categoryRepo   CategoryRepository
+	pricingService pricing.PricingService
+}
+
+func NewBookService(bookRepo BookRepository, authorRepo AuthorRepository, publisherRepo PublisherRepository, categoryRepo CategoryRepository, pricingService pricing.PricingService) BookService {
+	return &bookService{
+		bookRepo:       bookRepo,
+		authorRepo:     authorRepo,
+		publisherRepo:  publisherRepo,
+		categoryRepo:   categoryRepo,
+		pricingService: pricingService,
+	}
+}
+
+func (s *bookService) CreateBook(ctx context.Context, req dto.BookRequest) (*dto.BookResponse, error) {
+	if err := s.validateReferences(ctx, req); err != nil {
+		return nil, err
+	}
+
+	book := &Book{}
+	if err := applyBookRequest(book, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.bookRepo.Create(ctx, book)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return s.GetBook(ctx, created.ID, "")
+}
+
+func (s *bookService) GetBooks(ctx context.Context, query dto.BookListQuery) (*dto.BookListResponse, error) {
+	filter := BookFilter{
+		Title:    query.Title,
+		Language: query.Language,
+		Offset:   query.Offset(),
+		Limit:    query.Limit,
+	}
+	if query.Category != "" {
+		category, err := s.categoryRepo.FindBySlug(ctx, query.Category)
+		if err != nil {
+			return nil, translateCategoryError(err)
+		}
+		filter.CategoryPath = category.Path
+	}
+
+	books, total, err := s.bookRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := ToBookResponses(books)
+	prices := make([]*dto.BookResponse, 0, len(responses))
+	for i := range responses {
+		prices = append(prices, &responses[i])
+	}
+	if err := s.priceIn(ctx, query.Currency, prices); err != nil {
+		return nil, err
+	}
+
+	return &dto.BookListResponse{
+		Books:      responses,
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}, nil
+}
+
+func (s *bookService) GetBook(ctx context.Context, id uint, currency string) (*dto.BookResponse, error) {
+	book, err := s.bookRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	response := ToBookResponse(book)
+	if err := s.priceIn(ctx, currency, []*dto.BookResponse{response}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *bookService) GetBookByBarcode(ctx context.Context, code string) (*dto.BookResponse, error) {
+	normalized, err := isbn.FromBarcode(code)
+	if err != nil {
+		return nil, ErrInvalidISBN
+	}
+
+	book, err := s.bookRepo.FindByISBN(ctx, normalized)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return ToBookResponse(book), nil
+}
+
+func (s *bookService) SearchBooks(ctx context.Context, query dto.BookSearchQuery) (*dto.BookSearchResponse, error) {
+	terms := searchTerms(query.Q)
+	if len(terms) == 0 {
+		return nil, ErrEmptySearch
+	}
+
+	filter := BookSearchFilter{
+		Terms:          terms,
+		SearchLanguage: query.Language,
+		Language:       query.Language,
+		Offset:         query.Offset(),
+		Limit:          query.Limit,
+	}
+	if filter.SearchLanguage == "" {
+		filter.SearchLanguage = defaultSearchLanguage
+	}
+	if query.Category != "" {
+		category, err := s.categoryRepo.FindBySlug(ctx, query.Category)
+		if err != nil {
+			return nil, translateCategoryError(err)
+		}
+		filter.CategoryPath = category.Path
+	}
+
+	hits, total, err := s.bookRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.BookSearchResult, 0, len(hits))
+	for i := range hits {
+		results = append(results, dto.BookSearchResult{
+			Book:           *ToBookResponse(&hits[i].Book),
+			Rank:           hits[i].Rank,
+			TitleHighlight: hits[i].TitleHighlight,
+			Snippet:        hits[i].Snippet,
+		})
+	}
+	prices := make([]*dto.BookResponse, 0, len(results))
+	for i := range results {
+		prices = append(prices, &results[i].Book)
+	}
+	if err := s.priceIn(ctx, query.Currency, prices); err != nil {
+		return nil, err
+	}
+
+	return &dto.BookSearchResponse{
+		Results:    results,
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}, nil
+}
+
+func (s *bookService) UpdateBook(ctx context.Context, id uint, req dto.BookRequest) (*dto.BookResponse, error) {
+	book, err := s.bookRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	if err := s.validateReferences(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err := applyBookRequest(book, req); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.bookRepo.Update(ctx, book)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return s.GetBook(ctx, updated.ID, "")
+}
+
+func (s *bookService) DeleteBook(ctx context.Context, id uint) error {
+	if err := s.bookRepo.Delete(ctx, id); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+// priceIn replaces the catalog prices of the books with their prices in
+// currency. Nothing changes when currency is empty.
+func (s *bookService) priceIn(ctx context.Context, currency string, responses []*dto.BookResponse) error {
+	if currency == "" || len(responses) == 0 {
+		return nil
+	}
+
+	items := make([]pricing.Item, 0, len(responses))
+	for _, response := range responses {
+		items = append(items, pricing.Item{BookID: response.ID, Price: money.New(response.Price, response.Currency)})
+	}
+	quotes, err := s.pricingService.Quote(ctx, currency, items)
+	if errors.Is(err, pricing.ErrUnsupportedCurrency) {
+		return fmt.Errorf("%w: %s", ErrCurrencyNotAvailable, currency)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, response := range responses {
+		quote, ok := quotes[response.ID]
+		if !ok {
+			return fmt.Errorf("%w: no exchange rate from %s to %s", ErrCurrencyNotAvailable, response.Currency, currency)
+		}
+		response.Price = quote.Amount
+		response.Currency = quote.Currency
+		response.PriceConverted = quote.Converted
+	}
+	return nil
+}
+
+// validateReferences checks that the publisher, categories and every
+// contributor exist before the book is written, so callers get a 404 instead
+// of a foreign key error.
+func (s *bookService) validateReferences(ctx context.Context, req dto.BookRequest) error {
+	if req.PublisherID != nil {
+		if _, err := s.publisherRepo.FindByID(ctx, *req.PublisherID); err != nil {
+			return translatePublisherError(err)
+		}
+	}
+
+	if len(req.CategoryIDs) > 0 {
+		ids := uniqueIDs(req.CategoryIDs)
+		count, err := s.categoryRepo.CountByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if count != int64(len(ids)) {
+			return ErrCategoryNotFound
+		}
+	}
+
+	if len(req.Authors) == 0 {
+		return nil
+	}
+
+	seen := make(map[BookAuthor]bool, len(req.Authors))
+	ids := make([]uint, 0, len(req.Authors))
+	for _, a := range req.Authors {
+		key := BookAuthor{AuthorID: a.AuthorID, Role: contributorRole(a.Role)}
+		if seen[key] {
+			return ErrDuplicateContributor
+		}
+		seen[key] = true
+		ids = append(ids, a.AuthorID)
+	}
+
+	count, err := s.authorRepo.CountByIDs(ctx, uniqueIDs(ids))
+	if err != nil {
+		return err
+	}
+	if count != int64(len(uniqueIDs(ids))) {
+		return ErrAuthorNotFound
+	}
+	return nil
+}
+
+func applyBookRequest(book *Book, req dto.BookRequest) error {
+	normalizedISBN, err := isbn.Normalize(req.ISBN)
+	if err != nil {
+		return ErrInvalidISBN
+	}
+
+	publicationDate, err := parseDate(req.PublicationDate)
+	if err != nil {
+		return err
+	}
+	releaseDate, err := parseDate(req.ReleaseDate)
+	if err != nil {
+		return err
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	taxClass := strings.ToLower(strings.TrimSpace(req.TaxClass))
+	if taxClass == "" {
+		taxClass = TaxClassBook
+	}
+
+	book.Title = req.Title
+	book.Subtitle = req.Subtitle
+	book.ISBN = normalizedISBN
+	book.SKU = req.SKU
+	if book.SKU == "" {
+		book.SKU = normalizedISBN
+	}
+	book.Description = req.Description
+	book.Price = req.Price
+	book.Currency = currency
+	book.TaxClass = taxClass
+	book.Language = req.Language
+	book.PageCount = req.PageCount
+	book.PublicationDate = publicationDate
+	book.ReleaseDate = releaseDate
+	book.Backorderable = req.Backorderable
+	book.PublisherID = req.PublisherID
+	book.Publisher = nil
+	book.Authors = make([]BookAuthor, 0, len(req.Authors))
+	for i, a := range req.Authors {
+		book.Authors = append(book.Authors, BookAuthor{
+			AuthorID: a.AuthorID,
+			Role:     contributorRole(a.Role),
+			Position: i,
+		})
+	}
+	book.Categories = make([]Category, 0, len(req.CategoryIDs))
+	for _, id := range uniqueIDs(req.CategoryIDs) {
+		book.Categories = append(book.Categories, Category{ID: id})
+	}
+	return nil
+}
+
+// parseDate parses an optional date of a request, nil when empty.
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// searchTerms splits free text into lowercase letter and digit runs, which are
+// always safe inside a tsquery. Hyphenated numbers such as 978-0-547-92822-7
+// are kept whole so ISBNs match however they were typed.
+func searchTerms(q string) []string {
+	terms := make([]string, 0)
+	for _, field := range strings.Fields(q) {
+		if isHyphenatedNumber(field) {
+			field = strings.ReplaceAll(field, "-", "")
+		}
+		for _, term := range strings.FieldsFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if len(terms) == maxSearchTerms {
+				return terms
+			}
+			terms = append(terms, strings.ToLower(term))
+		}
+	}
+	return terms
+}
+
+func isHyphenatedNumber(s string) bool {
+	return strings.Contains(s, "-") && strings.Trim(s, "0123456789-Xx") == ""
+}
+
+func contributorRole(role string) string {
+	if role == "" {
+		return RoleAuthor
+	}
+	return role
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrBookNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateISBN
+	default:
+		return err
+	}
+}
+
+func ToBookResponse(book *Book) *dto.BookResponse {
+	response := &dto.BookResponse{
+		ID:            book.ID,
+		Title:         book.Title,
+		Subtitle:      book.Subtitle,
+		ISBN:          book.ISBN,
+		ISBNDisplay:   book.ISBN,
+		SKU:           book.SKU,
+		Description:   book.Description,
+		Price:         book.Price,
+		Currency:      book.Currency,
+		TaxClass:      book.TaxClass,
+		Language:      book.Language,
+		PageCount:     book.PageCount,
+		Preorder:      book.IsPreorder(time.Now()),
+		Backorderable: book.Backorderable,
+		Authors:       make([]dto.BookAuthorResponse, 0, len(book.Authors)),
+		CreatedAt:     book.CreatedAt,
+		ModifiedAt:    book.ModifiedAt,
+	}
+	if hyphenated, err := isbn.Hyphenate(book.ISBN); err == nil {
+		response.ISBNDisplay = hyphenated
+	}
+	if isbn10, err := isbn.ToISBN10(book.ISBN); err == nil {
+		response.ISBN10 = isbn10
+	}
+	if book.PublicationDate != nil {
+		response.PublicationDate = book.PublicationDate.Format(dateLayout)
+	}
+	if book.ReleaseDate != nil {
+		response.ReleaseDate = book.ReleaseDate.Format(dateLayout)
+	}
+	if book.Publisher != nil {
+		response.Publisher = ToPublisherResponse(book.Publisher)
+	}
+	for _, a := range book.Authors {
+		response.Authors = append(response.Authors, dto.BookAuthorResponse{
+			ID:       a.AuthorID,
+			Name:     a.Author.Name,
+			Role:     a.Role,
+			Position: a.Position,
+		})
+	}
+	response.Categories = ToCategoryResponses(book.Categories)
+	response.Rating = ToRatingResponse(book)
+	response.Cover = ToCoverResponse(book)
+	return response
+}
+
+// ToRatingResponse summarizes the rating aggregates of a book, with the
+// average rounded to two decimals.
+func ToRatingResponse(book *Book) dto.RatingResponse {
+	response := dto.RatingResponse{
+		Count: book.RatingCount,
+		Histogram: map[int]int{
+			1: book.Rating1Count,
+			2: book.Rating2Count,
+			3: book.Rating3Count,
+			4: book.Rating4Count,
+			5: book.Rating5Count,
+		},
+	}
+	if book.RatingCount > 0 {
+		response.Average = math.Round(float64(book.RatingSum)*100/float64(book.RatingCount)) / 100
+	}
+	return response
+}
+
+func ToBookResponses(books []Book) []dto.BookResponse {
+	responses := make([]dto.BookResponse, 0, len(books))
+	for i := range books {
+		responses = append(responses, *ToBookResponse(&books[i]))
+	}
+	return responses
+}
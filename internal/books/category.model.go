@@ -0,0 +1,61 @@
+package books
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Category is a node in the catalog tree. Path is a materialized path of
+// ancestor ids including the node itself, e.g. "3/7/12/", so a whole subtree
+// can be selected with a single prefix match.
+type Category struct {
+	ID         uint      `gorm:"primaryKey"`
+	ParentID   *uint     `gorm:"column:parent_id;index"`
+	Name       string    `gorm:"column:name;size:100;not null"`
+	Slug       string    `gorm:"column:slug;size:120;uniqueIndex;not null"`
+	Path       string    `gorm:"column:path;size:255;not null"`
+	Depth      int       `gorm:"column:depth;not null;default:0"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Category) TableName() string {
+	return "categories"
+}
+
+// AncestorIDs returns the ids of every ancestor, root first, excluding the
+// category itself.
+func (c *Category) AncestorIDs() []uint {
+	parts := strings.Split(strings.TrimSuffix(c.Path, "/"), "/")
+	ids := make([]uint, 0, len(parts))
+	for _, part := range parts[:len(parts)-1] {
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids
+}
+
+// IsAncestorOf reports whether other is c or lies somewhere below c.
+func (c *Category) IsAncestorOf(other *Category) bool {
+	return strings.HasPrefix(other.Path, c.Path)
+}
+
+type BookCategory struct {
+	BookID     uint `gorm:"column:book_id;primaryKey"`
+	CategoryID uint `gorm:"column:category_id;primaryKey;index"`
+}
+
+func (BookCategory) TableName() string {
+	return "book_categories"
+}
+
+func categoryPath(parent *Category, id uint) string {
+	prefix := ""
+	if parent != nil {
+		prefix = parent.Path
+	}
+	return prefix + strconv.FormatUint(uint64(id), 10) + "/"
+}
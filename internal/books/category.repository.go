@@ -0,0 +1,208 @@
+package books
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *Category) (*Category, error)
+	FindAll(ctx context.Context) ([]Category, error)
+	FindByID(ctx context.Context, id uint) (*Category, error)
+	FindBySlug(ctx context.Context, slug string) (*Category, error)
+	FindByIDs(ctx context.Context, ids []uint) ([]Category, error)
+	FindSubtree(ctx context.Context, category *Category) ([]Category, error)
+	CountByIDs(ctx context.Context, ids []uint) (int64, error)
+	CountChildren(ctx context.Context, id uint) (int64, error)
+	CountBooks(ctx context.Context, id uint) (int64, error)
+	Update(ctx context.Context, category *Category) (*Category, error)
+	Move(ctx context.Context, category *Category, parent *Category) (*Category, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &categoryRepository{
+		db: db,
+	}
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *Category) (*Category, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var parent *Category
+		if category.ParentID != nil {
+			if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).First(&parent, *category.ParentID).Error; err != nil {
+				return err
+			}
+			category.Depth = parent.Depth + 1
+		}
+
+		// The path embeds the generated id, so it can only be filled in after insert.
+		if err := tx.Create(category).Error; err != nil {
+			return err
+		}
+		category.Path = categoryPath(parent, category.ID)
+		return tx.Model(category).Update("path", category.Path).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (r *categoryRepository) FindAll(ctx context.Context) ([]Category, error) {
+	var categories []Category
+	result := r.db.WithContext(ctx).Order("depth, name").Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) FindByID(ctx context.Context, id uint) (*Category, error) {
+	var category *Category
+	result := r.db.WithContext(ctx).First(&category, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return category, nil
+}
+
+func (r *categoryRepository) FindBySlug(ctx context.Context, slug string) (*Category, error) {
+	var category *Category
+	result := r.db.WithContext(ctx).Where("slug = ?", slug).First(&category)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return category, nil
+}
+
+func (r *categoryRepository) FindByIDs(ctx context.Context, ids []uint) ([]Category, error) {
+	var categories []Category
+	if len(ids) == 0 {
+		return categories, nil
+	}
+	result := r.db.WithContext(ctx).Where("id IN ?", ids).Order("depth").Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) FindSubtree(ctx context.Context, category *Category) ([]Category, error) {
+	var categories []Category
+	result := r.db.WithContext(ctx).
+		Where("path LIKE ?", category.Path+"%").
+		Order("depth, name").
+		Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) CountByIDs(ctx context.Context, ids []uint) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&Category{}).Where("id IN ?", ids).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (r *categoryRepository) CountChildren(ctx context.Context, id uint) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&Category{}).Where("parent_id = ?", id).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (r *categoryRepository) CountBooks(ctx context.Context, id uint) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&BookCategory{}).
+		Joins("JOIN books ON books.id = book_categories.book_id AND books.deleted_at IS NULL").
+		Where("book_categories.category_id = ?", id).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *Category) (*Category, error) {
+	result := r.db.WithContext(ctx).Model(category).Updates(map[string]interface{}{
+		"name": category.Name,
+		"slug": category.Slug,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return category, nil
+}
+
+// Move re-parents category and rewrites the path and depth of its whole
+// subtree in one statement. A nil parent moves the category to the root.
+func (r *categoryRepository) Move(ctx context.Context, category *Category, parent *Category) (*Category, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current Category
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, category.ID).Error; err != nil {
+			return err
+		}
+
+		var parentID *uint
+		depth := 0
+		if parent != nil {
+			var locked Category
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, parent.ID).Error; err != nil {
+				return err
+			}
+			if current.IsAncestorOf(&locked) {
+				return ErrCategoryCycle
+			}
+			parentID = &locked.ID
+			depth = locked.Depth + 1
+			parent = &locked
+		}
+
+		newPath := categoryPath(parent, current.ID)
+		err := tx.Exec(
+			`UPDATE categories
+			SET path = ? || substr(path, ?), depth = depth + ?, modified_at = now()
+			WHERE path LIKE ?`,
+			newPath, len(current.Path)+1, depth-current.Depth, current.Path+"%",
+		).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&current).Update("parent_id", parentID).Error; err != nil {
+			return err
+		}
+
+		category.ParentID = parentID
+		category.Path = newPath
+		category.Depth = depth
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&Category{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
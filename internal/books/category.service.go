@@ -0,0 +1,257 @@
+package books
+
+import (
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrDuplicateSlug       = errors.New("a category with this slug already exists")
+	ErrInvalidSlug         = errors.New("category slug must contain letters or digits")
+	ErrCategoryCycle       = errors.New("a category cannot be moved below itself")
+	ErrCategoryHasChildren = errors.New("category still has subcategories")
+	ErrCategoryHasBooks    = errors.New("category still has books")
+)
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, req dto.CategoryRequest) (*dto.CategoryResponse, error)
+	GetCategoryTree(ctx context.Context) ([]dto.CategoryTreeResponse, error)
+	GetCategory(ctx context.Context, slug string) (*dto.CategoryDetailResponse, error)
+	GetCategoryBooks(ctx context.Context, slug string, query pkg.PaginationQuery) (*dto.CategoryBooksResponse, error)
+	UpdateCategory(ctx context.Context, id uint, req dto.CategoryRequest) (*dto.CategoryResponse, error)
+	MoveCategory(ctx context.Context, id uint, req dto.MoveCategoryRequest) (*dto.CategoryResponse, error)
+	DeleteCategory(ctx context.Context, id uint) error
+}
+
+type categoryService struct {
+	categoryRepo CategoryRepository
+	bookRepo     BookRepository
+}
+
+func NewCategoryService(categoryRepo CategoryRepository, bookRepo BookRepository) CategoryService {
+	return &categoryService{
+		categoryRepo: categoryRepo,
+		bookRepo:     bookRepo,
+	}
+}
+
+func (s *categoryService) CreateCategory(ctx context.Context, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	slug, err := categorySlug(req)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &Category{
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Slug:     slug,
+	}
+
+	created, err := s.categoryRepo.Create(ctx, category)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	return ToCategoryResponse(created), nil
+}
+
+func (s *categoryService) GetCategoryTree(ctx context.Context) ([]dto.CategoryTreeResponse, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCategoryTree(categories, nil), nil
+}
+
+func (s *categoryService) GetCategory(ctx context.Context, slug string) (*dto.CategoryDetailResponse, error) {
+	category, err := s.categoryRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	ancestors, err := s.categoryRepo.FindByIDs(ctx, category.AncestorIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	subtree, err := s.categoryRepo.FindSubtree(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CategoryDetailResponse{
+		CategoryResponse: *ToCategoryResponse(category),
+		Ancestors:        ToCategoryResponses(ancestors),
+		Children:         buildCategoryTree(subtree, &category.ID),
+	}
+	return response, nil
+}
+
+func (s *categoryService) GetCategoryBooks(ctx context.Context, slug string, query pkg.PaginationQuery) (*dto.CategoryBooksResponse, error) {
+	category, err := s.categoryRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	books, total, err := s.bookRepo.FindAll(ctx, BookFilter{
+		CategoryPath: category.Path,
+		Offset:       query.Offset(),
+		Limit:        query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CategoryBooksResponse{
+		Category:   *ToCategoryResponse(category),
+		Books:      ToBookResponses(books),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}, nil
+}
+
+func (s *categoryService) UpdateCategory(ctx context.Context, id uint, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	category, err := s.categoryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	slug, err := categorySlug(req)
+	if err != nil {
+		return nil, err
+	}
+
+	category.Name = req.Name
+	category.Slug = slug
+
+	updated, err := s.categoryRepo.Update(ctx, category)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	return ToCategoryResponse(updated), nil
+}
+
+func (s *categoryService) MoveCategory(ctx context.Context, id uint, req dto.MoveCategoryRequest) (*dto.CategoryResponse, error) {
+	category, err := s.categoryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	var parent *Category
+	if req.ParentID != nil {
+		parent, err = s.categoryRepo.FindByID(ctx, *req.ParentID)
+		if err != nil {
+			return nil, translateCategoryError(err)
+		}
+		if category.IsAncestorOf(parent) {
+			return nil, ErrCategoryCycle
+		}
+	}
+
+	moved, err := s.categoryRepo.Move(ctx, category, parent)
+	if err != nil {
+		return nil, translateCategoryError(err)
+	}
+
+	return ToCategoryResponse(moved), nil
+}
+
+func (s *categoryService) DeleteCategory(ctx context.Context, id uint) error {
+	children, err := s.categoryRepo.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return ErrCategoryHasChildren
+	}
+
+	books, err := s.categoryRepo.CountBooks(ctx, id)
+	if err != nil {
+		return err
+	}
+	if books > 0 {
+		return ErrCategoryHasBooks
+	}
+
+	if err := s.categoryRepo.Delete(ctx, id); err != nil {
+		return translateCategoryError(err)
+	}
+	return nil
+}
+
+func categorySlug(req dto.CategoryRequest) (string, error) {
+	slug := req.Slug
+	if slug == "" {
+		slug = req.Name
+	}
+	slug = pkg.Slugify(slug)
+	if slug == "" {
+		return "", ErrInvalidSlug
+	}
+	return slug, nil
+}
+
+// buildCategoryTree nests categories under parentID. It expects categories
+// ordered by depth, which every repository lookup guarantees.
+func buildCategoryTree(categories []Category, parentID *uint) []dto.CategoryTreeResponse {
+	children := make(map[uint][]Category)
+	var roots []Category
+	for _, c := range categories {
+		switch {
+		case c.ParentID == nil && parentID == nil:
+			roots = append(roots, c)
+		case c.ParentID != nil && parentID != nil && *c.ParentID == *parentID:
+			roots = append(roots, c)
+		case c.ParentID != nil:
+			children[*c.ParentID] = append(children[*c.ParentID], c)
+		}
+	}
+
+	var build func(nodes []Category) []dto.CategoryTreeResponse
+	build = func(nodes []Category) []dto.CategoryTreeResponse {
+		tree := make([]dto.CategoryTreeResponse, 0, len(nodes))
+		for i := range nodes {
+			tree = append(tree, dto.CategoryTreeResponse{
+				CategoryResponse: *ToCategoryResponse(&nodes[i]),
+				Children:         build(children[nodes[i].ID]),
+			})
+		}
+		return tree
+	}
+	return build(roots)
+}
+
+func translateCategoryError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrCategoryNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateSlug
+	default:
+		return err
+	}
+}
+
+func ToCategoryResponse(category *Category) *dto.CategoryResponse {
+	return &dto.CategoryResponse{
+		ID:       category.ID,
+		ParentID: category.ParentID,
+		Name:     category.Name,
+		Slug:     category.Slug,
+		Depth:    category.Depth,
+	}
+}
+
+func ToCategoryResponses(categories []Category) []dto.CategoryResponse {
+	responses := make([]dto.CategoryResponse, 0, len(categories))
+	for i := range categories {
+		responses = append(responses, *ToCategoryResponse(&categories[i]))
+	}
+	return responses
+}
@@ -0,0 +1,240 @@
+package books
+
+import (
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg/blob"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// MaxCoverSize bounds the bytes of an uploaded cover.
+	MaxCoverSize = 5 << 20
+	// MaxCoverDimension bounds the width and height of an uploaded cover, so a
+	// small file cannot decode to a huge image.
+	MaxCoverDimension = 6000
+
+	// CoverURLPrefix is where the API serves the blobs of covers.
+	CoverURLPrefix = "/api/v1/covers/"
+	coverKeyPrefix = "covers/"
+
+	thumbnailQuality = 85
+)
+
+// CoverSize is a thumbnail of the cover, scaled to Width keeping the aspect
+// ratio. Covers narrower than Width are not enlarged.
+type CoverSize struct {
+	Name  string
+	Width int
+}
+
+var CoverSizes = []CoverSize{
+	{Name: "small", Width: 160},
+	{Name: "medium", Width: 320},
+	{Name: "large", Width: 640},
+}
+
+// coverTypes maps the sniffed content types accepted for covers to the
+// extension the original is stored with.
+var coverTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+var (
+	ErrCoverTooLarge    = errors.New("cover image is larger than 5 MB")
+	ErrCoverUnsupported = errors.New("cover must be a JPEG, PNG or WebP image")
+	ErrCoverDimensions  = errors.New("cover image must be at most 6000 pixels wide and high")
+	ErrCoverNotFound    = errors.New("cover not found")
+	ErrCoverCorrupt     = errors.New("cover image is corrupt")
+	ErrBookHasNoCover   = errors.New("book has no cover")
+)
+
+type CoverService interface {
+	// UploadCover checks the image by its content, never by the declared
+	// type, stores it with its thumbnails and makes it the book's cover.
+	UploadCover(ctx context.Context, bookID uint, data []byte) (*dto.CoverResponse, error)
+	DeleteCover(ctx context.Context, bookID uint) error
+	// GetImage opens a blob of a cover by the path below CoverURLPrefix.
+	GetImage(ctx context.Context, path string) (*blob.Object, error)
+}
+
+type coverService struct {
+	bookRepo BookRepository
+	store    blob.BlobStore
+}
+
+func NewCoverService(bookRepo BookRepository, store blob.BlobStore) CoverService {
+	return &coverService{
+		bookRepo: bookRepo,
+		store:    store,
+	}
+}
+
+func (s *coverService) UploadCover(ctx context.Context, bookID uint, data []byte) (*dto.CoverResponse, error) {
+	if len(data) > MaxCoverSize {
+		return nil, ErrCoverTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	ext, ok := coverTypes[contentType]
+	if !ok {
+		return nil, ErrCoverUnsupported
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCoverCorrupt
+	}
+	if config.Width > MaxCoverDimension || config.Height > MaxCoverDimension {
+		return nil, ErrCoverDimensions
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCoverCorrupt
+	}
+
+	book, err := s.bookRepo.FindByID(ctx, bookID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	// Keys carry a hash of the image, so every upload gets new URLs and
+	// cached copies of the old cover never show up for the new one.
+	sum := sha256.Sum256(data)
+	dir := fmt.Sprintf("%s%d/%s", coverKeyPrefix, bookID, hex.EncodeToString(sum[:8]))
+	key := dir + "/original" + ext
+
+	err = s.putCover(ctx, key, data, contentType, img)
+	if err == nil {
+		err = s.bookRepo.SetCover(ctx, bookID, key, img.Bounds().Dx(), img.Bounds().Dy())
+	}
+	if err != nil {
+		// Blobs of the same image are still the book's cover.
+		if key != book.CoverKey {
+			s.deleteBlobs(ctx, coverKeys(key))
+		}
+		return nil, translateError(err)
+	}
+
+	// Uploading the same image again leaves the cover as it was.
+	if book.CoverKey != "" && book.CoverKey != key {
+		s.deleteBlobs(ctx, coverKeys(book.CoverKey))
+	}
+	return ToCoverResponse(&Book{CoverKey: key, CoverWidth: img.Bounds().Dx(), CoverHeight: img.Bounds().Dy()}), nil
+}
+
+func (s *coverService) DeleteCover(ctx context.Context, bookID uint) error {
+	book, err := s.bookRepo.FindByID(ctx, bookID)
+	if err != nil {
+		return translateError(err)
+	}
+	if book.CoverKey == "" {
+		return ErrBookHasNoCover
+	}
+	if err := s.bookRepo.SetCover(ctx, bookID, "", 0, 0); err != nil {
+		return translateError(err)
+	}
+	s.deleteBlobs(ctx, coverKeys(book.CoverKey))
+	return nil
+}
+
+func (s *coverService) GetImage(ctx context.Context, path string) (*blob.Object, error) {
+	object, err := s.store.Get(ctx, coverKeyPrefix+strings.TrimPrefix(path, "/"))
+	if errors.Is(err, blob.ErrNotFound) || errors.Is(err, blob.ErrInvalidKey) {
+		return nil, ErrCoverNotFound
+	}
+	return object, err
+}
+
+// putCover stores the original image and its thumbnails under the
+// directory of key.
+func (s *coverService) putCover(ctx context.Context, key string, data []byte, contentType string, img image.Image) error {
+	if err := s.store.Put(ctx, key, data, contentType); err != nil {
+		return err
+	}
+	for _, size := range CoverSizes {
+		thumbnail, err := scaleCover(img, size.Width)
+		if err != nil {
+			return err
+		}
+		if err := s.store.Put(ctx, path.Dir(key)+"/"+size.Name+".jpg", thumbnail, "image/jpeg"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteBlobs removes blobs that are no longer referenced. A blob left
+// behind only wastes space, so failures are logged and not returned.
+func (s *coverService) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			log.Printf("Failed to delete cover blob %s: %v", key, err)
+		}
+	}
+}
+
+// scaleCover encodes the image as a JPEG at most width pixels wide.
+// Transparent areas become white, as JPEG has no transparency.
+func scaleCover(img image.Image, width int) ([]byte, error) {
+	bounds := img.Bounds()
+	if bounds.Dx() < width {
+		width = bounds.Dx()
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	xdraw.Draw(dst, dst.Bounds(), image.White, image.Point{}, xdraw.Src)
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, xdraw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// coverKeys returns the keys of the original and the thumbnails of a cover.
+func coverKeys(key string) []string {
+	keys := []string{key}
+	for _, size := range CoverSizes {
+		keys = append(keys, path.Dir(key)+"/"+size.Name+".jpg")
+	}
+	return keys
+}
+
+// ToCoverResponse links the cover of the book, nil when it has none.
+func ToCoverResponse(book *Book) *dto.CoverResponse {
+	if book.CoverKey == "" {
+		return nil
+	}
+	url := func(key string) string {
+		return CoverURLPrefix + strings.TrimPrefix(key, coverKeyPrefix)
+	}
+	dir := path.Dir(book.CoverKey)
+	return &dto.CoverResponse{
+		Width:    book.CoverWidth,
+		Height:   book.CoverHeight,
+		Original: url(book.CoverKey),
+		Small:    url(dir + "/small.jpg"),
+		Medium:   url(dir + "/medium.jpg"),
+		Large:    url(dir + "/large.jpg"),
+	}
+}
@@ -0,0 +1,21 @@
+package books
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Publisher struct {
+	ID         uint           `gorm:"primaryKey"`
+	Name       string         `gorm:"column:name;size:255;uniqueIndex;not null"`
+	Website    string         `gorm:"column:website;size:255"`
+	Country    string         `gorm:"column:country;size:2"`
+	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time      `gorm:"column:modified_at;autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
+
+func (Publisher) TableName() string {
+	return "publishers"
+}
@@ -0,0 +1,92 @@
+package books
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type PublisherRepository interface {
+	Create(ctx context.Context, publisher *Publisher) (*Publisher, error)
+	FindAll(ctx context.Context, name string, offset, limit int) ([]Publisher, int64, error)
+	FindByID(ctx context.Context, id uint) (*Publisher, error)
+	CountBooks(ctx context.Context, id uint) (int64, error)
+	Update(ctx context.Context, publisher *Publisher) (*Publisher, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type publisherRepository struct {
+	db *gorm.DB
+}
+
+func NewPublisherRepository(db *gorm.DB) PublisherRepository {
+	return &publisherRepository{
+		db: db,
+	}
+}
+
+func (r *publisherRepository) Create(ctx context.Context, publisher *Publisher) (*Publisher, error) {
+	result := r.db.WithContext(ctx).Create(publisher)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return publisher, nil
+}
+
+func (r *publisherRepository) FindAll(ctx context.Context, name string, offset, limit int) ([]Publisher, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Publisher{})
+	if name != "" {
+		query = query.Where("name ILIKE ?", "%"+name+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var publishers []Publisher
+	result := query.Order("name").Offset(offset).Limit(limit).Find(&publishers)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return publishers, total, nil
+}
+
+func (r *publisherRepository) FindByID(ctx context.Context, id uint) (*Publisher, error) {
+	var publisher *Publisher
+	result := r.db.WithContext(ctx).First(&publisher, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return publisher, nil
+}
+
+func (r *publisherRepository) CountBooks(ctx context.Context, id uint) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&Book{}).Where("publisher_id = ?", id).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+func (r *publisherRepository) Update(ctx context.Context, publisher *Publisher) (*Publisher, error) {
+	result := r.db.WithContext(ctx).Save(publisher)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return publisher, nil
+}
+
+func (r *publisherRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&Publisher{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package books
+
+import (
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPublisherNotFound  = errors.New("publisher not found")
+	ErrDuplicatePublisher = errors.New("a publisher with this name already exists")
+	ErrPublisherHasBooks  = errors.New("publisher is still linked to books")
+)
+
+type PublisherService interface {
+	CreatePublisher(ctx context.Context, req dto.PublisherRequest) (*dto.PublisherResponse, error)
+	GetPublishers(ctx context.Context, query dto.NameListQuery) (*dto.PublisherListResponse, error)
+	GetPublisher(ctx context.Context, id uint) (*dto.PublisherResponse, error)
+	GetPublisherBooks(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.PublisherBooksResponse, error)
+	UpdatePublisher(ctx context.Context, id uint, req dto.PublisherRequest) (*dto.PublisherResponse, error)
+	DeletePublisher(ctx context.Context, id uint) error
+}
+
+type publisherService struct {
+	publisherRepo PublisherRepository
+	bookRepo      BookRepository
+}
+
+func NewPublisherService(publisherRepo PublisherRepository, bookRepo BookRepository) PublisherService {
+	return &publisherService{
+		publisherRepo: publisherRepo,
+		bookRepo:      bookRepo,
+	}
+}
+
+func (s *publisherService) CreatePublisher(ctx context.Context, req dto.PublisherRequest) (*dto.PublisherResponse, error) {
+	publisher := &Publisher{
+		Name:    req.Name,
+		Website: req.Website,
+		Country: req.Country,
+	}
+
+	created, err := s.publisherRepo.Create(ctx, publisher)
+	if err != nil {
+		return nil, translatePublisherError(err)
+	}
+
+	return ToPublisherResponse(created), nil
+}
+
+func (s *publisherService) GetPublishers(ctx context.Context, query dto.NameListQuery) (*dto.PublisherListResponse, error) {
+	publishers, total, err := s.publisherRepo.FindAll(ctx, query.Name, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PublisherListResponse{
+		Publishers: make([]dto.PublisherResponse, 0, len(publishers)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range publishers {
+		response.Publishers = append(response.Publishers, *ToPublisherResponse(&publishers[i]))
+	}
+
+	return response, nil
+}
+
+func (s *publisherService) GetPublisher(ctx context.Context, id uint) (*dto.PublisherResponse, error) {
+	publisher, err := s.publisherRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translatePublisherError(err)
+	}
+
+	return ToPublisherResponse(publisher), nil
+}
+
+func (s *publisherService) GetPublisherBooks(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.PublisherBooksResponse, error) {
+	publisher, err := s.publisherRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translatePublisherError(err)
+	}
+
+	books, total, err := s.bookRepo.FindAll(ctx, BookFilter{
+		PublisherID: id,
+		Offset:      query.Offset(),
+		Limit:       query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PublisherBooksResponse{
+		Publisher:  *ToPublisherResponse(publisher),
+		Books:      ToBookResponses(books),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}, nil
+}
+
+func (s *publisherService) UpdatePublisher(ctx context.Context, id uint, req dto.PublisherRequest) (*dto.PublisherResponse, error) {
+	publisher, err := s.publisherRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translatePublisherError(err)
+	}
+
+	publisher.Name = req.Name
+	publisher.Website = req.Website
+	publisher.Country = req.Country
+
+	updated, err := s.publisherRepo.Update(ctx, publisher)
+	if err != nil {
+		return nil, translatePublisherError(err)
+	}
+
+	return ToPublisherResponse(updated), nil
+}
+
+func (s *publisherService) DeletePublisher(ctx context.Context, id uint) error {
+	count, err := s.publisherRepo.CountBooks(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrPublisherHasBooks
+	}
+
+	if err := s.publisherRepo.Delete(ctx, id); err != nil {
+		return translatePublisherError(err)
+	}
+	return nil
+}
+
+func translatePublisherError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrPublisherNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicatePublisher
+	default:
+		return err
+	}
+}
+
+func ToPublisherResponse(publisher *Publisher) *dto.PublisherResponse {
+	return &dto.PublisherResponse{
+		ID:         publisher.ID,
+		Name:       publisher.Name,
+		Website:    publisher.Website,
+		Country:    publisher.Country,
+		CreatedAt:  publisher.CreatedAt,
+		ModifiedAt: publisher.ModifiedAt,
+	}
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CartHandler struct {
+	cartService carts.CartService
+}
+
+func NewCartHandler(cartService carts.CartService) *CartHandler {
+	return &CartHandler{
+		cartService: cartService,
+	}
+}
+
+// GetCart godoc
+// @Summary      Get the cart
+// @Description  Get the cart of the logged in user, or of the guest identified by the cart_token cookie. Lines are checked against current prices and stock and flagged when they changed
+// @Tags         cart
+// @Security     BearerAuth
+// @Produce      json
+// @Param        currency query    string false "Switch the cart to this currency"
+// @Success      200  {object}    pkg.Response{data=dto.CartResponse} "Cart retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /cart [get]
+func (h *CartHandler) GetCart(ctx *gin.Context) {
+	var query dto.CartQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.cartService.GetCart(ctx.Request.Context(), cartOwner(ctx), query.Currency)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Cart retrieve successfully", response)
+}
+
+// AddItem godoc
+// @Summary      Add a book to the cart
+// @Description  Add copies of a book to the cart. Guests without a cart get one along with the cart_token cookie
+// @Tags         cart
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        currency query    string false "Switch the cart to this currency"
+// @Param        request body     dto.AddCartItemRequest true "Cart item"
+// @Success      200  {object}    pkg.Response{data=dto.CartResponse} "Cart updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Failure      409  {object}    pkg.Response "Not enough copies in stock"
+// @Router       /cart/items [post]
+func (h *CartHandler) AddItem(ctx *gin.Context) {
+	var query dto.CartQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	var req dto.AddCartItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	owner := cartOwner(ctx)
+	if owner.UserID == nil && owner.Token == "" {
+		token, err := carts.NewToken()
+		if err != nil {
+			pkg.InternalServerErrorResponse(ctx, err.Error())
+			return
+		}
+		owner.Token = token
+	}
+
+	response, err := h.cartService.AddItem(ctx.Request.Context(), owner, query.Currency, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setTokenCookie(ctx, owner)
+	pkg.OkResponse(ctx, "Cart updated successfully", response)
+}
+
+// UpdateItem godoc
+// @Summary      Change the quantity of a cart line
+// @Description  Set the number of copies of a book in the cart
+// @Tags         cart
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        bookId  path     int true "Book ID"
+// @Param        request body     dto.UpdateCartItemRequest true "Quantity"
+// @Success      200  {object}    pkg.Response{data=dto.CartResponse} "Cart updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book is not in the cart"
+// @Failure      409  {object}    pkg.Response "Not enough copies in stock"
+// @Router       /cart/items/{bookId} [put]
+func (h *CartHandler) UpdateItem(ctx *gin.Context) {
+	bookID, err := pkg.ParamID(ctx, "bookId")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	var req dto.UpdateCartItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	owner := cartOwner(ctx)
+	response, err := h.cartService.UpdateItem(ctx.Request.Context(), owner, bookID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setTokenCookie(ctx, owner)
+	pkg.OkResponse(ctx, "Cart updated successfully", response)
+}
+
+// RemoveItem godoc
+// @Summary      Remove a book from the cart
+// @Description  Remove every copy of a book from the cart
+// @Tags         cart
+// @Security     BearerAuth
+// @Produce      json
+// @Param        bookId  path     int true "Book ID"
+// @Success      200  {object}    pkg.Response{data=dto.CartResponse} "Cart updated successfully"
+// @Failure      404  {object}    pkg.Response "Book is not in the cart"
+// @Router       /cart/items/{bookId} [delete]
+func (h *CartHandler) RemoveItem(ctx *gin.Context) {
+	bookID, err := pkg.ParamID(ctx, "bookId")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	owner := cartOwner(ctx)
+	response, err := h.cartService.RemoveItem(ctx.Request.Context(), owner, bookID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setTokenCookie(ctx, owner)
+	pkg.OkResponse(ctx, "Cart updated successfully", response)
+}
+
+// ApplyCoupon godoc
+// @Summary      Use a coupon code
+// @Description  Set the coupon code of the cart, replacing the previous one. The cart tells whether the coupon applies and, when it does not, why
+// @Tags         cart
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.ApplyCouponRequest true "Coupon code"
+// @Success      200  {object}    pkg.Response{data=dto.CartResponse} "Cart updated successfully"
+// @Failure      400  {object}    pkg.Response "Cart is empty"
+// @Failure      404  {object}    pkg.Response "Coupon code not found"
+// @Router       /cart/coupon [put]
+func (h *CartHandler) ApplyCoupon(ctx *gin.Context) {
+	var req dto.ApplyCouponRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.cartService.ApplyCoupon(ctx.Request.Context(), cartOwner(ctx), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Cart updated successfully", response)
+}
+
+// RemoveCoupon godoc
+// @Summary      Remove the coupon code
+// @Description  Remove the coupon code from the cart
+// @Tags         cart
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=dto.CartResponse} "Cart updated successfully"
+// @Failure      400  {object}    pkg.Response "Cart is empty"
+// @Router       /cart/coupon [delete]
+func (h *CartHandler) RemoveCoupon(ctx *gin.Context) {
+	response, err := h.cartService.RemoveCoupon(ctx.Request.Context(), cartOwner(ctx))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Cart updated successfully", response)
+}
+
+// cartOwner identifies the cart by the user set by the optional JWT
+// middleware, falling back to the guest cookie.
+func cartOwner(ctx *gin.Context) carts.Owner {
+	if userID, exist := ctx.Get("userID"); exist {
+		id := userID.(uint)
+		return carts.Owner{UserID: &id}
+	}
+	token, _ := ctx.Cookie(carts.TokenCookie)
+	return carts.Owner{Token: token}
+}
+
+// setTokenCookie (re)issues the guest cookie so it lives as long as the cart.
+func setTokenCookie(ctx *gin.Context, owner carts.Owner) {
+	if owner.UserID != nil {
+		return
+	}
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(carts.TokenCookie, owner.Token, int(carts.GuestCartTTL.Seconds()), "/", "", ctx.Request.TLS != nil, true)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, carts.ErrBookNotFound),
+		errors.Is(err, carts.ErrItemNotFound),
+		errors.Is(err, carts.ErrCouponNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, carts.ErrQuantityLimit),
+		errors.Is(err, carts.ErrEmptyCart),
+		errors.Is(err, carts.ErrCurrencyNotAvailable):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, carts.ErrInsufficientStock),
+		errors.Is(err, carts.ErrPriceNotAvailable):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func CartRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	cartRepository := carts.NewCartRepository(db)
+	bookRepository := books.NewBookRepository(db)
+	inventoryRepository := inventory.NewInventoryRepository(db)
+	promotionService := promotions.NewPromotionService(
+		promotions.NewPromotionRepository(db),
+		books.NewCategoryRepository(db),
+		books.NewAuthorRepository(db),
+	)
+	pricingService := pricing.NewPricingService(pricing.NewPricingRepository(db))
+	cartService := carts.NewCartService(cartRepository, bookRepository, inventoryRepository, promotionService, pricingService)
+	cartHandler := NewCartHandler(cartService)
+
+	router.Use(middleware.OptionalJWTAuth())
+	router.GET("", cartHandler.GetCart)
+	router.POST("/items", cartHandler.AddItem)
+	router.PUT("/items/:bookId", cartHandler.UpdateItem)
+	router.DELETE("/items/:bookId", cartHandler.RemoveItem)
+	router.PUT("/coupon", cartHandler.ApplyCoupon)
+	router.DELETE("/coupon", cartHandler.RemoveCoupon)
+}
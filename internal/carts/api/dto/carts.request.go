@@ -0,0 +1,25 @@
+package dto
+
+// CartQuery represents the query string of the cart endpoints
+// @Description Currency switches the cart, repricing its lines
+type CartQuery struct {
+	Currency string `form:"currency" binding:"omitempty,iso4217"`
+}
+
+// AddCartItemRequest represents a request to put copies of a book in the cart
+// @Description Cart item payload. Adding a book already in the cart adds to its quantity.
+type AddCartItemRequest struct {
+	BookID   uint `json:"book_id" binding:"required" example:"1"`
+	Quantity int  `json:"quantity" binding:"required,min=1,max=99" example:"1"`
+}
+
+// UpdateCartItemRequest represents a request to change the quantity of a cart line
+// @Description Cart item quantity payload
+type UpdateCartItemRequest struct {
+	Quantity int `json:"quantity" binding:"required,min=1,max=99" example:"2"`
+}
+
+// ApplyCouponRequest represents a request to use a coupon code on the cart
+type ApplyCouponRequest struct {
+	Code string `json:"code" binding:"required,max=40" example:"SUMMER10"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+// CartResponse is the cart revalidated against current prices and stock.
+// Subtotal only counts lines that can be bought, Total is the subtotal less
+// the discount of the promotions that applied.
+type CartResponse struct {
+	ID           uint                `json:"id,omitempty"`
+	Currency     string              `json:"currency"`
+	Items        []CartItemResponse  `json:"items"`
+	ItemCount    int                 `json:"item_count"`
+	Subtotal     int64               `json:"subtotal"`
+	Discount     int64               `json:"discount"`
+	Total        int64               `json:"total"`
+	FreeShipping bool                `json:"free_shipping"`
+	Promotions   []PromotionResponse `json:"promotions,omitempty"`
+	Coupon       *CouponResponse     `json:"coupon,omitempty"`
+	ExpiresAt    *time.Time          `json:"expires_at,omitempty"`
+}
+
+// PromotionResponse is the discount one promotion gave to the cart or to a
+// line.
+type PromotionResponse struct {
+	PromotionID uint   `json:"promotion_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
+}
+
+// CouponResponse tells whether the coupon code applied and, if not, why.
+type CouponResponse struct {
+	Code    string `json:"code"`
+	Applied bool   `json:"applied"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// CartItemResponse describes one cart line. Issues lists what changed since
+// the customer last saw it: price_changed, insufficient_stock or unavailable.
+// Promotions explains the discount of the line. Preorder marks a book that is
+// not released yet; Backorder a line with more copies than available, which
+// will be shipped when they arrive.
+type CartItemResponse struct {
+	BookID         uint                `json:"book_id"`
+	ISBN           string              `json:"isbn"`
+	Title          string              `json:"title"`
+	Quantity       int                 `json:"quantity"`
+	UnitPrice      int64               `json:"unit_price"`
+	PriceConverted bool                `json:"price_converted,omitempty"`
+	PreviousPrice  *int64              `json:"previous_price,omitempty"`
+	LineTotal      int64               `json:"line_total"`
+	Discount       int64               `json:"discount"`
+	Available      int                 `json:"available"`
+	Preorder       bool                `json:"preorder,omitempty"`
+	Backorder      bool                `json:"backorder,omitempty"`
+	Issues         []string            `json:"issues,omitempty"`
+	Promotions     []PromotionResponse `json:"promotions,omitempty"`
+}
@@ -0,0 +1,42 @@
+package carts
+
+import (
+	"bookstore-framework/internal/books"
+	"time"
+)
+
+// Cart belongs either to a user or to a guest holding its token in a cookie.
+// Guest carts are merged into the user's cart when the guest logs in. Every
+// write pushes ExpiresAt forward, abandoned carts are purged once it passes.
+type Cart struct {
+	ID         uint       `gorm:"primaryKey"`
+	UserID     *uint      `gorm:"column:user_id;uniqueIndex"`
+	Token      string     `gorm:"column:token;size:64;uniqueIndex;not null"`
+	Currency   string     `gorm:"column:currency;size:3;not null;default:USD"`
+	CouponCode string     `gorm:"column:coupon_code;size:40"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null;index"`
+	Items      []CartItem `gorm:"foreignKey:CartID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time  `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Cart) TableName() string {
+	return "carts"
+}
+
+// CartItem keeps the unit price the customer last saw, so a price change
+// since then can be reported when the cart is read.
+type CartItem struct {
+	ID         uint       `gorm:"primaryKey"`
+	CartID     uint       `gorm:"column:cart_id;not null;uniqueIndex:idx_cart_items_cart_book"`
+	BookID     uint       `gorm:"column:book_id;not null;uniqueIndex:idx_cart_items_cart_book"`
+	Quantity   int        `gorm:"column:quantity;not null;check:chk_cart_items_quantity,quantity > 0"`
+	UnitPrice  int64      `gorm:"column:unit_price;not null"`
+	Book       books.Book `gorm:"foreignKey:BookID"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time  `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (CartItem) TableName() string {
+	return "cart_items"
+}
@@ -0,0 +1,179 @@
+package carts
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CartRepository interface {
+	// FindByUserID and FindByToken also return expired carts that have not
+	// been purged yet, callers decide what expiry means.
+	FindByUserID(ctx context.Context, userID uint) (*Cart, error)
+	FindByToken(ctx context.Context, token string) (*Cart, error)
+	Create(ctx context.Context, cart *Cart) (*Cart, error)
+	Save(ctx context.Context, cart *Cart) error
+	Delete(ctx context.Context, id uint) error
+	// SaveItem inserts the line or overwrites its quantity and price.
+	SaveItem(ctx context.Context, item *CartItem) error
+	DeleteItem(ctx context.Context, cartID, bookID uint) error
+	UpdatePrices(ctx context.Context, items []CartItem) error
+	// Merge moves the items of a guest cart into the user's cart, summing the
+	// quantities of books in both up to maxQuantity, and deletes the guest cart.
+	Merge(ctx context.Context, guestToken string, userID uint, expiresAt time.Time, maxQuantity int) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type cartRepository struct {
+	db *gorm.DB
+}
+
+func NewCartRepository(db *gorm.DB) CartRepository {
+	return &cartRepository{
+		db: db,
+	}
+}
+
+func (r *cartRepository) FindByUserID(ctx context.Context, userID uint) (*Cart, error) {
+	var cart *Cart
+	result := preloadCart(pkg.DB(ctx, r.db)).Where("user_id = ?", userID).First(&cart)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cart, nil
+}
+
+func (r *cartRepository) FindByToken(ctx context.Context, token string) (*Cart, error) {
+	var cart *Cart
+	result := preloadCart(pkg.DB(ctx, r.db)).Where("token = ?", token).First(&cart)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cart, nil
+}
+
+func (r *cartRepository) Create(ctx context.Context, cart *Cart) (*Cart, error) {
+	result := pkg.DB(ctx, r.db).Omit(clause.Associations).Create(cart)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cart, nil
+}
+
+func (r *cartRepository) Save(ctx context.Context, cart *Cart) error {
+	return pkg.DB(ctx, r.db).Model(cart).Select("currency", "coupon_code", "expires_at").Updates(cart).Error
+}
+
+func (r *cartRepository) Delete(ctx context.Context, id uint) error {
+	result := pkg.DB(ctx, r.db).Delete(&Cart{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *cartRepository) SaveItem(ctx context.Context, item *CartItem) error {
+	return pkg.DB(ctx, r.db).Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "cart_id"}, {Name: "book_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"quantity", "unit_price", "modified_at"}),
+		}).
+		Create(item).Error
+}
+
+func (r *cartRepository) DeleteItem(ctx context.Context, cartID, bookID uint) error {
+	result := pkg.DB(ctx, r.db).Where("cart_id = ? AND book_id = ?", cartID, bookID).Delete(&CartItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *cartRepository) UpdatePrices(ctx context.Context, items []CartItem) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			err := tx.Model(&CartItem{}).Where("id = ?", item.ID).Update("unit_price", item.UnitPrice).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *cartRepository) Merge(ctx context.Context, guestToken string, userID uint, expiresAt time.Time, maxQuantity int) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		var guest Cart
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token = ? AND user_id IS NULL AND expires_at > ?", guestToken, now).
+			First(&guest).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var cart Cart
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&cart).Error
+		if err == nil && !cart.ExpiresAt.After(now) {
+			if err := tx.Delete(&cart).Error; err != nil {
+				return err
+			}
+			err = gorm.ErrRecordNotFound
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Without a cart of their own the user simply takes over the guest cart.
+			return tx.Model(&guest).Updates(map[string]interface{}{
+				"user_id":    userID,
+				"expires_at": expiresAt,
+			}).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`INSERT INTO cart_items (cart_id, book_id, quantity, unit_price, created_at, modified_at)
+			SELECT ?, book_id, quantity, unit_price, now(), now() FROM cart_items WHERE cart_id = ?
+			ON CONFLICT (cart_id, book_id) DO UPDATE
+			SET quantity = LEAST(cart_items.quantity + EXCLUDED.quantity, ?), modified_at = now()`,
+			cart.ID, guest.ID, maxQuantity).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(&guest).Error; err != nil {
+			return err
+		}
+		return tx.Model(&cart).Update("expires_at", expiresAt).Error
+	})
+}
+
+func (r *cartRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := pkg.DB(ctx, r.db).Where("expires_at <= ?", now).Delete(&Cart{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// preloadCart loads the lines with their books, including books removed from
+// the catalog since they were added so the cart can flag them.
+func preloadCart(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("cart_items.id")
+	}).Preload("Items.Book", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	})
+}
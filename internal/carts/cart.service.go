@@ -0,0 +1,533 @@
+package carts
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/pkg/money"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// TokenCookie holds the token of a guest cart.
+	TokenCookie = "cart_token"
+	// MaxItemQuantity bounds the copies of one book in a cart.
+	MaxItemQuantity = 99
+
+	GuestCartTTL = 30 * 24 * time.Hour
+	UserCartTTL  = 90 * 24 * time.Hour
+
+	defaultCurrency = "USD"
+)
+
+const (
+	IssuePriceChanged      = "price_changed"
+	IssueInsufficientStock = "insufficient_stock"
+	IssueUnavailable       = "unavailable"
+)
+
+var (
+	ErrBookNotFound         = errors.New("book not found")
+	ErrItemNotFound         = errors.New("book is not in the cart")
+	ErrInsufficientStock    = errors.New("not enough copies in stock")
+	ErrQuantityLimit        = errors.New("a cart holds at most 99 copies of a book")
+	ErrPriceNotAvailable    = errors.New("book has no price in the cart currency")
+	ErrCurrencyNotAvailable = errors.New("prices are not available in this currency")
+	ErrEmptyCart            = errors.New("cart is empty")
+	ErrCouponNotFound       = errors.New("coupon code not found")
+)
+
+// Owner identifies a cart by the logged in user or, for guests, by the token
+// from the cart cookie.
+type Owner struct {
+	UserID *uint
+	Token  string
+}
+
+type CartService interface {
+	// GetCart returns the cart, switching it to currency first when one is
+	// given. Switching reprices every line without flagging the change.
+	GetCart(ctx context.Context, owner Owner, currency string) (*dto.CartResponse, error)
+	// AddItem adds a book to the cart, in currency when one is given. A new
+	// cart otherwise takes the currency of the book.
+	AddItem(ctx context.Context, owner Owner, currency string, req dto.AddCartItemRequest) (*dto.CartResponse, error)
+	UpdateItem(ctx context.Context, owner Owner, bookID uint, req dto.UpdateCartItemRequest) (*dto.CartResponse, error)
+	RemoveItem(ctx context.Context, owner Owner, bookID uint) (*dto.CartResponse, error)
+	// ApplyCoupon sets the coupon code of the cart. Whether it applies, and
+	// why not, is explained every time the cart is read.
+	ApplyCoupon(ctx context.Context, owner Owner, req dto.ApplyCouponRequest) (*dto.CartResponse, error)
+	RemoveCoupon(ctx context.Context, owner Owner) (*dto.CartResponse, error)
+	// MergeGuestCart runs on login to move the guest cart into the user's cart.
+	MergeGuestCart(ctx context.Context, guestToken string, userID uint) error
+	// PurgeExpired deletes abandoned carts, it runs as a scheduled job.
+	PurgeExpired(ctx context.Context) error
+}
+
+type cartService struct {
+	cartRepo         CartRepository
+	bookRepo         books.BookRepository
+	inventoryRepo    inventory.InventoryRepository
+	promotionService promotions.PromotionService
+	pricingService   pricing.PricingService
+}
+
+func NewCartService(cartRepo CartRepository, bookRepo books.BookRepository, inventoryRepo inventory.InventoryRepository, promotionService promotions.PromotionService, pricingService pricing.PricingService) CartService {
+	return &cartService{
+		cartRepo:         cartRepo,
+		bookRepo:         bookRepo,
+		inventoryRepo:    inventoryRepo,
+		promotionService: promotionService,
+		pricingService:   pricingService,
+	}
+}
+
+// NewToken returns a random token for a guest cart.
+func NewToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *cartService) GetCart(ctx context.Context, owner Owner, currency string) (*dto.CartResponse, error) {
+	currency, err := supported(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.find(ctx, owner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if currency == "" {
+			currency = defaultCurrency
+		}
+		return &dto.CartResponse{Currency: currency, Items: []dto.CartItemResponse{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.switchCurrency(ctx, cart, currency); err != nil {
+		return nil, err
+	}
+	return s.revalidate(ctx, cart)
+}
+
+func (s *cartService) AddItem(ctx context.Context, owner Owner, currency string, req dto.AddCartItemRequest) (*dto.CartResponse, error) {
+	currency, err := supported(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := s.bookRepo.FindByID(ctx, req.BookID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrBookNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	initial := currency
+	if initial == "" {
+		initial = book.Currency
+	}
+	cart, err := s.findOrCreate(ctx, owner, initial)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.switchCurrency(ctx, cart, currency); err != nil {
+		return nil, err
+	}
+
+	quantity := req.Quantity
+	for _, item := range cart.Items {
+		if item.BookID == book.ID {
+			quantity += item.Quantity
+		}
+	}
+	if err := s.saveItem(ctx, cart, book, quantity); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, owner, "")
+}
+
+func (s *cartService) UpdateItem(ctx context.Context, owner Owner, bookID uint, req dto.UpdateCartItemRequest) (*dto.CartResponse, error) {
+	cart, err := s.find(ctx, owner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrItemNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	item := findItem(cart, bookID)
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
+	if item.Book.DeletedAt.Valid {
+		return nil, ErrBookNotFound
+	}
+	if err := s.saveItem(ctx, cart, &item.Book, req.Quantity); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, owner, "")
+}
+
+func (s *cartService) RemoveItem(ctx context.Context, owner Owner, bookID uint) (*dto.CartResponse, error) {
+	cart, err := s.find(ctx, owner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrItemNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cartRepo.DeleteItem(ctx, cart.ID, bookID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrItemNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	cart.ExpiresAt = time.Now().Add(ttl(owner))
+	if err := s.cartRepo.Save(ctx, cart); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, owner, "")
+}
+
+func (s *cartService) ApplyCoupon(ctx context.Context, owner Owner, req dto.ApplyCouponRequest) (*dto.CartResponse, error) {
+	cart, err := s.find(ctx, owner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrEmptyCart
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := s.promotionService.FindCoupon(ctx, req.Code)
+	if errors.Is(err, promotions.ErrCouponNotFound) {
+		return nil, ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	cart.CouponCode = code
+	if err := s.cartRepo.Save(ctx, cart); err != nil {
+		return nil, err
+	}
+	return s.revalidate(ctx, cart)
+}
+
+func (s *cartService) RemoveCoupon(ctx context.Context, owner Owner) (*dto.CartResponse, error) {
+	cart, err := s.find(ctx, owner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrEmptyCart
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cart.CouponCode = ""
+	if err := s.cartRepo.Save(ctx, cart); err != nil {
+		return nil, err
+	}
+	return s.revalidate(ctx, cart)
+}
+
+func (s *cartService) MergeGuestCart(ctx context.Context, guestToken string, userID uint) error {
+	return s.cartRepo.Merge(ctx, guestToken, userID, time.Now().Add(UserCartTTL), MaxItemQuantity)
+}
+
+func (s *cartService) PurgeExpired(ctx context.Context) error {
+	purged, err := s.cartRepo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Printf("Purged %d expired carts", purged)
+	}
+	return nil
+}
+
+// saveItem checks the new quantity of a line against stock, unless the book
+// takes backorders, prices it in the currency of the cart and extends the
+// life of the cart.
+func (s *cartService) saveItem(ctx context.Context, cart *Cart, book *books.Book, quantity int) error {
+	if quantity > MaxItemQuantity {
+		return ErrQuantityLimit
+	}
+	if !book.AcceptsBackorders(time.Now()) {
+		available, err := s.inventoryRepo.AvailableBySKU(ctx, []string{book.SKU})
+		if err != nil {
+			return err
+		}
+		if quantity > available[book.SKU] {
+			return ErrInsufficientStock
+		}
+	}
+
+	quotes, err := s.quote(ctx, cart.Currency, []CartItem{{BookID: book.ID, Book: *book}})
+	if err != nil {
+		return err
+	}
+	price, ok := quotes[book.ID]
+	if !ok {
+		return ErrPriceNotAvailable
+	}
+
+	err = s.cartRepo.SaveItem(ctx, &CartItem{
+		CartID:    cart.ID,
+		BookID:    book.ID,
+		Quantity:  quantity,
+		UnitPrice: price.Amount,
+	})
+	if err != nil {
+		return err
+	}
+	cart.ExpiresAt = time.Now().Add(ttl(Owner{UserID: cart.UserID}))
+	return s.cartRepo.Save(ctx, cart)
+}
+
+// find returns the live cart of the owner. An expired cart is deleted on
+// sight so the owner starts over with an empty one.
+func (s *cartService) find(ctx context.Context, owner Owner) (*Cart, error) {
+	var cart *Cart
+	var err error
+	switch {
+	case owner.UserID != nil:
+		cart, err = s.cartRepo.FindByUserID(ctx, *owner.UserID)
+	case owner.Token != "":
+		cart, err = s.cartRepo.FindByToken(ctx, owner.Token)
+	default:
+		return nil, gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cart.ExpiresAt.After(time.Now()) {
+		err := s.cartRepo.Delete(ctx, cart.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cart, nil
+}
+
+func (s *cartService) findOrCreate(ctx context.Context, owner Owner, currency string) (*Cart, error) {
+	cart, err := s.find(ctx, owner)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return cart, err
+	}
+
+	token := owner.Token
+	if owner.UserID != nil {
+		if token, err = NewToken(); err != nil {
+			return nil, err
+		}
+	}
+	cart, err = s.cartRepo.Create(ctx, &Cart{
+		UserID:    owner.UserID,
+		Token:     token,
+		Currency:  currency,
+		ExpiresAt: time.Now().Add(ttl(owner)),
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		// A concurrent request created the cart first.
+		return s.find(ctx, owner)
+	}
+	return cart, err
+}
+
+// switchCurrency moves the cart to another currency and reprices its lines.
+// Lines without a price in the new currency keep their old one and show up
+// as unavailable.
+func (s *cartService) switchCurrency(ctx context.Context, cart *Cart, currency string) error {
+	if currency == "" || currency == cart.Currency {
+		return nil
+	}
+
+	quotes, err := s.quote(ctx, currency, cart.Items)
+	if err != nil {
+		return err
+	}
+	var repriced []CartItem
+	for i := range cart.Items {
+		if price, ok := quotes[cart.Items[i].BookID]; ok {
+			cart.Items[i].UnitPrice = price.Amount
+			repriced = append(repriced, cart.Items[i])
+		}
+	}
+	if len(repriced) > 0 {
+		if err := s.cartRepo.UpdatePrices(ctx, repriced); err != nil {
+			return err
+		}
+	}
+	cart.Currency = currency
+	return s.cartRepo.Save(ctx, cart)
+}
+
+// quote prices the books of the lines in currency.
+func (s *cartService) quote(ctx context.Context, currency string, items []CartItem) (map[uint]pricing.Price, error) {
+	lines := make([]pricing.Item, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, pricing.Item{BookID: item.BookID, Price: money.New(item.Book.Price, item.Book.Currency)})
+	}
+	return s.pricingService.Quote(ctx, currency, lines)
+}
+
+// revalidate compares every line with the current catalog, converted to the
+// currency of the cart, and with stock. Lines whose price changed are flagged
+// once and then carry the new price. The promotions are worked out on the
+// lines that can be bought.
+func (s *cartService) revalidate(ctx context.Context, cart *Cart) (*dto.CartResponse, error) {
+	skus := make([]string, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		skus = append(skus, item.Book.SKU)
+	}
+	available := map[string]int{}
+	quotes := map[uint]pricing.Price{}
+	if len(skus) > 0 {
+		var err error
+		if available, err = s.inventoryRepo.AvailableBySKU(ctx, skus); err != nil {
+			return nil, err
+		}
+		if quotes, err = s.quote(ctx, cart.Currency, cart.Items); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &dto.CartResponse{
+		ID:        cart.ID,
+		Currency:  cart.Currency,
+		Items:     make([]dto.CartItemResponse, 0, len(cart.Items)),
+		ExpiresAt: &cart.ExpiresAt,
+	}
+	now := time.Now()
+	basket := promotions.Basket{Currency: cart.Currency, UserID: cart.UserID, Code: cart.CouponCode}
+	var buyable []int
+	var repriced []CartItem
+	for _, item := range cart.Items {
+		book := item.Book
+		price, priced := quotes[item.BookID]
+		line := dto.CartItemResponse{
+			BookID:         item.BookID,
+			ISBN:           book.ISBN,
+			Title:          book.Title,
+			Quantity:       item.Quantity,
+			UnitPrice:      price.Amount,
+			PriceConverted: price.Converted,
+			Available:      available[book.SKU],
+			Preorder:       book.IsPreorder(now),
+		}
+
+		if book.DeletedAt.Valid || !priced {
+			line.UnitPrice = item.UnitPrice
+			line.PriceConverted = false
+			line.Available = 0
+			line.Issues = append(line.Issues, IssueUnavailable)
+			response.Items = append(response.Items, line)
+			continue
+		}
+		if price.Amount != item.UnitPrice {
+			previous := item.UnitPrice
+			line.PreviousPrice = &previous
+			line.Issues = append(line.Issues, IssuePriceChanged)
+			item.UnitPrice = price.Amount
+			repriced = append(repriced, item)
+		}
+		if item.Quantity > line.Available && book.AcceptsBackorders(now) {
+			line.Backorder = true
+		}
+		if item.Quantity > line.Available && !line.Backorder {
+			line.Issues = append(line.Issues, IssueInsufficientStock)
+		} else {
+			line.LineTotal = line.UnitPrice * int64(item.Quantity)
+			response.Subtotal += line.LineTotal
+			response.ItemCount += item.Quantity
+			basket.Lines = append(basket.Lines, promotions.Line{
+				BookID:    item.BookID,
+				Quantity:  item.Quantity,
+				UnitPrice: line.UnitPrice,
+			})
+			buyable = append(buyable, len(response.Items))
+		}
+		response.Items = append(response.Items, line)
+	}
+
+	if len(repriced) > 0 {
+		if err := s.cartRepo.UpdatePrices(ctx, repriced); err != nil {
+			return nil, err
+		}
+	}
+
+	evaluation, err := s.promotionService.Evaluate(ctx, basket)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range evaluation.Lines {
+		line := &response.Items[buyable[i]]
+		line.Discount = result.Discount
+		line.Promotions = toPromotionResponses(result.Adjustments)
+	}
+	response.Discount = evaluation.Discount
+	response.Total = response.Subtotal - evaluation.Discount
+	response.FreeShipping = evaluation.FreeShipping
+	response.Promotions = toPromotionResponses(evaluation.Applied)
+	if coupon := evaluation.Coupon; coupon != nil {
+		response.Coupon = &dto.CouponResponse{Code: coupon.Code, Applied: coupon.Applied, Reason: coupon.Reason}
+	}
+	return response, nil
+}
+
+// supported normalizes a requested currency, which may be empty.
+func supported(currency string) (string, error) {
+	if currency == "" {
+		return "", nil
+	}
+	currency, err := pricing.Supported(currency)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrCurrencyNotAvailable, currency)
+	}
+	return currency, nil
+}
+
+func toPromotionResponses(adjustments []promotions.Adjustment) []dto.PromotionResponse {
+	var responses []dto.PromotionResponse
+	for _, adjustment := range adjustments {
+		responses = append(responses, dto.PromotionResponse{
+			PromotionID: adjustment.PromotionID,
+			Name:        adjustment.Name,
+			Description: adjustment.Description,
+			Amount:      adjustment.Amount,
+		})
+	}
+	return responses
+}
+
+func findItem(cart *Cart, bookID uint) *CartItem {
+	for i := range cart.Items {
+		if cart.Items[i].BookID == bookID {
+			return &cart.Items[i]
+		}
+	}
+	return nil
+}
+
+func ttl(owner Owner) time.Duration {
+	if owner.UserID != nil {
+		return UserCartTTL
+	}
+	return GuestCartTTL
+}
@@ -0,0 +1,8 @@
+package dto
+
+// ExportQuery represents the query string of the catalog export endpoint
+type ExportQuery struct {
+	Format       string `form:"format" binding:"required,oneof=csv jsonl onix"`
+	Category     string `form:"category"`
+	UpdatedSince string `form:"updated_since" binding:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
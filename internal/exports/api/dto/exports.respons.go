@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+// ExportBookResponse is one line of the JSON Lines feed, prices are in minor
+// currency units
+type ExportBookResponse struct {
+	ISBN            string                      `json:"isbn"`
+	SKU             string                      `json:"sku"`
+	Title           string                      `json:"title"`
+	Subtitle        string                      `json:"subtitle,omitempty"`
+	Description     string                      `json:"description,omitempty"`
+	Price           int64                       `json:"price"`
+	Currency        string                      `json:"currency"`
+	Language        string                      `json:"language,omitempty"`
+	PageCount       int                         `json:"page_count,omitempty"`
+	PublicationDate string                      `json:"publication_date,omitempty"`
+	Publisher       string                      `json:"publisher,omitempty"`
+	Contributors    []ExportContributorResponse `json:"contributors"`
+	Categories      []string                    `json:"categories"`
+	Stock           int                         `json:"stock"`
+	Deleted         bool                        `json:"deleted,omitempty"`
+	UpdatedAt       time.Time                   `json:"updated_at"`
+}
+
+type ExportContributorResponse struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"bookstore-framework/internal/exports"
+	"bookstore-framework/internal/exports/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	exportService exports.ExportService
+}
+
+func NewExportHandler(exportService exports.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportCatalog godoc
+// @Summary      Export the catalog
+// @Description  Stream books with prices and total stock as CSV, JSON Lines or ONIX 3.0. With updated_since the feed only holds books changed or deleted since then
+// @Tags         exports
+// @Security     BearerAuth
+// @Produce      text/csv,application/x-ndjson,application/xml
+// @Param        format        query  string true  "csv, jsonl or onix"
+// @Param        category      query  string false "Category slug, includes subcategories"
+// @Param        updated_since query  string false "RFC 3339 timestamp"
+// @Success      200  {file}    file "Catalog feed"
+// @Failure      400  {object}  pkg.Response "Invalid Request format"
+// @Failure      404  {object}  pkg.Response "Category not found"
+// @Router       /exports/catalog [get]
+func (h *ExportHandler) ExportCatalog(ctx *gin.Context) {
+	var query dto.ExportQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	contentType, extension, err := exports.ContentType(query.Format)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+	filter, err := h.exportService.ResolveFilter(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	filename := fmt.Sprintf("catalog-%s.%s", time.Now().UTC().Format("20060102T150405Z"), extension)
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Status(http.StatusOK)
+
+	// The status line is already sent, so a failure can only cut the feed short.
+	if err := h.exportService.Export(ctx.Request.Context(), query.Format, *filter, ctx.Writer); err != nil {
+		log.Printf("Catalog export failed: %v", err)
+		ctx.Abort()
+	}
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, exports.ErrCategoryNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, exports.ErrUnsupportedFormat), errors.Is(err, exports.ErrInvalidTimestamp):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/exports"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func ExportsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	exportRepository := exports.NewExportRepository(db)
+	categoryRepository := books.NewCategoryRepository(db)
+	exportService := exports.NewExportService(exportRepository, categoryRepository)
+	exportHandler := NewExportHandler(exportService)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.GET("/catalog", exportHandler.ExportCatalog)
+}
@@ -0,0 +1,242 @@
+package exports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/pkg/money"
+	"bookstore-framework/pkg/onix"
+	"bufio"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// senderName identifies this store in the header of ONIX messages.
+const senderName = "Bookstore"
+
+// ONIX code list 17 codes for the contributor roles books use.
+var onixContributorRoles = map[string]string{
+	books.RoleAuthor:      "A01",
+	books.RoleEditor:      "B01",
+	books.RoleTranslator:  "B06",
+	books.RoleIllustrator: "A12",
+}
+
+// The types below mirror the ONIX 3.0 reference tags in schema order.
+type onixProduct struct {
+	XMLName           xml.Name          `xml:"Product"`
+	RecordReference   string            `xml:"RecordReference"`
+	NotificationType  string            `xml:"NotificationType"`
+	ProductIdentifier onixIdentifier    `xml:"ProductIdentifier"`
+	DescriptiveDetail onixDescriptive   `xml:"DescriptiveDetail"`
+	CollateralDetail  *onixCollateral   `xml:"CollateralDetail,omitempty"`
+	PublishingDetail  *onixPublishing   `xml:"PublishingDetail,omitempty"`
+	ProductSupply     onixProductSupply `xml:"ProductSupply"`
+}
+
+type onixIdentifier struct {
+	ProductIDType string `xml:"ProductIDType"`
+	IDValue       string `xml:"IDValue"`
+}
+
+type onixDescriptive struct {
+	ProductComposition string            `xml:"ProductComposition"`
+	ProductForm        string            `xml:"ProductForm"`
+	TitleDetail        onixTitleDetail   `xml:"TitleDetail"`
+	Contributors       []onixContributor `xml:"Contributor"`
+	Language           *onixLanguage     `xml:"Language,omitempty"`
+	Extent             *onixExtent       `xml:"Extent,omitempty"`
+}
+
+type onixTitleDetail struct {
+	TitleType    string `xml:"TitleType"`
+	TitleElement struct {
+		TitleElementLevel string `xml:"TitleElementLevel"`
+		TitleText         string `xml:"TitleText"`
+		Subtitle          string `xml:"Subtitle,omitempty"`
+	} `xml:"TitleElement"`
+}
+
+type onixContributor struct {
+	SequenceNumber  int    `xml:"SequenceNumber"`
+	ContributorRole string `xml:"ContributorRole"`
+	PersonName      string `xml:"PersonName"`
+}
+
+type onixLanguage struct {
+	LanguageRole string `xml:"LanguageRole"`
+	LanguageCode string `xml:"LanguageCode"`
+}
+
+type onixExtent struct {
+	ExtentType  string `xml:"ExtentType"`
+	ExtentValue int    `xml:"ExtentValue"`
+	ExtentUnit  string `xml:"ExtentUnit"`
+}
+
+type onixCollateral struct {
+	TextContent struct {
+		TextType        string `xml:"TextType"`
+		ContentAudience string `xml:"ContentAudience"`
+		Text            string `xml:"Text"`
+	} `xml:"TextContent"`
+}
+
+type onixPublishing struct {
+	Publisher *struct {
+		PublishingRole string `xml:"PublishingRole"`
+		PublisherName  string `xml:"PublisherName"`
+	} `xml:"Publisher,omitempty"`
+	PublishingDate *struct {
+		PublishingDateRole string `xml:"PublishingDateRole"`
+		Date               string `xml:"Date"`
+	} `xml:"PublishingDate,omitempty"`
+}
+
+type onixProductSupply struct {
+	SupplyDetail struct {
+		Supplier struct {
+			SupplierRole string `xml:"SupplierRole"`
+			SupplierName string `xml:"SupplierName"`
+		} `xml:"Supplier"`
+		ProductAvailability string `xml:"ProductAvailability"`
+		Stock               struct {
+			OnHand int `xml:"OnHand"`
+		} `xml:"Stock"`
+		Price struct {
+			PriceType    string `xml:"PriceType"`
+			PriceAmount  string `xml:"PriceAmount"`
+			CurrencyCode string `xml:"CurrencyCode"`
+		} `xml:"Price"`
+	} `xml:"SupplyDetail"`
+}
+
+type onixWriter struct {
+	w       *bufio.Writer
+	encoder *xml.Encoder
+}
+
+func newONIXWriter(w io.Writer) *onixWriter {
+	buffered := bufio.NewWriter(w)
+	return &onixWriter{w: buffered, encoder: xml.NewEncoder(buffered)}
+}
+
+func (o *onixWriter) Begin() error {
+	if _, err := io.WriteString(o.w, xml.Header); err != nil {
+		return err
+	}
+	message := xml.StartElement{
+		Name: xml.Name{Local: "ONIXMessage"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "release"}, Value: "3.0"},
+			{Name: xml.Name{Local: "xmlns"}, Value: onix.Namespace},
+		},
+	}
+	if err := o.encoder.EncodeToken(message); err != nil {
+		return err
+	}
+
+	header := struct {
+		XMLName xml.Name `xml:"Header"`
+		Sender  struct {
+			SenderName string `xml:"SenderName"`
+		} `xml:"Sender"`
+		SentDateTime string `xml:"SentDateTime"`
+	}{SentDateTime: time.Now().UTC().Format("20060102T1504Z")}
+	header.Sender.SenderName = senderName
+	return o.encoder.Encode(header)
+}
+
+func (o *onixWriter) Write(row ExportRow) error {
+	book := row.Book
+	product := onixProduct{
+		RecordReference:   book.ISBN,
+		NotificationType:  "03",
+		ProductIdentifier: onixIdentifier{ProductIDType: "15", IDValue: book.ISBN},
+	}
+	if row.Deleted {
+		product.NotificationType = "05"
+	}
+
+	detail := &product.DescriptiveDetail
+	detail.ProductComposition = "00"
+	detail.ProductForm = "BA"
+	detail.TitleDetail.TitleType = "01"
+	detail.TitleDetail.TitleElement.TitleElementLevel = "01"
+	detail.TitleDetail.TitleElement.TitleText = book.Title
+	detail.TitleDetail.TitleElement.Subtitle = book.Subtitle
+	for i, a := range book.Authors {
+		detail.Contributors = append(detail.Contributors, onixContributor{
+			SequenceNumber:  i + 1,
+			ContributorRole: onixContributorRoles[a.Role],
+			PersonName:      a.Author.Name,
+		})
+	}
+	if book.Language != "" {
+		detail.Language = &onixLanguage{LanguageRole: "01", LanguageCode: onix.ToLanguage(book.Language)}
+	}
+	if book.PageCount > 0 {
+		detail.Extent = &onixExtent{ExtentType: "00", ExtentValue: book.PageCount, ExtentUnit: "03"}
+	}
+
+	if book.Description != "" {
+		product.CollateralDetail = &onixCollateral{}
+		product.CollateralDetail.TextContent.TextType = "03"
+		product.CollateralDetail.TextContent.ContentAudience = "00"
+		product.CollateralDetail.TextContent.Text = book.Description
+	}
+
+	if book.Publisher != nil || book.PublicationDate != nil {
+		publishing := &onixPublishing{}
+		if book.Publisher != nil {
+			publishing.Publisher = &struct {
+				PublishingRole string `xml:"PublishingRole"`
+				PublisherName  string `xml:"PublisherName"`
+			}{PublishingRole: "01", PublisherName: book.Publisher.Name}
+		}
+		if book.PublicationDate != nil {
+			publishing.PublishingDate = &struct {
+				PublishingDateRole string `xml:"PublishingDateRole"`
+				Date               string `xml:"Date"`
+			}{PublishingDateRole: "01", Date: book.PublicationDate.Format("20060102")}
+		}
+		product.PublishingDetail = publishing
+	}
+
+	supply := &product.ProductSupply.SupplyDetail
+	supply.Supplier.SupplierRole = "00"
+	supply.Supplier.SupplierName = senderName
+	supply.ProductAvailability = onixAvailability(row)
+	supply.Stock.OnHand = row.OnHand
+	supply.Price.PriceType = "01"
+	supply.Price.PriceAmount = money.FormatAmount(book.Price, book.Currency)
+	supply.Price.CurrencyCode = book.Currency
+
+	return o.encoder.Encode(product)
+}
+
+func (o *onixWriter) Flush() error {
+	if err := o.encoder.Flush(); err != nil {
+		return err
+	}
+	return o.w.Flush()
+}
+
+func (o *onixWriter) End() error {
+	if err := o.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: "ONIXMessage"}}); err != nil {
+		return err
+	}
+	return o.Flush()
+}
+
+// onixAvailability maps stock to code list 65: 21 in stock, 31 out of stock,
+// 40 no longer available.
+func onixAvailability(row ExportRow) string {
+	switch {
+	case row.Deleted:
+		return "40"
+	case row.OnHand > 0:
+		return "21"
+	default:
+		return "31"
+	}
+}
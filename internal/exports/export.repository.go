@@ -0,0 +1,108 @@
+package exports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// exportBatchSize is the number of books held in memory at once.
+const exportBatchSize = 500
+
+type ExportFilter struct {
+	// CategoryPath matches books in the category and all of its descendants.
+	CategoryPath string
+	// UpdatedSince limits the feed to books changed after the given time,
+	// including books deleted since then.
+	UpdatedSince *time.Time
+}
+
+// ExportRow is a book with its total stock across locations.
+type ExportRow struct {
+	Book    books.Book
+	OnHand  int
+	Deleted bool
+}
+
+type ExportRepository interface {
+	// StreamBooks calls fn with consecutive batches of books in id order.
+	StreamBooks(ctx context.Context, filter ExportFilter, fn func(rows []ExportRow) error) error
+}
+
+type exportRepository struct {
+	db *gorm.DB
+}
+
+func NewExportRepository(db *gorm.DB) ExportRepository {
+	return &exportRepository{
+		db: db,
+	}
+}
+
+func (r *exportRepository) StreamBooks(ctx context.Context, filter ExportFilter, fn func(rows []ExportRow) error) error {
+	query := r.db.WithContext(ctx).Model(&books.Book{})
+	if filter.UpdatedSince != nil {
+		query = query.Unscoped().Where("(deleted_at IS NULL AND modified_at >= ?) OR deleted_at >= ?",
+			*filter.UpdatedSince, *filter.UpdatedSince)
+	}
+	if filter.CategoryPath != "" {
+		query = query.Where("id IN (?)", r.db.Model(&books.BookCategory{}).
+			Select("book_categories.book_id").
+			Joins("JOIN categories ON categories.id = book_categories.category_id").
+			Where("categories.path LIKE ?", filter.CategoryPath+"%"))
+	}
+
+	var batch []books.Book
+	result := query.Preload("Publisher").
+		Preload("Authors", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Preload("Authors.Author").
+		Preload("Categories").
+		FindInBatches(&batch, exportBatchSize, func(tx *gorm.DB, _ int) error {
+			onHand, err := r.stockBySKU(ctx, batch)
+			if err != nil {
+				return err
+			}
+
+			rows := make([]ExportRow, 0, len(batch))
+			for _, book := range batch {
+				rows = append(rows, ExportRow{
+					Book:    book,
+					OnHand:  onHand[book.SKU],
+					Deleted: book.DeletedAt.Valid,
+				})
+			}
+			return fn(rows)
+		})
+	return result.Error
+}
+
+func (r *exportRepository) stockBySKU(ctx context.Context, batch []books.Book) (map[string]int, error) {
+	skus := make([]string, 0, len(batch))
+	for _, book := range batch {
+		skus = append(skus, book.SKU)
+	}
+
+	var levels []struct {
+		SKU    string
+		OnHand int
+	}
+	err := r.db.WithContext(ctx).Model(&inventory.StockLevel{}).
+		Select("sku, SUM(on_hand) AS on_hand").
+		Where("sku IN ?", skus).
+		Group("sku").
+		Scan(&levels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	onHand := make(map[string]int, len(levels))
+	for _, level := range levels {
+		onHand[level.SKU] = level.OnHand
+	}
+	return onHand, nil
+}
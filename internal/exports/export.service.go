@@ -0,0 +1,82 @@
+package exports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/exports/api/dto"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported export format, use csv, jsonl or onix")
+	ErrCategoryNotFound  = errors.New("category not found")
+	ErrInvalidTimestamp  = errors.New("updated_since must be an RFC 3339 timestamp")
+)
+
+type ExportService interface {
+	// ResolveFilter validates the query before any of the feed is written.
+	ResolveFilter(ctx context.Context, query dto.ExportQuery) (*ExportFilter, error)
+	// Export writes the feed to w one batch at a time.
+	Export(ctx context.Context, format string, filter ExportFilter, w io.Writer) error
+}
+
+type exportService struct {
+	exportRepo   ExportRepository
+	categoryRepo books.CategoryRepository
+}
+
+func NewExportService(exportRepo ExportRepository, categoryRepo books.CategoryRepository) ExportService {
+	return &exportService{
+		exportRepo:   exportRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (s *exportService) ResolveFilter(ctx context.Context, query dto.ExportQuery) (*ExportFilter, error) {
+	filter := &ExportFilter{}
+	if query.Category != "" {
+		category, err := s.categoryRepo.FindBySlug(ctx, query.Category)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		filter.CategoryPath = category.Path
+	}
+	if query.UpdatedSince != "" {
+		since, err := time.Parse(time.RFC3339, query.UpdatedSince)
+		if err != nil {
+			return nil, ErrInvalidTimestamp
+		}
+		filter.UpdatedSince = &since
+	}
+	return filter, nil
+}
+
+func (s *exportService) Export(ctx context.Context, format string, filter ExportFilter, w io.Writer) error {
+	writer, err := NewFeedWriter(format, w)
+	if err != nil {
+		return err
+	}
+	if err := writer.Begin(); err != nil {
+		return err
+	}
+
+	err = s.exportRepo.StreamBooks(ctx, filter, func(rows []ExportRow) error {
+		for _, row := range rows {
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		return writer.Flush()
+	})
+	if err != nil {
+		return err
+	}
+	return writer.End()
+}
@@ -0,0 +1,194 @@
+package exports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/exports/api/dto"
+	"bookstore-framework/pkg/money"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+	FormatONIX  = "onix"
+
+	dateLayout = "2006-01-02"
+)
+
+// FeedWriter encodes export rows in one feed format. Flush is called after
+// every batch so the feed reaches the client while it is being generated.
+type FeedWriter interface {
+	Begin() error
+	Write(row ExportRow) error
+	Flush() error
+	End() error
+}
+
+// NewFeedWriter returns the writer for format.
+func NewFeedWriter(format string, w io.Writer) (FeedWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatJSONL:
+		buffered := bufio.NewWriter(w)
+		return &jsonlWriter{w: buffered, encoder: json.NewEncoder(buffered)}, nil
+	case FormatONIX:
+		return newONIXWriter(w), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the media type and file extension of a feed format.
+func ContentType(format string) (string, string, error) {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8", "csv", nil
+	case FormatJSONL:
+		return "application/x-ndjson", "jsonl", nil
+	case FormatONIX:
+		return "application/xml; charset=utf-8", "xml", nil
+	default:
+		return "", "", ErrUnsupportedFormat
+	}
+}
+
+// csvColumns are compatible with the catalog import, which ignores the
+// columns it does not use.
+var csvColumns = []string{
+	"isbn", "sku", "title", "subtitle", "description", "price", "currency",
+	"language", "page_count", "publication_date", "publisher", "authors",
+	"categories", "stock", "status", "updated_at",
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Begin() error {
+	return c.w.Write(csvColumns)
+}
+
+func (c *csvWriter) Write(row ExportRow) error {
+	book := row.Book
+	status := "active"
+	if row.Deleted {
+		status = "deleted"
+	}
+
+	authors := make([]string, 0, len(book.Authors))
+	for _, a := range book.Authors {
+		if a.Role == books.RoleAuthor {
+			authors = append(authors, a.Author.Name)
+		} else {
+			authors = append(authors, fmt.Sprintf("%s (%s)", a.Author.Name, a.Role))
+		}
+	}
+
+	categories := make([]string, 0, len(book.Categories))
+	for _, c := range book.Categories {
+		categories = append(categories, c.Slug)
+	}
+
+	return c.w.Write([]string{
+		book.ISBN,
+		book.SKU,
+		book.Title,
+		book.Subtitle,
+		book.Description,
+		money.FormatAmount(book.Price, book.Currency),
+		book.Currency,
+		book.Language,
+		strconv.Itoa(book.PageCount),
+		formatDate(book.PublicationDate),
+		publisherName(book),
+		strings.Join(authors, "; "),
+		strings.Join(categories, "; "),
+		strconv.Itoa(row.OnHand),
+		status,
+		book.ModifiedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) End() error {
+	return c.Flush()
+}
+
+type jsonlWriter struct {
+	w       *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (j *jsonlWriter) Begin() error {
+	return nil
+}
+
+func (j *jsonlWriter) Write(row ExportRow) error {
+	return j.encoder.Encode(ToExportBookResponse(row))
+}
+
+func (j *jsonlWriter) Flush() error {
+	return j.w.Flush()
+}
+
+func (j *jsonlWriter) End() error {
+	return j.Flush()
+}
+
+func ToExportBookResponse(row ExportRow) *dto.ExportBookResponse {
+	book := row.Book
+	response := &dto.ExportBookResponse{
+		ISBN:            book.ISBN,
+		SKU:             book.SKU,
+		Title:           book.Title,
+		Subtitle:        book.Subtitle,
+		Description:     book.Description,
+		Price:           book.Price,
+		Currency:        book.Currency,
+		Language:        book.Language,
+		PageCount:       book.PageCount,
+		PublicationDate: formatDate(book.PublicationDate),
+		Publisher:       publisherName(book),
+		Contributors:    make([]dto.ExportContributorResponse, 0, len(book.Authors)),
+		Categories:      make([]string, 0, len(book.Categories)),
+		Stock:           row.OnHand,
+		Deleted:         row.Deleted,
+		UpdatedAt:       book.ModifiedAt,
+	}
+	for _, a := range book.Authors {
+		response.Contributors = append(response.Contributors, dto.ExportContributorResponse{
+			Name: a.Author.Name,
+			Role: a.Role,
+		})
+	}
+	for _, c := range book.Categories {
+		response.Categories = append(response.Categories, c.Slug)
+	}
+	return response
+}
+
+func formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(dateLayout)
+}
+
+func publisherName(book books.Book) string {
+	if book.Publisher == nil {
+		return ""
+	}
+	return book.Publisher.Name
+}
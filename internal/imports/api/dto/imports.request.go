@@ -0,0 +1,7 @@
+package dto
+
+// ImportRequest holds the form fields sent with an import file. The format is
+// taken from the file extension (.csv, .xml or .onix) when omitted.
+type ImportRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv onix" example:"csv"`
+}
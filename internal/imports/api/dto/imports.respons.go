@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type ImportJobResponse struct {
+	ID           uint       `json:"id"`
+	Format       string     `json:"format"`
+	Filename     string     `json:"filename"`
+	Status       string     `json:"status"`
+	TotalRows    int        `json:"total_rows"`
+	ImportedRows int        `json:"imported_rows"`
+	FailedRows   int        `json:"failed_rows"`
+	Error        string     `json:"error,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type ImportErrorResponse struct {
+	Row     int    `json:"row"`
+	ISBN    string `json:"isbn,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportErrorListResponse struct {
+	Errors     []ImportErrorResponse `json:"errors"`
+	Pagination pkg.PaginationMeta    `json:"pagination"`
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"bookstore-framework/internal/imports"
+	"bookstore-framework/internal/imports/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportSize bounds the upload of a single catalog file.
+const maxImportSize = 100 << 20
+
+type ImportHandler struct {
+	importService imports.ImportService
+}
+
+func NewImportHandler(importService imports.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// CreateImport godoc
+// @Summary      Import a catalog file
+// @Description  Upload a CSV or ONIX 3.0 file of books. The file is imported in the background, poll the job for progress and per-row errors
+// @Tags         imports
+// @Security     BearerAuth
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file   formData  file   true  "CSV or ONIX file"
+// @Param        format formData  string false "csv or onix, inferred from the file extension when omitted"
+// @Success      202  {object}    pkg.Response{data=dto.ImportJobResponse} "Import started successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      401  {object}    pkg.Response "User not found"
+// @Router       /imports [post]
+func (h *ImportHandler) CreateImport(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImportSize)
+
+	var req dto.ImportRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+	defer file.Close()
+
+	response, err := h.importService.CreateJob(ctx.Request.Context(), userID.(uint), req.Format, header.Filename, file)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.SuccessResponse(ctx, http.StatusAccepted, "Import started successfully", response)
+}
+
+// GetImport godoc
+// @Summary      Get an import job
+// @Description  Get the status and row counts of an import job
+// @Tags         imports
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Import job ID"
+// @Success      200  {object}    pkg.Response{data=dto.ImportJobResponse} "Import retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Import job not found"
+// @Router       /imports/{id} [get]
+func (h *ImportHandler) GetImport(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid import id", err.Error())
+		return
+	}
+
+	response, err := h.importService.GetJob(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Import retrieve successfully", response)
+}
+
+// GetImportErrors godoc
+// @Summary      List import errors
+// @Description  List the rejected records of an import job with the reason for each
+// @Tags         imports
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id    path       int true  "Import job ID"
+// @Param        page  query      int false "Page number" default(1)
+// @Param        limit query      int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.ImportErrorListResponse} "Import errors retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Import job not found"
+// @Router       /imports/{id}/errors [get]
+func (h *ImportHandler) GetImportErrors(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid import id", err.Error())
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.importService.GetJobErrors(ctx.Request.Context(), id, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Import errors retrieve successfully", response)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, imports.ErrJobNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, imports.ErrUnsupportedFormat):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
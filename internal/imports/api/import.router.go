@@ -0,0 +1,21 @@
+package api
+
+import (
+	"bookstore-framework/internal/imports"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func ImportsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	importRepository := imports.NewImportRepository(db)
+	importService := imports.NewImportService(importRepository)
+	importHandler := NewImportHandler(importService)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.POST("", importHandler.CreateImport)
+	router.GET("/:id", importHandler.GetImport)
+	router.GET("/:id/errors", importHandler.GetImportErrors)
+}
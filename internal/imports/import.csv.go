@@ -0,0 +1,112 @@
+package imports
+
+import (
+	"bookstore-framework/internal/books"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSV files need a header row naming their columns, in any order:
+//
+//	isbn,title,subtitle,description,price,currency,language,page_count,publication_date,publisher,authors
+//
+// Only isbn, title and price are required. Prices are decimal amounts and
+// authors are separated by semicolons, each with an optional role in
+// parentheses: "J. R. R. Tolkien; Alan Lee (illustrator)".
+var csvRequiredColumns = []string{"isbn", "title", "price"}
+
+// ParseCSV streams the rows of a CSV file to fn.
+func ParseCSV(r io.Reader, fn RecordFunc) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("csv file is empty")
+		}
+		return err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+	for _, name := range csvRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("csv header is missing the %q column", name)
+		}
+	}
+
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := reader.FieldPos(0)
+
+		value := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+
+		record, err := csvRecord(value)
+		record.Row = line
+		if err := fn(record, err); err != nil {
+			return err
+		}
+	}
+}
+
+func csvRecord(value func(string) string) (Record, error) {
+	record := Record{
+		ISBN:        value("isbn"),
+		Title:       value("title"),
+		Subtitle:    value("subtitle"),
+		Description: value("description"),
+		Currency:    value("currency"),
+		Language:    strings.ToLower(value("language")),
+		Publisher:   value("publisher"),
+	}
+
+	price, err := parsePrice(value("price"), record.Currency)
+	if err != nil {
+		return record, err
+	}
+	record.Price = price
+
+	if pages := value("page_count"); pages != "" {
+		record.PageCount, err = strconv.Atoi(pages)
+		if err != nil {
+			return record, fmt.Errorf("invalid page count %q", pages)
+		}
+	}
+
+	record.PublicationDate, err = parseDate(value("publication_date"))
+	if err != nil {
+		return record, err
+	}
+
+	for _, entry := range strings.Split(value("authors"), ";") {
+		name, role := strings.TrimSpace(entry), books.RoleAuthor
+		if open := strings.LastIndex(name, "("); open > 0 && strings.HasSuffix(name, ")") {
+			name, role = strings.TrimSpace(name[:open]), strings.ToLower(strings.TrimSpace(name[open+1:len(name)-1]))
+		}
+		if name != "" {
+			record.Contributors = append(record.Contributors, Contributor{Name: name, Role: role})
+		}
+	}
+	return record, nil
+}
@@ -0,0 +1,49 @@
+package imports
+
+import "time"
+
+const (
+	FormatCSV  = "csv"
+	FormatONIX = "onix"
+
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// ImportJob tracks one catalog file import. A job only fails as a whole when
+// the file cannot be read; invalid records are reported as ImportErrors.
+type ImportJob struct {
+	ID           uint       `gorm:"primaryKey"`
+	Format       string     `gorm:"column:format;size:8;not null"`
+	Filename     string     `gorm:"column:filename;size:255"`
+	Status       string     `gorm:"column:status;size:16;not null;default:pending;index"`
+	TotalRows    int        `gorm:"column:total_rows;not null;default:0"`
+	ImportedRows int        `gorm:"column:imported_rows;not null;default:0"`
+	FailedRows   int        `gorm:"column:failed_rows;not null;default:0"`
+	Error        string     `gorm:"column:error;type:text"`
+	ActorID      *uint      `gorm:"column:actor_id"`
+	StartedAt    *time.Time `gorm:"column:started_at"`
+	FinishedAt   *time.Time `gorm:"column:finished_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt   time.Time  `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (ImportJob) TableName() string {
+	return "import_jobs"
+}
+
+// ImportError reports why a single record of an import was rejected. Row is the
+// line number for CSV files and the product position for ONIX files.
+type ImportError struct {
+	ID      uint   `gorm:"primaryKey"`
+	JobID   uint   `gorm:"column:job_id;not null;index"`
+	Row     int    `gorm:"column:row;not null"`
+	ISBN    string `gorm:"column:isbn;size:32"`
+	Message string `gorm:"column:message;type:text;not null"`
+}
+
+func (ImportError) TableName() string {
+	return "import_errors"
+}
@@ -0,0 +1,216 @@
+package imports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/pkg/onix"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// onixProduct maps the parts of an ONIX 3.0 <Product> (reference tag names)
+// that the catalog stores.
+type onixProduct struct {
+	Identifiers []struct {
+		Type  string `xml:"ProductIDType"`
+		Value string `xml:"IDValue"`
+	} `xml:"ProductIdentifier"`
+	Contributors []struct {
+		Sequence       int      `xml:"SequenceNumber"`
+		Roles          []string `xml:"ContributorRole"`
+		PersonName     string   `xml:"PersonName"`
+		NamesBeforeKey string   `xml:"NamesBeforeKey"`
+		KeyNames       string   `xml:"KeyNames"`
+		CorporateName  string   `xml:"CorporateName"`
+	} `xml:"DescriptiveDetail>Contributor"`
+	Titles []struct {
+		Type     string `xml:"TitleType"`
+		Elements []struct {
+			Level         string `xml:"TitleElementLevel"`
+			Text          string `xml:"TitleText"`
+			Prefix        string `xml:"TitlePrefix"`
+			WithoutPrefix string `xml:"TitleWithoutPrefix"`
+			Subtitle      string `xml:"Subtitle"`
+		} `xml:"TitleElement"`
+	} `xml:"DescriptiveDetail>TitleDetail"`
+	Languages []struct {
+		Role string `xml:"LanguageRole"`
+		Code string `xml:"LanguageCode"`
+	} `xml:"DescriptiveDetail>Language"`
+	Extents []struct {
+		Type  string `xml:"ExtentType"`
+		Value string `xml:"ExtentValue"`
+		Unit  string `xml:"ExtentUnit"`
+	} `xml:"DescriptiveDetail>Extent"`
+	Texts []struct {
+		Type string `xml:"TextType"`
+		Text string `xml:"Text"`
+	} `xml:"CollateralDetail>TextContent"`
+	Publishers []struct {
+		Role string `xml:"PublishingRole"`
+		Name string `xml:"PublisherName"`
+	} `xml:"PublishingDetail>Publisher"`
+	Dates []struct {
+		Role string `xml:"PublishingDateRole"`
+		Date string `xml:"Date"`
+	} `xml:"PublishingDetail>PublishingDate"`
+	Prices []struct {
+		Type     string `xml:"PriceType"`
+		Amount   string `xml:"PriceAmount"`
+		Currency string `xml:"CurrencyCode"`
+	} `xml:"ProductSupply>SupplyDetail>Price"`
+}
+
+// ONIX code list 17 contributor roles the catalog distinguishes.
+var onixContributorRoles = map[string]string{
+	"A01": books.RoleAuthor,
+	"B01": books.RoleEditor,
+	"B06": books.RoleTranslator,
+	"A12": books.RoleIllustrator,
+}
+
+// ParseONIX streams the <Product> records of an ONIX 3.0 message to fn
+// without loading the whole message. Row numbers count products from 1.
+func ParseONIX(r io.Reader, fn RecordFunc) error {
+	decoder := xml.NewDecoder(r)
+	row := 0
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			if row == 0 {
+				return errors.New("onix message has no products")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Product" {
+			continue
+		}
+
+		row++
+		var product onixProduct
+		if err := decoder.DecodeElement(&product, &start); err != nil {
+			return err
+		}
+		record, err := product.record()
+		record.Row = row
+		if err := fn(record, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *onixProduct) record() (Record, error) {
+	record := Record{ISBN: p.isbn()}
+
+	for _, title := range p.Titles {
+		if title.Type != "01" {
+			continue
+		}
+		for _, element := range title.Elements {
+			if element.Level != "01" {
+				continue
+			}
+			record.Title = element.Text
+			if record.Title == "" {
+				record.Title = strings.TrimSpace(element.Prefix + " " + element.WithoutPrefix)
+			}
+			record.Subtitle = element.Subtitle
+		}
+	}
+
+	sort.SliceStable(p.Contributors, func(i, j int) bool {
+		return p.Contributors[i].Sequence < p.Contributors[j].Sequence
+	})
+	for _, c := range p.Contributors {
+		name := c.PersonName
+		if name == "" {
+			name = strings.TrimSpace(c.NamesBeforeKey + " " + c.KeyNames)
+		}
+		if name == "" {
+			name = c.CorporateName
+		}
+		for _, code := range c.Roles {
+			if role, ok := onixContributorRoles[code]; ok {
+				record.Contributors = append(record.Contributors, Contributor{Name: strings.TrimSpace(name), Role: role})
+			}
+		}
+	}
+
+	for _, language := range p.Languages {
+		if language.Role == "01" {
+			record.Language = onix.FromLanguage(strings.ToLower(language.Code))
+		}
+	}
+
+	for _, extent := range p.Extents {
+		if (extent.Type == "00" || extent.Type == "11") && extent.Unit == "03" {
+			pages, err := strconv.Atoi(extent.Value)
+			if err != nil {
+				return record, fmt.Errorf("invalid page count %q", extent.Value)
+			}
+			record.PageCount = pages
+			break
+		}
+	}
+
+	for _, textType := range []string{"03", "02"} {
+		for _, text := range p.Texts {
+			if text.Type == textType && record.Description == "" {
+				record.Description = strings.TrimSpace(text.Text)
+			}
+		}
+	}
+
+	for _, publisher := range p.Publishers {
+		if publisher.Role == "01" {
+			record.Publisher = strings.TrimSpace(publisher.Name)
+		}
+	}
+
+	var err error
+	for _, date := range p.Dates {
+		if date.Role == "01" {
+			if record.PublicationDate, err = parseDate(date.Date); err != nil {
+				return record, err
+			}
+		}
+	}
+
+	if len(p.Prices) == 0 {
+		return record, errors.New("price is required")
+	}
+	price := p.Prices[0]
+	for _, candidate := range p.Prices {
+		if candidate.Type == "01" || candidate.Type == "02" {
+			price = candidate
+			break
+		}
+	}
+	if record.Price, err = parsePrice(price.Amount, price.Currency); err != nil {
+		return record, err
+	}
+	record.Currency = price.Currency
+
+	return record, nil
+}
+
+// isbn prefers the ISBN-13 identifier, then GTIN-13, then ISBN-10.
+func (p *onixProduct) isbn() string {
+	for _, idType := range []string{"15", "03", "02"} {
+		for _, id := range p.Identifiers {
+			if id.Type == idType {
+				return id.Value
+			}
+		}
+	}
+	return ""
+}
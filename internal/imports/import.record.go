@@ -0,0 +1,137 @@
+package imports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/pkg/isbn"
+	"bookstore-framework/pkg/money"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Record is one book read from an import file, in the shape both parsers
+// produce. Prices are in minor currency units.
+type Record struct {
+	Row             int
+	ISBN            string
+	Title           string
+	Subtitle        string
+	Description     string
+	Price           int64
+	Currency        string
+	Language        string
+	PageCount       int
+	PublicationDate *time.Time
+	Publisher       string
+	Contributors    []Contributor
+}
+
+type Contributor struct {
+	Name string
+	Role string
+}
+
+// RecordFunc receives every record of a file in order. err is set when the
+// record could not be parsed; returning an error stops the import.
+type RecordFunc func(record Record, err error) error
+
+var contributorRoles = map[string]bool{
+	books.RoleAuthor:      true,
+	books.RoleEditor:      true,
+	books.RoleTranslator:  true,
+	books.RoleIllustrator: true,
+}
+
+// normalize validates a parsed record and rewrites its ISBN to the canonical
+// ISBN-13 used by the catalog.
+func (r *Record) normalize() error {
+	normalized, err := isbn.Normalize(r.ISBN)
+	if err != nil {
+		return fmt.Errorf("invalid ISBN %q", r.ISBN)
+	}
+	r.ISBN = normalized
+
+	r.Title = strings.TrimSpace(r.Title)
+	switch {
+	case r.Title == "":
+		return errors.New("title is required")
+	case len(r.Title) > 255 || len(r.Subtitle) > 255:
+		return errors.New("title and subtitle must be at most 255 characters")
+	case r.Price < 0:
+		return errors.New("price must not be negative")
+	case r.PageCount < 0:
+		return errors.New("page count must not be negative")
+	case len(r.Language) > 8:
+		return fmt.Errorf("unsupported language %q", r.Language)
+	case len(r.Publisher) > 255:
+		return errors.New("publisher must be at most 255 characters")
+	}
+
+	currency, err := normalizeCurrency(r.Currency)
+	if err != nil {
+		return err
+	}
+	r.Currency = currency
+
+	seen := make(map[Contributor]bool, len(r.Contributors))
+	for _, c := range r.Contributors {
+		if c.Name == "" || len(c.Name) > 255 {
+			return errors.New("contributor names must be 1 to 255 characters")
+		}
+		if !contributorRoles[c.Role] {
+			return fmt.Errorf("unsupported contributor role %q", c.Role)
+		}
+		if seen[c] {
+			return fmt.Errorf("%s is listed twice as %s", c.Name, c.Role)
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// normalizeCurrency upper-cases a currency code, USD when it is missing, and
+// checks that the store can price in it.
+func normalizeCurrency(s string) (string, error) {
+	currency := strings.ToUpper(strings.TrimSpace(s))
+	if currency == "" {
+		currency = "USD"
+	}
+	if _, ok := money.Lookup(currency); !ok {
+		return "", fmt.Errorf("unsupported currency %q", s)
+	}
+	return currency, nil
+}
+
+// parsePrice reads a decimal amount such as "12.99" into minor units of its
+// currency, so "1500" JPY is 1500 yen and "1.250" KWD 1250 fils.
+func parsePrice(s, currency string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("price is required")
+	}
+	currency, err := normalizeCurrency(currency)
+	if err != nil {
+		return 0, err
+	}
+
+	units, err := money.Parse(s, currency)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price %q", s)
+	}
+	return units, nil
+}
+
+// parseDate accepts ISO dates and the compact YYYYMMDD form used by ONIX.
+func parseDate(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{"2006-01-02", "20060102"} {
+		if date, err := time.Parse(layout, s); err == nil {
+			return &date, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid publication date %q", s)
+}
@@ -0,0 +1,221 @@
+package imports
+
+import (
+	"bookstore-framework/internal/books"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// bookUpdateColumns are overwritten when an imported ISBN already exists. The
+// SKU and category assignments are left as staff set them.
+var bookUpdateColumns = []string{
+	"title", "subtitle", "description", "price", "currency", "language",
+	"page_count", "publication_date", "publisher_id", "modified_at",
+}
+
+type ImportRepository interface {
+	CreateJob(ctx context.Context, job *ImportJob) (*ImportJob, error)
+	FindJobByID(ctx context.Context, id uint) (*ImportJob, error)
+	UpdateJob(ctx context.Context, job *ImportJob) error
+	AddErrors(ctx context.Context, errors []ImportError) error
+	FindErrors(ctx context.Context, jobID uint, offset, limit int) ([]ImportError, int64, error)
+	UpsertBatch(ctx context.Context, records []Record) error
+}
+
+type importRepository struct {
+	db *gorm.DB
+}
+
+func NewImportRepository(db *gorm.DB) ImportRepository {
+	return &importRepository{
+		db: db,
+	}
+}
+
+func (r *importRepository) CreateJob(ctx context.Context, job *ImportJob) (*ImportJob, error) {
+	if err := r.db.WithContext(ctx).Create(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *importRepository) FindJobByID(ctx context.Context, id uint) (*ImportJob, error) {
+	var job *ImportJob
+	if err := r.db.WithContext(ctx).First(&job, id).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *importRepository) UpdateJob(ctx context.Context, job *ImportJob) error {
+	return r.db.WithContext(ctx).Save(job).Error
+}
+
+func (r *importRepository) AddErrors(ctx context.Context, errors []ImportError) error {
+	if len(errors) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&errors).Error
+}
+
+func (r *importRepository) FindErrors(ctx context.Context, jobID uint, offset, limit int) ([]ImportError, int64, error) {
+	query := r.db.WithContext(ctx).Model(&ImportError{}).Where("job_id = ?", jobID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var errors []ImportError
+	if err := query.Order("row, id").Offset(offset).Limit(limit).Find(&errors).Error; err != nil {
+		return nil, 0, err
+	}
+	return errors, total, nil
+}
+
+// UpsertBatch writes a batch of valid records in one transaction. Publishers
+// and authors are matched by name and created when missing, books are matched
+// by ISBN, and a record that lists contributors replaces the book's existing
+// ones. Records in a batch must have distinct ISBNs.
+func (r *importRepository) UpsertBatch(ctx context.Context, records []Record) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		publisherIDs, err := upsertPublishers(tx, records)
+		if err != nil {
+			return err
+		}
+		authorIDs, err := findOrCreateAuthors(tx, records)
+		if err != nil {
+			return err
+		}
+
+		rows := make([]books.Book, 0, len(records))
+		for _, record := range records {
+			book := books.Book{
+				Title:           record.Title,
+				Subtitle:        record.Subtitle,
+				ISBN:            record.ISBN,
+				SKU:             record.ISBN,
+				Description:     record.Description,
+				Price:           record.Price,
+				Currency:        record.Currency,
+				TaxClass:        books.TaxClassBook,
+				Language:        record.Language,
+				PageCount:       record.PageCount,
+				PublicationDate: record.PublicationDate,
+			}
+			if id, ok := publisherIDs[record.Publisher]; ok {
+				book.PublisherID = &id
+			}
+			rows = append(rows, book)
+		}
+
+		// Re-importing a deleted book brings it back into the catalog.
+		updates := append(clause.AssignmentColumns(bookUpdateColumns),
+			clause.Assignment{Column: clause.Column{Name: "deleted_at"}, Value: nil})
+		err = tx.Omit("Publisher", "Authors", "Categories").
+			Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "isbn"}}, DoUpdates: updates}).
+			Create(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		var replaced []uint
+		var links []books.BookAuthor
+		for i, record := range records {
+			if len(record.Contributors) == 0 {
+				continue
+			}
+			replaced = append(replaced, rows[i].ID)
+			for position, c := range record.Contributors {
+				links = append(links, books.BookAuthor{
+					BookID:   rows[i].ID,
+					AuthorID: authorIDs[c.Name],
+					Role:     c.Role,
+					Position: position,
+				})
+			}
+		}
+		if len(replaced) == 0 {
+			return nil
+		}
+		if err := tx.Where("book_id IN ?", replaced).Delete(&books.BookAuthor{}).Error; err != nil {
+			return err
+		}
+		return tx.Omit("Author").Create(&links).Error
+	})
+}
+
+func upsertPublishers(tx *gorm.DB, records []Record) (map[string]uint, error) {
+	ids := make(map[string]uint)
+	var publishers []books.Publisher
+	for _, record := range records {
+		if _, ok := ids[record.Publisher]; record.Publisher != "" && !ok {
+			ids[record.Publisher] = 0
+			publishers = append(publishers, books.Publisher{Name: record.Publisher})
+		}
+	}
+	if len(publishers) == 0 {
+		return ids, nil
+	}
+
+	// Updating deleted_at on conflict makes Postgres return the id of existing
+	// publishers too, and restores soft-deleted ones that are referenced again.
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Set{{Column: clause.Column{Name: "deleted_at"}, Value: nil}},
+	}).Create(&publishers).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, publisher := range publishers {
+		ids[publisher.Name] = publisher.ID
+	}
+	return ids, nil
+}
+
+// findOrCreateAuthors resolves contributor names to author ids. Names are not
+// unique, so the oldest author with a matching name wins.
+func findOrCreateAuthors(tx *gorm.DB, records []Record) (map[string]uint, error) {
+	ids := make(map[string]uint)
+	var names []string
+	for _, record := range records {
+		for _, c := range record.Contributors {
+			if _, ok := ids[c.Name]; !ok {
+				ids[c.Name] = 0
+				names = append(names, c.Name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	var existing []books.Author
+	if err := tx.Where("name IN ?", names).Order("id").Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	for _, author := range existing {
+		if ids[author.Name] == 0 {
+			ids[author.Name] = author.ID
+		}
+	}
+
+	var missing []books.Author
+	for _, name := range names {
+		if ids[name] == 0 {
+			missing = append(missing, books.Author{Name: name})
+		}
+	}
+	if len(missing) == 0 {
+		return ids, nil
+	}
+	if err := tx.Create(&missing).Error; err != nil {
+		return nil, err
+	}
+	for _, author := range missing {
+		ids[author.Name] = author.ID
+	}
+	return ids, nil
+}
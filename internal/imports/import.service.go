@@ -0,0 +1,296 @@
+package imports
+
+import (
+	"bookstore-framework/internal/imports/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const batchSize = 500
+
+var (
+	ErrJobNotFound       = errors.New("import job not found")
+	ErrUnsupportedFormat = errors.New("unsupported import format, use csv or onix")
+)
+
+type ImportService interface {
+	// CreateJob stores the upload and imports it in the background.
+	CreateJob(ctx context.Context, actorID uint, format, filename string, file io.Reader) (*dto.ImportJobResponse, error)
+	// Import runs an import to completion before returning.
+	Import(ctx context.Context, actorID uint, format, filename string, file io.Reader) (*dto.ImportJobResponse, error)
+	GetJob(ctx context.Context, id uint) (*dto.ImportJobResponse, error)
+	GetJobErrors(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.ImportErrorListResponse, error)
+}
+
+type importService struct {
+	importRepo ImportRepository
+}
+
+func NewImportService(importRepo ImportRepository) ImportService {
+	return &importService{
+		importRepo: importRepo,
+	}
+}
+
+func (s *importService) CreateJob(ctx context.Context, actorID uint, format, filename string, file io.Reader) (*dto.ImportJobResponse, error) {
+	format, err := DetectFormat(format, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	spool, err := os.CreateTemp("", "bookstore-import-*")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(spool, file); err != nil {
+		spool.Close()
+		os.Remove(spool.Name())
+		return nil, err
+	}
+	if err := spool.Close(); err != nil {
+		os.Remove(spool.Name())
+		return nil, err
+	}
+
+	job, err := s.importRepo.CreateJob(ctx, newJob(actorID, format, filename))
+	if err != nil {
+		os.Remove(spool.Name())
+		return nil, err
+	}
+
+	// Build the response before the job is handed to the worker goroutine.
+	response := ToImportJobResponse(job)
+	go func() {
+		defer os.Remove(spool.Name())
+		file, err := os.Open(spool.Name())
+		if err != nil {
+			log.Printf("Import job %d: %v", job.ID, err)
+			return
+		}
+		defer file.Close()
+		if err := s.run(context.Background(), job, file); err != nil {
+			log.Printf("Import job %d failed: %v", job.ID, err)
+		}
+	}()
+
+	return response, nil
+}
+
+func (s *importService) Import(ctx context.Context, actorID uint, format, filename string, file io.Reader) (*dto.ImportJobResponse, error) {
+	format, err := DetectFormat(format, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.importRepo.CreateJob(ctx, newJob(actorID, format, filename))
+	if err != nil {
+		return nil, err
+	}
+
+	// A file that cannot be read is recorded on the job, not returned, so
+	// callers report it the same way as an asynchronous job.
+	if err := s.run(ctx, job, file); err != nil && job.Status != StatusFailed {
+		return nil, err
+	}
+	return ToImportJobResponse(job), nil
+}
+
+func (s *importService) GetJob(ctx context.Context, id uint) (*dto.ImportJobResponse, error) {
+	job, err := s.importRepo.FindJobByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return ToImportJobResponse(job), nil
+}
+
+func (s *importService) GetJobErrors(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.ImportErrorListResponse, error) {
+	if _, err := s.importRepo.FindJobByID(ctx, id); err != nil {
+		return nil, translateError(err)
+	}
+
+	rowErrors, total, err := s.importRepo.FindErrors(ctx, id, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ImportErrorListResponse{
+		Errors:     make([]dto.ImportErrorResponse, 0, len(rowErrors)),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}
+	for _, e := range rowErrors {
+		response.Errors = append(response.Errors, dto.ImportErrorResponse{
+			Row:     e.Row,
+			ISBN:    e.ISBN,
+			Message: e.Message,
+		})
+	}
+	return response, nil
+}
+
+// run parses the file and upserts valid records in batches, recording every
+// rejected record. Progress is saved after each batch so GetJob can report
+// it while the import is running.
+func (s *importService) run(ctx context.Context, job *ImportJob, file io.Reader) error {
+	started := time.Now()
+	job.Status = StatusRunning
+	job.StartedAt = &started
+	if err := s.importRepo.UpdateJob(ctx, job); err != nil {
+		return err
+	}
+
+	batch := make([]Record, 0, batchSize)
+	inBatch := make(map[string]bool, batchSize)
+	var rowErrors []ImportError
+
+	reject := func(record Record, err error) {
+		job.FailedRows++
+		rowErrors = append(rowErrors, ImportError{
+			JobID:   job.ID,
+			Row:     record.Row,
+			ISBN:    record.ISBN,
+			Message: err.Error(),
+		})
+	}
+
+	flush := func() error {
+		if len(batch) > 0 {
+			if err := s.importRepo.UpsertBatch(ctx, batch); err != nil {
+				// Retry one by one so a single bad record does not reject
+				// the rest of its batch.
+				for _, record := range batch {
+					if err := s.importRepo.UpsertBatch(ctx, []Record{record}); err != nil {
+						reject(record, translateUpsertError(err))
+						continue
+					}
+					job.ImportedRows++
+				}
+			} else {
+				job.ImportedRows += len(batch)
+			}
+			batch = batch[:0]
+			clear(inBatch)
+		}
+
+		if err := s.importRepo.AddErrors(ctx, rowErrors); err != nil {
+			return err
+		}
+		rowErrors = rowErrors[:0]
+		return s.importRepo.UpdateJob(ctx, job)
+	}
+
+	parse := ParseCSV
+	if job.Format == FormatONIX {
+		parse = ParseONIX
+	}
+	err := parse(file, func(record Record, err error) error {
+		job.TotalRows++
+		if err == nil {
+			err = record.normalize()
+		}
+		if err != nil {
+			reject(record, err)
+			if len(rowErrors) >= batchSize {
+				return flush()
+			}
+			return nil
+		}
+
+		// A batch upserts each ISBN once; a repeated ISBN is applied after
+		// the earlier occurrence, so the last one in the file wins.
+		if inBatch[record.ISBN] {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		batch = append(batch, record)
+		inBatch[record.ISBN] = true
+		if len(batch) == batchSize {
+			return flush()
+		}
+		return nil
+	})
+	if flushErr := flush(); err == nil {
+		err = flushErr
+	}
+
+	finished := time.Now()
+	job.FinishedAt = &finished
+	job.Status = StatusCompleted
+	if err != nil {
+		job.Status = StatusFailed
+		job.Error = err.Error()
+	}
+	if updateErr := s.importRepo.UpdateJob(ctx, job); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+// DetectFormat returns the explicit format, or infers it from the file
+// extension.
+func DetectFormat(format, filename string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(filename)) {
+		case ".csv":
+			format = FormatCSV
+		case ".xml", ".onix":
+			format = FormatONIX
+		}
+	}
+	if format != FormatCSV && format != FormatONIX {
+		return "", ErrUnsupportedFormat
+	}
+	return format, nil
+}
+
+func newJob(actorID uint, format, filename string) *ImportJob {
+	job := &ImportJob{
+		Format:   format,
+		Filename: filepath.Base(filename),
+		Status:   StatusPending,
+	}
+	if actorID != 0 {
+		job.ActorID = &actorID
+	}
+	return job
+}
+
+func translateError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrJobNotFound
+	}
+	return err
+}
+
+func translateUpsertError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errors.New("the SKU of this ISBN is already used by another book")
+	}
+	return err
+}
+
+func ToImportJobResponse(job *ImportJob) *dto.ImportJobResponse {
+	return &dto.ImportJobResponse{
+		ID:           job.ID,
+		Format:       job.Format,
+		Filename:     job.Filename,
+		Status:       job.Status,
+		TotalRows:    job.TotalRows,
+		ImportedRows: job.ImportedRows,
+		FailedRows:   job.FailedRows,
+		Error:        job.Error,
+		StartedAt:    job.StartedAt,
+		FinishedAt:   job.FinishedAt,
+		CreatedAt:    job.CreatedAt,
+	}
+}
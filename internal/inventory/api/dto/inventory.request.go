@@ -0,0 +1,39 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// LocationRequest represents a create stock location request
+// @Description Stock location payload
+type LocationRequest struct {
+	Code string `json:"code" binding:"required,max=32" example:"main"`
+	Name string `json:"name" binding:"required,max=100" example:"Main warehouse"`
+}
+
+// MovementRequest represents a stock movement to append to the ledger
+// @Description Stock movement payload. Quantity is a positive number of copies
+// @Description for receipts, sales and returns, and a signed delta for adjustments.
+type MovementRequest struct {
+	SKU        string `json:"sku" binding:"required,max=64" example:"9780756404741"`
+	LocationID uint   `json:"location_id" binding:"required" example:"1"`
+	Type       string `json:"type" binding:"required,oneof=receipt sale return adjustment" example:"receipt"`
+	Quantity   int    `json:"quantity" binding:"required,ne=0" example:"10"`
+	Reason     string `json:"reason" binding:"max=255" example:"Initial stock"`
+	Reference  string `json:"reference" binding:"max=64" example:"PO-1001"`
+}
+
+// TransferRequest represents a stock transfer between two locations
+// @Description Stock transfer payload
+type TransferRequest struct {
+	SKU            string `json:"sku" binding:"required,max=64" example:"9780756404741"`
+	FromLocationID uint   `json:"from_location_id" binding:"required" example:"1"`
+	ToLocationID   uint   `json:"to_location_id" binding:"required,nefield=FromLocationID" example:"2"`
+	Quantity       int    `json:"quantity" binding:"required,gt=0" example:"5"`
+	Reason         string `json:"reason" binding:"max=255" example:"Restock store front"`
+}
+
+// MovementListQuery represents the query string of the ledger endpoint
+type MovementListQuery struct {
+	pkg.PaginationQuery
+	SKU        string `form:"sku"`
+	LocationID uint   `form:"location_id"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type LocationResponse struct {
+	ID     uint   `json:"id"`
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+type StockLevelResponse struct {
+	Location  LocationResponse `json:"location"`
+	OnHand    int              `json:"on_hand"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type StockResponse struct {
+	SKU    string               `json:"sku"`
+	BookID uint                 `json:"book_id"`
+	OnHand int                  `json:"on_hand"`
+	Levels []StockLevelResponse `json:"levels"`
+}
+
+type MovementResponse struct {
+	ID           uint      `json:"id"`
+	SKU          string    `json:"sku"`
+	LocationID   uint      `json:"location_id"`
+	Type         string    `json:"type"`
+	Quantity     int       `json:"quantity"`
+	BalanceAfter int       `json:"balance_after"`
+	Reason       string    `json:"reason,omitempty"`
+	Reference    string    `json:"reference,omitempty"`
+	ActorID      *uint     `json:"actor_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type MovementListResponse struct {
+	Movements  []MovementResponse `json:"movements"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/inventory/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryHandler struct {
+	inventoryService inventory.InventoryService
+}
+
+func NewInventoryHandler(inventoryService inventory.InventoryService) *InventoryHandler {
+	return &InventoryHandler{
+		inventoryService: inventoryService,
+	}
+}
+
+// CreateLocation godoc
+// @Summary      Create a stock location
+// @Description  Add a warehouse or store that holds stock
+// @Tags         inventory
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.LocationRequest true "Location information"
+// @Success      201  {object}    pkg.Response{data=dto.LocationResponse} "Location created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      409  {object}    pkg.Response "Duplicate location code"
+// @Router       /inventory/locations [post]
+func (h *InventoryHandler) CreateLocation(ctx *gin.Context) {
+	var req dto.LocationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.inventoryService.CreateLocation(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Location created successfully", response)
+}
+
+// GetLocations godoc
+// @Summary      List stock locations
+// @Description  List every warehouse or store that holds stock
+// @Tags         inventory
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=[]dto.LocationResponse} "Locations retrieve successfully"
+// @Router       /inventory/locations [get]
+func (h *InventoryHandler) GetLocations(ctx *gin.Context) {
+	response, err := h.inventoryService.GetLocations(ctx.Request.Context())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Locations retrieve successfully", response)
+}
+
+// GetStock godoc
+// @Summary      Get stock of a SKU
+// @Description  Get the on-hand quantity of a SKU per location
+// @Tags         inventory
+// @Security     BearerAuth
+// @Produce      json
+// @Param        sku  path        string true "SKU"
+// @Success      200  {object}    pkg.Response{data=dto.StockResponse} "Stock retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Unknown SKU"
+// @Router       /inventory/stock/{sku} [get]
+func (h *InventoryHandler) GetStock(ctx *gin.Context) {
+	response, err := h.inventoryService.GetStock(ctx.Request.Context(), ctx.Param("sku"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Stock retrieve successfully", response)
+}
+
+// RecomputeStock godoc
+// @Summary      Recompute stock of a SKU
+// @Description  Rebuild the cached on-hand quantities of a SKU from the movement ledger
+// @Tags         inventory
+// @Security     BearerAuth
+// @Produce      json
+// @Param        sku  path        string true "SKU"
+// @Success      200  {object}    pkg.Response{data=dto.StockResponse} "Stock recomputed successfully"
+// @Failure      404  {object}    pkg.Response "Unknown SKU"
+// @Router       /inventory/stock/{sku}/recompute [post]
+func (h *InventoryHandler) RecomputeStock(ctx *gin.Context) {
+	response, err := h.inventoryService.RecomputeStock(ctx.Request.Context(), ctx.Param("sku"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Stock recomputed successfully", response)
+}
+
+// RecordMovement godoc
+// @Summary      Record a stock movement
+// @Description  Append a receipt, sale, return or adjustment to the ledger
+// @Tags         inventory
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.MovementRequest true "Movement information"
+// @Success      201  {object}    pkg.Response{data=dto.MovementResponse} "Movement recorded successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Unknown SKU or location"
+// @Failure      409  {object}    pkg.Response "Insufficient stock"
+// @Router       /inventory/movements [post]
+func (h *InventoryHandler) RecordMovement(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.MovementRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.inventoryService.RecordMovement(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Movement recorded successfully", response)
+}
+
+// Transfer godoc
+// @Summary      Transfer stock
+// @Description  Move stock of a SKU from one location to another
+// @Tags         inventory
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.TransferRequest true "Transfer information"
+// @Success      201  {object}    pkg.Response{data=[]dto.MovementResponse} "Transfer recorded successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Unknown SKU or location"
+// @Failure      409  {object}    pkg.Response "Insufficient stock"
+// @Router       /inventory/transfers [post]
+func (h *InventoryHandler) Transfer(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.TransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.inventoryService.Transfer(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Transfer recorded successfully", response)
+}
+
+// GetMovements godoc
+// @Summary      List stock movements
+// @Description  Browse the stock ledger, newest first
+// @Tags         inventory
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page        query    int    false "Page number" default(1)
+// @Param        limit       query    int    false "Page size" default(20)
+// @Param        sku         query    string false "Filter by SKU"
+// @Param        location_id query    int    false "Filter by location"
+// @Success      200  {object}    pkg.Response{data=dto.MovementListResponse} "Movements retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /inventory/movements [get]
+func (h *InventoryHandler) GetMovements(ctx *gin.Context) {
+	var query dto.MovementListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.inventoryService.GetMovements(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Movements retrieve successfully", response)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, inventory.ErrUnknownSKU),
+		errors.Is(err, inventory.ErrLocationNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, inventory.ErrReasonRequired),
+		errors.Is(err, inventory.ErrInvalidQuantity),
+		errors.Is(err, inventory.ErrLocationInactive):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, inventory.ErrInsufficientStock),
+		errors.Is(err, inventory.ErrDuplicateLocation):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func InventoryRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	inventoryRepository := inventory.NewInventoryRepository(db)
+	bookRepository := books.NewBookRepository(db)
+	inventoryService := inventory.NewInventoryService(inventoryRepository, bookRepository)
+	inventoryHandler := NewInventoryHandler(inventoryService)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.GET("/locations", inventoryHandler.GetLocations)
+	router.POST("/locations", inventoryHandler.CreateLocation)
+	router.GET("/stock/:sku", inventoryHandler.GetStock)
+	router.POST("/stock/:sku/recompute", inventoryHandler.RecomputeStock)
+	router.GET("/movements", inventoryHandler.GetMovements)
+	router.POST("/movements", inventoryHandler.RecordMovement)
+	router.POST("/transfers", inventoryHandler.Transfer)
+}
@@ -0,0 +1,94 @@
+package inventory
+
+import "time"
+
+const (
+	ReservationActive    = "active"
+	ReservationWaiting   = "waiting"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+)
+
+const (
+	MovementReceipt     = "receipt"
+	MovementSale        = "sale"
+	MovementReturn      = "return"
+	MovementAdjustment  = "adjustment"
+	MovementTransferOut = "transfer_out"
+	MovementTransferIn  = "transfer_in"
+)
+
+type Location struct {
+	ID         uint      `gorm:"primaryKey"`
+	Code       string    `gorm:"column:code;size:32;uniqueIndex;not null"`
+	Name       string    `gorm:"column:name;size:100;not null"`
+	Active     bool      `gorm:"column:active;not null;default:true"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Location) TableName() string {
+	return "inventory_locations"
+}
+
+// StockMovement is one immutable entry of the stock ledger. Quantity is a
+// signed delta: receipts and returns are positive, sales negative.
+type StockMovement struct {
+	ID           uint      `gorm:"primaryKey"`
+	SKU          string    `gorm:"column:sku;size:64;not null;index:idx_stock_movements_sku_location"`
+	LocationID   uint      `gorm:"column:location_id;not null;index:idx_stock_movements_sku_location"`
+	BookID       uint      `gorm:"column:book_id;not null;index"`
+	Type         string    `gorm:"column:type;size:20;not null"`
+	Quantity     int       `gorm:"column:quantity;not null"`
+	BalanceAfter int       `gorm:"column:balance_after;not null"`
+	Reason       string    `gorm:"column:reason;size:255"`
+	Reference    string    `gorm:"column:reference;size:64;index"`
+	ActorID      *uint     `gorm:"column:actor_id"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime;index"`
+	Location     Location  `gorm:"foreignKey:LocationID"`
+}
+
+func (StockMovement) TableName() string {
+	return "stock_movements"
+}
+
+// StockLevel caches the ledger balance of a SKU at a location. It is only
+// written in the same transaction as the movement that changes it.
+type StockLevel struct {
+	SKU        string    `gorm:"column:sku;size:64;primaryKey"`
+	LocationID uint      `gorm:"column:location_id;primaryKey"`
+	BookID     uint      `gorm:"column:book_id;not null;index"`
+	OnHand     int       `gorm:"column:on_hand;not null;default:0;check:chk_stock_levels_on_hand,on_hand >= 0"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	Location   Location  `gorm:"foreignKey:LocationID"`
+}
+
+func (StockLevel) TableName() string {
+	return "stock_levels"
+}
+
+// StockReservation holds copies of a SKU for an order that has not shipped.
+// Reserved copies stay on hand but can no longer be sold to anyone else. A
+// reservation is committed into sale movements when the order ships, or
+// released when it is cancelled.
+//
+// A waiting reservation is a backorder: copies ordered that are not in stock
+// yet. Incoming stock is allocated to waiting reservations of the SKU in the
+// order they were made, turning them active.
+type StockReservation struct {
+	ID         uint      `gorm:"primaryKey"`
+	SKU        string    `gorm:"column:sku;size:64;not null;index:idx_stock_reservations_sku_status"`
+	BookID     uint      `gorm:"column:book_id;not null"`
+	Quantity   int       `gorm:"column:quantity;not null;check:chk_stock_reservations_quantity,quantity > 0"`
+	Status     string    `gorm:"column:status;size:20;not null;index:idx_stock_reservations_sku_status"`
+	Reference  string    `gorm:"column:reference;size:64;not null;index"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+	// Backorder lets Reserve wait for the copies that are not in stock
+	// instead of failing.
+	Backorder bool `gorm:"-"`
+}
+
+func (StockReservation) TableName() string {
+	return "stock_reservations"
+}
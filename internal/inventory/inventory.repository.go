@@ -0,0 +1,464 @@
+package inventory
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+type MovementFilter struct {
+	SKU        string
+	LocationID uint
+	Offset     int
+	Limit      int
+}
+
+type InventoryRepository interface {
+	CreateLocation(ctx context.Context, location *Location) (*Location, error)
+	FindLocations(ctx context.Context) ([]Location, error)
+	FindLocationByID(ctx context.Context, id uint) (*Location, error)
+	FindStockLevels(ctx context.Context, sku string) ([]StockLevel, error)
+	// AvailableBySKU totals stock across locations less active and waiting
+	// reservations, SKUs without stock are omitted.
+	AvailableBySKU(ctx context.Context, skus []string) (map[string]int, error)
+	// SoldBySKU totals the copies sold since a time, SKUs without sales are
+	// omitted.
+	SoldBySKU(ctx context.Context, skus []string, since time.Time) (map[string]int, error)
+	FindMovements(ctx context.Context, filter MovementFilter) ([]StockMovement, int64, error)
+	RecordMovements(ctx context.Context, movements []StockMovement) ([]StockMovement, error)
+	Recompute(ctx context.Context, sku string) ([]StockLevel, error)
+	// Reserve holds stock for every reservation or for none of them. A
+	// backorder reservation holds what is available and waits for the rest,
+	// so it may be split into an active and a waiting reservation. The
+	// reservations made are returned.
+	Reserve(ctx context.Context, reservations []StockReservation) ([]StockReservation, error)
+	// FindAllocatableSKUs returns the SKUs with waiting reservations of books
+	// released by now.
+	FindAllocatableSKUs(ctx context.Context, now time.Time) ([]string, error)
+	// Allocate fills the waiting reservations of a SKU from the stock not
+	// reserved yet, oldest first. It returns the references left with no
+	// waiting reservation.
+	Allocate(ctx context.Context, sku string) ([]string, error)
+	// CommitReservations turns the active reservations of reference into sale
+	// movements, taking copies from the locations with the most stock first.
+	CommitReservations(ctx context.Context, reference string, actorID *uint) error
+	// ReleaseReservations releases the active and waiting reservations of
+	// reference.
+	ReleaseReservations(ctx context.Context, reference string) error
+}
+
+type inventoryRepository struct {
+	db *gorm.DB
+}
+
+func NewInventoryRepository(db *gorm.DB) InventoryRepository {
+	return &inventoryRepository{
+		db: db,
+	}
+}
+
+func (r *inventoryRepository) CreateLocation(ctx context.Context, location *Location) (*Location, error) {
+	result := pkg.DB(ctx, r.db).Create(location)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return location, nil
+}
+
+func (r *inventoryRepository) FindLocations(ctx context.Context) ([]Location, error) {
+	var locations []Location
+	result := pkg.DB(ctx, r.db).Order("id").Find(&locations)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return locations, nil
+}
+
+func (r *inventoryRepository) FindLocationByID(ctx context.Context, id uint) (*Location, error) {
+	var location *Location
+	result := pkg.DB(ctx, r.db).First(&location, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return location, nil
+}
+
+func (r *inventoryRepository) FindStockLevels(ctx context.Context, sku string) ([]StockLevel, error) {
+	var levels []StockLevel
+	result := pkg.DB(ctx, r.db).Preload("Location").Where("sku = ?", sku).Order("location_id").Find(&levels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return levels, nil
+}
+
+func (r *inventoryRepository) AvailableBySKU(ctx context.Context, skus []string) (map[string]int, error) {
+	var levels []struct {
+		SKU       string
+		Available int
+	}
+	result := pkg.DB(ctx, r.db).Raw(
+		`SELECT l.sku, l.on_hand - COALESCE(r.reserved, 0) AS available
+		FROM (SELECT sku, SUM(on_hand) AS on_hand FROM stock_levels WHERE sku IN ? GROUP BY sku) l
+		LEFT JOIN (
+			SELECT sku, SUM(quantity) AS reserved FROM stock_reservations
+			WHERE sku IN ? AND status IN ? GROUP BY sku
+		) r ON r.sku = l.sku`,
+		skus, skus, []string{ReservationActive, ReservationWaiting},
+	).Scan(&levels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	available := make(map[string]int, len(levels))
+	for _, level := range levels {
+		// Backorders can promise more copies than there are.
+		available[level.SKU] = max(level.Available, 0)
+	}
+	return available, nil
+}
+
+func (r *inventoryRepository) SoldBySKU(ctx context.Context, skus []string, since time.Time) (map[string]int, error) {
+	var rows []struct {
+		SKU  string
+		Sold int
+	}
+	result := pkg.DB(ctx, r.db).Model(&StockMovement{}).
+		Select("sku, -SUM(quantity) AS sold").
+		Where("sku IN ? AND type = ? AND created_at >= ?", skus, MovementSale, since).
+		Group("sku").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	sold := make(map[string]int, len(rows))
+	for _, row := range rows {
+		sold[row.SKU] = row.Sold
+	}
+	return sold, nil
+}
+
+func (r *inventoryRepository) FindMovements(ctx context.Context, filter MovementFilter) ([]StockMovement, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&StockMovement{})
+	if filter.SKU != "" {
+		query = query.Where("sku = ?", filter.SKU)
+	}
+	if filter.LocationID != 0 {
+		query = query.Where("location_id = ?", filter.LocationID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var movements []StockMovement
+	result := query.Preload("Location").Order("id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&movements)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return movements, total, nil
+}
+
+// RecordMovements applies every movement to its cached stock level and
+// appends it to the ledger, all in one transaction. Decrements only succeed
+// while enough stock is on hand, so concurrent sales of the last copy cannot
+// both commit.
+func (r *inventoryRepository) RecordMovements(ctx context.Context, movements []StockMovement) ([]StockMovement, error) {
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for i := range movements {
+			balance, err := applyMovement(tx, &movements[i])
+			if err != nil {
+				return err
+			}
+			movements[i].BalanceAfter = balance
+			if err := tx.Omit("Location").Create(&movements[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+func applyMovement(tx *gorm.DB, movement *StockMovement) (int, error) {
+	var balance int
+	if movement.Quantity < 0 {
+		result := tx.Raw(
+			`UPDATE stock_levels SET on_hand = on_hand + ?, updated_at = now()
+			WHERE sku = ? AND location_id = ? AND on_hand >= ?
+			RETURNING on_hand`,
+			movement.Quantity, movement.SKU, movement.LocationID, -movement.Quantity,
+		).Scan(&balance)
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return 0, ErrInsufficientStock
+		}
+		return balance, nil
+	}
+
+	result := tx.Raw(
+		`INSERT INTO stock_levels (sku, location_id, book_id, on_hand, updated_at)
+		VALUES (?, ?, ?, ?, now())
+		ON CONFLICT (sku, location_id)
+		DO UPDATE SET on_hand = stock_levels.on_hand + EXCLUDED.on_hand, updated_at = now()
+		RETURNING on_hand`,
+		movement.SKU, movement.LocationID, movement.BookID, movement.Quantity,
+	).Scan(&balance)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return balance, nil
+}
+
+// Recompute rebuilds the cached stock levels of a SKU from the ledger.
+func (r *inventoryRepository) Recompute(ctx context.Context, sku string) ([]StockLevel, error) {
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`SELECT 1 FROM stock_levels WHERE sku = ? FOR UPDATE`, sku).Error; err != nil {
+			return err
+		}
+		return tx.Exec(
+			`INSERT INTO stock_levels (sku, location_id, book_id, on_hand, updated_at)
+			SELECT sku, location_id, MAX(book_id), SUM(quantity), now()
+			FROM stock_movements WHERE sku = ?
+			GROUP BY sku, location_id
+			ON CONFLICT (sku, location_id)
+			DO UPDATE SET on_hand = EXCLUDED.on_hand, updated_at = now()`,
+			sku,
+		).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindStockLevels(ctx, sku)
+}
+
+func (r *inventoryRepository) Reserve(ctx context.Context, reservations []StockReservation) ([]StockReservation, error) {
+	// Reserving SKUs in a fixed order keeps concurrent checkouts from
+	// deadlocking on each other's stock rows.
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].SKU < reservations[j].SKU
+	})
+
+	var reserved []StockReservation
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, reservation := range reservations {
+			// Copies promised to waiting reservations are not free either, so
+			// new orders never jump the queue of backorders.
+			free, err := freeStock(tx, reservation.SKU, ReservationActive, ReservationWaiting)
+			if err != nil {
+				return err
+			}
+			if free < reservation.Quantity && !reservation.Backorder {
+				return ErrInsufficientStock
+			}
+
+			active := reservation
+			active.Quantity = min(max(free, 0), reservation.Quantity)
+			active.Status = ReservationActive
+			waiting := reservation
+			waiting.Quantity = reservation.Quantity - active.Quantity
+			waiting.Status = ReservationWaiting
+			for _, part := range []StockReservation{active, waiting} {
+				if part.Quantity == 0 {
+					continue
+				}
+				if err := tx.Create(&part).Error; err != nil {
+					return err
+				}
+				reserved = append(reserved, part)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reserved, nil
+}
+
+func (r *inventoryRepository) FindAllocatableSKUs(ctx context.Context, now time.Time) ([]string, error) {
+	var skus []string
+	result := pkg.DB(ctx, r.db).Model(&StockReservation{}).
+		Distinct("stock_reservations.sku").
+		Joins("JOIN books ON books.id = stock_reservations.book_id").
+		Where("stock_reservations.status = ? AND (books.release_date IS NULL OR books.release_date <= ?)", ReservationWaiting, now).
+		Order("stock_reservations.sku").
+		Pluck("stock_reservations.sku", &skus)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return skus, nil
+}
+
+func (r *inventoryRepository) Allocate(ctx context.Context, sku string) ([]string, error) {
+	var filled []string
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		free, err := freeStock(tx, sku, ReservationActive)
+		if err != nil || free <= 0 {
+			return err
+		}
+
+		var waiting []StockReservation
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("sku = ? AND status = ?", sku, ReservationWaiting).
+			Order("id").
+			Find(&waiting).Error
+		if err != nil {
+			return err
+		}
+
+		var allocated []string
+		for _, reservation := range waiting {
+			if free == 0 {
+				break
+			}
+			take := min(free, reservation.Quantity)
+			if take == reservation.Quantity {
+				err = tx.Model(&reservation).Update("status", ReservationActive).Error
+			} else {
+				// A partly filled reservation keeps waiting for the rest.
+				err = tx.Model(&reservation).Update("quantity", reservation.Quantity-take).Error
+				if err == nil {
+					err = tx.Create(&StockReservation{
+						SKU:       reservation.SKU,
+						BookID:    reservation.BookID,
+						Quantity:  take,
+						Status:    ReservationActive,
+						Reference: reservation.Reference,
+					}).Error
+				}
+			}
+			if err != nil {
+				return err
+			}
+			free -= take
+			if !slices.Contains(allocated, reservation.Reference) {
+				allocated = append(allocated, reservation.Reference)
+			}
+		}
+		if len(allocated) == 0 {
+			return nil
+		}
+
+		var stillWaiting []string
+		err = tx.Model(&StockReservation{}).
+			Where("reference IN ? AND status = ?", allocated, ReservationWaiting).
+			Distinct().
+			Pluck("reference", &stillWaiting).Error
+		if err != nil {
+			return err
+		}
+		for _, reference := range allocated {
+			if !slices.Contains(stillWaiting, reference) {
+				filled = append(filled, reference)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filled, nil
+}
+
+// freeStock locks the stock rows of a SKU, which serializes reservations and
+// allocations of it, and returns the copies on hand less the reservations in
+// the given statuses.
+func freeStock(tx *gorm.DB, sku string, statuses ...string) (int, error) {
+	var onHand int
+	err := tx.Raw(
+		`SELECT COALESCE(SUM(on_hand), 0) FROM (
+			SELECT on_hand FROM stock_levels WHERE sku = ? FOR UPDATE
+		) s`,
+		sku,
+	).Scan(&onHand).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reserved int
+	err = tx.Model(&StockReservation{}).
+		Select("COALESCE(SUM(quantity), 0)").
+		Where("sku = ? AND status IN ?", sku, statuses).
+		Scan(&reserved).Error
+	if err != nil {
+		return 0, err
+	}
+	return onHand - reserved, nil
+}
+
+func (r *inventoryRepository) CommitReservations(ctx context.Context, reference string, actorID *uint) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var reservations []StockReservation
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("reference = ? AND status = ?", reference, ReservationActive).
+			Order("sku").
+			Find(&reservations).Error
+		if err != nil {
+			return err
+		}
+
+		var movements []StockMovement
+		for _, reservation := range reservations {
+			var levels []StockLevel
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("sku = ? AND on_hand > 0", reservation.SKU).
+				Order("on_hand DESC, location_id").
+				Find(&levels).Error
+			if err != nil {
+				return err
+			}
+
+			remaining := reservation.Quantity
+			for _, level := range levels {
+				if remaining == 0 {
+					break
+				}
+				take := min(remaining, level.OnHand)
+				movements = append(movements, StockMovement{
+					SKU:        reservation.SKU,
+					LocationID: level.LocationID,
+					BookID:     reservation.BookID,
+					Type:       MovementSale,
+					Quantity:   -take,
+					Reference:  reference,
+					ActorID:    actorID,
+				})
+				remaining -= take
+			}
+			if remaining > 0 {
+				return ErrInsufficientStock
+			}
+		}
+
+		if len(movements) > 0 {
+			if _, err := r.RecordMovements(pkg.WithTx(ctx, tx), movements); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&StockReservation{}).
+			Where("reference = ? AND status = ?", reference, ReservationActive).
+			Update("status", ReservationCommitted).Error
+	})
+}
+
+func (r *inventoryRepository) ReleaseReservations(ctx context.Context, reference string) error {
+	return pkg.DB(ctx, r.db).Model(&StockReservation{}).
+		Where("reference = ? AND status IN ?", reference, []string{ReservationActive, ReservationWaiting}).
+		Update("status", ReservationReleased).Error
+}
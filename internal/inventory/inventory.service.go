@@ -0,0 +1,279 @@
+package inventory
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrLocationNotFound  = errors.New("stock location not found")
+	ErrLocationInactive  = errors.New("stock location is inactive")
+	ErrDuplicateLocation = errors.New("a stock location with this code already exists")
+	ErrUnknownSKU        = errors.New("no book with this SKU")
+	ErrReasonRequired    = errors.New("a reason is required for stock adjustments")
+	ErrInvalidQuantity   = errors.New("quantity must be positive for receipts, sales and returns")
+)
+
+type InventoryService interface {
+	CreateLocation(ctx context.Context, req dto.LocationRequest) (*dto.LocationResponse, error)
+	GetLocations(ctx context.Context) ([]dto.LocationResponse, error)
+	GetStock(ctx context.Context, sku string) (*dto.StockResponse, error)
+	RecordMovement(ctx context.Context, actorID uint, req dto.MovementRequest) (*dto.MovementResponse, error)
+	Transfer(ctx context.Context, actorID uint, req dto.TransferRequest) ([]dto.MovementResponse, error)
+	GetMovements(ctx context.Context, query dto.MovementListQuery) (*dto.MovementListResponse, error)
+	RecomputeStock(ctx context.Context, sku string) (*dto.StockResponse, error)
+}
+
+type inventoryService struct {
+	inventoryRepo InventoryRepository
+	bookRepo      books.BookRepository
+}
+
+func NewInventoryService(inventoryRepo InventoryRepository, bookRepo books.BookRepository) InventoryService {
+	return &inventoryService{
+		inventoryRepo: inventoryRepo,
+		bookRepo:      bookRepo,
+	}
+}
+
+func (s *inventoryService) CreateLocation(ctx context.Context, req dto.LocationRequest) (*dto.LocationResponse, error) {
+	location := &Location{
+		Code:   req.Code,
+		Name:   req.Name,
+		Active: true,
+	}
+
+	created, err := s.inventoryRepo.CreateLocation(ctx, location)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateLocation
+		}
+		return nil, err
+	}
+
+	return ToLocationResponse(created), nil
+}
+
+func (s *inventoryService) GetLocations(ctx context.Context) ([]dto.LocationResponse, error) {
+	locations, err := s.inventoryRepo.FindLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.LocationResponse, 0, len(locations))
+	for i := range locations {
+		response = append(response, *ToLocationResponse(&locations[i]))
+	}
+	return response, nil
+}
+
+func (s *inventoryService) GetStock(ctx context.Context, sku string) (*dto.StockResponse, error) {
+	book, err := s.findBook(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := s.inventoryRepo.FindStockLevels(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStockResponse(book, levels), nil
+}
+
+func (s *inventoryService) RecordMovement(ctx context.Context, actorID uint, req dto.MovementRequest) (*dto.MovementResponse, error) {
+	quantity := req.Quantity
+	switch req.Type {
+	case MovementAdjustment:
+		if req.Reason == "" {
+			return nil, ErrReasonRequired
+		}
+	case MovementSale:
+		if quantity < 0 {
+			return nil, ErrInvalidQuantity
+		}
+		quantity = -quantity
+	default:
+		if quantity < 0 {
+			return nil, ErrInvalidQuantity
+		}
+	}
+
+	book, err := s.findBook(ctx, req.SKU)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkLocation(ctx, req.LocationID); err != nil {
+		return nil, err
+	}
+
+	movements, err := s.inventoryRepo.RecordMovements(ctx, []StockMovement{{
+		SKU:        book.SKU,
+		LocationID: req.LocationID,
+		BookID:     book.ID,
+		Type:       req.Type,
+		Quantity:   quantity,
+		Reason:     req.Reason,
+		Reference:  req.Reference,
+		ActorID:    &actorID,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	return ToMovementResponse(&movements[0]), nil
+}
+
+func (s *inventoryService) Transfer(ctx context.Context, actorID uint, req dto.TransferRequest) ([]dto.MovementResponse, error) {
+	book, err := s.findBook(ctx, req.SKU)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkLocation(ctx, req.FromLocationID); err != nil {
+		return nil, err
+	}
+	if err := s.checkLocation(ctx, req.ToLocationID); err != nil {
+		return nil, err
+	}
+
+	reference := fmt.Sprintf("TRF-%d", time.Now().UnixNano())
+	movements, err := s.inventoryRepo.RecordMovements(ctx, []StockMovement{
+		{
+			SKU:        book.SKU,
+			LocationID: req.FromLocationID,
+			BookID:     book.ID,
+			Type:       MovementTransferOut,
+			Quantity:   -req.Quantity,
+			Reason:     req.Reason,
+			Reference:  reference,
+			ActorID:    &actorID,
+		},
+		{
+			SKU:        book.SKU,
+			LocationID: req.ToLocationID,
+			BookID:     book.ID,
+			Type:       MovementTransferIn,
+			Quantity:   req.Quantity,
+			Reason:     req.Reason,
+			Reference:  reference,
+			ActorID:    &actorID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.MovementResponse, 0, len(movements))
+	for i := range movements {
+		response = append(response, *ToMovementResponse(&movements[i]))
+	}
+	return response, nil
+}
+
+func (s *inventoryService) GetMovements(ctx context.Context, query dto.MovementListQuery) (*dto.MovementListResponse, error) {
+	movements, total, err := s.inventoryRepo.FindMovements(ctx, MovementFilter{
+		SKU:        query.SKU,
+		LocationID: query.LocationID,
+		Offset:     query.Offset(),
+		Limit:      query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.MovementListResponse{
+		Movements:  make([]dto.MovementResponse, 0, len(movements)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range movements {
+		response.Movements = append(response.Movements, *ToMovementResponse(&movements[i]))
+	}
+	return response, nil
+}
+
+func (s *inventoryService) RecomputeStock(ctx context.Context, sku string) (*dto.StockResponse, error) {
+	book, err := s.findBook(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := s.inventoryRepo.Recompute(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStockResponse(book, levels), nil
+}
+
+func (s *inventoryService) findBook(ctx context.Context, sku string) (*books.Book, error) {
+	book, err := s.bookRepo.FindBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnknownSKU
+		}
+		return nil, err
+	}
+	return book, nil
+}
+
+func (s *inventoryService) checkLocation(ctx context.Context, id uint) error {
+	location, err := s.inventoryRepo.FindLocationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLocationNotFound
+		}
+		return err
+	}
+	if !location.Active {
+		return ErrLocationInactive
+	}
+	return nil
+}
+
+func toStockResponse(book *books.Book, levels []StockLevel) *dto.StockResponse {
+	response := &dto.StockResponse{
+		SKU:    book.SKU,
+		BookID: book.ID,
+		Levels: make([]dto.StockLevelResponse, 0, len(levels)),
+	}
+	for i := range levels {
+		response.OnHand += levels[i].OnHand
+		response.Levels = append(response.Levels, dto.StockLevelResponse{
+			Location:  *ToLocationResponse(&levels[i].Location),
+			OnHand:    levels[i].OnHand,
+			UpdatedAt: levels[i].UpdatedAt,
+		})
+	}
+	return response
+}
+
+func ToLocationResponse(location *Location) *dto.LocationResponse {
+	return &dto.LocationResponse{
+		ID:     location.ID,
+		Code:   location.Code,
+		Name:   location.Name,
+		Active: location.Active,
+	}
+}
+
+func ToMovementResponse(movement *StockMovement) *dto.MovementResponse {
+	return &dto.MovementResponse{
+		ID:           movement.ID,
+		SKU:          movement.SKU,
+		LocationID:   movement.LocationID,
+		Type:         movement.Type,
+		Quantity:     movement.Quantity,
+		BalanceAfter: movement.BalanceAfter,
+		Reason:       movement.Reason,
+		Reference:    movement.Reference,
+		ActorID:      movement.ActorID,
+		CreatedAt:    movement.CreatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+// AccountResponse describes the points of the logged in user. Multiplier is
+// the percentage of the purchase points the tier earns; PointValue is what a
+// point takes off an order, in minor units of PointCurrency.
+type AccountResponse struct {
+	Balance          int    `json:"balance"`
+	Earned           int    `json:"earned"`
+	Tier             string `json:"tier"`
+	Multiplier       int    `json:"multiplier"`
+	NextTier         string `json:"next_tier,omitempty"`
+	PointsToNextTier int    `json:"points_to_next_tier,omitempty"`
+	PointValue       int64  `json:"point_value"`
+	PointCurrency    string `json:"point_currency"`
+}
+
+// EntryResponse is one change of the points. Remaining is what is left to
+// spend of a credit until it expires.
+type EntryResponse struct {
+	ID        uint       `json:"id"`
+	Type      string     `json:"type"`
+	Reference string     `json:"reference"`
+	Points    int        `json:"points"`
+	Remaining int        `json:"remaining,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type EntryListResponse struct {
+	Entries    []EntryResponse    `json:"entries"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/loyalty/api/dto"
+	"bookstore-framework/pkg"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoyaltyHandler struct {
+	loyaltyService loyalty.LoyaltyService
+}
+
+func NewLoyaltyHandler(loyaltyService loyalty.LoyaltyService) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		loyaltyService: loyaltyService,
+	}
+}
+
+// GetAccount godoc
+// @Summary      Get loyalty points
+// @Description  Get the points balance and tier of the logged in user. Paid orders earn a point per currency unit, times the tier multiplier, and reviews of bought books earn points too. Points are redeemed at checkout and expire a year after they are earned
+// @Tags         loyalty
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=dto.AccountResponse} "Loyalty account retrieve successfully"
+// @Failure      401  {object}    pkg.Response "User not found"
+// @Router       /loyalty [get]
+func (h *LoyaltyHandler) GetAccount(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var response *dto.AccountResponse
+	response, err := h.loyaltyService.GetAccount(ctx.Request.Context(), userID.(uint))
+	if err != nil {
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+		return
+	}
+
+	pkg.OkResponse(ctx, "Loyalty account retrieve successfully", response)
+}
+
+// GetEntries godoc
+// @Summary      List loyalty points history
+// @Description  List the points earned, redeemed, reversed and expired of the logged in user, newest first
+// @Tags         loyalty
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int    false "Page number" default(1)
+// @Param        limit  query     int    false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.EntryListResponse} "Loyalty history retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /loyalty/entries [get]
+func (h *LoyaltyHandler) GetEntries(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.loyaltyService.GetEntries(ctx.Request.Context(), userID.(uint), query)
+	if err != nil {
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+		return
+	}
+
+	pkg.OkResponse(ctx, "Loyalty history retrieve successfully", response)
+}
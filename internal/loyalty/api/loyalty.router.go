@@ -0,0 +1,22 @@
+package api
+
+import (
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func LoyaltyRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	loyaltyService := loyalty.NewLoyaltyService(
+		loyalty.NewLoyaltyRepository(db),
+		pricing.NewPricingService(pricing.NewPricingRepository(db)),
+	)
+	loyaltyHandler := NewLoyaltyHandler(loyaltyService)
+
+	router.Use(middleware.JWTAuth())
+	router.GET("", loyaltyHandler.GetAccount)
+	router.GET("/entries", loyaltyHandler.GetEntries)
+}
@@ -0,0 +1,108 @@
+package loyalty
+
+import (
+	"bookstore-framework/pkg/money"
+	"fmt"
+	"time"
+)
+
+const (
+	EntryPurchase = "purchase"
+	EntryReview   = "review"
+	EntryRedeem   = "redeem"
+	// EntryReversal takes back the points of a purchase that was cancelled
+	// or refunded.
+	EntryReversal = "reversal"
+	// EntryRestore gives back the points redeemed on a cancelled or
+	// refunded order.
+	EntryRestore = "restore"
+	EntryExpiry  = "expiry"
+)
+
+const (
+	// BaseCurrency is the currency points are earned and valued in. Orders in
+	// other currencies are converted at the exchange rates.
+	BaseCurrency = "USD"
+	// PurchaseUnit is the amount, in minor units of BaseCurrency, that earns
+	// one point before the tier multiplier.
+	PurchaseUnit = 100
+	ReviewPoints = 50
+	// PointValue is what one point takes off an order, in minor units of
+	// BaseCurrency.
+	PointValue = 1
+	// PointsLifetime is how long points can be spent after they are earned.
+	PointsLifetime = 365 * 24 * time.Hour
+)
+
+// Tier sets the multiplier, in percent, of the points earned by customers
+// who earned at least Threshold points.
+type Tier struct {
+	Name       string
+	Threshold  int
+	Multiplier int
+}
+
+// Tiers are ordered by threshold.
+var Tiers = []Tier{
+	{Name: "bronze", Threshold: 0, Multiplier: 100},
+	{Name: "silver", Threshold: 1000, Multiplier: 125},
+	{Name: "gold", Threshold: 5000, Multiplier: 150},
+}
+
+// TierFor returns the tier of a customer who earned the given points, and the
+// next tier, nil at the top.
+func TierFor(earned int) (Tier, *Tier) {
+	current := 0
+	for i, tier := range Tiers {
+		if earned >= tier.Threshold {
+			current = i
+		}
+	}
+	if current+1 < len(Tiers) {
+		return Tiers[current], &Tiers[current+1]
+	}
+	return Tiers[current], nil
+}
+
+// PointsValue is what points take off an order, in BaseCurrency.
+func PointsValue(points int) money.Money {
+	return money.New(int64(points)*PointValue, BaseCurrency)
+}
+
+// OrderReference identifies an order in the ledger.
+func OrderReference(orderID uint) string {
+	return fmt.Sprintf("order:%d", orderID)
+}
+
+// Account caches the ledger of a customer. Balance is what can be spent,
+// Earned what was earned over time, less reversals, and sets the tier. It is
+// only written in the same transaction as the entry that changes it.
+type Account struct {
+	UserID     uint      `gorm:"column:user_id;primaryKey"`
+	Balance    int       `gorm:"column:balance;not null;default:0;check:chk_loyalty_accounts_balance,balance >= 0"`
+	Earned     int       `gorm:"column:earned;not null;default:0"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Account) TableName() string {
+	return "loyalty_accounts"
+}
+
+// Entry is one immutable change of a customer's points. Points is a signed
+// delta. Credits keep the points not spent yet in Remaining until ExpiresAt;
+// debits spend the credits that expire first. A user has one entry of each
+// type per reference, so awarding or reversing twice has no effect.
+type Entry struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"column:user_id;not null;uniqueIndex:idx_loyalty_entries_user_type_reference"`
+	Type      string     `gorm:"column:type;size:20;not null;uniqueIndex:idx_loyalty_entries_user_type_reference"`
+	Reference string     `gorm:"column:reference;size:64;not null;uniqueIndex:idx_loyalty_entries_user_type_reference"`
+	Points    int        `gorm:"column:points;not null"`
+	Remaining int        `gorm:"column:remaining;not null;default:0"`
+	ExpiresAt *time.Time `gorm:"column:expires_at;index"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (Entry) TableName() string {
+	return "loyalty_entries"
+}
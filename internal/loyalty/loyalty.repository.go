@@ -0,0 +1,232 @@
+package loyalty
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LoyaltyRepository interface {
+	// FindAccount returns an empty account for users who never earned points.
+	FindAccount(ctx context.Context, userID uint) (*Account, error)
+	FindEntries(ctx context.Context, userID uint, offset, limit int) ([]Entry, int64, error)
+	FindEntry(ctx context.Context, userID uint, entryType, reference string) (*Entry, error)
+	// Record adds an entry to the ledger and the balance of its user. Credits
+	// can be spent until PointsLifetime after now; debits spend the credits
+	// that expire first and fail with ErrInsufficientPoints when the balance
+	// is short, except reversals, which take what is left. An entry of the
+	// same type and reference fails with gorm.ErrDuplicatedKey.
+	Record(ctx context.Context, entry *Entry, now time.Time) error
+	// Expire takes the points left on credits that expired by now off the
+	// balance of up to limit users, and returns how many users it expired.
+	Expire(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+type loyaltyRepository struct {
+	db *gorm.DB
+}
+
+func NewLoyaltyRepository(db *gorm.DB) LoyaltyRepository {
+	return &loyaltyRepository{
+		db: db,
+	}
+}
+
+func (r *loyaltyRepository) FindAccount(ctx context.Context, userID uint) (*Account, error) {
+	var account Account
+	err := pkg.DB(ctx, r.db).Where("user_id = ?", userID).First(&account).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &Account{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (r *loyaltyRepository) FindEntries(ctx context.Context, userID uint, offset, limit int) ([]Entry, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Entry{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []Entry
+	result := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&entries)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return entries, total, nil
+}
+
+func (r *loyaltyRepository) FindEntry(ctx context.Context, userID uint, entryType, reference string) (*Entry, error) {
+	var entry *Entry
+	result := pkg.DB(ctx, r.db).
+		Where("user_id = ? AND type = ? AND reference = ?", userID, entryType, reference).
+		First(&entry)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return entry, nil
+}
+
+func (r *loyaltyRepository) Record(ctx context.Context, entry *Entry, now time.Time) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		account, err := lockAccount(tx, entry.UserID)
+		if err != nil {
+			return err
+		}
+
+		if entry.Points < 0 {
+			// Points that expired cannot be spent, even before the expiry
+			// job took them off the balance.
+			if entry.Type == EntryRedeem {
+				if err := expireCredits(tx, account, now); err != nil {
+					return err
+				}
+			}
+			spend := -entry.Points
+			if spend > account.Balance {
+				if entry.Type != EntryReversal {
+					return ErrInsufficientPoints
+				}
+				spend = account.Balance
+				entry.Points = -spend
+			}
+			if err := spendCredits(tx, entry, spend); err != nil {
+				return err
+			}
+		} else {
+			expiresAt := now.Add(PointsLifetime)
+			entry.Remaining = entry.Points
+			entry.ExpiresAt = &expiresAt
+		}
+
+		if err := tx.Create(entry).Error; err != nil {
+			return err
+		}
+		account.Balance += entry.Points
+		switch entry.Type {
+		case EntryPurchase, EntryReview, EntryReversal:
+			account.Earned += entry.Points
+		}
+		return saveAccount(tx, account)
+	})
+}
+
+func (r *loyaltyRepository) Expire(ctx context.Context, now time.Time, limit int) (int, error) {
+	var userIDs []uint
+	err := pkg.DB(ctx, r.db).Model(&Entry{}).
+		Distinct("user_id").
+		Where("remaining > 0 AND expires_at <= ?", now).
+		Order("user_id").
+		Limit(limit).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for _, userID := range userIDs {
+		err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+			account, err := lockAccount(tx, userID)
+			if err != nil {
+				return err
+			}
+			if err := expireCredits(tx, account, now); err != nil {
+				return err
+			}
+			return saveAccount(tx, account)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("user %d: %w", userID, err)
+		}
+	}
+	return len(userIDs), nil
+}
+
+// lockAccount creates the account of the user when it has none and locks it
+// until the transaction ends.
+func lockAccount(tx *gorm.DB, userID uint) (*Account, error) {
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Account{UserID: userID}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var account Account
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&account).Error
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func saveAccount(tx *gorm.DB, account *Account) error {
+	return tx.Model(account).Updates(map[string]interface{}{
+		"balance": account.Balance,
+		"earned":  account.Earned,
+	}).Error
+}
+
+// spendCredits takes points off the credits of the entry's user that expire
+// first. Reversals spend the credit they reverse first.
+func spendCredits(tx *gorm.DB, entry *Entry, points int) error {
+	var credits []Entry
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND remaining > 0", entry.UserID).
+		Order(clause.Expr{SQL: "reference = ? DESC, expires_at, id", Vars: []interface{}{entry.Reference}}).
+		Find(&credits).Error
+	if err != nil {
+		return err
+	}
+
+	for _, credit := range credits {
+		if points == 0 {
+			break
+		}
+		take := min(points, credit.Remaining)
+		err := tx.Model(&Entry{}).Where("id = ?", credit.ID).
+			UpdateColumn("remaining", credit.Remaining-take).Error
+		if err != nil {
+			return err
+		}
+		points -= take
+	}
+	return nil
+}
+
+// expireCredits records the expiry of the points left on the account's
+// credits that expired by now and takes them off its balance.
+func expireCredits(tx *gorm.DB, account *Account, now time.Time) error {
+	var credits []Entry
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND remaining > 0 AND expires_at <= ?", account.UserID, now).
+		Order("id").
+		Find(&credits).Error
+	if err != nil {
+		return err
+	}
+
+	for _, credit := range credits {
+		err := tx.Create(&Entry{
+			UserID:    account.UserID,
+			Type:      EntryExpiry,
+			Reference: fmt.Sprintf("entry:%d", credit.ID),
+			Points:    -credit.Remaining,
+		}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&Entry{}).Where("id = ?", credit.ID).UpdateColumn("remaining", 0).Error
+		if err != nil {
+			return err
+		}
+		account.Balance -= credit.Remaining
+	}
+	return nil
+}
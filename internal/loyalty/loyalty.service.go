@@ -0,0 +1,185 @@
+package loyalty
+
+import (
+	"bookstore-framework/internal/loyalty/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const expireBatch = 500
+
+var (
+	ErrInsufficientPoints = errors.New("not enough loyalty points")
+	ErrRedemptionTooLarge = errors.New("redeemed points are worth more than the order")
+)
+
+type LoyaltyService interface {
+	GetAccount(ctx context.Context, userID uint) (*dto.AccountResponse, error)
+	GetEntries(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.EntryListResponse, error)
+	// AwardPurchase credits the points of a paid order at the tier
+	// multiplier of the user, converting its total from the order currency
+	// to BaseCurrency. An order is awarded once.
+	AwardPurchase(ctx context.Context, userID, orderID uint, total int64, currency string) error
+	// AwardReview credits the points of a review of a book the user bought.
+	// A book is awarded once per user, even when it is reviewed again.
+	AwardReview(ctx context.Context, userID, bookID uint) error
+	Redeem(ctx context.Context, userID, orderID uint, points int) error
+	// ReverseOrder takes back the points a cancelled or refunded order
+	// earned, and gives back the points redeemed on it.
+	ReverseOrder(ctx context.Context, userID, orderID uint) error
+	// ExpirePoints takes the points that expired off the balances.
+	ExpirePoints(ctx context.Context) error
+}
+
+type loyaltyService struct {
+	loyaltyRepo    LoyaltyRepository
+	pricingService pricing.PricingService
+}
+
+func NewLoyaltyService(loyaltyRepo LoyaltyRepository, pricingService pricing.PricingService) LoyaltyService {
+	return &loyaltyService{
+		loyaltyRepo:    loyaltyRepo,
+		pricingService: pricingService,
+	}
+}
+
+func (s *loyaltyService) GetAccount(ctx context.Context, userID uint) (*dto.AccountResponse, error) {
+	account, err := s.loyaltyRepo.FindAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tier, next := TierFor(account.Earned)
+	response := &dto.AccountResponse{
+		Balance:       account.Balance,
+		Earned:        account.Earned,
+		Tier:          tier.Name,
+		Multiplier:    tier.Multiplier,
+		PointValue:    PointValue,
+		PointCurrency: BaseCurrency,
+	}
+	if next != nil {
+		response.NextTier = next.Name
+		response.PointsToNextTier = next.Threshold - account.Earned
+	}
+	return response, nil
+}
+
+func (s *loyaltyService) GetEntries(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.EntryListResponse, error) {
+	entries, total, err := s.loyaltyRepo.FindEntries(ctx, userID, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.EntryListResponse{
+		Entries:    make([]dto.EntryResponse, 0, len(entries)),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, dto.EntryResponse{
+			ID:        entry.ID,
+			Type:      entry.Type,
+			Reference: entry.Reference,
+			Points:    entry.Points,
+			Remaining: entry.Remaining,
+			ExpiresAt: entry.ExpiresAt,
+			CreatedAt: entry.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+func (s *loyaltyService) AwardPurchase(ctx context.Context, userID, orderID uint, total int64, currency string) error {
+	spent, err := s.pricingService.Convert(ctx, money.New(total, currency), BaseCurrency)
+	if err != nil {
+		return err
+	}
+	account, err := s.loyaltyRepo.FindAccount(ctx, userID)
+	if err != nil {
+		return err
+	}
+	tier, _ := TierFor(account.Earned)
+	points := int(spent.Amount/PurchaseUnit) * tier.Multiplier / 100
+	if points == 0 {
+		return nil
+	}
+	return s.record(ctx, &Entry{UserID: userID, Type: EntryPurchase, Reference: OrderReference(orderID), Points: points})
+}
+
+func (s *loyaltyService) AwardReview(ctx context.Context, userID, bookID uint) error {
+	return s.record(ctx, &Entry{
+		UserID:    userID,
+		Type:      EntryReview,
+		Reference: fmt.Sprintf("book:%d", bookID),
+		Points:    ReviewPoints,
+	})
+}
+
+func (s *loyaltyService) Redeem(ctx context.Context, userID, orderID uint, points int) error {
+	return s.loyaltyRepo.Record(ctx, &Entry{
+		UserID:    userID,
+		Type:      EntryRedeem,
+		Reference: OrderReference(orderID),
+		Points:    -points,
+	}, time.Now())
+}
+
+func (s *loyaltyService) ReverseOrder(ctx context.Context, userID, orderID uint) error {
+	reference := OrderReference(orderID)
+
+	purchase, err := s.loyaltyRepo.FindEntry(ctx, userID, EntryPurchase, reference)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if purchase != nil {
+		err := s.record(ctx, &Entry{UserID: userID, Type: EntryReversal, Reference: reference, Points: -purchase.Points})
+		if err != nil {
+			return err
+		}
+	}
+
+	redeem, err := s.loyaltyRepo.FindEntry(ctx, userID, EntryRedeem, reference)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.record(ctx, &Entry{UserID: userID, Type: EntryRestore, Reference: reference, Points: -redeem.Points})
+}
+
+func (s *loyaltyService) ExpirePoints(ctx context.Context) error {
+	expired := 0
+	for {
+		users, err := s.loyaltyRepo.Expire(ctx, time.Now(), expireBatch)
+		if err != nil {
+			return err
+		}
+		expired += users
+		if users < expireBatch {
+			break
+		}
+	}
+	if expired > 0 {
+		log.Printf("Expired the loyalty points of %d users", expired)
+	}
+	return nil
+}
+
+// record adds an entry that may already have been recorded, such as the
+// award of an order that was paid twice.
+func (s *loyaltyService) record(ctx context.Context, entry *Entry) error {
+	err := s.loyaltyRepo.Record(ctx, entry, time.Now())
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil
+	}
+	return err
+}
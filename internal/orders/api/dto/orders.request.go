@@ -0,0 +1,47 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// AddressRequest represents a postal address
+type AddressRequest struct {
+	Name       string `json:"name" binding:"required,max=100" example:"Bilbo Baggins"`
+	Line1      string `json:"line1" binding:"required,max=255" example:"Bag End"`
+	Line2      string `json:"line2" binding:"max=255" example:"Bagshot Row"`
+	City       string `json:"city" binding:"required,max=100" example:"Hobbiton"`
+	Region     string `json:"region" binding:"max=100" example:"The Shire"`
+	PostalCode string `json:"postal_code" binding:"required,max=20" example:"SH1 1BE"`
+	Country    string `json:"country" binding:"required,iso3166_1_alpha2" example:"GB"`
+}
+
+// CheckoutRequest represents a request to place an order for the cart
+// @Description Checkout payload. The order is placed for the current cart of the logged in user.
+// @Description Redeemed loyalty points come off the total.
+// @Description A gift card, then the store credit when use_store_credit is set, pay what they can of the total; an order they pay in full is paid at once.
+type CheckoutRequest struct {
+	ShippingAddress AddressRequest `json:"shipping_address" binding:"required"`
+	RedeemPoints    int            `json:"redeem_points" binding:"omitempty,min=1" example:"500"`
+	GiftCardCode    string         `json:"gift_card_code" binding:"max=32" example:"7KQM-X2PD-H9RT-4WLB"`
+	UseStoreCredit  bool           `json:"use_store_credit" example:"true"`
+}
+
+// TransitionRequest represents a request to move an order to another status
+// @Description Order status change. Allowed changes: pending to paid or cancelled,
+// @Description paid to fulfilling, cancelled or refunded, fulfilling to shipped or cancelled,
+// @Description shipped to delivered, delivered to refunded.
+type TransitionRequest struct {
+	Status string `json:"status" binding:"required,oneof=paid fulfilling shipped delivered cancelled refunded" example:"fulfilling"`
+	Note   string `json:"note" binding:"max=255" example:"Picked at the main warehouse"`
+}
+
+// CancelOrderRequest represents a customer's request to cancel an order
+type CancelOrderRequest struct {
+	Note string `json:"note" binding:"max=255" example:"Ordered the wrong edition"`
+}
+
+// OrderListQuery represents the query string of the order list endpoint.
+// Backordered lists only the orders waiting for stock, or only the others.
+type OrderListQuery struct {
+	pkg.PaginationQuery
+	Status      string `form:"status" binding:"omitempty,oneof=pending paid fulfilling shipped delivered cancelled refunded"`
+	Backordered *bool  `form:"backordered"`
+}
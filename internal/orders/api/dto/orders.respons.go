@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type AddressResponse struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
+
+// OrderItemResponse describes one line of an order. Promotions explains its
+// discount; TaxRate is in basis points.
+type OrderItemResponse struct {
+	BookID     uint                     `json:"book_id"`
+	SKU        string                   `json:"sku"`
+	ISBN       string                   `json:"isbn"`
+	Title      string                   `json:"title"`
+	Quantity   int                      `json:"quantity"`
+	UnitPrice  int64                    `json:"unit_price"`
+	LineTotal  int64                    `json:"line_total"`
+	Discount   int64                    `json:"discount"`
+	Promotions []OrderPromotionResponse `json:"promotions,omitempty"`
+	TaxClass   string                   `json:"tax_class"`
+	TaxName    string                   `json:"tax_name,omitempty"`
+	TaxRate    int                      `json:"tax_rate"`
+	Tax        int64                    `json:"tax"`
+}
+
+// OrderTaxResponse is the tax of the order at one rate, in basis points.
+type OrderTaxResponse struct {
+	Name   string `json:"name"`
+	Rate   int    `json:"rate"`
+	Amount int64  `json:"amount"`
+}
+
+// OrderPromotionResponse is the discount a promotion gave to the order or to
+// one of its lines.
+type OrderPromotionResponse struct {
+	PromotionID uint   `json:"promotion_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
+}
+
+type OrderTransitionResponse struct {
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    *uint     `json:"actor_id,omitempty"`
+	Note       string    `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// OrderResponse carries prices in minor currency units; Total is the
+// subtotal less the discount, plus the tax unless prices include it, less
+// what the redeemed loyalty points are worth. AmountDue is what is left to pay
+// through the payment provider once the gift card and store credit paid their
+// part of the total. History is only included when a single order is read. A
+// backordered order waits for copies of pre-ordered or out of stock books.
+type OrderResponse struct {
+	ID               uint                      `json:"id"`
+	UserID           uint                      `json:"user_id"`
+	Status           string                    `json:"status"`
+	Currency         string                    `json:"currency"`
+	Subtotal         int64                     `json:"subtotal"`
+	Discount         int64                     `json:"discount"`
+	Tax              int64                     `json:"tax"`
+	Total            int64                     `json:"total"`
+	PricesIncludeTax bool                      `json:"prices_include_tax"`
+	CouponCode       string                    `json:"coupon_code,omitempty"`
+	FreeShipping     bool                      `json:"free_shipping"`
+	PointsRedeemed   int                       `json:"points_redeemed,omitempty"`
+	PointsDiscount   int64                     `json:"points_discount,omitempty"`
+	GiftCardAmount   int64                     `json:"gift_card_amount,omitempty"`
+	StoreCredit      int64                     `json:"store_credit,omitempty"`
+	AmountDue        int64                     `json:"amount_due"`
+	Backordered      bool                      `json:"backordered"`
+	Promotions       []OrderPromotionResponse  `json:"promotions,omitempty"`
+	Taxes            []OrderTaxResponse        `json:"taxes,omitempty"`
+	ShippingAddress  AddressResponse           `json:"shipping_address"`
+	Items            []OrderItemResponse       `json:"items"`
+	History          []OrderTransitionResponse `json:"history,omitempty"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	ModifiedAt       time.Time                 `json:"modified_at"`
+}
+
+// OrderNotificationResponse tells the customer about their order, such as
+// a "fulfillable" backordered order that got all its copies.
+type OrderNotificationResponse struct {
+	ID        uint      `json:"id"`
+	OrderID   uint      `json:"order_id"`
+	Type      string    `json:"type"`
+	Message   string    `json:"message"`
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OrderNotificationListResponse struct {
+	Notifications []OrderNotificationResponse `json:"notifications"`
+	Pagination    pkg.PaginationMeta          `json:"pagination"`
+}
+
+type OrderListResponse struct {
+	Orders     []OrderResponse    `json:"orders"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
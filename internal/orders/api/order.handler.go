@@ -0,0 +1,302 @@
+package api
+
+import (
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/orders/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrderHandler struct {
+	orderService orders.OrderService
+}
+
+func NewOrderHandler(orderService orders.OrderService) *OrderHandler {
+	return &OrderHandler{
+		orderService: orderService,
+	}
+}
+
+// Checkout godoc
+// @Summary      Place an order
+// @Description  Turn the cart of the logged in user into a pending order. Prices are fixed at checkout and the stock is reserved until the order ships or is cancelled. Copies of pre-ordered and backorderable books that are not in stock are backordered: the order waits for them and the customer is notified once it has them all. Redeemed loyalty points come off the total, then a gift card and the store credit pay what they can of it; an order they pay in full is paid at once
+// @Tags         orders
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.CheckoutRequest true "Shipping address"
+// @Success      201  {object}    pkg.Response{data=dto.OrderResponse} "Order created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      401  {object}    pkg.Response "User not found"
+// @Failure      404  {object}    pkg.Response "Gift card not found"
+// @Failure      409  {object}    pkg.Response "Cart changed, coupon no longer applies, not enough copies in stock, not enough points or gift card expired or empty"
+// @Router       /orders/checkout [post]
+func (h *OrderHandler) Checkout(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.CheckoutRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.orderService.Checkout(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Order created successfully", response)
+}
+
+// GetOrders godoc
+// @Summary      List orders
+// @Description  List the orders of the logged in user, newest first. Staff see the orders of every customer
+// @Tags         orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int    false "Page number" default(1)
+// @Param        limit  query     int    false "Page size" default(20)
+// @Param        status query     string false "Filter by status"
+// @Param        backordered query bool  false "Only orders waiting for stock, or only the others"
+// @Success      200  {object}    pkg.Response{data=dto.OrderListResponse} "Orders retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /orders [get]
+func (h *OrderHandler) GetOrders(ctx *gin.Context) {
+	actor, ok := orderActor(ctx)
+	if !ok {
+		return
+	}
+
+	var query dto.OrderListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.orderService.GetOrders(ctx.Request.Context(), actor, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Orders retrieve successfully", response)
+}
+
+// GetOrder godoc
+// @Summary      Get an order
+// @Description  Get an order with its items and status history
+// @Tags         orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Order ID"
+// @Success      200  {object}    pkg.Response{data=dto.OrderResponse} "Order retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Order not found"
+// @Router       /orders/{id} [get]
+func (h *OrderHandler) GetOrder(ctx *gin.Context) {
+	actor, ok := orderActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid order id", err.Error())
+		return
+	}
+
+	response, err := h.orderService.GetOrder(ctx.Request.Context(), actor, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Order retrieve successfully", response)
+}
+
+// CancelOrder godoc
+// @Summary      Cancel an order
+// @Description  Cancel an order that has not been paid yet and release its stock
+// @Tags         orders
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Order ID"
+// @Param        request body     dto.CancelOrderRequest false "Reason"
+// @Success      200  {object}    pkg.Response{data=dto.OrderResponse} "Order cancelled successfully"
+// @Failure      404  {object}    pkg.Response "Order not found"
+// @Failure      409  {object}    pkg.Response "Order cannot move to this status"
+// @Router       /orders/{id}/cancel [post]
+func (h *OrderHandler) CancelOrder(ctx *gin.Context) {
+	actor, ok := orderActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid order id", err.Error())
+		return
+	}
+
+	var req dto.CancelOrderRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+			return
+		}
+	}
+
+	response, err := h.orderService.Cancel(ctx.Request.Context(), actor, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Order cancelled successfully", response)
+}
+
+// TransitionOrder godoc
+// @Summary      Change the status of an order
+// @Description  Move an order along its lifecycle. Shipping turns the reserved stock into sales, cancelling or refunding releases it. Backordered orders cannot be fulfilled or shipped. Every change is recorded in the order history
+// @Tags         orders
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Order ID"
+// @Param        request body     dto.TransitionRequest true "New status"
+// @Success      200  {object}    pkg.Response{data=dto.OrderResponse} "Order updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Order not found"
+// @Failure      409  {object}    pkg.Response "Order cannot move to this status"
+// @Router       /orders/{id}/transitions [post]
+func (h *OrderHandler) TransitionOrder(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid order id", err.Error())
+		return
+	}
+
+	var req dto.TransitionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	actorID := userID.(uint)
+	response, err := h.orderService.Transition(ctx.Request.Context(), &actorID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Order updated successfully", response)
+}
+
+// GetNotifications godoc
+// @Summary      List order notifications
+// @Description  Get the notifications about your orders, the latest first, such as a backordered order that got all its copies
+// @Tags         orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query    int false "Page number" default(1)
+// @Param        limit    query    int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.OrderNotificationListResponse} "Notifications retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /orders/notifications [get]
+func (h *OrderHandler) GetNotifications(ctx *gin.Context) {
+	actor, ok := orderActor(ctx)
+	if !ok {
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.orderService.GetNotifications(ctx.Request.Context(), actor.UserID, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Notifications retrieve successfully", response)
+}
+
+// MarkNotificationsRead godoc
+// @Summary      Mark order notifications as read
+// @Tags         orders
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response "Notifications marked as read"
+// @Router       /orders/notifications/read [put]
+func (h *OrderHandler) MarkNotificationsRead(ctx *gin.Context) {
+	actor, ok := orderActor(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.orderService.MarkNotificationsRead(ctx.Request.Context(), actor.UserID); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Notifications marked as read", nil)
+}
+
+// orderActor reads the user set by the JWT middleware, answering the request
+// itself when there is none.
+func orderActor(ctx *gin.Context) (orders.Actor, bool) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return orders.Actor{}, false
+	}
+	return orders.Actor{
+		UserID: userID.(uint),
+		Staff:  ctx.GetString("role") == users.RoleStaff,
+	}, true
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, orders.ErrOrderNotFound),
+		errors.Is(err, wallet.ErrGiftCardNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, orders.ErrEmptyCart),
+		errors.Is(err, wallet.ErrCurrencyMismatch):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, orders.ErrCartChanged),
+		errors.Is(err, orders.ErrCouponNotApplicable),
+		errors.Is(err, orders.ErrPromotionUnavailable),
+		errors.Is(err, orders.ErrInsufficientStock),
+		errors.Is(err, orders.ErrInvalidTransition),
+		errors.Is(err, loyalty.ErrInsufficientPoints),
+		errors.Is(err, loyalty.ErrRedemptionTooLarge),
+		errors.Is(err, pricing.ErrExchangeRateNotFound),
+		errors.Is(err, wallet.ErrGiftCardExpired),
+		errors.Is(err, wallet.ErrGiftCardEmpty):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/middleware"
+	"bookstore-framework/pkg"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func OrdersRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	orderRepository := orders.NewOrderRepository(db)
+	cartRepository := carts.NewCartRepository(db)
+	inventoryRepository := inventory.NewInventoryRepository(db)
+	promotionService := promotions.NewPromotionService(
+		promotions.NewPromotionRepository(db),
+		books.NewCategoryRepository(db),
+		books.NewAuthorRepository(db),
+	)
+	taxService := taxes.NewTaxService(taxes.NewTaxRepository(db))
+	pricingService := pricing.NewPricingService(pricing.NewPricingRepository(db))
+	loyaltyService := loyalty.NewLoyaltyService(loyalty.NewLoyaltyRepository(db), pricingService)
+	transactor := pkg.NewTransactor(db)
+	walletService := wallet.NewWalletService(wallet.NewWalletRepository(db), transactor)
+	orderService := orders.NewOrderService(orderRepository, cartRepository, inventoryRepository, promotionService, taxService, pricingService, loyaltyService, walletService, transactor)
+	orderHandler := NewOrderHandler(orderService)
+
+	router.Use(middleware.JWTAuth())
+	router.POST("/checkout", orderHandler.Checkout)
+	router.GET("", orderHandler.GetOrders)
+	router.GET("/notifications", orderHandler.GetNotifications)
+	router.PUT("/notifications/read", orderHandler.MarkNotificationsRead)
+	router.GET("/:id", orderHandler.GetOrder)
+	router.POST("/:id/cancel", orderHandler.CancelOrder)
+
+	staff := router.Group("")
+	staff.Use(middleware.RequireRole(users.RoleStaff))
+	staff.POST("/:id/transitions", orderHandler.TransitionOrder)
+}
@@ -0,0 +1,192 @@
+package orders
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	StatusPending    = "pending"
+	StatusPaid       = "paid"
+	StatusFulfilling = "fulfilling"
+	StatusShipped    = "shipped"
+	StatusDelivered  = "delivered"
+	StatusCancelled  = "cancelled"
+	StatusRefunded   = "refunded"
+)
+
+// PurchasedStatuses are the statuses of orders the customer paid for and did
+// not get refunded.
+var PurchasedStatuses = []string{StatusPaid, StatusFulfilling, StatusShipped, StatusDelivered}
+
+// transitions lists the statuses each status can move to. Cancelled and
+// refunded orders are final.
+var transitions = map[string][]string{
+	StatusPending:    {StatusPaid, StatusCancelled},
+	StatusPaid:       {StatusFulfilling, StatusCancelled, StatusRefunded},
+	StatusFulfilling: {StatusShipped, StatusCancelled},
+	StatusShipped:    {StatusDelivered},
+	StatusDelivered:  {StatusRefunded},
+}
+
+// CanTransition reports whether an order may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Address is a postal address stored with the order.
+type Address struct {
+	Name       string `gorm:"column:name;size:100"`
+	Line1      string `gorm:"column:line1;size:255"`
+	Line2      string `gorm:"column:line2;size:255"`
+	City       string `gorm:"column:city;size:100"`
+	Region     string `gorm:"column:region;size:100"`
+	PostalCode string `gorm:"column:postal_code;size:20"`
+	Country    string `gorm:"column:country;size:2"`
+}
+
+// NotificationFulfillable tells the customer that a backordered order has all
+// its copies and can ship.
+const NotificationFulfillable = "fulfillable"
+
+// Order totals are in minor units of Currency. When PricesIncludeTax is set,
+// Tax is the part of the discounted subtotal that is tax, otherwise it is
+// added on top of it to make the total. PointsDiscount, what the redeemed
+// loyalty points are worth, comes off the total last. GiftCardAmount and
+// StoreCredit are the parts of the total paid from a gift card and the
+// customer's wallet; the payment provider is asked for the rest.
+//
+// A backordered order waits for copies of pre-ordered or out of stock books
+// and cannot be fulfilled until they are all allocated to it.
+type Order struct {
+	ID               uint              `gorm:"primaryKey"`
+	UserID           uint              `gorm:"column:user_id;not null;index"`
+	Status           string            `gorm:"column:status;size:20;not null;index"`
+	Currency         string            `gorm:"column:currency;size:3;not null"`
+	Subtotal         int64             `gorm:"column:subtotal;not null"`
+	Discount         int64             `gorm:"column:discount;not null;default:0"`
+	Tax              int64             `gorm:"column:tax;not null;default:0"`
+	Total            int64             `gorm:"column:total;not null"`
+	PricesIncludeTax bool              `gorm:"column:prices_include_tax;not null;default:false"`
+	CouponCode       string            `gorm:"column:coupon_code;size:40"`
+	FreeShipping     bool              `gorm:"column:free_shipping;not null;default:false"`
+	PointsRedeemed   int               `gorm:"column:points_redeemed;not null;default:0"`
+	PointsDiscount   int64             `gorm:"column:points_discount;not null;default:0"`
+	GiftCardID       *uint             `gorm:"column:gift_card_id;index"`
+	GiftCardAmount   int64             `gorm:"column:gift_card_amount;not null;default:0"`
+	StoreCredit      int64             `gorm:"column:store_credit;not null;default:0"`
+	Backordered      bool              `gorm:"column:backordered;not null;default:false;index"`
+	Shipping         Address           `gorm:"embedded;embeddedPrefix:shipping_"`
+	Items            []OrderItem       `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	Discounts        []OrderDiscount   `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	Transitions      []OrderTransition `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time         `gorm:"column:created_at;autoCreateTime;index"`
+	ModifiedAt       time.Time         `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Order) TableName() string {
+	return "orders"
+}
+
+// AmountDue is what is left of the total to pay through the payment provider.
+func (o Order) AmountDue() int64 {
+	return o.Total - o.GiftCardAmount - o.StoreCredit
+}
+
+// Reference identifies the order in the stock ledger and reservations.
+func (o Order) Reference() string {
+	return fmt.Sprintf("order:%d", o.ID)
+}
+
+// OrderIDFromReference returns the ID of the order a reference identifies,
+// false when it is not the reference of an order.
+func OrderIDFromReference(reference string) (uint, bool) {
+	id, found := strings.CutPrefix(reference, "order:")
+	if !found {
+		return 0, false
+	}
+	parsed, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || parsed == 0 {
+		return 0, false
+	}
+	return uint(parsed), true
+}
+
+// OrderItem snapshots the book as it was sold, so later catalog edits do not
+// change past orders. TaxName and TaxRate, in basis points, are those of the
+// rate the line was taxed at.
+type OrderItem struct {
+	ID        uint   `gorm:"primaryKey"`
+	OrderID   uint   `gorm:"column:order_id;not null;index"`
+	BookID    uint   `gorm:"column:book_id;not null;index"`
+	SKU       string `gorm:"column:sku;size:64;not null"`
+	ISBN      string `gorm:"column:isbn;size:17;not null"`
+	Title     string `gorm:"column:title;size:255;not null"`
+	Quantity  int    `gorm:"column:quantity;not null"`
+	UnitPrice int64  `gorm:"column:unit_price;not null"`
+	LineTotal int64  `gorm:"column:line_total;not null"`
+	Discount  int64  `gorm:"column:discount;not null;default:0"`
+	TaxClass  string `gorm:"column:tax_class;size:32;not null;default:book"`
+	TaxName   string `gorm:"column:tax_name;size:50"`
+	TaxRate   int    `gorm:"column:tax_rate;not null;default:0"`
+	Tax       int64  `gorm:"column:tax;not null;default:0"`
+}
+
+func (OrderItem) TableName() string {
+	return "order_items"
+}
+
+// OrderTransition records one status change and who made it. ActorID is nil
+// for changes made by the system, such as payment notifications.
+type OrderTransition struct {
+	ID         uint      `gorm:"primaryKey"`
+	OrderID    uint      `gorm:"column:order_id;not null;index"`
+	FromStatus string    `gorm:"column:from_status;size:20"`
+	ToStatus   string    `gorm:"column:to_status;size:20;not null"`
+	ActorID    *uint     `gorm:"column:actor_id"`
+	Note       string    `gorm:"column:note;size:255"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (OrderTransition) TableName() string {
+	return "order_transitions"
+}
+
+// OrderDiscount records what a promotion took off a line of the order, or,
+// with no BookID, a benefit for the whole order such as free shipping.
+type OrderDiscount struct {
+	ID          uint   `gorm:"primaryKey"`
+	OrderID     uint   `gorm:"column:order_id;not null;index"`
+	BookID      *uint  `gorm:"column:book_id"`
+	PromotionID uint   `gorm:"column:promotion_id;not null;index"`
+	Name        string `gorm:"column:name;size:100;not null"`
+	Description string `gorm:"column:description;size:255;not null"`
+	Amount      int64  `gorm:"column:amount;not null"`
+}
+
+func (OrderDiscount) TableName() string {
+	return "order_discounts"
+}
+
+// OrderNotification tells a customer about a change to their order that they
+// did not make themselves.
+type OrderNotification struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"column:user_id;not null;index"`
+	OrderID   uint       `gorm:"column:order_id;not null;index"`
+	Type      string     `gorm:"column:type;size:32;not null"`
+	Message   string     `gorm:"column:message;size:255;not null"`
+	ReadAt    *time.Time `gorm:"column:read_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (OrderNotification) TableName() string {
+	return "order_notifications"
+}
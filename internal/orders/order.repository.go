@@ -0,0 +1,167 @@
+package orders
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrderFilter struct {
+	// UserID limits the list to the orders of one customer when set.
+	UserID      *uint
+	Status      string
+	Backordered *bool
+	Offset      int
+	Limit       int
+}
+
+type OrderRepository interface {
+	// Create saves the order with its items and first transition.
+	Create(ctx context.Context, order *Order) (*Order, error)
+	FindByID(ctx context.Context, id uint) (*Order, error)
+	// FindByIDForUpdate locks the order row until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id uint) (*Order, error)
+	FindAll(ctx context.Context, filter OrderFilter) ([]Order, int64, error)
+	UpdateStatus(ctx context.Context, order *Order, transition *OrderTransition) error
+	// SetBackordered reports whether the flag of the order changed.
+	SetBackordered(ctx context.Context, id uint, backordered bool) (bool, error)
+	// HasPurchased tells whether the user paid for an order of the book that
+	// was not cancelled or refunded since.
+	HasPurchased(ctx context.Context, userID, bookID uint) (bool, error)
+	CreateNotification(ctx context.Context, notification *OrderNotification) error
+	FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]OrderNotification, int64, error)
+	MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error
+}
+
+type orderRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) OrderRepository {
+	return &orderRepository{
+		db: db,
+	}
+}
+
+func (r *orderRepository) Create(ctx context.Context, order *Order) (*Order, error) {
+	result := pkg.DB(ctx, r.db).Create(order)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return order, nil
+}
+
+func (r *orderRepository) FindByID(ctx context.Context, id uint) (*Order, error) {
+	var order *Order
+	result := preloadOrder(pkg.DB(ctx, r.db)).First(&order, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return order, nil
+}
+
+func (r *orderRepository) FindByIDForUpdate(ctx context.Context, id uint) (*Order, error) {
+	var order *Order
+	result := pkg.DB(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return order, nil
+}
+
+func (r *orderRepository) FindAll(ctx context.Context, filter OrderFilter) ([]Order, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Order{})
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Backordered != nil {
+		query = query.Where("backordered = ?", *filter.Backordered)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orders []Order
+	result := query.Preload("Items").Order("id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&orders)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return orders, total, nil
+}
+
+func (r *orderRepository) UpdateStatus(ctx context.Context, order *Order, transition *OrderTransition) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(order).Update("status", order.Status).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(transition).Error
+	})
+}
+
+func (r *orderRepository) SetBackordered(ctx context.Context, id uint, backordered bool) (bool, error) {
+	result := pkg.DB(ctx, r.db).Model(&Order{}).
+		Where("id = ? AND backordered = ?", id, !backordered).
+		Update("backordered", backordered)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *orderRepository) HasPurchased(ctx context.Context, userID, bookID uint) (bool, error) {
+	var count int64
+	err := pkg.DB(ctx, r.db).Model(&OrderItem{}).
+		Joins("JOIN orders ON orders.id = order_items.order_id").
+		Where("orders.user_id = ? AND order_items.book_id = ? AND orders.status IN ?",
+			userID, bookID, PurchasedStatuses).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *orderRepository) CreateNotification(ctx context.Context, notification *OrderNotification) error {
+	return pkg.DB(ctx, r.db).Create(notification).Error
+}
+
+func (r *orderRepository) FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]OrderNotification, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&OrderNotification{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []OrderNotification
+	result := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&notifications)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return notifications, total, nil
+}
+
+func (r *orderRepository) MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error {
+	return pkg.DB(ctx, r.db).Model(&OrderNotification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		UpdateColumn("read_at", now).Error
+}
+
+func preloadOrder(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("order_items.id")
+	}).Preload("Discounts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("order_discounts.id")
+	}).Preload("Transitions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("order_transitions.id")
+	})
+}
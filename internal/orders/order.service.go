@@ -0,0 +1,644 @@
+package orders
+
+import (
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOrderNotFound     = errors.New("order not found")
+	ErrEmptyCart         = errors.New("cart is empty")
+	ErrCartChanged       = errors.New("cart changed since it was last reviewed, review the cart and try again")
+	ErrInsufficientStock = errors.New("not enough copies in stock")
+	ErrInvalidTransition = errors.New("order cannot move to this status")
+	// ErrCouponNotApplicable is wrapped with the reason the coupon does not apply.
+	ErrCouponNotApplicable  = errors.New("coupon code does not apply to this cart")
+	ErrPromotionUnavailable = errors.New("a promotion in the cart is no longer available, review the cart and try again")
+)
+
+// Actor is the user acting on an order. Customers only reach their own
+// orders, staff reach every order.
+type Actor struct {
+	UserID uint
+	Staff  bool
+}
+
+type OrderService interface {
+	// Checkout turns the user's cart into a pending order, reserving the stock,
+	// redeeming loyalty points, charging the gift card and store credit and
+	// emptying the cart in the same transaction. An order they pay in full is
+	// paid at once.
+	Checkout(ctx context.Context, userID uint, req dto.CheckoutRequest) (*dto.OrderResponse, error)
+	GetOrder(ctx context.Context, actor Actor, id uint) (*dto.OrderResponse, error)
+	GetOrders(ctx context.Context, actor Actor, query dto.OrderListQuery) (*dto.OrderListResponse, error)
+	// Cancel lets customers cancel their own orders until they are paid.
+	Cancel(ctx context.Context, actor Actor, id uint, req dto.CancelOrderRequest) (*dto.OrderResponse, error)
+	// Transition moves an order along the state machine. A nil actorID marks
+	// a change made by the system. Backordered orders cannot be fulfilled.
+	Transition(ctx context.Context, actorID *uint, id uint, req dto.TransitionRequest) (*dto.OrderResponse, error)
+	GetNotifications(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.OrderNotificationListResponse, error)
+	MarkNotificationsRead(ctx context.Context, userID uint) error
+	// AllocateBackorders fills waiting reservations of released books from
+	// the stock that arrived, oldest orders first, and notifies the customers
+	// whose orders got all their copies.
+	AllocateBackorders(ctx context.Context) error
+}
+
+type orderService struct {
+	orderRepo        OrderRepository
+	cartRepo         carts.CartRepository
+	inventoryRepo    inventory.InventoryRepository
+	promotionService promotions.PromotionService
+	taxService       taxes.TaxService
+	pricingService   pricing.PricingService
+	loyaltyService   loyalty.LoyaltyService
+	walletService    wallet.WalletService
+	transactor       pkg.Transactor
+}
+
+func NewOrderService(orderRepo OrderRepository, cartRepo carts.CartRepository, inventoryRepo inventory.InventoryRepository, promotionService promotions.PromotionService, taxService taxes.TaxService, pricingService pricing.PricingService, loyaltyService loyalty.LoyaltyService, walletService wallet.WalletService, transactor pkg.Transactor) OrderService {
+	return &orderService{
+		orderRepo:        orderRepo,
+		cartRepo:         cartRepo,
+		inventoryRepo:    inventoryRepo,
+		promotionService: promotionService,
+		taxService:       taxService,
+		pricingService:   pricingService,
+		loyaltyService:   loyaltyService,
+		walletService:    walletService,
+		transactor:       transactor,
+	}
+}
+
+func (s *orderService) Checkout(ctx context.Context, userID uint, req dto.CheckoutRequest) (*dto.OrderResponse, error) {
+	cart, err := s.cartRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrEmptyCart
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 || !cart.ExpiresAt.After(time.Now()) {
+		return nil, ErrEmptyCart
+	}
+
+	address := req.ShippingAddress
+	order := &Order{
+		UserID:   userID,
+		Status:   StatusPending,
+		Currency: cart.Currency,
+		Shipping: Address{
+			Name:       address.Name,
+			Line1:      address.Line1,
+			Line2:      address.Line2,
+			City:       address.City,
+			Region:     address.Region,
+			PostalCode: address.PostalCode,
+			Country:    address.Country,
+		},
+		Transitions: []OrderTransition{{ToStatus: StatusPending, ActorID: &userID}},
+	}
+
+	items := make([]pricing.Item, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, pricing.Item{BookID: item.BookID, Price: money.New(item.Book.Price, item.Book.Currency)})
+	}
+	quotes, err := s.pricingService.Quote(ctx, cart.Currency, items)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	basket := promotions.Basket{Currency: cart.Currency, UserID: &userID, Code: cart.CouponCode}
+	reservations := make([]inventory.StockReservation, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		book := item.Book
+		// The customer must see the cart again before paying a price they
+		// were not shown.
+		price, priced := quotes[item.BookID]
+		if book.DeletedAt.Valid || !priced || price.Amount != item.UnitPrice {
+			return nil, ErrCartChanged
+		}
+
+		line := OrderItem{
+			BookID:    book.ID,
+			SKU:       book.SKU,
+			ISBN:      book.ISBN,
+			Title:     book.Title,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			LineTotal: item.UnitPrice * int64(item.Quantity),
+			TaxClass:  book.TaxClass,
+		}
+		order.Items = append(order.Items, line)
+		order.Subtotal += line.LineTotal
+		reservations = append(reservations, inventory.StockReservation{
+			SKU:       book.SKU,
+			BookID:    book.ID,
+			Quantity:  item.Quantity,
+			Backorder: book.AcceptsBackorders(now),
+		})
+		basket.Lines = append(basket.Lines, promotions.Line{
+			BookID:    book.ID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+
+	evaluation, err := s.promotionService.Evaluate(ctx, basket)
+	if err != nil {
+		return nil, err
+	}
+	if coupon := evaluation.Coupon; coupon != nil && !coupon.Applied {
+		return nil, fmt.Errorf("%w: %s", ErrCouponNotApplicable, coupon.Reason)
+	}
+	applyPromotions(order, evaluation)
+
+	lines := make([]taxes.Line, 0, len(order.Items))
+	for _, item := range order.Items {
+		lines = append(lines, taxes.Line{
+			BookID:   item.BookID,
+			TaxClass: item.TaxClass,
+			Amount:   item.LineTotal - item.Discount,
+		})
+	}
+	calculation, err := s.taxService.Calculate(ctx, taxes.Destination{
+		Country: order.Shipping.Country,
+		Region:  order.Shipping.Region,
+	}, lines)
+	if err != nil {
+		return nil, err
+	}
+	applyTaxes(order, calculation)
+	if req.RedeemPoints > 0 {
+		discount, err := s.pricingService.Convert(ctx, loyalty.PointsValue(req.RedeemPoints), order.Currency)
+		if err != nil {
+			return nil, err
+		}
+		order.PointsRedeemed = req.RedeemPoints
+		order.PointsDiscount = discount.Amount
+		if order.PointsDiscount > order.Total {
+			return nil, loyalty.ErrRedemptionTooLarge
+		}
+		order.Total -= order.PointsDiscount
+	}
+	var funds *wallet.Funds
+	if req.GiftCardCode != "" || req.UseStoreCredit {
+		funds, err = s.walletService.Funds(ctx, userID, wallet.FundsRequest{
+			Currency:       order.Currency,
+			Amount:         order.Total,
+			Code:           req.GiftCardCode,
+			UseStoreCredit: req.UseStoreCredit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		order.GiftCardID = funds.GiftCardID
+		order.GiftCardAmount = funds.GiftCard
+		order.StoreCredit = funds.StoreCredit
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.orderRepo.Create(ctx, order); err != nil {
+			return err
+		}
+		for i := range reservations {
+			reservations[i].Reference = order.Reference()
+		}
+		reserved, err := s.inventoryRepo.Reserve(ctx, reservations)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range reserved {
+			if reservation.Status == inventory.ReservationWaiting {
+				order.Backordered = true
+			}
+		}
+		if order.Backordered {
+			if _, err := s.orderRepo.SetBackordered(ctx, order.ID, true); err != nil {
+				return err
+			}
+		}
+		if order.PointsRedeemed > 0 {
+			if err := s.loyaltyService.Redeem(ctx, userID, order.ID, order.PointsRedeemed); err != nil {
+				return err
+			}
+		}
+		if funds != nil {
+			if err := s.walletService.Charge(ctx, userID, order.ID, *funds); err != nil {
+				return err
+			}
+		}
+		if err := s.promotionService.Redeem(ctx, evaluation, userID, order.ID); err != nil {
+			return err
+		}
+		// Deleting the cart also fails a concurrent checkout of the same cart.
+		err = s.cartRepo.Delete(ctx, cart.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrEmptyCart
+		}
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	if order.AmountDue() == 0 {
+		return s.transition(ctx, order.ID, StatusPaid, nil, "Paid with gift card and store credit", nil)
+	}
+	return ToOrderResponse(order, true), nil
+}
+
+func (s *orderService) GetOrder(ctx context.Context, actor Actor, id uint) (*dto.OrderResponse, error) {
+	order, err := s.orderRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if !actor.Staff && order.UserID != actor.UserID {
+		return nil, ErrOrderNotFound
+	}
+	return ToOrderResponse(order, true), nil
+}
+
+func (s *orderService) GetOrders(ctx context.Context, actor Actor, query dto.OrderListQuery) (*dto.OrderListResponse, error) {
+	filter := OrderFilter{
+		Status:      query.Status,
+		Backordered: query.Backordered,
+		Offset:      query.Offset(),
+		Limit:       query.Limit,
+	}
+	if !actor.Staff {
+		filter.UserID = &actor.UserID
+	}
+
+	orders, total, err := s.orderRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.OrderListResponse{
+		Orders:     make([]dto.OrderResponse, 0, len(orders)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, *ToOrderResponse(&order, false))
+	}
+	return response, nil
+}
+
+func (s *orderService) Cancel(ctx context.Context, actor Actor, id uint, req dto.CancelOrderRequest) (*dto.OrderResponse, error) {
+	return s.transition(ctx, id, StatusCancelled, &actor.UserID, req.Note, func(order *Order) error {
+		if actor.Staff {
+			return nil
+		}
+		if order.UserID != actor.UserID {
+			return ErrOrderNotFound
+		}
+		if order.Status != StatusPending {
+			return fmt.Errorf("%w: paid orders are cancelled by staff", ErrInvalidTransition)
+		}
+		return nil
+	})
+}
+
+func (s *orderService) Transition(ctx context.Context, actorID *uint, id uint, req dto.TransitionRequest) (*dto.OrderResponse, error) {
+	return s.transition(ctx, id, req.Status, actorID, req.Note, nil)
+}
+
+// transition changes the status of a locked order along with its stock and
+// loyalty points: a paid order earns points, a shipped order turns its
+// reservations into sales, a cancelled or refunded order gives back the stock
+// it still holds, reverses its points and gives what it took from a gift
+// card or the wallet back to the wallet.
+func (s *orderService) transition(ctx context.Context, id uint, to string, actorID *uint, note string, authorize func(order *Order) error) (*dto.OrderResponse, error) {
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		order, err := s.orderRepo.FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if authorize != nil {
+			if err := authorize(order); err != nil {
+				return err
+			}
+		}
+		if !CanTransition(order.Status, to) {
+			return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, order.Status, to)
+		}
+		if order.Backordered && (to == StatusFulfilling || to == StatusShipped) {
+			return fmt.Errorf("%w: the order is waiting for stock", ErrInvalidTransition)
+		}
+
+		switch to {
+		case StatusPaid:
+			err = s.loyaltyService.AwardPurchase(ctx, order.UserID, order.ID, order.Total, order.Currency)
+		case StatusShipped:
+			err = s.inventoryRepo.CommitReservations(ctx, order.Reference(), actorID)
+		case StatusCancelled, StatusRefunded:
+			err = s.inventoryRepo.ReleaseReservations(ctx, order.Reference())
+			if err == nil {
+				err = s.loyaltyService.ReverseOrder(ctx, order.UserID, order.ID)
+			}
+			if err == nil {
+				err = s.walletService.ReverseOrder(ctx, order.UserID, order.ID)
+			}
+			if err == nil {
+				err = s.promotionService.Release(ctx, order.ID)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		from := order.Status
+		order.Status = to
+		return s.orderRepo.UpdateStatus(ctx, order, &OrderTransition{
+			OrderID:    order.ID,
+			FromStatus: from,
+			ToStatus:   to,
+			ActorID:    actorID,
+			Note:       note,
+		})
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	order, err := s.orderRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToOrderResponse(order, true), nil
+}
+
+func (s *orderService) GetNotifications(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.OrderNotificationListResponse, error) {
+	notifications, total, err := s.orderRepo.FindNotifications(ctx, userID, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.OrderNotificationListResponse{
+		Notifications: make([]dto.OrderNotificationResponse, 0, len(notifications)),
+		Pagination:    pkg.NewPaginationMeta(query, total),
+	}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, dto.OrderNotificationResponse{
+			ID:        notification.ID,
+			OrderID:   notification.OrderID,
+			Type:      notification.Type,
+			Message:   notification.Message,
+			Read:      notification.ReadAt != nil,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+func (s *orderService) MarkNotificationsRead(ctx context.Context, userID uint) error {
+	return s.orderRepo.MarkNotificationsRead(ctx, userID, time.Now())
+}
+
+func (s *orderService) AllocateBackorders(ctx context.Context) error {
+	skus, err := s.inventoryRepo.FindAllocatableSKUs(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	notified := 0
+	for _, sku := range skus {
+		// The orders are flagged in the transaction of the allocation, so no
+		// order is left backordered when a run fails halfway.
+		err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			references, err := s.inventoryRepo.Allocate(ctx, sku)
+			if err != nil {
+				return err
+			}
+			for _, reference := range references {
+				id, ok := OrderIDFromReference(reference)
+				if !ok {
+					continue
+				}
+				sent, err := s.notifyFulfillable(ctx, id)
+				if err != nil {
+					return err
+				}
+				if sent {
+					notified++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if notified > 0 {
+		log.Printf("Notified %d orders that are no longer backordered", notified)
+	}
+	return nil
+}
+
+// notifyFulfillable clears the backordered flag of the order and tells the
+// customer, unless the order was not backordered.
+func (s *orderService) notifyFulfillable(ctx context.Context, id uint) (bool, error) {
+	changed, err := s.orderRepo.SetBackordered(ctx, id, false)
+	if err != nil || !changed {
+		return false, err
+	}
+	order, err := s.orderRepo.FindByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	err = s.orderRepo.CreateNotification(ctx, &OrderNotification{
+		UserID:  order.UserID,
+		OrderID: order.ID,
+		Type:    NotificationFulfillable,
+		Message: fmt.Sprintf("Every book of order #%d is now in stock, the order will be shipped soon.", order.ID),
+	})
+	return err == nil, err
+}
+
+// applyPromotions copies the discounts of an evaluation, whose lines follow
+// the order items, onto the order.
+func applyPromotions(order *Order, evaluation *promotions.Evaluation) {
+	for i, line := range evaluation.Lines {
+		item := &order.Items[i]
+		item.Discount = line.Discount
+		for _, adjustment := range line.Adjustments {
+			bookID := item.BookID
+			order.Discounts = append(order.Discounts, OrderDiscount{
+				BookID:      &bookID,
+				PromotionID: adjustment.PromotionID,
+				Name:        adjustment.Name,
+				Description: adjustment.Description,
+				Amount:      adjustment.Amount,
+			})
+		}
+	}
+	for _, applied := range evaluation.Applied {
+		if applied.Amount == 0 {
+			order.Discounts = append(order.Discounts, OrderDiscount{
+				PromotionID: applied.PromotionID,
+				Name:        applied.Name,
+				Description: applied.Description,
+			})
+		}
+	}
+	order.Discount = evaluation.Discount
+	order.FreeShipping = evaluation.FreeShipping
+	if evaluation.Coupon != nil {
+		order.CouponCode = evaluation.Coupon.Code
+	}
+}
+
+// applyTaxes copies a tax calculation, whose lines follow the order items,
+// onto the order and works out its total.
+func applyTaxes(order *Order, calculation *taxes.Calculation) {
+	for i, line := range calculation.Lines {
+		item := &order.Items[i]
+		item.TaxName = line.Name
+		item.TaxRate = line.Rate
+		item.Tax = line.Tax
+	}
+	order.Tax = calculation.Tax
+	order.PricesIncludeTax = calculation.PricesIncludeTax
+	order.Total = order.Subtotal - order.Discount
+	if !order.PricesIncludeTax {
+		order.Total += order.Tax
+	}
+}
+
+func ToOrderResponse(order *Order, withHistory bool) *dto.OrderResponse {
+	response := &dto.OrderResponse{
+		ID:               order.ID,
+		UserID:           order.UserID,
+		Status:           order.Status,
+		Currency:         order.Currency,
+		Subtotal:         order.Subtotal,
+		Discount:         order.Discount,
+		Tax:              order.Tax,
+		Total:            order.Total,
+		PricesIncludeTax: order.PricesIncludeTax,
+		CouponCode:       order.CouponCode,
+		FreeShipping:     order.FreeShipping,
+		PointsRedeemed:   order.PointsRedeemed,
+		PointsDiscount:   order.PointsDiscount,
+		GiftCardAmount:   order.GiftCardAmount,
+		StoreCredit:      order.StoreCredit,
+		AmountDue:        order.AmountDue(),
+		Backordered:      order.Backordered,
+		ShippingAddress: dto.AddressResponse{
+			Name:       order.Shipping.Name,
+			Line1:      order.Shipping.Line1,
+			Line2:      order.Shipping.Line2,
+			City:       order.Shipping.City,
+			Region:     order.Shipping.Region,
+			PostalCode: order.Shipping.PostalCode,
+			Country:    order.Shipping.Country,
+		},
+		Items:      make([]dto.OrderItemResponse, 0, len(order.Items)),
+		CreatedAt:  order.CreatedAt,
+		ModifiedAt: order.ModifiedAt,
+	}
+	for _, item := range order.Items {
+		line := dto.OrderItemResponse{
+			BookID:    item.BookID,
+			SKU:       item.SKU,
+			ISBN:      item.ISBN,
+			Title:     item.Title,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			LineTotal: item.LineTotal,
+			Discount:  item.Discount,
+			TaxClass:  item.TaxClass,
+			TaxName:   item.TaxName,
+			TaxRate:   item.TaxRate,
+			Tax:       item.Tax,
+		}
+		for _, discount := range order.Discounts {
+			if discount.BookID != nil && *discount.BookID == item.BookID {
+				line.Promotions = append(line.Promotions, toPromotionResponse(discount))
+			}
+		}
+		response.Items = append(response.Items, line)
+	}
+	// The order lists each promotion once with its total over every line.
+	for _, discount := range order.Discounts {
+		found := false
+		for i := range response.Promotions {
+			if response.Promotions[i].PromotionID == discount.PromotionID {
+				response.Promotions[i].Amount += discount.Amount
+				found = true
+			}
+		}
+		if !found {
+			response.Promotions = append(response.Promotions, toPromotionResponse(discount))
+		}
+	}
+	// Taxes sums the tax of the lines by rate.
+	for _, item := range order.Items {
+		if item.Tax == 0 {
+			continue
+		}
+		found := false
+		for i := range response.Taxes {
+			if response.Taxes[i].Name == item.TaxName && response.Taxes[i].Rate == item.TaxRate {
+				response.Taxes[i].Amount += item.Tax
+				found = true
+			}
+		}
+		if !found {
+			response.Taxes = append(response.Taxes, dto.OrderTaxResponse{Name: item.TaxName, Rate: item.TaxRate, Amount: item.Tax})
+		}
+	}
+	if withHistory {
+		for _, transition := range order.Transitions {
+			response.History = append(response.History, dto.OrderTransitionResponse{
+				FromStatus: transition.FromStatus,
+				ToStatus:   transition.ToStatus,
+				ActorID:    transition.ActorID,
+				Note:       transition.Note,
+				CreatedAt:  transition.CreatedAt,
+			})
+		}
+	}
+	return response
+}
+
+func toPromotionResponse(discount OrderDiscount) dto.OrderPromotionResponse {
+	return dto.OrderPromotionResponse{
+		PromotionID: discount.PromotionID,
+		Name:        discount.Name,
+		Description: discount.Description,
+		Amount:      discount.Amount,
+	}
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrOrderNotFound
+	case errors.Is(err, inventory.ErrInsufficientStock):
+		return ErrInsufficientStock
+	case errors.Is(err, promotions.ErrUsageLimitReached):
+		return ErrPromotionUnavailable
+	case errors.Is(err, wallet.ErrInsufficientFunds):
+		return ErrCartChanged
+	default:
+		return err
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+// CreatePaymentRequest represents a request to pay for an order
+// @Description Starts a payment for a pending order, or returns the payment already in progress.
+type CreatePaymentRequest struct {
+	OrderID uint `json:"order_id" binding:"required" example:"42"`
+}
+
+// RefundRequest represents a request to refund a captured payment
+// @Description Refund in minor currency units. Leave the amount out to refund what is left of the payment.
+type RefundRequest struct {
+	Amount int64  `json:"amount" binding:"omitempty,min=1" example:"1299"`
+	Reason string `json:"reason" binding:"max=255" example:"Damaged in transit"`
+}
+
+// CompleteFakePaymentRequest represents the customer's answer on the fake provider
+type CompleteFakePaymentRequest struct {
+	Outcome string `json:"outcome" binding:"required,oneof=succeeded failed" example:"succeeded"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+type RefundResponse struct {
+	ID               uint      `json:"id"`
+	ProviderRefundID string    `json:"provider_refund_id"`
+	Amount           int64     `json:"amount"`
+	ActorID          *uint     `json:"actor_id,omitempty"`
+	Reason           string    `json:"reason,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// PaymentResponse carries amounts in minor currency units. ClientSecret is
+// only returned to the customer starting the payment.
+type PaymentResponse struct {
+	ID             uint             `json:"id"`
+	OrderID        uint             `json:"order_id"`
+	Provider       string           `json:"provider"`
+	IntentID       string           `json:"intent_id"`
+	Status         string           `json:"status"`
+	Amount         int64            `json:"amount"`
+	Currency       string           `json:"currency"`
+	RefundedAmount int64            `json:"refunded_amount"`
+	ClientSecret   string           `json:"client_secret,omitempty"`
+	Refunds        []RefundResponse `json:"refunds,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	ModifiedAt     time.Time        `json:"modified_at"`
+}
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/payments/api/dto"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/pkg"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookSize bounds the webhook body read before its signature is checked.
+const maxWebhookSize = 1 << 20
+
+type PaymentHandler struct {
+	paymentService payments.PaymentService
+	// fake is set when payments go through the fake provider.
+	fake *payments.FakeProvider
+}
+
+func NewPaymentHandler(paymentService payments.PaymentService, fake *payments.FakeProvider) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+		fake:           fake,
+	}
+}
+
+// CreatePayment godoc
+// @Summary      Pay for an order
+// @Description  Start paying for a pending order. The client secret is passed to the provider on the storefront to confirm the payment; the order becomes paid when the provider reports the payment captured. Calling it again returns the payment in progress
+// @Tags         payments
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.CreatePaymentRequest true "Order to pay"
+// @Success      201  {object}    pkg.Response{data=dto.PaymentResponse} "Payment created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Order not found"
+// @Failure      409  {object}    pkg.Response "Order is not awaiting payment"
+// @Router       /payments [post]
+func (h *PaymentHandler) CreatePayment(ctx *gin.Context) {
+	actor, ok := paymentActor(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.CreatePaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.paymentService.CreatePayment(ctx.Request.Context(), actor, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Payment created successfully", response)
+}
+
+// GetPayment godoc
+// @Summary      Get a payment
+// @Description  Get a payment of an order with its refunds
+// @Tags         payments
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Payment ID"
+// @Success      200  {object}    pkg.Response{data=dto.PaymentResponse} "Payment retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Payment not found"
+// @Router       /payments/{id} [get]
+func (h *PaymentHandler) GetPayment(ctx *gin.Context) {
+	actor, ok := paymentActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid payment id", err.Error())
+		return
+	}
+
+	response, err := h.paymentService.GetPayment(ctx.Request.Context(), actor, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Payment retrieve successfully", response)
+}
+
+// Webhook godoc
+// @Summary      Receive a payment provider event
+// @Description  Endpoint called by the payment provider. The request is authenticated by its signature, and events delivered more than once are applied once
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}    pkg.Response "Webhook processed successfully"
+// @Failure      400  {object}    pkg.Response "Invalid webhook signature"
+// @Router       /payments/webhook [post]
+func (h *PaymentHandler) Webhook(ctx *gin.Context) {
+	payload, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxWebhookSize))
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	if err := h.paymentService.HandleWebhook(ctx.Request.Context(), payload, ctx.Request.Header); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Webhook processed successfully", nil)
+}
+
+// RefundPayment godoc
+// @Summary      Refund a payment
+// @Description  Refund a captured payment in full or in part. A full refund also moves the order to refunded and releases the stock it still holds
+// @Tags         payments
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Payment ID"
+// @Param        request body     dto.RefundRequest false "Amount and reason"
+// @Success      200  {object}    pkg.Response{data=dto.PaymentResponse} "Payment refunded successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Payment not found"
+// @Failure      409  {object}    pkg.Response "Payment cannot be refunded"
+// @Router       /payments/{id}/refunds [post]
+func (h *PaymentHandler) RefundPayment(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid payment id", err.Error())
+		return
+	}
+
+	var req dto.RefundRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+			return
+		}
+	}
+
+	response, err := h.paymentService.Refund(ctx.Request.Context(), userID.(uint), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Payment refunded successfully", response)
+}
+
+// CompleteFakePayment godoc
+// @Summary      Complete a fake payment
+// @Description  Development only, available when PAYMENT_PROVIDER is fake. Plays the customer on the provider's payment page and delivers the signed webhook the provider would send
+// @Tags         payments
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        intentId path    string true "Payment intent ID"
+// @Param        request  body    dto.CompleteFakePaymentRequest true "Outcome"
+// @Success      200  {object}    pkg.Response "Webhook processed successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Payment intent not found"
+// @Router       /payments/fake/intents/{intentId}/complete [post]
+func (h *PaymentHandler) CompleteFakePayment(ctx *gin.Context) {
+	var req dto.CompleteFakePaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	payload, header, err := h.fake.Complete(ctx.Param("intentId"), req.Outcome == payments.IntentSucceeded)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	if err := h.paymentService.HandleWebhook(ctx.Request.Context(), payload, header); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Webhook processed successfully", nil)
+}
+
+// paymentActor reads the user set by the JWT middleware, answering the
+// request itself when there is none.
+func paymentActor(ctx *gin.Context) (orders.Actor, bool) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return orders.Actor{}, false
+	}
+	return orders.Actor{
+		UserID: userID.(uint),
+		Staff:  ctx.GetString("role") == users.RoleStaff,
+	}, true
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, payments.ErrPaymentNotFound),
+		errors.Is(err, payments.ErrIntentNotFound),
+		errors.Is(err, orders.ErrOrderNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, payments.ErrInvalidSignature):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, payments.ErrOrderNotPayable),
+		errors.Is(err, payments.ErrPaymentInProgress),
+		errors.Is(err, payments.ErrNotRefundable),
+		errors.Is(err, payments.ErrInvalidRefundAmount),
+		errors.Is(err, payments.ErrInvalidIntentState),
+		errors.Is(err, orders.ErrInvalidTransition):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentsRoutes serves the payment service built in main, which the
+// reconciliation job shares with the webhook.
+func PaymentsRoutes(router *gin.RouterGroup, paymentService payments.PaymentService, provider payments.PaymentProvider) {
+	fake, _ := provider.(*payments.FakeProvider)
+	paymentHandler := NewPaymentHandler(paymentService, fake)
+
+	router.POST("/webhook", paymentHandler.Webhook)
+
+	protected := router.Group("")
+	protected.Use(middleware.JWTAuth())
+	protected.POST("", paymentHandler.CreatePayment)
+	protected.GET("/:id", paymentHandler.GetPayment)
+	if fake != nil {
+		protected.POST("/fake/intents/:intentId/complete", paymentHandler.CompleteFakePayment)
+	}
+
+	staff := protected.Group("")
+	staff.Use(middleware.RequireRole(users.RoleStaff))
+	staff.POST("/:id/refunds", paymentHandler.RefundPayment)
+}
@@ -0,0 +1,181 @@
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+const (
+	ProviderFake = "fake"
+
+	// FakeSignatureHeader carries the hex HMAC-SHA256 of the webhook body.
+	FakeSignatureHeader = "X-Fake-Signature"
+)
+
+// fakeStore is shared by every FakeProvider in the process, the way a remote
+// provider is shared by every server talking to it.
+var fakeStore = struct {
+	sync.Mutex
+	intents map[string]*fakeIntent
+	keys    map[string]string
+}{
+	intents: map[string]*fakeIntent{},
+	keys:    map[string]string{},
+}
+
+type fakeIntent struct {
+	Intent
+	refunded int64
+}
+
+// FakeProvider is an in-memory provider for local development and tests.
+// Intents stay pending until Complete plays the part of the customer.
+type FakeProvider struct {
+	secret []byte
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{secret: []byte(webhookSecret)}
+}
+
+func (p *FakeProvider) Name() string {
+	return ProviderFake
+}
+
+func (p *FakeProvider) CreateIntent(_ context.Context, req IntentRequest) (*Intent, error) {
+	fakeStore.Lock()
+	defer fakeStore.Unlock()
+
+	if id, ok := fakeStore.keys[req.IdempotencyKey]; ok && req.IdempotencyKey != "" {
+		intent := fakeStore.intents[id].Intent
+		return &intent, nil
+	}
+
+	intent := &fakeIntent{Intent: Intent{
+		ID:           "pi_" + randomHex(12),
+		Status:       IntentPending,
+		Amount:       req.Amount,
+		Currency:     req.Currency,
+		ClientSecret: "secret_" + randomHex(16),
+	}}
+	fakeStore.intents[intent.ID] = intent
+	if req.IdempotencyKey != "" {
+		fakeStore.keys[req.IdempotencyKey] = intent.ID
+	}
+	result := intent.Intent
+	return &result, nil
+}
+
+func (p *FakeProvider) GetIntent(_ context.Context, intentID string) (*Intent, error) {
+	fakeStore.Lock()
+	defer fakeStore.Unlock()
+
+	intent, ok := fakeStore.intents[intentID]
+	if !ok {
+		return nil, ErrIntentNotFound
+	}
+	result := intent.Intent
+	return &result, nil
+}
+
+func (p *FakeProvider) Capture(_ context.Context, intentID string) (*Intent, error) {
+	fakeStore.Lock()
+	defer fakeStore.Unlock()
+
+	intent, ok := fakeStore.intents[intentID]
+	if !ok {
+		return nil, ErrIntentNotFound
+	}
+	switch intent.Status {
+	case IntentAuthorized:
+		intent.Status = IntentSucceeded
+	case IntentSucceeded:
+	default:
+		return nil, ErrInvalidIntentState
+	}
+	result := intent.Intent
+	return &result, nil
+}
+
+func (p *FakeProvider) Refund(_ context.Context, intentID string, amount int64) (*Refund, error) {
+	fakeStore.Lock()
+	defer fakeStore.Unlock()
+
+	intent, ok := fakeStore.intents[intentID]
+	if !ok {
+		return nil, ErrIntentNotFound
+	}
+	if intent.Status != IntentSucceeded || amount <= 0 || intent.refunded+amount > intent.Amount {
+		return nil, ErrInvalidIntentState
+	}
+	intent.refunded += amount
+	return &Refund{ID: "re_" + randomHex(12), Amount: amount, Status: IntentSucceeded}, nil
+}
+
+func (p *FakeProvider) VerifyWebhook(payload []byte, header http.Header) (*WebhookEvent, error) {
+	signature, err := hex.DecodeString(header.Get(FakeSignatureHeader))
+	if err != nil || !hmac.Equal(signature, p.sign(payload)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// Complete settles a pending intent as the customer would, authorizing or
+// failing it, and returns the signed webhook the provider would send.
+func (p *FakeProvider) Complete(intentID string, authorize bool) ([]byte, http.Header, error) {
+	fakeStore.Lock()
+	intent, ok := fakeStore.intents[intentID]
+	if !ok {
+		fakeStore.Unlock()
+		return nil, nil, ErrIntentNotFound
+	}
+	if intent.Status != IntentPending {
+		fakeStore.Unlock()
+		return nil, nil, ErrInvalidIntentState
+	}
+	event := WebhookEvent{ID: "evt_" + randomHex(12), IntentID: intentID, Amount: intent.Amount}
+	if authorize {
+		intent.Status = IntentAuthorized
+		event.Type = EventPaymentAuthorized
+	} else {
+		intent.Status = IntentFailed
+		event.Type = EventPaymentFailed
+	}
+	fakeStore.Unlock()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, nil, err
+	}
+	return payload, p.Header(payload), nil
+}
+
+// Header returns the headers that sign payload.
+func (p *FakeProvider) Header(payload []byte) http.Header {
+	header := http.Header{}
+	header.Set(FakeSignatureHeader, hex.EncodeToString(p.sign(payload)))
+	return header
+}
+
+func (p *FakeProvider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
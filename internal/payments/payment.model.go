@@ -0,0 +1,65 @@
+package payments
+
+import "time"
+
+const (
+	PaymentPending    = "pending"
+	PaymentAuthorized = "authorized"
+	PaymentCaptured   = "captured"
+	PaymentFailed     = "failed"
+	PaymentRefunded   = "refunded"
+)
+
+// Payment is one attempt to pay for an order through a provider intent. An
+// order may have several failed attempts but only one that is captured.
+type Payment struct {
+	ID             uint            `gorm:"primaryKey"`
+	OrderID        uint            `gorm:"column:order_id;not null;index"`
+	Provider       string          `gorm:"column:provider;size:20;not null;uniqueIndex:idx_payments_provider_intent"`
+	IntentID       string          `gorm:"column:intent_id;size:100;not null;uniqueIndex:idx_payments_provider_intent"`
+	Status         string          `gorm:"column:status;size:20;not null;index"`
+	Amount         int64           `gorm:"column:amount;not null"`
+	Currency       string          `gorm:"column:currency;size:3;not null"`
+	RefundedAmount int64           `gorm:"column:refunded_amount;not null;default:0"`
+	Refunds        []PaymentRefund `gorm:"foreignKey:PaymentID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time       `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt     time.Time       `gorm:"column:modified_at;autoUpdateTime;index"`
+}
+
+func (Payment) TableName() string {
+	return "payments"
+}
+
+// Open reports whether the provider may still settle the payment.
+func (p Payment) Open() bool {
+	return p.Status == PaymentPending || p.Status == PaymentAuthorized
+}
+
+// PaymentEvent records every webhook event handled, so that events delivered
+// more than once are applied once.
+type PaymentEvent struct {
+	ID        uint      `gorm:"primaryKey"`
+	Provider  string    `gorm:"column:provider;size:20;not null;uniqueIndex:idx_payment_events_provider_event"`
+	EventID   string    `gorm:"column:event_id;size:100;not null;uniqueIndex:idx_payment_events_provider_event"`
+	Type      string    `gorm:"column:type;size:50;not null"`
+	IntentID  string    `gorm:"column:intent_id;size:100;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (PaymentEvent) TableName() string {
+	return "payment_events"
+}
+
+type PaymentRefund struct {
+	ID               uint      `gorm:"primaryKey"`
+	PaymentID        uint      `gorm:"column:payment_id;not null;index"`
+	ProviderRefundID string    `gorm:"column:provider_refund_id;size:100;not null"`
+	Amount           int64     `gorm:"column:amount;not null"`
+	ActorID          *uint     `gorm:"column:actor_id"`
+	Reason           string    `gorm:"column:reason;size:255"`
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (PaymentRefund) TableName() string {
+	return "payment_refunds"
+}
@@ -0,0 +1,85 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Intent statuses as reported by a provider.
+const (
+	IntentPending    = "pending"
+	IntentAuthorized = "authorized"
+	IntentSucceeded  = "succeeded"
+	IntentFailed     = "failed"
+	IntentCanceled   = "canceled"
+)
+
+// Webhook event types.
+const (
+	EventPaymentAuthorized = "payment.authorized"
+	EventPaymentSucceeded  = "payment.succeeded"
+	EventPaymentFailed     = "payment.failed"
+	EventRefundSucceeded   = "refund.succeeded"
+)
+
+var (
+	ErrInvalidSignature   = errors.New("invalid webhook signature")
+	ErrIntentNotFound     = errors.New("payment intent not found")
+	ErrInvalidIntentState = errors.New("payment intent cannot be changed in its current state")
+)
+
+type IntentRequest struct {
+	Amount    int64
+	Currency  string
+	Reference string
+	// IdempotencyKey makes retried requests return the intent created first.
+	IdempotencyKey string
+}
+
+type Intent struct {
+	ID       string
+	Status   string
+	Amount   int64
+	Currency string
+	// ClientSecret lets the storefront confirm the payment with the provider.
+	ClientSecret string
+}
+
+type Refund struct {
+	ID     string
+	Amount int64
+	Status string
+}
+
+// WebhookEvent is a verified notification from the provider. ID is unique per
+// event and is used to process redelivered events only once.
+type WebhookEvent struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	IntentID string `json:"intent_id"`
+	Amount   int64  `json:"amount"`
+}
+
+// PaymentProvider is the boundary to a payment service. Amounts are in minor
+// currency units.
+type PaymentProvider interface {
+	Name() string
+	CreateIntent(ctx context.Context, req IntentRequest) (*Intent, error)
+	GetIntent(ctx context.Context, intentID string) (*Intent, error)
+	Capture(ctx context.Context, intentID string) (*Intent, error)
+	Refund(ctx context.Context, intentID string, amount int64) (*Refund, error)
+	// VerifyWebhook checks the signature of a webhook request and decodes it.
+	VerifyWebhook(payload []byte, header http.Header) (*WebhookEvent, error)
+}
+
+// NewProvider returns the provider selected in the configuration.
+func NewProvider(name, webhookSecret string) (PaymentProvider, error) {
+	switch name {
+	case "", ProviderFake:
+		return NewFakeProvider(webhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
+}
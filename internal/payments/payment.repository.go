@@ -0,0 +1,106 @@
+package payments
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *Payment) (*Payment, error)
+	FindByID(ctx context.Context, id uint) (*Payment, error)
+	// FindByIntentIDForUpdate locks the payment row until the transaction ends.
+	FindByIntentIDForUpdate(ctx context.Context, provider, intentID string) (*Payment, error)
+	FindByOrderID(ctx context.Context, orderID uint) ([]Payment, error)
+	// FindStale lists open payments not changed since before, oldest first.
+	FindStale(ctx context.Context, before time.Time, limit int) ([]Payment, error)
+	Update(ctx context.Context, payment *Payment) error
+	// RecordEvent saves a webhook event and reports false when it was
+	// already recorded.
+	RecordEvent(ctx context.Context, event *PaymentEvent) (bool, error)
+	AddRefund(ctx context.Context, refund *PaymentRefund) error
+}
+
+type paymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) PaymentRepository {
+	return &paymentRepository{
+		db: db,
+	}
+}
+
+func (r *paymentRepository) Create(ctx context.Context, payment *Payment) (*Payment, error) {
+	result := pkg.DB(ctx, r.db).Create(payment)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return payment, nil
+}
+
+func (r *paymentRepository) FindByID(ctx context.Context, id uint) (*Payment, error) {
+	var payment *Payment
+	result := pkg.DB(ctx, r.db).Preload("Refunds", func(db *gorm.DB) *gorm.DB {
+		return db.Order("payment_refunds.id")
+	}).First(&payment, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return payment, nil
+}
+
+func (r *paymentRepository) FindByIntentIDForUpdate(ctx context.Context, provider, intentID string) (*Payment, error) {
+	var payment *Payment
+	result := pkg.DB(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("provider = ? AND intent_id = ?", provider, intentID).
+		First(&payment)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return payment, nil
+}
+
+func (r *paymentRepository) FindByOrderID(ctx context.Context, orderID uint) ([]Payment, error) {
+	var payments []Payment
+	result := pkg.DB(ctx, r.db).Where("order_id = ?", orderID).Order("id").Find(&payments)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return payments, nil
+}
+
+func (r *paymentRepository) FindStale(ctx context.Context, before time.Time, limit int) ([]Payment, error) {
+	var payments []Payment
+	result := pkg.DB(ctx, r.db).
+		Where("status IN ? AND modified_at < ?", []string{PaymentPending, PaymentAuthorized}, before).
+		Order("modified_at").
+		Limit(limit).
+		Find(&payments)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return payments, nil
+}
+
+func (r *paymentRepository) Update(ctx context.Context, payment *Payment) error {
+	return pkg.DB(ctx, r.db).Model(payment).Updates(map[string]interface{}{
+		"status":          payment.Status,
+		"refunded_amount": payment.RefundedAmount,
+	}).Error
+}
+
+func (r *paymentRepository) RecordEvent(ctx context.Context, event *PaymentEvent) (bool, error) {
+	result := pkg.DB(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *paymentRepository) AddRefund(ctx context.Context, refund *PaymentRefund) error {
+	return pkg.DB(ctx, r.db).Create(refund).Error
+}
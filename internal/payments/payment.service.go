@@ -0,0 +1,389 @@
+package payments
+
+import (
+	"bookstore-framework/internal/orders"
+	ordersDto "bookstore-framework/internal/orders/api/dto"
+	"bookstore-framework/internal/payments/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// ReconcileAfter is how long a payment may stay open before it is
+	// checked with the provider, in case a webhook was lost.
+	ReconcileAfter = 15 * time.Minute
+	reconcileBatch = 100
+)
+
+var (
+	ErrPaymentNotFound     = errors.New("payment not found")
+	ErrOrderNotPayable     = errors.New("order is not awaiting payment")
+	ErrPaymentInProgress   = errors.New("a payment for this order is already being started, try again")
+	ErrNotRefundable       = errors.New("only captured payments can be refunded")
+	ErrInvalidRefundAmount = errors.New("refund amount exceeds what is left of the payment")
+)
+
+type PaymentService interface {
+	// CreatePayment starts paying for a pending order, returning the open
+	// payment instead when there is one.
+	CreatePayment(ctx context.Context, actor orders.Actor, req dto.CreatePaymentRequest) (*dto.PaymentResponse, error)
+	GetPayment(ctx context.Context, actor orders.Actor, id uint) (*dto.PaymentResponse, error)
+	// HandleWebhook applies a provider event. Events already handled are
+	// acknowledged without being applied again.
+	HandleWebhook(ctx context.Context, payload []byte, header http.Header) error
+	Refund(ctx context.Context, actorID uint, id uint, req dto.RefundRequest) (*dto.PaymentResponse, error)
+	// Reconcile settles payments left open longer than ReconcileAfter with
+	// the state the provider reports.
+	Reconcile(ctx context.Context) error
+}
+
+type paymentService struct {
+	paymentRepo  PaymentRepository
+	provider     PaymentProvider
+	orderService orders.OrderService
+	transactor   pkg.Transactor
+}
+
+func NewPaymentService(paymentRepo PaymentRepository, provider PaymentProvider, orderService orders.OrderService, transactor pkg.Transactor) PaymentService {
+	return &paymentService{
+		paymentRepo:  paymentRepo,
+		provider:     provider,
+		orderService: orderService,
+		transactor:   transactor,
+	}
+}
+
+func (s *paymentService) CreatePayment(ctx context.Context, actor orders.Actor, req dto.CreatePaymentRequest) (*dto.PaymentResponse, error) {
+	order, err := s.orderService.GetOrder(ctx, actor, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != orders.StatusPending {
+		return nil, ErrOrderNotPayable
+	}
+
+	attempts, err := s.paymentRepo.FindByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, payment := range attempts {
+		if payment.Open() {
+			intent, err := s.provider.GetIntent(ctx, payment.IntentID)
+			if err != nil {
+				return nil, err
+			}
+			response := ToPaymentResponse(&payment)
+			response.ClientSecret = intent.ClientSecret
+			return response, nil
+		}
+	}
+
+	// The key is the same for concurrent requests, so the provider opens a
+	// single intent for them.
+	intent, err := s.provider.CreateIntent(ctx, IntentRequest{
+		Amount:         order.AmountDue,
+		Currency:       order.Currency,
+		Reference:      fmt.Sprintf("order:%d", order.ID),
+		IdempotencyKey: fmt.Sprintf("order-%d-attempt-%d", order.ID, len(attempts)+1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := s.paymentRepo.Create(ctx, &Payment{
+		OrderID:  order.ID,
+		Provider: s.provider.Name(),
+		IntentID: intent.ID,
+		Status:   PaymentPending,
+		Amount:   intent.Amount,
+		Currency: intent.Currency,
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	response := ToPaymentResponse(payment)
+	response.ClientSecret = intent.ClientSecret
+	return response, nil
+}
+
+func (s *paymentService) GetPayment(ctx context.Context, actor orders.Actor, id uint) (*dto.PaymentResponse, error) {
+	payment, err := s.paymentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if _, err := s.orderService.GetOrder(ctx, actor, payment.OrderID); err != nil {
+		if errors.Is(err, orders.ErrOrderNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	return ToPaymentResponse(payment), nil
+}
+
+func (s *paymentService) HandleWebhook(ctx context.Context, payload []byte, header http.Header) error {
+	event, err := s.provider.VerifyWebhook(payload, header)
+	if err != nil {
+		return err
+	}
+
+	var capture bool
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// The event is recorded in the transaction that applies it, so an
+		// event that failed is applied again when the provider retries it.
+		fresh, err := s.paymentRepo.RecordEvent(ctx, &PaymentEvent{
+			Provider: s.provider.Name(),
+			EventID:  event.ID,
+			Type:     event.Type,
+			IntentID: event.IntentID,
+		})
+		if err != nil || !fresh {
+			return err
+		}
+
+		payment, err := s.paymentRepo.FindByIntentIDForUpdate(ctx, s.provider.Name(), event.IntentID)
+		if err != nil {
+			return err
+		}
+		switch event.Type {
+		case EventPaymentAuthorized:
+			capture = payment.Status == PaymentPending
+			return s.setStatus(ctx, payment, PaymentAuthorized)
+		case EventPaymentSucceeded:
+			return s.capture(ctx, payment)
+		case EventPaymentFailed:
+			return s.setStatus(ctx, payment, PaymentFailed)
+		}
+		return nil
+	})
+	if err != nil {
+		return translateError(err)
+	}
+
+	if capture {
+		// A failed capture is retried by the reconciliation job, the event
+		// itself was handled.
+		if err := s.captureIntent(ctx, event.IntentID); err != nil {
+			log.Printf("Failed to capture payment intent %s: %v", event.IntentID, err)
+		}
+	}
+	return nil
+}
+
+func (s *paymentService) Refund(ctx context.Context, actorID uint, id uint, req dto.RefundRequest) (*dto.PaymentResponse, error) {
+	payment, err := s.paymentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if payment.Status != PaymentCaptured {
+		return nil, ErrNotRefundable
+	}
+	amount := req.Amount
+	if amount == 0 {
+		amount = payment.Amount - payment.RefundedAmount
+	}
+	if payment.RefundedAmount+amount > payment.Amount {
+		return nil, ErrInvalidRefundAmount
+	}
+
+	// Check the order before any money moves: a full refund has to be able
+	// to refund the order too.
+	full := payment.RefundedAmount+amount == payment.Amount
+	if full {
+		order, err := s.orderService.GetOrder(ctx, orders.Actor{Staff: true}, payment.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status != orders.StatusCancelled && !orders.CanTransition(order.Status, orders.StatusRefunded) {
+			return nil, fmt.Errorf("%w: %s to %s", orders.ErrInvalidTransition, order.Status, orders.StatusRefunded)
+		}
+	}
+
+	refund, err := s.provider.Refund(ctx, payment.IntentID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		payment, err := s.paymentRepo.FindByIntentIDForUpdate(ctx, payment.Provider, payment.IntentID)
+		if err != nil {
+			return err
+		}
+		err = s.paymentRepo.AddRefund(ctx, &PaymentRefund{
+			PaymentID:        payment.ID,
+			ProviderRefundID: refund.ID,
+			Amount:           refund.Amount,
+			ActorID:          &actorID,
+			Reason:           req.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		payment.RefundedAmount += refund.Amount
+		if payment.RefundedAmount >= payment.Amount {
+			payment.Status = PaymentRefunded
+		}
+		if err := s.paymentRepo.Update(ctx, payment); err != nil || payment.Status != PaymentRefunded {
+			return err
+		}
+		_, err = s.orderService.Transition(ctx, &actorID, payment.OrderID, ordersDto.TransitionRequest{
+			Status: orders.StatusRefunded,
+			Note:   req.Reason,
+		})
+		if errors.Is(err, orders.ErrInvalidTransition) {
+			// The order was cancelled before the payment was captured.
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	payment, err = s.paymentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPaymentResponse(payment), nil
+}
+
+func (s *paymentService) Reconcile(ctx context.Context) error {
+	payments, err := s.paymentRepo.FindStale(ctx, time.Now().Add(-ReconcileAfter), reconcileBatch)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, payment := range payments {
+		var err error
+		if payment.Status == PaymentAuthorized {
+			err = s.captureIntent(ctx, payment.IntentID)
+		} else {
+			err = s.syncIntent(ctx, payment.IntentID)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("payment %d: %w", payment.ID, err))
+		}
+	}
+	if len(payments) > 0 {
+		log.Printf("Reconciled %d open payments", len(payments)-len(errs))
+	}
+	return errors.Join(errs...)
+}
+
+// captureIntent captures an authorized intent and applies the result.
+func (s *paymentService) captureIntent(ctx context.Context, intentID string) error {
+	intent, err := s.provider.Capture(ctx, intentID)
+	if err != nil {
+		return err
+	}
+	return s.applyIntent(ctx, intent)
+}
+
+// syncIntent applies the state of an intent as the provider reports it,
+// capturing it when it was authorized in the meantime.
+func (s *paymentService) syncIntent(ctx context.Context, intentID string) error {
+	intent, err := s.provider.GetIntent(ctx, intentID)
+	if err != nil {
+		return err
+	}
+	if intent.Status == IntentAuthorized {
+		return s.captureIntent(ctx, intentID)
+	}
+	return s.applyIntent(ctx, intent)
+}
+
+func (s *paymentService) applyIntent(ctx context.Context, intent *Intent) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		payment, err := s.paymentRepo.FindByIntentIDForUpdate(ctx, s.provider.Name(), intent.ID)
+		if err != nil {
+			return err
+		}
+		switch intent.Status {
+		case IntentSucceeded:
+			return s.capture(ctx, payment)
+		case IntentFailed, IntentCanceled:
+			return s.setStatus(ctx, payment, PaymentFailed)
+		case IntentAuthorized:
+			return s.setStatus(ctx, payment, PaymentAuthorized)
+		}
+		return nil
+	})
+}
+
+// capture marks a locked payment captured and the order paid. A payment
+// captured after its order was cancelled is left for staff to refund.
+func (s *paymentService) capture(ctx context.Context, payment *Payment) error {
+	if payment.Status == PaymentCaptured || payment.Status == PaymentRefunded {
+		return nil
+	}
+	payment.Status = PaymentCaptured
+	if err := s.paymentRepo.Update(ctx, payment); err != nil {
+		return err
+	}
+
+	_, err := s.orderService.Transition(ctx, nil, payment.OrderID, ordersDto.TransitionRequest{
+		Status: orders.StatusPaid,
+		Note:   fmt.Sprintf("Payment %s captured", payment.IntentID),
+	})
+	if errors.Is(err, orders.ErrInvalidTransition) {
+		log.Printf("Payment %d captured for order %d, which is no longer pending: refund it", payment.ID, payment.OrderID)
+		return nil
+	}
+	return err
+}
+
+// setStatus moves an open payment to status, leaving settled payments as
+// they are since provider events may arrive out of order.
+func (s *paymentService) setStatus(ctx context.Context, payment *Payment, status string) error {
+	if !payment.Open() || payment.Status == status {
+		return nil
+	}
+	payment.Status = status
+	return s.paymentRepo.Update(ctx, payment)
+}
+
+func ToPaymentResponse(payment *Payment) *dto.PaymentResponse {
+	response := &dto.PaymentResponse{
+		ID:             payment.ID,
+		OrderID:        payment.OrderID,
+		Provider:       payment.Provider,
+		IntentID:       payment.IntentID,
+		Status:         payment.Status,
+		Amount:         payment.Amount,
+		Currency:       payment.Currency,
+		RefundedAmount: payment.RefundedAmount,
+		CreatedAt:      payment.CreatedAt,
+		ModifiedAt:     payment.ModifiedAt,
+	}
+	for _, refund := range payment.Refunds {
+		response.Refunds = append(response.Refunds, dto.RefundResponse{
+			ID:               refund.ID,
+			ProviderRefundID: refund.ProviderRefundID,
+			Amount:           refund.Amount,
+			ActorID:          refund.ActorID,
+			Reason:           refund.Reason,
+			CreatedAt:        refund.CreatedAt,
+		})
+	}
+	return response
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrPaymentNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrPaymentInProgress
+	default:
+		return err
+	}
+}
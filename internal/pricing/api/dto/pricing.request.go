@@ -0,0 +1,22 @@
+package dto
+
+// PriceListRequest represents prices to set in the price list of a currency
+// @Description Prices in minor units of the price list currency. Books already in the price list get the new price.
+type PriceListRequest struct {
+	Prices []BookPriceRequest `json:"prices" binding:"required,min=1,max=1000,dive"`
+}
+
+type BookPriceRequest struct {
+	BookID uint  `json:"book_id" binding:"required" example:"1"`
+	Amount int64 `json:"amount" binding:"min=0" example:"1199"`
+}
+
+// ExchangeRateRequest represents an exchange rate to set
+// @Description One unit of base is worth rate units of quote. The rate is a decimal number with up to 10 decimal
+// @Description places, written as a string so it is never rounded on the way. The reverse rate is used for
+// @Description conversions the other way when it is not set itself.
+type ExchangeRateRequest struct {
+	Base  string `json:"base" binding:"required,iso4217" example:"USD"`
+	Quote string `json:"quote" binding:"required,iso4217,nefield=Base" example:"EUR"`
+	Rate  string `json:"rate" binding:"required,max=21" example:"0.9215"`
+}
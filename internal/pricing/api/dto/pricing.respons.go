@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+// CurrencyResponse describes a currency prices can be shown in. Exponent is
+// the number of decimals of its amounts, and converted prices are rounded to
+// multiples of increment minor units.
+type CurrencyResponse struct {
+	Code      string `json:"code"`
+	Exponent  int    `json:"exponent"`
+	Increment int64  `json:"increment"`
+}
+
+type BookPriceResponse struct {
+	BookID     uint      `json:"book_id"`
+	Amount     int64     `json:"amount"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+type PriceListResponse struct {
+	Currency   string              `json:"currency"`
+	Prices     []BookPriceResponse `json:"prices"`
+	Pagination pkg.PaginationMeta  `json:"pagination"`
+}
+
+type ExchangeRateResponse struct {
+	Base       string    `json:"base"`
+	Quote      string    `json:"quote"`
+	Rate       string    `json:"rate"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/pricing/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PricingHandler struct {
+	pricingService pricing.PricingService
+}
+
+func NewPricingHandler(pricingService pricing.PricingService) *PricingHandler {
+	return &PricingHandler{
+		pricingService: pricingService,
+	}
+}
+
+// GetCurrencies godoc
+// @Summary      List currencies
+// @Description  List the currencies the catalog and carts can be shown in, with their decimals and the increment converted prices are rounded to
+// @Tags         pricing
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=[]dto.CurrencyResponse} "Currencies retrieve successfully"
+// @Router       /pricing/currencies [get]
+func (h *PricingHandler) GetCurrencies(ctx *gin.Context) {
+	pkg.OkResponse(ctx, "Currencies retrieve successfully", h.pricingService.GetCurrencies(ctx.Request.Context()))
+}
+
+// GetPriceList godoc
+// @Summary      Get a price list
+// @Description  List the prices set for books in a currency, by book (staff only)
+// @Tags         pricing
+// @Security     BearerAuth
+// @Produce      json
+// @Param        currency path     string true  "ISO 4217 currency code"
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        limit    query    int    false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.PriceListResponse} "Price list retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Currency is not supported"
+// @Router       /pricing/price-lists/{currency} [get]
+func (h *PricingHandler) GetPriceList(ctx *gin.Context) {
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.pricingService.GetPriceList(ctx.Request.Context(), ctx.Param("currency"), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Price list retrieve successfully", response)
+}
+
+// SavePriceList godoc
+// @Summary      Set prices in a price list
+// @Description  Set the prices of books in a currency (staff only). Listed prices take precedence over the catalog price and over prices converted with exchange rates
+// @Tags         pricing
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        currency path     string true "ISO 4217 currency code"
+// @Param        request  body     dto.PriceListRequest true "Prices"
+// @Success      200  {object}    pkg.Response "Price list updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /pricing/price-lists/{currency} [put]
+func (h *PricingHandler) SavePriceList(ctx *gin.Context) {
+	var req dto.PriceListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	if err := h.pricingService.SavePriceList(ctx.Request.Context(), ctx.Param("currency"), req); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Price list updated successfully", nil)
+}
+
+// DeletePrice godoc
+// @Summary      Remove a price from a price list
+// @Description  Remove the price of a book from a price list, so it is shown at its catalog or converted price again (staff only)
+// @Tags         pricing
+// @Security     BearerAuth
+// @Produce      json
+// @Param        currency path     string true "ISO 4217 currency code"
+// @Param        bookId   path     int    true "Book ID"
+// @Success      200  {object}    pkg.Response "Price removed successfully"
+// @Failure      404  {object}    pkg.Response "Book has no price in this price list"
+// @Router       /pricing/price-lists/{currency}/books/{bookId} [delete]
+func (h *PricingHandler) DeletePrice(ctx *gin.Context) {
+	bookID, err := pkg.ParamID(ctx, "bookId")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	if err := h.pricingService.DeletePrice(ctx.Request.Context(), ctx.Param("currency"), bookID); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Price removed successfully", nil)
+}
+
+// GetExchangeRates godoc
+// @Summary      List exchange rates
+// @Description  List the exchange rates used to convert prices (staff only)
+// @Tags         pricing
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=[]dto.ExchangeRateResponse} "Exchange rates retrieve successfully"
+// @Router       /pricing/exchange-rates [get]
+func (h *PricingHandler) GetExchangeRates(ctx *gin.Context) {
+	response, err := h.pricingService.GetExchangeRates(ctx.Request.Context())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Exchange rates retrieve successfully", response)
+}
+
+// SaveExchangeRate godoc
+// @Summary      Set an exchange rate
+// @Description  Create or replace the exchange rate between two currencies (staff only). Carts priced at the old rate are repriced and flagged the next time they are read
+// @Tags         pricing
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.ExchangeRateRequest true "Exchange rate"
+// @Success      200  {object}    pkg.Response{data=dto.ExchangeRateResponse} "Exchange rate saved successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /pricing/exchange-rates [put]
+func (h *PricingHandler) SaveExchangeRate(ctx *gin.Context) {
+	var req dto.ExchangeRateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.pricingService.SaveExchangeRate(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Exchange rate saved successfully", response)
+}
+
+// DeleteExchangeRate godoc
+// @Summary      Delete an exchange rate
+// @Description  Remove an exchange rate (staff only). Books without a price in a currency are no longer available in it
+// @Tags         pricing
+// @Security     BearerAuth
+// @Produce      json
+// @Param        base  path       string true "Base currency"
+// @Param        quote path       string true "Quote currency"
+// @Success      200  {object}    pkg.Response "Exchange rate deleted successfully"
+// @Failure      404  {object}    pkg.Response "Exchange rate not found"
+// @Router       /pricing/exchange-rates/{base}/{quote} [delete]
+func (h *PricingHandler) DeleteExchangeRate(ctx *gin.Context) {
+	if err := h.pricingService.DeleteExchangeRate(ctx.Request.Context(), ctx.Param("base"), ctx.Param("quote")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Exchange rate deleted successfully", nil)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, pricing.ErrPriceNotFound),
+		errors.Is(err, pricing.ErrExchangeRateNotFound),
+		errors.Is(err, pricing.ErrBookNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, pricing.ErrUnsupportedCurrency),
+		errors.Is(err, pricing.ErrInvalidExchangeRate),
+		errors.Is(err, pricing.ErrSameCurrency):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
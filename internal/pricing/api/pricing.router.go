@@ -0,0 +1,26 @@
+package api
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func PricingRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	pricingService := pricing.NewPricingService(pricing.NewPricingRepository(db))
+	pricingHandler := NewPricingHandler(pricingService)
+
+	router.GET("/currencies", pricingHandler.GetCurrencies)
+
+	staff := router.Group("")
+	staff.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	staff.GET("/price-lists/:currency", pricingHandler.GetPriceList)
+	staff.PUT("/price-lists/:currency", pricingHandler.SavePriceList)
+	staff.DELETE("/price-lists/:currency/books/:bookId", pricingHandler.DeletePrice)
+	staff.GET("/exchange-rates", pricingHandler.GetExchangeRates)
+	staff.PUT("/exchange-rates", pricingHandler.SaveExchangeRate)
+	staff.DELETE("/exchange-rates/:base/:quote", pricingHandler.DeleteExchangeRate)
+}
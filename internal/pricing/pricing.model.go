@@ -0,0 +1,30 @@
+package pricing
+
+import "time"
+
+// BookPrice is the price of a book in the price list of a currency. It takes
+// precedence over the catalog price and over converted prices.
+type BookPrice struct {
+	BookID     uint      `gorm:"column:book_id;primaryKey"`
+	Currency   string    `gorm:"column:currency;size:3;primaryKey"`
+	Amount     int64     `gorm:"column:amount;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (BookPrice) TableName() string {
+	return "book_prices"
+}
+
+// ExchangeRate says one unit of Base is worth Rate units of Quote. Rates are
+// kept as decimal strings so they never pass through floats.
+type ExchangeRate struct {
+	Base       string    `gorm:"column:base;size:3;primaryKey"`
+	Quote      string    `gorm:"column:quote;size:3;primaryKey"`
+	Rate       string    `gorm:"column:rate;type:numeric(20,10);not null"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
@@ -0,0 +1,148 @@
+package pricing
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PriceListFilter struct {
+	Currency string
+	Offset   int
+	Limit    int
+}
+
+type PricingRepository interface {
+	FindPrices(ctx context.Context, currency string, bookIDs []uint) ([]BookPrice, error)
+	FindPriceList(ctx context.Context, filter PriceListFilter) ([]BookPrice, int64, error)
+	// SavePrices creates or replaces prices of a price list.
+	SavePrices(ctx context.Context, prices []BookPrice) error
+	DeletePrice(ctx context.Context, currency string, bookID uint) error
+	// MissingBooks returns the IDs that are not books of the catalog.
+	MissingBooks(ctx context.Context, bookIDs []uint) ([]uint, error)
+	// FindRates returns the rates between the currency and any of the others,
+	// in either direction.
+	FindRates(ctx context.Context, currency string, others []string) ([]ExchangeRate, error)
+	FindAllRates(ctx context.Context) ([]ExchangeRate, error)
+	SaveRate(ctx context.Context, rate *ExchangeRate) error
+	DeleteRate(ctx context.Context, base, quote string) error
+}
+
+type pricingRepository struct {
+	db *gorm.DB
+}
+
+func NewPricingRepository(db *gorm.DB) PricingRepository {
+	return &pricingRepository{
+		db: db,
+	}
+}
+
+func (r *pricingRepository) FindPrices(ctx context.Context, currency string, bookIDs []uint) ([]BookPrice, error) {
+	var prices []BookPrice
+	result := pkg.DB(ctx, r.db).Where("currency = ? AND book_id IN ?", currency, bookIDs).Find(&prices)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return prices, nil
+}
+
+func (r *pricingRepository) FindPriceList(ctx context.Context, filter PriceListFilter) ([]BookPrice, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&BookPrice{}).Where("currency = ?", filter.Currency)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var prices []BookPrice
+	result := query.Order("book_id").Offset(filter.Offset).Limit(filter.Limit).Find(&prices)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return prices, total, nil
+}
+
+func (r *pricingRepository) SavePrices(ctx context.Context, prices []BookPrice) error {
+	return pkg.DB(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "book_id"}, {Name: "currency"}},
+			DoUpdates: clause.AssignmentColumns([]string{"amount", "modified_at"}),
+		}).
+		Create(&prices).Error
+}
+
+func (r *pricingRepository) DeletePrice(ctx context.Context, currency string, bookID uint) error {
+	result := pkg.DB(ctx, r.db).Where("currency = ? AND book_id = ?", currency, bookID).Delete(&BookPrice{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *pricingRepository) MissingBooks(ctx context.Context, bookIDs []uint) ([]uint, error) {
+	var found []uint
+	err := pkg.DB(ctx, r.db).Table("books").
+		Where("id IN ? AND deleted_at IS NULL", bookIDs).
+		Pluck("id", &found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	exists := make(map[uint]bool, len(found))
+	for _, id := range found {
+		exists[id] = true
+	}
+	var missing []uint
+	for _, id := range bookIDs {
+		if !exists[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+func (r *pricingRepository) FindRates(ctx context.Context, currency string, others []string) ([]ExchangeRate, error) {
+	var rates []ExchangeRate
+	result := pkg.DB(ctx, r.db).
+		Where("(base = ? AND quote IN ?) OR (quote = ? AND base IN ?)", currency, others, currency, others).
+		Find(&rates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rates, nil
+}
+
+func (r *pricingRepository) FindAllRates(ctx context.Context) ([]ExchangeRate, error) {
+	var rates []ExchangeRate
+	result := pkg.DB(ctx, r.db).Order("base, quote").Find(&rates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rates, nil
+}
+
+func (r *pricingRepository) SaveRate(ctx context.Context, rate *ExchangeRate) error {
+	return pkg.DB(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "base"}, {Name: "quote"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "modified_at"}),
+		}).
+		Create(rate).Error
+}
+
+func (r *pricingRepository) DeleteRate(ctx context.Context, base, quote string) error {
+	result := pkg.DB(ctx, r.db).Where("base = ? AND quote = ?", base, quote).Delete(&ExchangeRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package pricing
+
+import (
+	"bookstore-framework/internal/pricing/api/dto"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUnsupportedCurrency  = errors.New("currency is not supported")
+	ErrPriceNotFound        = errors.New("book has no price in this price list")
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+	ErrInvalidExchangeRate  = errors.New("exchange rate must be a positive decimal number with up to 10 decimal places")
+	ErrSameCurrency         = errors.New("exchange rate must be between two different currencies")
+	ErrBookNotFound         = errors.New("book not found")
+)
+
+// Item is a book to price, with its catalog price.
+type Item struct {
+	BookID uint
+	Price  money.Money
+}
+
+// Price is what a book costs in a currency. Converted prices come from the
+// exchange rate table, the others from a price list or the catalog.
+type Price struct {
+	money.Money
+	Converted bool
+}
+
+type PricingService interface {
+	GetCurrencies(ctx context.Context) []dto.CurrencyResponse
+	GetPriceList(ctx context.Context, currency string, query pkg.PaginationQuery) (*dto.PriceListResponse, error)
+	SavePriceList(ctx context.Context, currency string, req dto.PriceListRequest) error
+	DeletePrice(ctx context.Context, currency string, bookID uint) error
+	GetExchangeRates(ctx context.Context) ([]dto.ExchangeRateResponse, error)
+	SaveExchangeRate(ctx context.Context, req dto.ExchangeRateRequest) (*dto.ExchangeRateResponse, error)
+	DeleteExchangeRate(ctx context.Context, base, quote string) error
+	// Quote prices books in a currency: from its price list, else at the
+	// catalog price when the book is priced in that currency, else by
+	// converting the catalog price. Books that cannot be priced, for want of
+	// an exchange rate, are left out of the result.
+	Quote(ctx context.Context, currency string, items []Item) (map[uint]Price, error)
+	// Convert turns an amount into a currency at the exchange rate between
+	// them, rounded to the rules of that currency.
+	Convert(ctx context.Context, amount money.Money, currency string) (money.Money, error)
+}
+
+type pricingService struct {
+	pricingRepo PricingRepository
+}
+
+func NewPricingService(pricingRepo PricingRepository) PricingService {
+	return &pricingService{
+		pricingRepo: pricingRepo,
+	}
+}
+
+// Supported normalizes a currency code and checks that prices can be shown
+// in it.
+func Supported(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if _, ok := money.Lookup(currency); !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedCurrency, currency)
+	}
+	return currency, nil
+}
+
+func (s *pricingService) GetCurrencies(ctx context.Context) []dto.CurrencyResponse {
+	currencies := money.Currencies()
+	response := make([]dto.CurrencyResponse, 0, len(currencies))
+	for _, currency := range currencies {
+		response = append(response, dto.CurrencyResponse{
+			Code:      currency.Code,
+			Exponent:  currency.Exponent,
+			Increment: currency.Increment,
+		})
+	}
+	return response
+}
+
+func (s *pricingService) GetPriceList(ctx context.Context, currency string, query pkg.PaginationQuery) (*dto.PriceListResponse, error) {
+	currency, err := Supported(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, total, err := s.pricingRepo.FindPriceList(ctx, PriceListFilter{
+		Currency: currency,
+		Offset:   query.Offset(),
+		Limit:    query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PriceListResponse{
+		Currency:   currency,
+		Prices:     make([]dto.BookPriceResponse, 0, len(prices)),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}
+	for _, price := range prices {
+		response.Prices = append(response.Prices, dto.BookPriceResponse{
+			BookID:     price.BookID,
+			Amount:     price.Amount,
+			ModifiedAt: price.ModifiedAt,
+		})
+	}
+	return response, nil
+}
+
+func (s *pricingService) SavePriceList(ctx context.Context, currency string, req dto.PriceListRequest) error {
+	currency, err := Supported(currency)
+	if err != nil {
+		return err
+	}
+
+	prices := make([]BookPrice, 0, len(req.Prices))
+	bookIDs := make([]uint, 0, len(req.Prices))
+	seen := make(map[uint]bool, len(req.Prices))
+	for _, price := range req.Prices {
+		if seen[price.BookID] {
+			continue
+		}
+		seen[price.BookID] = true
+		bookIDs = append(bookIDs, price.BookID)
+		prices = append(prices, BookPrice{BookID: price.BookID, Currency: currency, Amount: price.Amount})
+	}
+
+	missing, err := s.pricingRepo.MissingBooks(ctx, bookIDs)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %v", ErrBookNotFound, missing)
+	}
+	return s.pricingRepo.SavePrices(ctx, prices)
+}
+
+func (s *pricingService) DeletePrice(ctx context.Context, currency string, bookID uint) error {
+	err := s.pricingRepo.DeletePrice(ctx, strings.ToUpper(currency), bookID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrPriceNotFound
+	}
+	return err
+}
+
+func (s *pricingService) GetExchangeRates(ctx context.Context) ([]dto.ExchangeRateResponse, error) {
+	rates, err := s.pricingRepo.FindAllRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.ExchangeRateResponse, 0, len(rates))
+	for i := range rates {
+		response = append(response, *ToExchangeRateResponse(&rates[i]))
+	}
+	return response, nil
+}
+
+func (s *pricingService) SaveExchangeRate(ctx context.Context, req dto.ExchangeRateRequest) (*dto.ExchangeRateResponse, error) {
+	base, err := Supported(req.Base)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := Supported(req.Quote)
+	if err != nil {
+		return nil, err
+	}
+	if base == quote {
+		return nil, ErrSameCurrency
+	}
+	if _, err := money.ParseRate(req.Rate); err != nil {
+		return nil, ErrInvalidExchangeRate
+	}
+
+	rate := &ExchangeRate{Base: base, Quote: quote, Rate: strings.TrimSpace(req.Rate)}
+	if err := s.pricingRepo.SaveRate(ctx, rate); err != nil {
+		return nil, err
+	}
+	return ToExchangeRateResponse(rate), nil
+}
+
+func (s *pricingService) DeleteExchangeRate(ctx context.Context, base, quote string) error {
+	err := s.pricingRepo.DeleteRate(ctx, strings.ToUpper(base), strings.ToUpper(quote))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrExchangeRateNotFound
+	}
+	return err
+}
+
+func (s *pricingService) Quote(ctx context.Context, currency string, items []Item) (map[uint]Price, error) {
+	currency, err := Supported(currency)
+	if err != nil {
+		return nil, err
+	}
+	quotes := make(map[uint]Price, len(items))
+	if len(items) == 0 {
+		return quotes, nil
+	}
+
+	bookIDs := make([]uint, 0, len(items))
+	for _, item := range items {
+		bookIDs = append(bookIDs, item.BookID)
+	}
+	listed, err := s.pricingRepo.FindPrices(ctx, currency, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, price := range listed {
+		quotes[price.BookID] = Price{Money: money.New(price.Amount, currency)}
+	}
+
+	var sources []string
+	for _, item := range items {
+		if _, ok := quotes[item.BookID]; ok {
+			continue
+		}
+		if item.Price.Currency == currency {
+			quotes[item.BookID] = Price{Money: item.Price}
+		} else if !contains(sources, item.Price.Currency) {
+			sources = append(sources, item.Price.Currency)
+		}
+	}
+	if len(sources) == 0 {
+		return quotes, nil
+	}
+
+	rates, err := s.ratesTo(ctx, currency, sources)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		rate, ok := rates[item.Price.Currency]
+		if _, quoted := quotes[item.BookID]; quoted || !ok {
+			continue
+		}
+		converted, err := item.Price.Convert(currency, rate)
+		if err != nil {
+			// A catalog currency the store does not support cannot be converted.
+			continue
+		}
+		quotes[item.BookID] = Price{Money: converted, Converted: true}
+	}
+	return quotes, nil
+}
+
+func (s *pricingService) Convert(ctx context.Context, amount money.Money, currency string) (money.Money, error) {
+	if amount.Currency == currency {
+		return amount, nil
+	}
+	rates, err := s.ratesTo(ctx, currency, []string{amount.Currency})
+	if err != nil {
+		return money.Money{}, err
+	}
+	rate, ok := rates[amount.Currency]
+	if !ok {
+		return money.Money{}, fmt.Errorf("%w: %s/%s", ErrExchangeRateNotFound, amount.Currency, currency)
+	}
+	return amount.Convert(currency, rate)
+}
+
+// ratesTo returns the rate from each source currency to the currency, using
+// the reverse rate when only that one is set.
+func (s *pricingService) ratesTo(ctx context.Context, currency string, sources []string) (map[string]*big.Rat, error) {
+	stored, err := s.pricingRepo.FindRates(ctx, currency, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]*big.Rat, len(sources))
+	for _, rate := range stored {
+		value, err := money.ParseRate(trimRate(rate.Rate))
+		if err != nil {
+			return nil, fmt.Errorf("exchange rate %s/%s: %w", rate.Base, rate.Quote, err)
+		}
+		if rate.Quote == currency {
+			rates[rate.Base] = value
+		} else if _, direct := rates[rate.Quote]; !direct {
+			rates[rate.Quote] = new(big.Rat).Inv(value)
+		}
+	}
+	return rates, nil
+}
+
+func ToExchangeRateResponse(rate *ExchangeRate) *dto.ExchangeRateResponse {
+	return &dto.ExchangeRateResponse{
+		Base:       rate.Base,
+		Quote:      rate.Quote,
+		Rate:       trimRate(rate.Rate),
+		ModifiedAt: rate.ModifiedAt,
+	}
+}
+
+// trimRate drops the trailing zeros Postgres pads numeric values with.
+func trimRate(rate string) string {
+	if strings.Contains(rate, ".") {
+		rate = strings.TrimRight(strings.TrimRight(rate, "0"), ".")
+	}
+	return rate
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
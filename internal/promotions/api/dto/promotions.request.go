@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+// PromotionRequest represents a create or update promotion request
+// @Description Promotion payload. value is a percentage (1-100) for percentage promotions and an amount in minor
+// @Description units of currency for fixed_amount promotions. buy_x_get_y gives get_quantity copies free for every
+// @Description buy_quantity copies bought, the cheapest first. Leave code empty for a promotion that applies on its own.
+type PromotionRequest struct {
+	Name         string     `json:"name" binding:"required,max=100" example:"Summer fantasy sale"`
+	Code         string     `json:"code" binding:"max=40" example:"SUMMER10"`
+	Type         string     `json:"type" binding:"required,oneof=percentage fixed_amount buy_x_get_y free_shipping" example:"percentage"`
+	Value        int64      `json:"value" binding:"min=0" example:"10"`
+	BuyQuantity  int        `json:"buy_quantity" binding:"min=0" example:"0"`
+	GetQuantity  int        `json:"get_quantity" binding:"min=0" example:"0"`
+	Scope        string     `json:"scope" binding:"omitempty,oneof=all category author" example:"category"`
+	ScopeID      *uint      `json:"scope_id" example:"3"`
+	Currency     string     `json:"currency" binding:"omitempty,iso4217" example:"USD"`
+	MinSubtotal  int64      `json:"min_subtotal" binding:"min=0" example:"2000"`
+	StartsAt     *time.Time `json:"starts_at" example:"2025-06-01T00:00:00Z"`
+	EndsAt       *time.Time `json:"ends_at" example:"2025-09-01T00:00:00Z"`
+	UsageLimit   *int       `json:"usage_limit" binding:"omitempty,min=1" example:"1000"`
+	PerUserLimit *int       `json:"per_user_limit" binding:"omitempty,min=1" example:"1"`
+	Stackable    bool       `json:"stackable" example:"false"`
+	Priority     int        `json:"priority" example:"10"`
+	Active       *bool      `json:"active" example:"true"`
+}
+
+// PromotionListQuery represents the query string of the promotion list endpoint
+type PromotionListQuery struct {
+	pkg.PaginationQuery
+	Active *bool `form:"active"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type PromotionResponse struct {
+	ID           uint       `json:"id"`
+	Name         string     `json:"name"`
+	Code         string     `json:"code,omitempty"`
+	Type         string     `json:"type"`
+	Description  string     `json:"description"`
+	Value        int64      `json:"value"`
+	BuyQuantity  int        `json:"buy_quantity,omitempty"`
+	GetQuantity  int        `json:"get_quantity,omitempty"`
+	Scope        string     `json:"scope"`
+	ScopeID      *uint      `json:"scope_id,omitempty"`
+	Currency     string     `json:"currency,omitempty"`
+	MinSubtotal  int64      `json:"min_subtotal"`
+	StartsAt     *time.Time `json:"starts_at,omitempty"`
+	EndsAt       *time.Time `json:"ends_at,omitempty"`
+	UsageLimit   *int       `json:"usage_limit,omitempty"`
+	PerUserLimit *int       `json:"per_user_limit,omitempty"`
+	UsageCount   int        `json:"usage_count"`
+	Stackable    bool       `json:"stackable"`
+	Priority     int        `json:"priority"`
+	Active       bool       `json:"active"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ModifiedAt   time.Time  `json:"modified_at"`
+}
+
+type PromotionListResponse struct {
+	Promotions []PromotionResponse `json:"promotions"`
+	Pagination pkg.PaginationMeta  `json:"pagination"`
+}
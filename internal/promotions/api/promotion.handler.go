@@ -0,0 +1,154 @@
+package api
+
+import (
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/promotions/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PromotionHandler struct {
+	promotionService promotions.PromotionService
+}
+
+func NewPromotionHandler(promotionService promotions.PromotionService) *PromotionHandler {
+	return &PromotionHandler{
+		promotionService: promotionService,
+	}
+}
+
+// CreatePromotion godoc
+// @Summary      Create a promotion
+// @Description  Add a discount rule (staff only). Promotions apply in priority order, highest first; a promotion that is not stackable only applies when no other promotion did, and stops the ones after it
+// @Tags         promotions
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.PromotionRequest true "Promotion rule"
+// @Success      201  {object}    pkg.Response{data=dto.PromotionResponse} "Promotion created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Access forbidden"
+// @Failure      409  {object}    pkg.Response "Another promotion already uses this code"
+// @Router       /promotions [post]
+func (h *PromotionHandler) CreatePromotion(ctx *gin.Context) {
+	var req dto.PromotionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.promotionService.CreatePromotion(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Promotion created successfully", response)
+}
+
+// GetPromotions godoc
+// @Summary      List promotions
+// @Description  List promotions, newest first (staff only)
+// @Tags         promotions
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int  false "Page number" default(1)
+// @Param        limit  query     int  false "Page size" default(20)
+// @Param        active query     bool false "Filter by active flag"
+// @Success      200  {object}    pkg.Response{data=dto.PromotionListResponse} "Promotions retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /promotions [get]
+func (h *PromotionHandler) GetPromotions(ctx *gin.Context) {
+	var query dto.PromotionListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.promotionService.GetPromotions(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Promotions retrieve successfully", response)
+}
+
+// GetPromotion godoc
+// @Summary      Get a promotion
+// @Description  Get a single promotion with its usage count (staff only)
+// @Tags         promotions
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Promotion ID"
+// @Success      200  {object}    pkg.Response{data=dto.PromotionResponse} "Promotion retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Promotion not found"
+// @Router       /promotions/{id} [get]
+func (h *PromotionHandler) GetPromotion(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid promotion id", err.Error())
+		return
+	}
+
+	response, err := h.promotionService.GetPromotion(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Promotion retrieve successfully", response)
+}
+
+// UpdatePromotion godoc
+// @Summary      Update a promotion
+// @Description  Replace the rule of a promotion (staff only). Set active to false to end it early
+// @Tags         promotions
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Promotion ID"
+// @Param        request body     dto.PromotionRequest true "Promotion rule"
+// @Success      200  {object}    pkg.Response{data=dto.PromotionResponse} "Promotion updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Promotion not found"
+// @Failure      409  {object}    pkg.Response "Another promotion already uses this code"
+// @Router       /promotions/{id} [put]
+func (h *PromotionHandler) UpdatePromotion(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid promotion id", err.Error())
+		return
+	}
+
+	var req dto.PromotionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.promotionService.UpdatePromotion(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Promotion updated successfully", response)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, promotions.ErrPromotionNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, promotions.ErrInvalidPromotion),
+		errors.Is(err, promotions.ErrScopeNotFound):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, promotions.ErrDuplicateCode):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
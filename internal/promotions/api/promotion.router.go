@@ -0,0 +1,26 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func PromotionsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	promotionService := promotions.NewPromotionService(
+		promotions.NewPromotionRepository(db),
+		books.NewCategoryRepository(db),
+		books.NewAuthorRepository(db),
+	)
+	promotionHandler := NewPromotionHandler(promotionService)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.POST("", promotionHandler.CreatePromotion)
+	router.GET("", promotionHandler.GetPromotions)
+	router.GET("/:id", promotionHandler.GetPromotion)
+	router.PUT("/:id", promotionHandler.UpdatePromotion)
+}
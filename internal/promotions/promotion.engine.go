@@ -0,0 +1,263 @@
+package promotions
+
+import (
+	"bookstore-framework/pkg/money"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Basket is a cart or an order as the engine sees it.
+type Basket struct {
+	Currency string
+	// UserID is nil for guests, who cannot use promotions limited per user.
+	UserID *uint
+	// Code is the coupon code entered by the customer, if any.
+	Code  string
+	Lines []Line
+}
+
+type Line struct {
+	BookID    uint
+	Quantity  int
+	UnitPrice int64
+	// CategoryIDs holds the categories of the book and all their ancestors.
+	CategoryIDs []uint
+	AuthorIDs   []uint
+}
+
+// Adjustment is the part of a discount one promotion gave.
+type Adjustment struct {
+	PromotionID uint
+	Name        string
+	Description string
+	Amount      int64
+}
+
+type LineResult struct {
+	BookID      uint
+	Discount    int64
+	Adjustments []Adjustment
+}
+
+// CouponResult tells whether the coupon code of the basket applied and, when
+// it did not, why.
+type CouponResult struct {
+	Code    string
+	Applied bool
+	Reason  string
+}
+
+// Evaluation is the outcome of the promotions for a basket. Lines follow the
+// order of the basket lines, Applied holds the total of each promotion.
+type Evaluation struct {
+	Lines        []LineResult
+	Discount     int64
+	FreeShipping bool
+	Applied      []Adjustment
+	Coupon       *CouponResult
+}
+
+// Evaluate applies promotions to a basket in priority order, highest first.
+// Each promotion discounts what earlier ones left of a line, so lines never go
+// below zero. usage holds how many times the basket's user already redeemed
+// each promotion.
+func Evaluate(promotions []Promotion, basket Basket, usage map[uint]int64, now time.Time) *Evaluation {
+	evaluation := &Evaluation{Lines: make([]LineResult, len(basket.Lines))}
+	remaining := make([]int64, len(basket.Lines))
+	var subtotal int64
+	for i, line := range basket.Lines {
+		evaluation.Lines[i].BookID = line.BookID
+		remaining[i] = line.UnitPrice * int64(line.Quantity)
+		subtotal += remaining[i]
+	}
+	if basket.Code != "" {
+		evaluation.Coupon = &CouponResult{Code: basket.Code, Reason: "coupon code not found"}
+	}
+
+	ordered := make([]Promotion, len(promotions))
+	copy(ordered, promotions)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority > ordered[j].Priority
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	exclusive := false
+	for i := range ordered {
+		promotion := &ordered[i]
+		coupon := promotion.Code != nil && *promotion.Code == basket.Code
+		if promotion.Code != nil && !coupon {
+			continue
+		}
+
+		reason := promotion.unavailable(basket, usage, subtotal, now)
+		if reason == "" && (exclusive || (!promotion.Stackable && len(evaluation.Applied) > 0)) {
+			reason = "cannot be combined with the promotions already applied"
+		}
+		var discounts []int64
+		if reason == "" && promotion.Type != TypeFreeShipping {
+			discounts = promotion.discounts(basket.Lines, remaining)
+			if sum(discounts) == 0 {
+				reason = "no eligible books in the cart"
+			}
+		}
+		if coupon {
+			evaluation.Coupon.Applied = reason == ""
+			evaluation.Coupon.Reason = reason
+		}
+		if reason != "" {
+			continue
+		}
+
+		applied := Adjustment{PromotionID: promotion.ID, Name: promotion.Name, Description: promotion.Describe()}
+		if promotion.Type == TypeFreeShipping {
+			evaluation.FreeShipping = true
+		}
+		for i, discount := range discounts {
+			if discount == 0 {
+				continue
+			}
+			adjustment := applied
+			adjustment.Amount = discount
+			evaluation.Lines[i].Adjustments = append(evaluation.Lines[i].Adjustments, adjustment)
+			evaluation.Lines[i].Discount += discount
+			remaining[i] -= discount
+			applied.Amount += discount
+		}
+		evaluation.Discount += applied.Amount
+		evaluation.Applied = append(evaluation.Applied, applied)
+		if !promotion.Stackable {
+			exclusive = true
+		}
+	}
+	return evaluation
+}
+
+// Describe explains the rule of a promotion to customers.
+func (p *Promotion) Describe() string {
+	switch p.Type {
+	case TypePercentage:
+		return fmt.Sprintf("%d%% off", p.Value)
+	case TypeFixedAmount:
+		return money.Format(p.Value, p.Currency) + " off"
+	case TypeBuyXGetY:
+		return fmt.Sprintf("buy %d, get %d free", p.BuyQuantity, p.GetQuantity)
+	case TypeFreeShipping:
+		return "free shipping"
+	default:
+		return p.Type
+	}
+}
+
+// unavailable returns why the promotion cannot apply to the basket, or an
+// empty string when it can.
+func (p *Promotion) unavailable(basket Basket, usage map[uint]int64, subtotal int64, now time.Time) string {
+	switch {
+	case !p.Active:
+		return "promotion is not active"
+	case p.StartsAt != nil && now.Before(*p.StartsAt):
+		return "promotion has not started yet"
+	case p.EndsAt != nil && !now.Before(*p.EndsAt):
+		return "promotion has ended"
+	case p.UsageLimit != nil && p.UsageCount >= *p.UsageLimit:
+		return "promotion has been fully redeemed"
+	case p.PerUserLimit != nil && basket.UserID == nil:
+		return "log in to use this promotion"
+	case p.PerUserLimit != nil && usage[p.ID] >= int64(*p.PerUserLimit):
+		return "you have already used this promotion as many times as allowed"
+	case p.Currency != "" && p.Currency != basket.Currency:
+		return fmt.Sprintf("promotion only applies to carts in %s", p.Currency)
+	case subtotal < p.MinSubtotal:
+		return "requires a subtotal of at least " + money.Format(p.MinSubtotal, p.Currency)
+	}
+	return ""
+}
+
+// discounts returns the discount of each line, given what earlier promotions
+// left of it.
+func (p *Promotion) discounts(lines []Line, remaining []int64) []int64 {
+	discounts := make([]int64, len(lines))
+	eligible := make([]int, 0, len(lines))
+	for i, line := range lines {
+		if remaining[i] > 0 && p.matches(line) {
+			eligible = append(eligible, i)
+		}
+	}
+
+	switch p.Type {
+	case TypePercentage:
+		for _, i := range eligible {
+			discounts[i] = (remaining[i]*p.Value + 50) / 100
+		}
+	case TypeFixedAmount:
+		var base int64
+		for _, i := range eligible {
+			base += remaining[i]
+		}
+		amount := min(p.Value, base)
+		// Spread the amount over the lines by their share, then hand out the
+		// minor units lost to rounding down in line order.
+		left := amount
+		for _, i := range eligible {
+			discounts[i] = amount * remaining[i] / base
+			left -= discounts[i]
+		}
+		for _, i := range eligible {
+			extra := min(left, remaining[i]-discounts[i])
+			discounts[i] += extra
+			left -= extra
+		}
+	case TypeBuyXGetY:
+		// The cheapest copies are the free ones: Y for every X+Y eligible copies.
+		type copyOf struct {
+			line  int
+			price int64
+		}
+		var copies []copyOf
+		for _, i := range eligible {
+			for n := 0; n < lines[i].Quantity; n++ {
+				copies = append(copies, copyOf{line: i, price: lines[i].UnitPrice})
+			}
+		}
+		sort.SliceStable(copies, func(a, b int) bool { return copies[a].price < copies[b].price })
+		group := p.BuyQuantity + p.GetQuantity
+		if group <= 0 {
+			break
+		}
+		free := len(copies) / group * p.GetQuantity
+		for _, c := range copies[:free] {
+			discounts[c.line] += min(c.price, remaining[c.line]-discounts[c.line])
+		}
+	}
+	return discounts
+}
+
+func (p *Promotion) matches(line Line) bool {
+	switch p.Scope {
+	case ScopeCategory:
+		return p.ScopeID != nil && contains(line.CategoryIDs, *p.ScopeID)
+	case ScopeAuthor:
+		return p.ScopeID != nil && contains(line.AuthorIDs, *p.ScopeID)
+	default:
+		return true
+	}
+}
+
+func contains(ids []uint, id uint) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+func sum(amounts []int64) int64 {
+	var total int64
+	for _, amount := range amounts {
+		total += amount
+	}
+	return total
+}
@@ -0,0 +1,67 @@
+package promotions
+
+import "time"
+
+const (
+	TypePercentage   = "percentage"
+	TypeFixedAmount  = "fixed_amount"
+	TypeBuyXGetY     = "buy_x_get_y"
+	TypeFreeShipping = "free_shipping"
+)
+
+const (
+	ScopeAll      = "all"
+	ScopeCategory = "category"
+	ScopeAuthor   = "author"
+)
+
+// Promotion is a discount rule. Promotions without a code apply on their own
+// to every cart they match, the others only once the customer enters the
+// code. Value is a percentage for percentage promotions and an amount in
+// minor units of Currency for fixed amount promotions.
+type Promotion struct {
+	ID          uint    `gorm:"primaryKey"`
+	Name        string  `gorm:"column:name;size:100;not null"`
+	Code        *string `gorm:"column:code;size:40;uniqueIndex"`
+	Type        string  `gorm:"column:type;size:20;not null"`
+	Value       int64   `gorm:"column:value;not null;default:0"`
+	BuyQuantity int     `gorm:"column:buy_quantity;not null;default:0"`
+	GetQuantity int     `gorm:"column:get_quantity;not null;default:0"`
+	// Scope limits the discount to the books of a category, subcategories
+	// included, or of an author.
+	Scope        string     `gorm:"column:scope;size:20;not null;default:all"`
+	ScopeID      *uint      `gorm:"column:scope_id"`
+	Currency     string     `gorm:"column:currency;size:3"`
+	MinSubtotal  int64      `gorm:"column:min_subtotal;not null;default:0"`
+	StartsAt     *time.Time `gorm:"column:starts_at"`
+	EndsAt       *time.Time `gorm:"column:ends_at"`
+	UsageLimit   *int       `gorm:"column:usage_limit"`
+	PerUserLimit *int       `gorm:"column:per_user_limit"`
+	UsageCount   int        `gorm:"column:usage_count;not null;default:0"`
+	// Stackable promotions combine with each other. A promotion that is not
+	// stackable only applies to carts no other promotion applied to, and no
+	// other promotion applies after it.
+	Stackable  bool      `gorm:"column:stackable;not null;default:false"`
+	Priority   int       `gorm:"column:priority;not null;default:0"`
+	Active     bool      `gorm:"column:active;not null;default:true;index"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Promotion) TableName() string {
+	return "promotions"
+}
+
+// PromotionRedemption records a promotion used by an order, for the usage
+// limits.
+type PromotionRedemption struct {
+	ID          uint      `gorm:"primaryKey"`
+	PromotionID uint      `gorm:"column:promotion_id;not null;uniqueIndex:idx_promotion_redemptions_promotion_order"`
+	OrderID     uint      `gorm:"column:order_id;not null;uniqueIndex:idx_promotion_redemptions_promotion_order"`
+	UserID      uint      `gorm:"column:user_id;not null;index"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (PromotionRedemption) TableName() string {
+	return "promotion_redemptions"
+}
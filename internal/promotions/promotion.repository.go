@@ -0,0 +1,246 @@
+package promotions
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PromotionFilter struct {
+	Active *bool
+	Offset int
+	Limit  int
+}
+
+// BookScope holds what a promotion can be scoped to for one book.
+type BookScope struct {
+	// CategoryIDs holds the categories of the book and all their ancestors.
+	CategoryIDs []uint
+	AuthorIDs   []uint
+}
+
+type PromotionRepository interface {
+	Create(ctx context.Context, promotion *Promotion) (*Promotion, error)
+	FindByID(ctx context.Context, id uint) (*Promotion, error)
+	FindByCode(ctx context.Context, code string) (*Promotion, error)
+	FindAll(ctx context.Context, filter PromotionFilter) ([]Promotion, int64, error)
+	// FindApplicable returns the automatic promotions running at now and the
+	// promotion with the given code, whatever its state.
+	FindApplicable(ctx context.Context, code string, now time.Time) ([]Promotion, error)
+	Update(ctx context.Context, promotion *Promotion) (*Promotion, error)
+	FindBookScopes(ctx context.Context, bookIDs []uint) (map[uint]BookScope, error)
+	// CountRedemptions counts the redemptions of each promotion by a user.
+	CountRedemptions(ctx context.Context, userID uint, promotionIDs []uint) (map[uint]int64, error)
+	// Redeem records a redemption, checking the usage limits against the
+	// locked promotion so concurrent orders cannot exceed them.
+	Redeem(ctx context.Context, redemption *PromotionRedemption) error
+	// ReleaseRedemptions deletes the redemptions of an order and gives their
+	// uses back to the usage limits of the promotions.
+	ReleaseRedemptions(ctx context.Context, orderID uint) error
+}
+
+type promotionRepository struct {
+	db *gorm.DB
+}
+
+func NewPromotionRepository(db *gorm.DB) PromotionRepository {
+	return &promotionRepository{
+		db: db,
+	}
+}
+
+func (r *promotionRepository) Create(ctx context.Context, promotion *Promotion) (*Promotion, error) {
+	result := pkg.DB(ctx, r.db).Create(promotion)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return promotion, nil
+}
+
+func (r *promotionRepository) FindByID(ctx context.Context, id uint) (*Promotion, error) {
+	var promotion *Promotion
+	result := pkg.DB(ctx, r.db).First(&promotion, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return promotion, nil
+}
+
+func (r *promotionRepository) FindByCode(ctx context.Context, code string) (*Promotion, error) {
+	var promotion *Promotion
+	result := pkg.DB(ctx, r.db).Where("code = ?", code).First(&promotion)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return promotion, nil
+}
+
+func (r *promotionRepository) FindAll(ctx context.Context, filter PromotionFilter) ([]Promotion, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Promotion{})
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var promotions []Promotion
+	result := query.Order("id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&promotions)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return promotions, total, nil
+}
+
+func (r *promotionRepository) FindApplicable(ctx context.Context, code string, now time.Time) ([]Promotion, error) {
+	query := pkg.DB(ctx, r.db).
+		Where("code IS NULL AND active AND (starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)", now, now)
+	if code != "" {
+		query = query.Or("code = ?", code)
+	}
+
+	var promotions []Promotion
+	result := query.Find(&promotions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return promotions, nil
+}
+
+func (r *promotionRepository) Update(ctx context.Context, promotion *Promotion) (*Promotion, error) {
+	result := pkg.DB(ctx, r.db).Model(promotion).Select("*").Omit("id", "usage_count", "created_at").Updates(promotion)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return promotion, nil
+}
+
+func (r *promotionRepository) FindBookScopes(ctx context.Context, bookIDs []uint) (map[uint]BookScope, error) {
+	scopes := make(map[uint]BookScope, len(bookIDs))
+	if len(bookIDs) == 0 {
+		return scopes, nil
+	}
+
+	var categories []struct {
+		BookID uint
+		Path   string
+	}
+	err := pkg.DB(ctx, r.db).Table("book_categories").
+		Select("book_categories.book_id, categories.path").
+		Joins("JOIN categories ON categories.id = book_categories.category_id").
+		Where("book_categories.book_id IN ?", bookIDs).
+		Scan(&categories).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range categories {
+		scope := scopes[category.BookID]
+		// The materialized path lists the category and every ancestor.
+		for _, part := range strings.Split(strings.TrimSuffix(category.Path, "/"), "/") {
+			if id, err := strconv.ParseUint(part, 10, 64); err == nil && !contains(scope.CategoryIDs, uint(id)) {
+				scope.CategoryIDs = append(scope.CategoryIDs, uint(id))
+			}
+		}
+		scopes[category.BookID] = scope
+	}
+
+	var authors []struct {
+		BookID   uint
+		AuthorID uint
+	}
+	err = pkg.DB(ctx, r.db).Table("book_authors").
+		Distinct("book_id", "author_id").
+		Where("book_id IN ?", bookIDs).
+		Scan(&authors).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, author := range authors {
+		scope := scopes[author.BookID]
+		scope.AuthorIDs = append(scope.AuthorIDs, author.AuthorID)
+		scopes[author.BookID] = scope
+	}
+	return scopes, nil
+}
+
+func (r *promotionRepository) CountRedemptions(ctx context.Context, userID uint, promotionIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(promotionIDs))
+	if len(promotionIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		PromotionID uint
+		Count       int64
+	}
+	err := pkg.DB(ctx, r.db).Model(&PromotionRedemption{}).
+		Select("promotion_id, COUNT(*) AS count").
+		Where("user_id = ? AND promotion_id IN ?", userID, promotionIDs).
+		Group("promotion_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.PromotionID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *promotionRepository) Redeem(ctx context.Context, redemption *PromotionRedemption) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var promotion Promotion
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promotion, redemption.PromotionID).Error
+		if err != nil {
+			return err
+		}
+		if promotion.UsageLimit != nil && promotion.UsageCount >= *promotion.UsageLimit {
+			return ErrUsageLimitReached
+		}
+		if promotion.PerUserLimit != nil {
+			var used int64
+			err := tx.Model(&PromotionRedemption{}).
+				Where("promotion_id = ? AND user_id = ?", promotion.ID, redemption.UserID).
+				Count(&used).Error
+			if err != nil {
+				return err
+			}
+			if used >= int64(*promotion.PerUserLimit) {
+				return ErrUsageLimitReached
+			}
+		}
+
+		err = tx.Model(&promotion).UpdateColumn("usage_count", gorm.Expr("usage_count + 1")).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(redemption).Error
+	})
+}
+
+func (r *promotionRepository) ReleaseRedemptions(ctx context.Context, orderID uint) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var released []PromotionRedemption
+		err := tx.Clauses(clause.Returning{Columns: []clause.Column{{Name: "promotion_id"}}}).
+			Where("order_id = ?", orderID).
+			Delete(&released).Error
+		if err != nil || len(released) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(released))
+		for _, redemption := range released {
+			ids = append(ids, redemption.PromotionID)
+		}
+		return tx.Model(&Promotion{}).
+			Where("id IN ? AND usage_count > 0", ids).
+			UpdateColumn("usage_count", gorm.Expr("usage_count - 1")).Error
+	})
+}
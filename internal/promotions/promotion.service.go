@@ -0,0 +1,288 @@
+package promotions
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/promotions/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPromotionNotFound = errors.New("promotion not found")
+	ErrCouponNotFound    = errors.New("coupon code not found")
+	ErrDuplicateCode     = errors.New("another promotion already uses this code")
+	ErrInvalidPromotion  = errors.New("invalid promotion")
+	ErrScopeNotFound     = errors.New("category or author of the promotion not found")
+	ErrUsageLimitReached = errors.New("promotion has reached its usage limit")
+)
+
+type PromotionService interface {
+	CreatePromotion(ctx context.Context, req dto.PromotionRequest) (*dto.PromotionResponse, error)
+	GetPromotions(ctx context.Context, query dto.PromotionListQuery) (*dto.PromotionListResponse, error)
+	GetPromotion(ctx context.Context, id uint) (*dto.PromotionResponse, error)
+	UpdatePromotion(ctx context.Context, id uint, req dto.PromotionRequest) (*dto.PromotionResponse, error)
+	// FindCoupon checks that a coupon code exists and returns it normalized.
+	FindCoupon(ctx context.Context, code string) (string, error)
+	// Evaluate applies the promotions in effect, and the basket's coupon, to
+	// the basket. The lines only need book, quantity and price.
+	Evaluate(ctx context.Context, basket Basket) (*Evaluation, error)
+	// Redeem counts the promotions of an evaluation against their usage limits
+	// once the order is placed.
+	Redeem(ctx context.Context, evaluation *Evaluation, userID, orderID uint) error
+	// Release gives the promotions redeemed by a cancelled or refunded order
+	// back to their usage limits.
+	Release(ctx context.Context, orderID uint) error
+}
+
+type promotionService struct {
+	promotionRepo PromotionRepository
+	categoryRepo  books.CategoryRepository
+	authorRepo    books.AuthorRepository
+}
+
+func NewPromotionService(promotionRepo PromotionRepository, categoryRepo books.CategoryRepository, authorRepo books.AuthorRepository) PromotionService {
+	return &promotionService{
+		promotionRepo: promotionRepo,
+		categoryRepo:  categoryRepo,
+		authorRepo:    authorRepo,
+	}
+}
+
+// NormalizeCode makes coupon codes case insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (s *promotionService) CreatePromotion(ctx context.Context, req dto.PromotionRequest) (*dto.PromotionResponse, error) {
+	promotion := &Promotion{}
+	if err := s.apply(ctx, promotion, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.promotionRepo.Create(ctx, promotion)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPromotionResponse(created), nil
+}
+
+func (s *promotionService) GetPromotions(ctx context.Context, query dto.PromotionListQuery) (*dto.PromotionListResponse, error) {
+	promotions, total, err := s.promotionRepo.FindAll(ctx, PromotionFilter{
+		Active: query.Active,
+		Offset: query.Offset(),
+		Limit:  query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PromotionListResponse{
+		Promotions: make([]dto.PromotionResponse, 0, len(promotions)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range promotions {
+		response.Promotions = append(response.Promotions, *ToPromotionResponse(&promotions[i]))
+	}
+	return response, nil
+}
+
+func (s *promotionService) GetPromotion(ctx context.Context, id uint) (*dto.PromotionResponse, error) {
+	promotion, err := s.promotionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPromotionResponse(promotion), nil
+}
+
+func (s *promotionService) UpdatePromotion(ctx context.Context, id uint, req dto.PromotionRequest) (*dto.PromotionResponse, error) {
+	promotion, err := s.promotionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if err := s.apply(ctx, promotion, req); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.promotionRepo.Update(ctx, promotion)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPromotionResponse(updated), nil
+}
+
+func (s *promotionService) FindCoupon(ctx context.Context, code string) (string, error) {
+	code = NormalizeCode(code)
+	if _, err := s.promotionRepo.FindByCode(ctx, code); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrCouponNotFound
+		}
+		return "", err
+	}
+	return code, nil
+}
+
+func (s *promotionService) Evaluate(ctx context.Context, basket Basket) (*Evaluation, error) {
+	basket.Code = NormalizeCode(basket.Code)
+	now := time.Now()
+	promotions, err := s.promotionRepo.FindApplicable(ctx, basket.Code, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(promotions) == 0 || len(basket.Lines) == 0 {
+		return Evaluate(promotions, basket, nil, now), nil
+	}
+
+	bookIDs := make([]uint, 0, len(basket.Lines))
+	for _, line := range basket.Lines {
+		bookIDs = append(bookIDs, line.BookID)
+	}
+	scopes, err := s.promotionRepo.FindBookScopes(ctx, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range basket.Lines {
+		scope := scopes[basket.Lines[i].BookID]
+		basket.Lines[i].CategoryIDs = scope.CategoryIDs
+		basket.Lines[i].AuthorIDs = scope.AuthorIDs
+	}
+
+	var usage map[uint]int64
+	if basket.UserID != nil {
+		limited := make([]uint, 0, len(promotions))
+		for _, promotion := range promotions {
+			if promotion.PerUserLimit != nil {
+				limited = append(limited, promotion.ID)
+			}
+		}
+		if usage, err = s.promotionRepo.CountRedemptions(ctx, *basket.UserID, limited); err != nil {
+			return nil, err
+		}
+	}
+	return Evaluate(promotions, basket, usage, now), nil
+}
+
+func (s *promotionService) Redeem(ctx context.Context, evaluation *Evaluation, userID, orderID uint) error {
+	for _, applied := range evaluation.Applied {
+		err := s.promotionRepo.Redeem(ctx, &PromotionRedemption{
+			PromotionID: applied.PromotionID,
+			OrderID:     orderID,
+			UserID:      userID,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", applied.Name, translateError(err))
+		}
+	}
+	return nil
+}
+
+func (s *promotionService) Release(ctx context.Context, orderID uint) error {
+	return s.promotionRepo.ReleaseRedemptions(ctx, orderID)
+}
+
+// apply validates the request and copies it onto the promotion.
+func (s *promotionService) apply(ctx context.Context, promotion *Promotion, req dto.PromotionRequest) error {
+	scope := req.Scope
+	if scope == "" {
+		scope = ScopeAll
+	}
+	switch {
+	case req.Type == TypePercentage && (req.Value < 1 || req.Value > 100):
+		return fmt.Errorf("%w: percentage value must be between 1 and 100", ErrInvalidPromotion)
+	case req.Type == TypeFixedAmount && (req.Value < 1 || req.Currency == ""):
+		return fmt.Errorf("%w: fixed amount promotions need a value and a currency", ErrInvalidPromotion)
+	case req.Type == TypeBuyXGetY && (req.BuyQuantity < 1 || req.GetQuantity < 1):
+		return fmt.Errorf("%w: buy_x_get_y promotions need buy_quantity and get_quantity", ErrInvalidPromotion)
+	case req.MinSubtotal > 0 && req.Currency == "":
+		return fmt.Errorf("%w: a minimum subtotal needs a currency", ErrInvalidPromotion)
+	case scope != ScopeAll && req.ScopeID == nil:
+		return fmt.Errorf("%w: scope_id is required for %s promotions", ErrInvalidPromotion, scope)
+	case req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt):
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidPromotion)
+	}
+
+	var err error
+	switch scope {
+	case ScopeCategory:
+		_, err = s.categoryRepo.FindByID(ctx, *req.ScopeID)
+	case ScopeAuthor:
+		_, err = s.authorRepo.FindByID(ctx, *req.ScopeID)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrScopeNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	promotion.Name = req.Name
+	promotion.Code = nil
+	if code := NormalizeCode(req.Code); code != "" {
+		promotion.Code = &code
+	}
+	promotion.Type = req.Type
+	promotion.Value = req.Value
+	promotion.BuyQuantity = req.BuyQuantity
+	promotion.GetQuantity = req.GetQuantity
+	promotion.Scope = scope
+	promotion.ScopeID = nil
+	if scope != ScopeAll {
+		promotion.ScopeID = req.ScopeID
+	}
+	promotion.Currency = req.Currency
+	promotion.MinSubtotal = req.MinSubtotal
+	promotion.StartsAt = req.StartsAt
+	promotion.EndsAt = req.EndsAt
+	promotion.UsageLimit = req.UsageLimit
+	promotion.PerUserLimit = req.PerUserLimit
+	promotion.Stackable = req.Stackable
+	promotion.Priority = req.Priority
+	promotion.Active = req.Active == nil || *req.Active
+	return nil
+}
+
+func ToPromotionResponse(promotion *Promotion) *dto.PromotionResponse {
+	response := &dto.PromotionResponse{
+		ID:           promotion.ID,
+		Name:         promotion.Name,
+		Type:         promotion.Type,
+		Description:  promotion.Describe(),
+		Value:        promotion.Value,
+		BuyQuantity:  promotion.BuyQuantity,
+		GetQuantity:  promotion.GetQuantity,
+		Scope:        promotion.Scope,
+		ScopeID:      promotion.ScopeID,
+		Currency:     promotion.Currency,
+		MinSubtotal:  promotion.MinSubtotal,
+		StartsAt:     promotion.StartsAt,
+		EndsAt:       promotion.EndsAt,
+		UsageLimit:   promotion.UsageLimit,
+		PerUserLimit: promotion.PerUserLimit,
+		UsageCount:   promotion.UsageCount,
+		Stackable:    promotion.Stackable,
+		Priority:     promotion.Priority,
+		Active:       promotion.Active,
+		CreatedAt:    promotion.CreatedAt,
+		ModifiedAt:   promotion.ModifiedAt,
+	}
+	if promotion.Code != nil {
+		response.Code = *promotion.Code
+	}
+	return response
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrPromotionNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateCode
+	default:
+		return err
+	}
+}
@@ -0,0 +1,83 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// SupplierRequest represents a supplier to create or update
+// @Description Costs of the supplier's catalog and purchase orders are in its currency. Inactive suppliers cannot be sent new purchase orders.
+type SupplierRequest struct {
+	Name         string `json:"name" binding:"required,max=255" example:"Northern Book Distribution"`
+	Email        string `json:"email" binding:"required,email,max=255" example:"orders@northernbooks.example"`
+	Phone        string `json:"phone" binding:"max=32" example:"+44 20 7946 0000"`
+	Currency     string `json:"currency" binding:"required,iso4217" example:"GBP"`
+	LeadTimeDays int    `json:"lead_time_days" binding:"min=0,max=365" example:"5"`
+	Active       *bool  `json:"active" example:"true"`
+	Note         string `json:"note" binding:"max=255" example:"Account 20-4411"`
+}
+
+// SupplierItemsRequest represents books to set in the catalog of a supplier
+// @Description Costs in minor units of the supplier's currency. Books already in the catalog get the new cost and minimum quantity.
+type SupplierItemsRequest struct {
+	Items []SupplierItemRequest `json:"items" binding:"required,min=1,max=1000,dive"`
+}
+
+type SupplierItemRequest struct {
+	BookID      uint   `json:"book_id" binding:"required" example:"1"`
+	SupplierSKU string `json:"supplier_sku" binding:"max=64" example:"NBD-88231"`
+	Cost        int64  `json:"cost" binding:"min=0" example:"699"`
+	MinQuantity int    `json:"min_quantity" binding:"omitempty,min=1" example:"5"`
+}
+
+// SupplierListQuery represents the query string of the supplier list endpoint
+type SupplierListQuery struct {
+	pkg.PaginationQuery
+	Active *bool `form:"active"`
+}
+
+type PurchaseOrderItemRequest struct {
+	BookID   uint   `json:"book_id" binding:"required" example:"1"`
+	Quantity int    `json:"quantity" binding:"required,min=1" example:"20"`
+	UnitCost *int64 `json:"unit_cost" binding:"omitempty,min=0" example:"699"`
+}
+
+// PurchaseOrderRequest represents a draft purchase order to create or update
+// @Description Books must be in the catalog of the supplier, ordered at least in its minimum quantity. Leave the unit cost out to order at the catalog cost.
+type PurchaseOrderRequest struct {
+	SupplierID uint                       `json:"supplier_id" binding:"required" example:"1"`
+	LocationID uint                       `json:"location_id" binding:"required" example:"1"`
+	Items      []PurchaseOrderItemRequest `json:"items" binding:"required,min=1,max=500,dive"`
+	Note       string                     `json:"note" binding:"max=255" example:"Restock for the autumn table"`
+}
+
+type ReceiveItemRequest struct {
+	BookID   uint `json:"book_id" binding:"required" example:"1"`
+	Quantity int  `json:"quantity" binding:"required,min=1" example:"12"`
+}
+
+// ReceivePurchaseOrderRequest represents copies of a purchase order that arrived
+// @Description Received copies go into stock at the location of the purchase order. A delivery can bring part of the copies ordered; the purchase order is received once every copy arrived.
+type ReceivePurchaseOrderRequest struct {
+	Items []ReceiveItemRequest `json:"items" binding:"required,min=1,dive"`
+	Note  string               `json:"note" binding:"max=255" example:"Rest of the order due next week"`
+}
+
+// PurchaseOrderListQuery represents the query string of the purchase order list endpoint
+type PurchaseOrderListQuery struct {
+	pkg.PaginationQuery
+	SupplierID uint   `form:"supplier_id"`
+	Status     string `form:"status" binding:"omitempty,oneof=draft sent partially_received received cancelled"`
+}
+
+// ReorderRuleRequest represents the reorder point of a SKU
+// @Description The SKU runs low once the copies available and on order fall to the reorder point. Restocks are delivered to the location and ordered from the supplier when one is given, otherwise from the active supplier with the shortest lead time that sells the book. A lead time replaces the lead time of the supplier.
+type ReorderRuleRequest struct {
+	ReorderPoint int   `json:"reorder_point" binding:"min=0" example:"10"`
+	LeadTimeDays *int  `json:"lead_time_days" binding:"omitempty,min=0,max=365" example:"7"`
+	SupplierID   *uint `json:"supplier_id" example:"1"`
+	LocationID   uint  `json:"location_id" binding:"required" example:"1"`
+}
+
+// LowStockAlertListQuery represents the query string of the low stock alert list endpoint
+type LowStockAlertListQuery struct {
+	pkg.PaginationQuery
+	Status string `form:"status" binding:"omitempty,oneof=open resolved"`
+}
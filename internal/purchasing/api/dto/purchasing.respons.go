@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type SupplierResponse struct {
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	Phone        string    `json:"phone,omitempty"`
+	Currency     string    `json:"currency"`
+	LeadTimeDays int       `json:"lead_time_days"`
+	Active       bool      `json:"active"`
+	Note         string    `json:"note,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	ModifiedAt   time.Time `json:"modified_at"`
+}
+
+type SupplierListResponse struct {
+	Suppliers  []SupplierResponse `json:"suppliers"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
+
+// SupplierItemResponse carries the cost in minor units of the supplier's
+// currency.
+type SupplierItemResponse struct {
+	BookID      uint   `json:"book_id"`
+	SupplierSKU string `json:"supplier_sku,omitempty"`
+	Cost        int64  `json:"cost"`
+	MinQuantity int    `json:"min_quantity"`
+}
+
+type SupplierCatalogResponse struct {
+	SupplierID uint                   `json:"supplier_id"`
+	Currency   string                 `json:"currency"`
+	Items      []SupplierItemResponse `json:"items"`
+}
+
+type PurchaseOrderItemResponse struct {
+	BookID           uint   `json:"book_id"`
+	SKU              string `json:"sku"`
+	Title            string `json:"title"`
+	SupplierSKU      string `json:"supplier_sku,omitempty"`
+	Quantity         int    `json:"quantity"`
+	ReceivedQuantity int    `json:"received_quantity"`
+	UnitCost         int64  `json:"unit_cost"`
+	LineTotal        int64  `json:"line_total"`
+}
+
+// PurchaseOrderResponse carries costs in minor units of the supplier's
+// currency. SupplierEmail is where the purchase order is sent.
+type PurchaseOrderResponse struct {
+	ID            uint                        `json:"id"`
+	SupplierID    uint                        `json:"supplier_id"`
+	SupplierName  string                      `json:"supplier_name"`
+	SupplierEmail string                      `json:"supplier_email"`
+	LocationID    uint                        `json:"location_id"`
+	Status        string                      `json:"status"`
+	Currency      string                      `json:"currency"`
+	Total         int64                       `json:"total"`
+	Note          string                      `json:"note,omitempty"`
+	Items         []PurchaseOrderItemResponse `json:"items"`
+	SentAt        *time.Time                  `json:"sent_at,omitempty"`
+	ReceivedAt    *time.Time                  `json:"received_at,omitempty"`
+	CreatedAt     time.Time                   `json:"created_at"`
+	ModifiedAt    time.Time                   `json:"modified_at"`
+}
+
+type PurchaseOrderListResponse struct {
+	PurchaseOrders []PurchaseOrderResponse `json:"purchase_orders"`
+	Pagination     pkg.PaginationMeta      `json:"pagination"`
+}
+
+type ReorderRuleResponse struct {
+	SKU          string    `json:"sku"`
+	BookID       uint      `json:"book_id"`
+	ReorderPoint int       `json:"reorder_point"`
+	LeadTimeDays *int      `json:"lead_time_days,omitempty"`
+	SupplierID   *uint     `json:"supplier_id,omitempty"`
+	LocationID   uint      `json:"location_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	ModifiedAt   time.Time `json:"modified_at"`
+}
+
+type ReorderRuleListResponse struct {
+	ReorderRules []ReorderRuleResponse `json:"reorder_rules"`
+	Pagination   pkg.PaginationMeta    `json:"pagination"`
+}
+
+// LowStockAlertResponse carries the stock of the SKU when the alert was
+// raised. PurchaseOrderID is the draft purchase order proposed to restock it.
+type LowStockAlertResponse struct {
+	ID                uint       `json:"id"`
+	SKU               string     `json:"sku"`
+	BookID            uint       `json:"book_id"`
+	Status            string     `json:"status"`
+	ReorderPoint      int        `json:"reorder_point"`
+	Available         int        `json:"available"`
+	OnOrder           int        `json:"on_order"`
+	DailySales        float64    `json:"daily_sales"`
+	SuggestedQuantity int        `json:"suggested_quantity"`
+	PurchaseOrderID   *uint      `json:"purchase_order_id,omitempty"`
+	ResolvedAt        *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+type LowStockAlertListResponse struct {
+	Alerts     []LowStockAlertResponse `json:"alerts"`
+	Pagination pkg.PaginationMeta      `json:"pagination"`
+}
+
+// StockNotificationResponse tells a staff user that low stock alerts were
+// raised.
+type StockNotificationResponse struct {
+	ID        uint      `json:"id"`
+	Type      string    `json:"type"`
+	Message   string    `json:"message"`
+	Read      bool      `json:"read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type StockNotificationListResponse struct {
+	Notifications []StockNotificationResponse `json:"notifications"`
+	Pagination    pkg.PaginationMeta          `json:"pagination"`
+}
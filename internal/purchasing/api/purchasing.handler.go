@@ -0,0 +1,488 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/purchasing/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PurchasingHandler struct {
+	purchasingService purchasing.PurchasingService
+}
+
+func NewPurchasingHandler(purchasingService purchasing.PurchasingService) *PurchasingHandler {
+	return &PurchasingHandler{
+		purchasingService: purchasingService,
+	}
+}
+
+// CreateSupplier godoc
+// @Summary      Create a supplier
+// @Description  Add a distributor or publisher the store buys stock from (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.SupplierRequest true "Supplier"
+// @Success      201  {object}    pkg.Response{data=dto.SupplierResponse} "Supplier created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      409  {object}    pkg.Response "A supplier with this name already exists"
+// @Router       /suppliers [post]
+func (h *PurchasingHandler) CreateSupplier(ctx *gin.Context) {
+	var req dto.SupplierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.CreateSupplier(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Supplier created successfully", response)
+}
+
+// GetSuppliers godoc
+// @Summary      List suppliers
+// @Description  List suppliers by name (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int  false "Page number" default(1)
+// @Param        limit  query     int  false "Page size" default(20)
+// @Param        active query     bool false "Filter by active suppliers"
+// @Success      200  {object}    pkg.Response{data=dto.SupplierListResponse} "Suppliers retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /suppliers [get]
+func (h *PurchasingHandler) GetSuppliers(ctx *gin.Context) {
+	var query dto.SupplierListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.GetSuppliers(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Suppliers retrieve successfully", response)
+}
+
+// GetSupplier godoc
+// @Summary      Get a supplier
+// @Description  Get a supplier (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Supplier ID"
+// @Success      200  {object}    pkg.Response{data=dto.SupplierResponse} "Supplier retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Supplier not found"
+// @Router       /suppliers/{id} [get]
+func (h *PurchasingHandler) GetSupplier(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid supplier id", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.GetSupplier(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Supplier retrieve successfully", response)
+}
+
+// UpdateSupplier godoc
+// @Summary      Update a supplier
+// @Description  Update a supplier. Deactivate suppliers the store no longer buys from (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Supplier ID"
+// @Param        request body     dto.SupplierRequest true "Supplier"
+// @Success      200  {object}    pkg.Response{data=dto.SupplierResponse} "Supplier updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Supplier not found"
+// @Failure      409  {object}    pkg.Response "A supplier with this name already exists"
+// @Router       /suppliers/{id} [put]
+func (h *PurchasingHandler) UpdateSupplier(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid supplier id", err.Error())
+		return
+	}
+
+	var req dto.SupplierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.UpdateSupplier(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Supplier updated successfully", response)
+}
+
+// GetCatalog godoc
+// @Summary      Get the catalog of a supplier
+// @Description  List the books the supplier sells, with their cost and minimum order quantity (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Supplier ID"
+// @Success      200  {object}    pkg.Response{data=dto.SupplierCatalogResponse} "Catalog retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Supplier not found"
+// @Router       /suppliers/{id}/catalog [get]
+func (h *PurchasingHandler) GetCatalog(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid supplier id", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.GetCatalog(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Catalog retrieve successfully", response)
+}
+
+// SaveCatalogItems godoc
+// @Summary      Set books in the catalog of a supplier
+// @Description  Add books to the catalog of a supplier, or change their cost and minimum order quantity (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Supplier ID"
+// @Param        request body     dto.SupplierItemsRequest true "Books with their cost"
+// @Success      200  {object}    pkg.Response{data=dto.SupplierCatalogResponse} "Catalog updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Supplier or book not found"
+// @Router       /suppliers/{id}/catalog [put]
+func (h *PurchasingHandler) SaveCatalogItems(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid supplier id", err.Error())
+		return
+	}
+
+	var req dto.SupplierItemsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.SaveCatalogItems(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Catalog updated successfully", response)
+}
+
+// DeleteCatalogItem godoc
+// @Summary      Remove a book from the catalog of a supplier
+// @Description  Remove a book the supplier no longer sells. Purchase orders already drafted keep it (staff only)
+// @Tags         suppliers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path      int true "Supplier ID"
+// @Param        bookId path      int true "Book ID"
+// @Success      200  {object}    pkg.Response "Book removed from the catalog successfully"
+// @Failure      404  {object}    pkg.Response "Supplier not found or book not in its catalog"
+// @Router       /suppliers/{id}/catalog/{bookId} [delete]
+func (h *PurchasingHandler) DeleteCatalogItem(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid supplier id", err.Error())
+		return
+	}
+	bookID, err := pkg.ParamID(ctx, "bookId")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	if err := h.purchasingService.DeleteCatalogItem(ctx.Request.Context(), id, bookID); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book removed from the catalog successfully", nil)
+}
+
+// CreatePurchaseOrder godoc
+// @Summary      Draft a purchase order
+// @Description  Draft a purchase order of books in the catalog of a supplier, to be delivered to a stock location. Items are priced at the catalog cost unless a unit cost is given (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.PurchaseOrderRequest true "Supplier, location and items"
+// @Success      201  {object}    pkg.Response{data=dto.PurchaseOrderResponse} "Purchase order created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid items, quantity below the minimum or inactive supplier"
+// @Failure      404  {object}    pkg.Response "Supplier, book or location not found"
+// @Router       /purchase-orders [post]
+func (h *PurchasingHandler) CreatePurchaseOrder(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.PurchaseOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.CreatePurchaseOrder(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Purchase order created successfully", response)
+}
+
+// GetPurchaseOrders godoc
+// @Summary      List purchase orders
+// @Description  List purchase orders, newest first (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page        query   int    false "Page number" default(1)
+// @Param        limit       query   int    false "Page size" default(20)
+// @Param        supplier_id query   int    false "Filter by supplier"
+// @Param        status      query   string false "Filter by status"
+// @Success      200  {object}    pkg.Response{data=dto.PurchaseOrderListResponse} "Purchase orders retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /purchase-orders [get]
+func (h *PurchasingHandler) GetPurchaseOrders(ctx *gin.Context) {
+	var query dto.PurchaseOrderListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.GetPurchaseOrders(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Purchase orders retrieve successfully", response)
+}
+
+// GetPurchaseOrder godoc
+// @Summary      Get a purchase order
+// @Description  Get a purchase order with its items and what was received of them (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Purchase order ID"
+// @Success      200  {object}    pkg.Response{data=dto.PurchaseOrderResponse} "Purchase order retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Purchase order not found"
+// @Router       /purchase-orders/{id} [get]
+func (h *PurchasingHandler) GetPurchaseOrder(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid purchase order id", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.GetPurchaseOrder(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Purchase order retrieve successfully", response)
+}
+
+// UpdatePurchaseOrder godoc
+// @Summary      Update a draft purchase order
+// @Description  Replace the supplier, location and items of a purchase order that was not sent yet (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Purchase order ID"
+// @Param        request body     dto.PurchaseOrderRequest true "Supplier, location and items"
+// @Success      200  {object}    pkg.Response{data=dto.PurchaseOrderResponse} "Purchase order updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid items, quantity below the minimum or inactive supplier"
+// @Failure      404  {object}    pkg.Response "Purchase order, supplier, book or location not found"
+// @Failure      409  {object}    pkg.Response "Purchase order is not a draft"
+// @Router       /purchase-orders/{id} [put]
+func (h *PurchasingHandler) UpdatePurchaseOrder(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid purchase order id", err.Error())
+		return
+	}
+
+	var req dto.PurchaseOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.UpdatePurchaseOrder(ctx.Request.Context(), userID.(uint), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Purchase order updated successfully", response)
+}
+
+// SendPurchaseOrder godoc
+// @Summary      Send a purchase order
+// @Description  Mark a draft purchase order as sent to the supplier, at the supplier email of the response (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Purchase order ID"
+// @Success      200  {object}    pkg.Response{data=dto.PurchaseOrderResponse} "Purchase order sent successfully"
+// @Failure      400  {object}    pkg.Response "Supplier is inactive"
+// @Failure      404  {object}    pkg.Response "Purchase order not found"
+// @Failure      409  {object}    pkg.Response "Purchase order cannot move to this status"
+// @Router       /purchase-orders/{id}/send [post]
+func (h *PurchasingHandler) SendPurchaseOrder(ctx *gin.Context) {
+	h.advance(ctx, h.purchasingService.Send, "Purchase order sent successfully")
+}
+
+// CancelPurchaseOrder godoc
+// @Summary      Cancel a purchase order
+// @Description  Cancel a draft or sent purchase order nothing was received of yet (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Purchase order ID"
+// @Success      200  {object}    pkg.Response{data=dto.PurchaseOrderResponse} "Purchase order cancelled successfully"
+// @Failure      404  {object}    pkg.Response "Purchase order not found"
+// @Failure      409  {object}    pkg.Response "Purchase order cannot move to this status"
+// @Router       /purchase-orders/{id}/cancel [post]
+func (h *PurchasingHandler) CancelPurchaseOrder(ctx *gin.Context) {
+	h.advance(ctx, h.purchasingService.Cancel, "Purchase order cancelled successfully")
+}
+
+type advanceFunc func(ctx context.Context, actorID uint, id uint) (*dto.PurchaseOrderResponse, error)
+
+func (h *PurchasingHandler) advance(ctx *gin.Context, advance advanceFunc, message string) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid purchase order id", err.Error())
+		return
+	}
+
+	var response *dto.PurchaseOrderResponse
+	response, err = advance(ctx.Request.Context(), userID.(uint), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, message, response)
+}
+
+// ReceivePurchaseOrder godoc
+// @Summary      Receive a purchase order
+// @Description  Record copies of a sent purchase order that arrived. They are posted as stock receipts at the location of the purchase order; the order is received once every copy arrived (staff only)
+// @Tags         purchase-orders
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Purchase order ID"
+// @Param        request body     dto.ReceivePurchaseOrderRequest true "Copies received of each book"
+// @Success      200  {object}    pkg.Response{data=dto.PurchaseOrderResponse} "Purchase order received successfully"
+// @Failure      400  {object}    pkg.Response "Invalid items, more copies than outstanding or inactive location"
+// @Failure      404  {object}    pkg.Response "Purchase order or location not found"
+// @Failure      409  {object}    pkg.Response "Purchase order cannot be received"
+// @Router       /purchase-orders/{id}/receive [post]
+func (h *PurchasingHandler) ReceivePurchaseOrder(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid purchase order id", err.Error())
+		return
+	}
+
+	var req dto.ReceivePurchaseOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.purchasingService.Receive(ctx.Request.Context(), userID.(uint), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Purchase order received successfully", response)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, purchasing.ErrSupplierNotFound),
+		errors.Is(err, purchasing.ErrSupplierItemNotFound),
+		errors.Is(err, purchasing.ErrPurchaseOrderNotFound),
+		errors.Is(err, purchasing.ErrReorderRuleNotFound),
+		errors.Is(err, books.ErrBookNotFound),
+		errors.Is(err, inventory.ErrLocationNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, purchasing.ErrUnsupportedCurrency),
+		errors.Is(err, purchasing.ErrSupplierInactive),
+		errors.Is(err, purchasing.ErrInvalidItems),
+		errors.Is(err, purchasing.ErrBelowMinimumQuantity),
+		errors.Is(err, purchasing.ErrOverReceipt),
+		errors.Is(err, inventory.ErrLocationInactive):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, purchasing.ErrDuplicateSupplier),
+		errors.Is(err, purchasing.ErrNotEditable),
+		errors.Is(err, purchasing.ErrInvalidTransition):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
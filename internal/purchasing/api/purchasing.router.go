@@ -0,0 +1,78 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+	"bookstore-framework/pkg"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func newPurchasingHandler(db *gorm.DB) *PurchasingHandler {
+	purchasingService := purchasing.NewPurchasingService(
+		purchasing.NewPurchasingRepository(db),
+		books.NewBookRepository(db),
+		inventory.NewInventoryRepository(db),
+		pkg.NewTransactor(db),
+	)
+	return NewPurchasingHandler(purchasingService)
+}
+
+func newReorderHandler(db *gorm.DB) *ReorderHandler {
+	reorderService := purchasing.NewReorderService(
+		purchasing.NewReorderRepository(db),
+		purchasing.NewPurchasingRepository(db),
+		books.NewBookRepository(db),
+		inventory.NewInventoryRepository(db),
+		pkg.NewTransactor(db),
+	)
+	return NewReorderHandler(reorderService)
+}
+
+func SuppliersRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	purchasingHandler := newPurchasingHandler(db)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.POST("", purchasingHandler.CreateSupplier)
+	router.GET("", purchasingHandler.GetSuppliers)
+	router.GET("/:id", purchasingHandler.GetSupplier)
+	router.PUT("/:id", purchasingHandler.UpdateSupplier)
+	router.GET("/:id/catalog", purchasingHandler.GetCatalog)
+	router.PUT("/:id/catalog", purchasingHandler.SaveCatalogItems)
+	router.DELETE("/:id/catalog/:bookId", purchasingHandler.DeleteCatalogItem)
+}
+
+func PurchaseOrdersRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	purchasingHandler := newPurchasingHandler(db)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.POST("", purchasingHandler.CreatePurchaseOrder)
+	router.GET("", purchasingHandler.GetPurchaseOrders)
+	router.GET("/:id", purchasingHandler.GetPurchaseOrder)
+	router.PUT("/:id", purchasingHandler.UpdatePurchaseOrder)
+	router.POST("/:id/send", purchasingHandler.SendPurchaseOrder)
+	router.POST("/:id/cancel", purchasingHandler.CancelPurchaseOrder)
+	router.POST("/:id/receive", purchasingHandler.ReceivePurchaseOrder)
+}
+
+func ReorderRulesRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	reorderHandler := newReorderHandler(db)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.GET("", reorderHandler.GetReorderRules)
+	router.PUT("/:sku", reorderHandler.SaveReorderRule)
+	router.DELETE("/:sku", reorderHandler.DeleteReorderRule)
+}
+
+func StockAlertsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	reorderHandler := newReorderHandler(db)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.GET("", reorderHandler.GetLowStockAlerts)
+	router.GET("/notifications", reorderHandler.GetStockNotifications)
+	router.PUT("/notifications/read", reorderHandler.MarkStockNotificationsRead)
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/purchasing/api/dto"
+	"bookstore-framework/pkg"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReorderHandler struct {
+	reorderService purchasing.ReorderService
+}
+
+func NewReorderHandler(reorderService purchasing.ReorderService) *ReorderHandler {
+	return &ReorderHandler{
+		reorderService: reorderService,
+	}
+}
+
+// SaveReorderRule godoc
+// @Summary      Set the reorder point of a SKU
+// @Description  Set when a SKU runs low and where and from whom it is restocked. Every hour, SKUs that fell to their reorder point raise a low stock alert and get a draft purchase order sized from their sales of the last 30 days (staff only)
+// @Tags         reorder-rules
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        sku     path     string true "SKU"
+// @Param        request body     dto.ReorderRuleRequest true "Reorder point, lead time, supplier and location"
+// @Success      200  {object}    pkg.Response{data=dto.ReorderRuleResponse} "Reorder rule saved successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book, supplier or location not found"
+// @Router       /reorder-rules/{sku} [put]
+func (h *ReorderHandler) SaveReorderRule(ctx *gin.Context) {
+	var req dto.ReorderRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reorderService.SaveRule(ctx.Request.Context(), ctx.Param("sku"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Reorder rule saved successfully", response)
+}
+
+// GetReorderRules godoc
+// @Summary      List reorder rules
+// @Description  List the reorder points of SKUs by SKU (staff only)
+// @Tags         reorder-rules
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page  query      int false "Page number" default(1)
+// @Param        limit query      int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.ReorderRuleListResponse} "Reorder rules retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /reorder-rules [get]
+func (h *ReorderHandler) GetReorderRules(ctx *gin.Context) {
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	var response *dto.ReorderRuleListResponse
+	response, err := h.reorderService.GetRules(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Reorder rules retrieve successfully", response)
+}
+
+// DeleteReorderRule godoc
+// @Summary      Remove the reorder point of a SKU
+// @Description  Stop watching the stock of a SKU. Its open alert stays until it is resolved (staff only)
+// @Tags         reorder-rules
+// @Security     BearerAuth
+// @Produce      json
+// @Param        sku  path        string true "SKU"
+// @Success      200  {object}    pkg.Response "Reorder rule removed successfully"
+// @Failure      404  {object}    pkg.Response "Reorder rule not found"
+// @Router       /reorder-rules/{sku} [delete]
+func (h *ReorderHandler) DeleteReorderRule(ctx *gin.Context) {
+	if err := h.reorderService.DeleteRule(ctx.Request.Context(), ctx.Param("sku")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Reorder rule removed successfully", nil)
+}
+
+// GetLowStockAlerts godoc
+// @Summary      List low stock alerts
+// @Description  List the alerts raised for SKUs that fell to their reorder point, newest first, with the draft purchase order proposed to restock them (staff only)
+// @Tags         stock-alerts
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int    false "Page number" default(1)
+// @Param        limit  query     int    false "Page size" default(20)
+// @Param        status query     string false "Filter by status" Enums(open, resolved)
+// @Success      200  {object}    pkg.Response{data=dto.LowStockAlertListResponse} "Low stock alerts retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /stock-alerts [get]
+func (h *ReorderHandler) GetLowStockAlerts(ctx *gin.Context) {
+	var query dto.LowStockAlertListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reorderService.GetAlerts(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Low stock alerts retrieve successfully", response)
+}
+
+// GetStockNotifications godoc
+// @Summary      List stock notifications
+// @Description  Get your notifications about low stock alerts raised by the hourly check of the reorder rules, the latest first (staff only)
+// @Tags         stock-alerts
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query    int false "Page number" default(1)
+// @Param        limit    query    int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.StockNotificationListResponse} "Notifications retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /stock-alerts/notifications [get]
+func (h *ReorderHandler) GetStockNotifications(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reorderService.GetNotifications(ctx.Request.Context(), userID.(uint), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Notifications retrieve successfully", response)
+}
+
+// MarkStockNotificationsRead godoc
+// @Summary      Mark stock notifications as read
+// @Tags         stock-alerts
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response "Notifications marked as read"
+// @Router       /stock-alerts/notifications/read [put]
+func (h *ReorderHandler) MarkStockNotificationsRead(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	if err := h.reorderService.MarkNotificationsRead(ctx.Request.Context(), userID.(uint)); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Notifications marked as read", nil)
+}
@@ -0,0 +1,118 @@
+package purchasing
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	StatusDraft             = "draft"
+	StatusSent              = "sent"
+	StatusPartiallyReceived = "partially_received"
+	StatusReceived          = "received"
+	StatusCancelled         = "cancelled"
+)
+
+// transitions lists the statuses each status can move to. A partially
+// received order stays partially received until its last copies arrive.
+// Received and cancelled orders are final.
+var transitions = map[string][]string{
+	StatusDraft:             {StatusSent, StatusCancelled},
+	StatusSent:              {StatusPartiallyReceived, StatusReceived, StatusCancelled},
+	StatusPartiallyReceived: {StatusPartiallyReceived, StatusReceived},
+}
+
+// CanTransition reports whether a purchase order may move from one status to
+// another.
+func CanTransition(from, to string) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// Supplier is a distributor or publisher the store buys stock from. Costs of
+// its catalog and of its purchase orders are in minor units of Currency.
+type Supplier struct {
+	ID           uint      `gorm:"primaryKey"`
+	Name         string    `gorm:"column:name;size:255;not null;uniqueIndex"`
+	Email        string    `gorm:"column:email;size:255;not null"`
+	Phone        string    `gorm:"column:phone;size:32"`
+	Currency     string    `gorm:"column:currency;size:3;not null"`
+	LeadTimeDays int       `gorm:"column:lead_time_days;not null;default:0"`
+	Active       bool      `gorm:"column:active;not null;default:true"`
+	Note         string    `gorm:"column:note;size:255"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt   time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Supplier) TableName() string {
+	return "suppliers"
+}
+
+// SupplierItem is a book in the catalog of a supplier, with what the supplier
+// charges for a copy and the fewest copies it takes an order for.
+type SupplierItem struct {
+	ID          uint      `gorm:"primaryKey"`
+	SupplierID  uint      `gorm:"column:supplier_id;not null;uniqueIndex:idx_supplier_items_book"`
+	BookID      uint      `gorm:"column:book_id;not null;uniqueIndex:idx_supplier_items_book;index"`
+	SupplierSKU string    `gorm:"column:supplier_sku;size:64"`
+	Cost        int64     `gorm:"column:cost;not null;check:chk_supplier_items_cost,cost >= 0"`
+	MinQuantity int       `gorm:"column:min_quantity;not null;default:1"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt  time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (SupplierItem) TableName() string {
+	return "supplier_items"
+}
+
+// PurchaseOrder is an order of stock from a supplier, delivered to a stock
+// location. Total is the cost of its items in minor units of Currency.
+type PurchaseOrder struct {
+	ID         uint                `gorm:"primaryKey"`
+	SupplierID uint                `gorm:"column:supplier_id;not null;index"`
+	LocationID uint                `gorm:"column:location_id;not null"`
+	Status     string              `gorm:"column:status;size:20;not null;index"`
+	Currency   string              `gorm:"column:currency;size:3;not null"`
+	Total      int64               `gorm:"column:total;not null"`
+	Note       string              `gorm:"column:note;size:255"`
+	ActorID    *uint               `gorm:"column:actor_id"`
+	SentAt     *time.Time          `gorm:"column:sent_at"`
+	ReceivedAt *time.Time          `gorm:"column:received_at"`
+	Supplier   Supplier            `gorm:"foreignKey:SupplierID"`
+	Items      []PurchaseOrderItem `gorm:"foreignKey:PurchaseOrderID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time           `gorm:"column:created_at;autoCreateTime;index"`
+	ModifiedAt time.Time           `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (PurchaseOrder) TableName() string {
+	return "purchase_orders"
+}
+
+// Reference identifies the purchase order in the stock ledger.
+func (p PurchaseOrder) Reference() string {
+	return fmt.Sprintf("purchase_order:%d", p.ID)
+}
+
+// PurchaseOrderItem is a number of copies of a book ordered at UnitCost, of
+// which ReceivedQuantity arrived so far.
+type PurchaseOrderItem struct {
+	ID               uint   `gorm:"primaryKey"`
+	PurchaseOrderID  uint   `gorm:"column:purchase_order_id;not null;index"`
+	BookID           uint   `gorm:"column:book_id;not null;index"`
+	SKU              string `gorm:"column:sku;size:64;not null"`
+	Title            string `gorm:"column:title;size:255;not null"`
+	SupplierSKU      string `gorm:"column:supplier_sku;size:64"`
+	Quantity         int    `gorm:"column:quantity;not null;check:chk_purchase_order_items_quantity,quantity > 0"`
+	ReceivedQuantity int    `gorm:"column:received_quantity;not null;default:0"`
+	UnitCost         int64  `gorm:"column:unit_cost;not null"`
+	LineTotal        int64  `gorm:"column:line_total;not null"`
+}
+
+func (PurchaseOrderItem) TableName() string {
+	return "purchase_order_items"
+}
+
+// Outstanding is how many copies of the line are still to arrive.
+func (i PurchaseOrderItem) Outstanding() int {
+	return i.Quantity - i.ReceivedQuantity
+}
@@ -0,0 +1,248 @@
+package purchasing
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SupplierFilter struct {
+	Active *bool
+	Offset int
+	Limit  int
+}
+
+type PurchaseOrderFilter struct {
+	SupplierID uint
+	Status     string
+	Offset     int
+	Limit      int
+}
+
+type PurchasingRepository interface {
+	CreateSupplier(ctx context.Context, supplier *Supplier) (*Supplier, error)
+	FindSupplierByID(ctx context.Context, id uint) (*Supplier, error)
+	FindSuppliers(ctx context.Context, filter SupplierFilter) ([]Supplier, int64, error)
+	UpdateSupplier(ctx context.Context, supplier *Supplier) (*Supplier, error)
+	// SaveSupplierItems adds books to the catalog of their supplier. Books
+	// already in the catalog get the new cost and minimum quantity.
+	SaveSupplierItems(ctx context.Context, items []SupplierItem) error
+	FindSupplierItems(ctx context.Context, supplierID uint) ([]SupplierItem, error)
+	DeleteSupplierItem(ctx context.Context, supplierID, bookID uint) error
+	// CreatePurchaseOrder saves the purchase order with its items.
+	CreatePurchaseOrder(ctx context.Context, order *PurchaseOrder) (*PurchaseOrder, error)
+	// FindPurchaseOrderByID returns a purchase order with its supplier and
+	// items.
+	FindPurchaseOrderByID(ctx context.Context, id uint) (*PurchaseOrder, error)
+	// FindPurchaseOrderForUpdate locks the purchase order row until the
+	// transaction ends and returns it with its supplier and items.
+	FindPurchaseOrderForUpdate(ctx context.Context, id uint) (*PurchaseOrder, error)
+	FindPurchaseOrders(ctx context.Context, filter PurchaseOrderFilter) ([]PurchaseOrder, int64, error)
+	// UpdatePurchaseOrder saves the status of the purchase order and what
+	// was received of its items.
+	UpdatePurchaseOrder(ctx context.Context, order *PurchaseOrder) error
+	// ReplacePurchaseOrderItems saves the supplier, location, note and total
+	// of the purchase order and replaces its items.
+	ReplacePurchaseOrderItems(ctx context.Context, order *PurchaseOrder) error
+}
+
+type purchasingRepository struct {
+	db *gorm.DB
+}
+
+func NewPurchasingRepository(db *gorm.DB) PurchasingRepository {
+	return &purchasingRepository{
+		db: db,
+	}
+}
+
+func (r *purchasingRepository) CreateSupplier(ctx context.Context, supplier *Supplier) (*Supplier, error) {
+	result := pkg.DB(ctx, r.db).Create(supplier)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return supplier, nil
+}
+
+func (r *purchasingRepository) FindSupplierByID(ctx context.Context, id uint) (*Supplier, error) {
+	var supplier *Supplier
+	result := pkg.DB(ctx, r.db).First(&supplier, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return supplier, nil
+}
+
+func (r *purchasingRepository) FindSuppliers(ctx context.Context, filter SupplierFilter) ([]Supplier, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Supplier{})
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var suppliers []Supplier
+	result := query.Order("name").Offset(filter.Offset).Limit(filter.Limit).Find(&suppliers)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return suppliers, total, nil
+}
+
+func (r *purchasingRepository) UpdateSupplier(ctx context.Context, supplier *Supplier) (*Supplier, error) {
+	result := pkg.DB(ctx, r.db).Model(supplier).Select("*").Omit("id", "created_at").Updates(supplier)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return supplier, nil
+}
+
+func (r *purchasingRepository) SaveSupplierItems(ctx context.Context, items []SupplierItem) error {
+	return pkg.DB(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "supplier_id"}, {Name: "book_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"supplier_sku", "cost", "min_quantity", "modified_at"}),
+		}).
+		Create(&items).Error
+}
+
+func (r *purchasingRepository) FindSupplierItems(ctx context.Context, supplierID uint) ([]SupplierItem, error) {
+	var items []SupplierItem
+	result := pkg.DB(ctx, r.db).Where("supplier_id = ?", supplierID).Order("book_id").Find(&items)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return items, nil
+}
+
+func (r *purchasingRepository) DeleteSupplierItem(ctx context.Context, supplierID, bookID uint) error {
+	result := pkg.DB(ctx, r.db).Where("supplier_id = ? AND book_id = ?", supplierID, bookID).Delete(&SupplierItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *purchasingRepository) CreatePurchaseOrder(ctx context.Context, order *PurchaseOrder) (*PurchaseOrder, error) {
+	result := pkg.DB(ctx, r.db).Omit("Supplier").Create(order)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return order, nil
+}
+
+func (r *purchasingRepository) FindPurchaseOrderByID(ctx context.Context, id uint) (*PurchaseOrder, error) {
+	return r.findPurchaseOrder(pkg.DB(ctx, r.db), id)
+}
+
+func (r *purchasingRepository) FindPurchaseOrderForUpdate(ctx context.Context, id uint) (*PurchaseOrder, error) {
+	return r.findPurchaseOrder(pkg.DB(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "purchase_orders"}}), id)
+}
+
+func (r *purchasingRepository) findPurchaseOrder(db *gorm.DB, id uint) (*PurchaseOrder, error) {
+	var order *PurchaseOrder
+	result := db.
+		Joins("Supplier").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("purchase_order_items.id")
+		}).
+		First(&order, "purchase_orders.id = ?", id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return order, nil
+}
+
+func (r *purchasingRepository) FindPurchaseOrders(ctx context.Context, filter PurchaseOrderFilter) ([]PurchaseOrder, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&PurchaseOrder{})
+	if filter.SupplierID != 0 {
+		query = query.Where("purchase_orders.supplier_id = ?", filter.SupplierID)
+	}
+	if filter.Status != "" {
+		query = query.Where("purchase_orders.status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orders []PurchaseOrder
+	result := query.
+		Joins("Supplier").
+		Preload("Items").
+		Order("purchase_orders.id DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&orders)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return orders, total, nil
+}
+
+func (r *purchasingRepository) UpdatePurchaseOrder(ctx context.Context, order *PurchaseOrder) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PurchaseOrder{}).Where("id = ?", order.ID).Updates(map[string]interface{}{
+			"status":      order.Status,
+			"note":        order.Note,
+			"actor_id":    order.ActorID,
+			"sent_at":     order.SentAt,
+			"received_at": order.ReceivedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		for _, item := range order.Items {
+			err := tx.Model(&PurchaseOrderItem{}).Where("id = ?", item.ID).
+				Update("received_quantity", item.ReceivedQuantity).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *purchasingRepository) ReplacePurchaseOrderItems(ctx context.Context, order *PurchaseOrder) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PurchaseOrder{}).Where("id = ?", order.ID).Updates(map[string]interface{}{
+			"supplier_id": order.SupplierID,
+			"currency":    order.Currency,
+			"location_id": order.LocationID,
+			"note":        order.Note,
+			"total":       order.Total,
+			"actor_id":    order.ActorID,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("purchase_order_id = ?", order.ID).Delete(&PurchaseOrderItem{}).Error; err != nil {
+			return err
+		}
+		for i := range order.Items {
+			order.Items[i].ID = 0
+			order.Items[i].PurchaseOrderID = order.ID
+		}
+		return tx.Create(&order.Items).Error
+	})
+}
@@ -0,0 +1,568 @@
+package purchasing
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/purchasing/api/dto"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSupplierNotFound      = errors.New("supplier not found")
+	ErrDuplicateSupplier     = errors.New("a supplier with this name already exists")
+	ErrSupplierInactive      = errors.New("supplier is inactive")
+	ErrSupplierItemNotFound  = errors.New("book is not in the catalog of the supplier")
+	ErrUnsupportedCurrency   = errors.New("currency is not supported")
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrInvalidItems          = errors.New("purchase order items are invalid")
+	ErrBelowMinimumQuantity  = errors.New("quantity is below the minimum the supplier takes")
+	ErrNotEditable           = errors.New("only draft purchase orders can be changed")
+	ErrInvalidTransition     = errors.New("purchase order cannot move to this status")
+	ErrOverReceipt           = errors.New("more copies received than are outstanding")
+)
+
+type PurchasingService interface {
+	CreateSupplier(ctx context.Context, req dto.SupplierRequest) (*dto.SupplierResponse, error)
+	GetSupplier(ctx context.Context, id uint) (*dto.SupplierResponse, error)
+	GetSuppliers(ctx context.Context, query dto.SupplierListQuery) (*dto.SupplierListResponse, error)
+	UpdateSupplier(ctx context.Context, id uint, req dto.SupplierRequest) (*dto.SupplierResponse, error)
+	GetCatalog(ctx context.Context, supplierID uint) (*dto.SupplierCatalogResponse, error)
+	// SaveCatalogItems adds books to the catalog of a supplier or changes
+	// their cost and minimum quantity.
+	SaveCatalogItems(ctx context.Context, supplierID uint, req dto.SupplierItemsRequest) (*dto.SupplierCatalogResponse, error)
+	DeleteCatalogItem(ctx context.Context, supplierID, bookID uint) error
+	// CreatePurchaseOrder drafts a purchase order of books in the catalog of
+	// an active supplier.
+	CreatePurchaseOrder(ctx context.Context, actorID uint, req dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, id uint) (*dto.PurchaseOrderResponse, error)
+	GetPurchaseOrders(ctx context.Context, query dto.PurchaseOrderListQuery) (*dto.PurchaseOrderListResponse, error)
+	// UpdatePurchaseOrder replaces the supplier, location and items of a
+	// draft purchase order.
+	UpdatePurchaseOrder(ctx context.Context, actorID uint, id uint, req dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error)
+	// Send marks a draft purchase order as sent to its supplier.
+	Send(ctx context.Context, actorID uint, id uint) (*dto.PurchaseOrderResponse, error)
+	// Cancel cancels a purchase order nothing was received of.
+	Cancel(ctx context.Context, actorID uint, id uint) (*dto.PurchaseOrderResponse, error)
+	// Receive records copies of a sent purchase order that arrived, posting
+	// them as stock receipts at its location in the same transaction.
+	Receive(ctx context.Context, actorID uint, id uint, req dto.ReceivePurchaseOrderRequest) (*dto.PurchaseOrderResponse, error)
+}
+
+type purchasingService struct {
+	purchasingRepo PurchasingRepository
+	bookRepo       books.BookRepository
+	inventoryRepo  inventory.InventoryRepository
+	transactor     pkg.Transactor
+}
+
+func NewPurchasingService(purchasingRepo PurchasingRepository, bookRepo books.BookRepository, inventoryRepo inventory.InventoryRepository, transactor pkg.Transactor) PurchasingService {
+	return &purchasingService{
+		purchasingRepo: purchasingRepo,
+		bookRepo:       bookRepo,
+		inventoryRepo:  inventoryRepo,
+		transactor:     transactor,
+	}
+}
+
+func (s *purchasingService) CreateSupplier(ctx context.Context, req dto.SupplierRequest) (*dto.SupplierResponse, error) {
+	supplier := &Supplier{Active: true}
+	if err := applySupplier(supplier, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.purchasingRepo.CreateSupplier(ctx, supplier)
+	if err != nil {
+		return nil, translateSupplierError(err)
+	}
+	return ToSupplierResponse(created), nil
+}
+
+func (s *purchasingService) GetSupplier(ctx context.Context, id uint) (*dto.SupplierResponse, error) {
+	supplier, err := s.findSupplier(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return ToSupplierResponse(supplier), nil
+}
+
+func (s *purchasingService) GetSuppliers(ctx context.Context, query dto.SupplierListQuery) (*dto.SupplierListResponse, error) {
+	suppliers, total, err := s.purchasingRepo.FindSuppliers(ctx, SupplierFilter{
+		Active: query.Active,
+		Offset: query.Offset(),
+		Limit:  query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SupplierListResponse{
+		Suppliers:  make([]dto.SupplierResponse, 0, len(suppliers)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range suppliers {
+		response.Suppliers = append(response.Suppliers, *ToSupplierResponse(&suppliers[i]))
+	}
+	return response, nil
+}
+
+func (s *purchasingService) UpdateSupplier(ctx context.Context, id uint, req dto.SupplierRequest) (*dto.SupplierResponse, error) {
+	supplier, err := s.findSupplier(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applySupplier(supplier, req); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.purchasingRepo.UpdateSupplier(ctx, supplier)
+	if err != nil {
+		return nil, translateSupplierError(err)
+	}
+	return ToSupplierResponse(updated), nil
+}
+
+func (s *purchasingService) GetCatalog(ctx context.Context, supplierID uint) (*dto.SupplierCatalogResponse, error) {
+	supplier, err := s.findSupplier(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+	return s.catalog(ctx, supplier)
+}
+
+func (s *purchasingService) SaveCatalogItems(ctx context.Context, supplierID uint, req dto.SupplierItemsRequest) (*dto.SupplierCatalogResponse, error) {
+	supplier, err := s.findSupplier(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SupplierItem, 0, len(req.Items))
+	seen := make(map[uint]bool, len(req.Items))
+	for _, item := range req.Items {
+		if seen[item.BookID] {
+			return nil, fmt.Errorf("%w: book %d is listed twice", ErrInvalidItems, item.BookID)
+		}
+		seen[item.BookID] = true
+		if _, err := s.findBook(ctx, item.BookID); err != nil {
+			return nil, err
+		}
+
+		minQuantity := item.MinQuantity
+		if minQuantity == 0 {
+			minQuantity = 1
+		}
+		items = append(items, SupplierItem{
+			SupplierID:  supplier.ID,
+			BookID:      item.BookID,
+			SupplierSKU: item.SupplierSKU,
+			Cost:        item.Cost,
+			MinQuantity: minQuantity,
+		})
+	}
+
+	if err := s.purchasingRepo.SaveSupplierItems(ctx, items); err != nil {
+		return nil, err
+	}
+	return s.catalog(ctx, supplier)
+}
+
+func (s *purchasingService) DeleteCatalogItem(ctx context.Context, supplierID, bookID uint) error {
+	if _, err := s.findSupplier(ctx, supplierID); err != nil {
+		return err
+	}
+	err := s.purchasingRepo.DeleteSupplierItem(ctx, supplierID, bookID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrSupplierItemNotFound
+	}
+	return err
+}
+
+func (s *purchasingService) CreatePurchaseOrder(ctx context.Context, actorID uint, req dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	order := &PurchaseOrder{
+		Status:  StatusDraft,
+		ActorID: &actorID,
+	}
+	if err := s.apply(ctx, order, req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.purchasingRepo.CreatePurchaseOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	return ToPurchaseOrderResponse(order), nil
+}
+
+func (s *purchasingService) GetPurchaseOrder(ctx context.Context, id uint) (*dto.PurchaseOrderResponse, error) {
+	order, err := s.purchasingRepo.FindPurchaseOrderByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPurchaseOrderResponse(order), nil
+}
+
+func (s *purchasingService) GetPurchaseOrders(ctx context.Context, query dto.PurchaseOrderListQuery) (*dto.PurchaseOrderListResponse, error) {
+	orders, total, err := s.purchasingRepo.FindPurchaseOrders(ctx, PurchaseOrderFilter{
+		SupplierID: query.SupplierID,
+		Status:     query.Status,
+		Offset:     query.Offset(),
+		Limit:      query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PurchaseOrderListResponse{
+		PurchaseOrders: make([]dto.PurchaseOrderResponse, 0, len(orders)),
+		Pagination:     pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range orders {
+		response.PurchaseOrders = append(response.PurchaseOrders, *ToPurchaseOrderResponse(&orders[i]))
+	}
+	return response, nil
+}
+
+func (s *purchasingService) UpdatePurchaseOrder(ctx context.Context, actorID uint, id uint, req dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	var order *PurchaseOrder
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.purchasingRepo.FindPurchaseOrderForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order.Status != StatusDraft {
+			return ErrNotEditable
+		}
+
+		order.ActorID = &actorID
+		if err := s.apply(ctx, order, req); err != nil {
+			return err
+		}
+		return s.purchasingRepo.ReplacePurchaseOrderItems(ctx, order)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPurchaseOrderResponse(order), nil
+}
+
+func (s *purchasingService) Send(ctx context.Context, actorID uint, id uint) (*dto.PurchaseOrderResponse, error) {
+	return s.advance(ctx, actorID, id, StatusSent)
+}
+
+func (s *purchasingService) Cancel(ctx context.Context, actorID uint, id uint) (*dto.PurchaseOrderResponse, error) {
+	return s.advance(ctx, actorID, id, StatusCancelled)
+}
+
+// advance moves the purchase order to status, locking it so that it cannot
+// be received or changed meanwhile.
+func (s *purchasingService) advance(ctx context.Context, actorID uint, id uint, status string) (*dto.PurchaseOrderResponse, error) {
+	var order *PurchaseOrder
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.purchasingRepo.FindPurchaseOrderForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !CanTransition(order.Status, status) {
+			return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, order.Status, status)
+		}
+		if status == StatusSent && !order.Supplier.Active {
+			return ErrSupplierInactive
+		}
+
+		order.Status = status
+		order.ActorID = &actorID
+		if status == StatusSent {
+			now := time.Now()
+			order.SentAt = &now
+		}
+		return s.purchasingRepo.UpdatePurchaseOrder(ctx, order)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPurchaseOrderResponse(order), nil
+}
+
+func (s *purchasingService) Receive(ctx context.Context, actorID uint, id uint, req dto.ReceivePurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	var order *PurchaseOrder
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Locking the purchase order keeps concurrent deliveries from
+		// receiving the same copies twice.
+		var err error
+		order, err = s.purchasingRepo.FindPurchaseOrderForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order.Status != StatusSent && order.Status != StatusPartiallyReceived {
+			return fmt.Errorf("%w: %s purchase orders cannot be received", ErrInvalidTransition, order.Status)
+		}
+		if err := s.checkLocation(ctx, order.LocationID); err != nil {
+			return err
+		}
+
+		lines := make(map[uint]*PurchaseOrderItem, len(order.Items))
+		for i := range order.Items {
+			lines[order.Items[i].BookID] = &order.Items[i]
+		}
+		movements := make([]inventory.StockMovement, 0, len(req.Items))
+		for _, item := range req.Items {
+			line, found := lines[item.BookID]
+			if !found {
+				return fmt.Errorf("%w: book %d is not in the purchase order or listed twice", ErrInvalidItems, item.BookID)
+			}
+			delete(lines, item.BookID)
+			if left := line.Outstanding(); item.Quantity > left {
+				return fmt.Errorf("%w: %d copies of book %d are outstanding", ErrOverReceipt, left, item.BookID)
+			}
+
+			line.ReceivedQuantity += item.Quantity
+			movements = append(movements, inventory.StockMovement{
+				SKU:        line.SKU,
+				LocationID: order.LocationID,
+				BookID:     line.BookID,
+				Type:       inventory.MovementReceipt,
+				Quantity:   item.Quantity,
+				Reason:     fmt.Sprintf("Received on purchase order %d", order.ID),
+				Reference:  order.Reference(),
+				ActorID:    &actorID,
+			})
+		}
+
+		order.Status = StatusReceived
+		for _, line := range order.Items {
+			if line.Outstanding() > 0 {
+				order.Status = StatusPartiallyReceived
+			}
+		}
+		if order.Status == StatusReceived {
+			now := time.Now()
+			order.ReceivedAt = &now
+		}
+		order.ActorID = &actorID
+		if req.Note != "" {
+			order.Note = req.Note
+		}
+
+		if err := s.purchasingRepo.UpdatePurchaseOrder(ctx, order); err != nil {
+			return err
+		}
+		_, err = s.inventoryRepo.RecordMovements(ctx, movements)
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToPurchaseOrderResponse(order), nil
+}
+
+// apply sets the supplier, location and items of a draft purchase order from
+// the request, pricing the items from the supplier's catalog.
+func (s *purchasingService) apply(ctx context.Context, order *PurchaseOrder, req dto.PurchaseOrderRequest) error {
+	supplier, err := s.findSupplier(ctx, req.SupplierID)
+	if err != nil {
+		return err
+	}
+	if !supplier.Active {
+		return ErrSupplierInactive
+	}
+	if err := s.checkLocation(ctx, req.LocationID); err != nil {
+		return err
+	}
+
+	catalog, err := s.purchasingRepo.FindSupplierItems(ctx, supplier.ID)
+	if err != nil {
+		return err
+	}
+	offered := make(map[uint]SupplierItem, len(catalog))
+	for _, item := range catalog {
+		offered[item.BookID] = item
+	}
+
+	items := make([]PurchaseOrderItem, 0, len(req.Items))
+	var total int64
+	for _, item := range req.Items {
+		offer, found := offered[item.BookID]
+		if !found {
+			return fmt.Errorf("%w: book %d is not in the catalog of the supplier or listed twice", ErrInvalidItems, item.BookID)
+		}
+		delete(offered, item.BookID)
+		if item.Quantity < offer.MinQuantity {
+			return fmt.Errorf("%w: book %d is ordered in at least %d copies", ErrBelowMinimumQuantity, item.BookID, offer.MinQuantity)
+		}
+
+		book, err := s.findBook(ctx, item.BookID)
+		if err != nil {
+			return err
+		}
+		if book.SKU == "" {
+			return fmt.Errorf("%w: book %d has no SKU to stock it under", ErrInvalidItems, item.BookID)
+		}
+
+		unitCost := offer.Cost
+		if item.UnitCost != nil {
+			unitCost = *item.UnitCost
+		}
+		lineTotal := unitCost * int64(item.Quantity)
+		items = append(items, PurchaseOrderItem{
+			BookID:      book.ID,
+			SKU:         book.SKU,
+			Title:       book.Title,
+			SupplierSKU: offer.SupplierSKU,
+			Quantity:    item.Quantity,
+			UnitCost:    unitCost,
+			LineTotal:   lineTotal,
+		})
+		total += lineTotal
+	}
+
+	order.SupplierID = supplier.ID
+	order.Supplier = *supplier
+	order.Currency = supplier.Currency
+	order.LocationID = req.LocationID
+	order.Note = req.Note
+	order.Items = items
+	order.Total = total
+	return nil
+}
+
+func (s *purchasingService) catalog(ctx context.Context, supplier *Supplier) (*dto.SupplierCatalogResponse, error) {
+	items, err := s.purchasingRepo.FindSupplierItems(ctx, supplier.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SupplierCatalogResponse{
+		SupplierID: supplier.ID,
+		Currency:   supplier.Currency,
+		Items:      make([]dto.SupplierItemResponse, 0, len(items)),
+	}
+	for _, item := range items {
+		response.Items = append(response.Items, dto.SupplierItemResponse{
+			BookID:      item.BookID,
+			SupplierSKU: item.SupplierSKU,
+			Cost:        item.Cost,
+			MinQuantity: item.MinQuantity,
+		})
+	}
+	return response, nil
+}
+
+func (s *purchasingService) findSupplier(ctx context.Context, id uint) (*Supplier, error) {
+	supplier, err := s.purchasingRepo.FindSupplierByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSupplierNotFound
+	}
+	return supplier, err
+}
+
+func (s *purchasingService) findBook(ctx context.Context, id uint) (*books.Book, error) {
+	book, err := s.bookRepo.FindByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %d", books.ErrBookNotFound, id)
+	}
+	return book, err
+}
+
+func (s *purchasingService) checkLocation(ctx context.Context, id uint) error {
+	location, err := s.inventoryRepo.FindLocationByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return inventory.ErrLocationNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !location.Active {
+		return inventory.ErrLocationInactive
+	}
+	return nil
+}
+
+func applySupplier(supplier *Supplier, req dto.SupplierRequest) error {
+	if _, ok := money.Lookup(req.Currency); !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedCurrency, req.Currency)
+	}
+	supplier.Name = req.Name
+	supplier.Email = req.Email
+	supplier.Phone = req.Phone
+	supplier.Currency = req.Currency
+	supplier.LeadTimeDays = req.LeadTimeDays
+	supplier.Note = req.Note
+	if req.Active != nil {
+		supplier.Active = *req.Active
+	}
+	return nil
+}
+
+func ToSupplierResponse(supplier *Supplier) *dto.SupplierResponse {
+	return &dto.SupplierResponse{
+		ID:           supplier.ID,
+		Name:         supplier.Name,
+		Email:        supplier.Email,
+		Phone:        supplier.Phone,
+		Currency:     supplier.Currency,
+		LeadTimeDays: supplier.LeadTimeDays,
+		Active:       supplier.Active,
+		Note:         supplier.Note,
+		CreatedAt:    supplier.CreatedAt,
+		ModifiedAt:   supplier.ModifiedAt,
+	}
+}
+
+func ToPurchaseOrderResponse(order *PurchaseOrder) *dto.PurchaseOrderResponse {
+	response := &dto.PurchaseOrderResponse{
+		ID:            order.ID,
+		SupplierID:    order.SupplierID,
+		SupplierName:  order.Supplier.Name,
+		SupplierEmail: order.Supplier.Email,
+		LocationID:    order.LocationID,
+		Status:        order.Status,
+		Currency:      order.Currency,
+		Total:         order.Total,
+		Note:          order.Note,
+		Items:         make([]dto.PurchaseOrderItemResponse, 0, len(order.Items)),
+		SentAt:        order.SentAt,
+		ReceivedAt:    order.ReceivedAt,
+		CreatedAt:     order.CreatedAt,
+		ModifiedAt:    order.ModifiedAt,
+	}
+	for _, item := range order.Items {
+		response.Items = append(response.Items, dto.PurchaseOrderItemResponse{
+			BookID:           item.BookID,
+			SKU:              item.SKU,
+			Title:            item.Title,
+			SupplierSKU:      item.SupplierSKU,
+			Quantity:         item.Quantity,
+			ReceivedQuantity: item.ReceivedQuantity,
+			UnitCost:         item.UnitCost,
+			LineTotal:        item.LineTotal,
+		})
+	}
+	return response
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrPurchaseOrderNotFound
+	default:
+		return err
+	}
+}
+
+func translateSupplierError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrSupplierNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateSupplier
+	default:
+		return err
+	}
+}
@@ -0,0 +1,98 @@
+package purchasing
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// SalesWindow is how far back sales are counted to tell how fast a SKU
+	// sells.
+	SalesWindow = 30 * 24 * time.Hour
+	// CoverDays is how many days of sales a proposed purchase order covers
+	// beyond the lead time.
+	CoverDays = 30
+)
+
+const (
+	AlertOpen     = "open"
+	AlertResolved = "resolved"
+)
+
+// NotificationLowStock tells staff that low stock alerts were raised.
+const NotificationLowStock = "low_stock"
+
+// ReorderRule tells when a SKU runs low: once the copies available and on
+// order fall to ReorderPoint. Restocks are delivered to LocationID, ordered
+// from SupplierID when set and otherwise from an active supplier with the book
+// in its catalog. LeadTimeDays, when set, replaces the lead time of the
+// supplier.
+type ReorderRule struct {
+	ID           uint      `gorm:"primaryKey"`
+	SKU          string    `gorm:"column:sku;size:64;not null;uniqueIndex"`
+	BookID       uint      `gorm:"column:book_id;not null;index"`
+	ReorderPoint int       `gorm:"column:reorder_point;not null;check:chk_reorder_rules_reorder_point,reorder_point >= 0"`
+	LeadTimeDays *int      `gorm:"column:lead_time_days"`
+	SupplierID   *uint     `gorm:"column:supplier_id"`
+	LocationID   uint      `gorm:"column:location_id;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt   time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (ReorderRule) TableName() string {
+	return "reorder_rules"
+}
+
+// LowStockAlert tells staff that a SKU fell to its reorder point. Available
+// and OnOrder are what there was when the alert was raised, and
+// SuggestedQuantity what the draft purchase order proposes, if a supplier
+// could be found for it. A SKU has at most one open alert, resolved once its
+// stock is back above the reorder point.
+type LowStockAlert struct {
+	ID                uint       `gorm:"primaryKey"`
+	SKU               string     `gorm:"column:sku;size:64;not null;uniqueIndex:idx_low_stock_alerts_open,where:resolved_at IS NULL"`
+	BookID            uint       `gorm:"column:book_id;not null"`
+	ReorderPoint      int        `gorm:"column:reorder_point;not null"`
+	Available         int        `gorm:"column:available;not null"`
+	OnOrder           int        `gorm:"column:on_order;not null"`
+	DailySales        float64    `gorm:"column:daily_sales;not null"`
+	SuggestedQuantity int        `gorm:"column:suggested_quantity;not null"`
+	PurchaseOrderID   *uint      `gorm:"column:purchase_order_id"`
+	ResolvedAt        *time.Time `gorm:"column:resolved_at;index"`
+	CreatedAt         time.Time  `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (LowStockAlert) TableName() string {
+	return "low_stock_alerts"
+}
+
+// Status tells whether the alert is still open.
+func (a LowStockAlert) Status() string {
+	if a.ResolvedAt != nil {
+		return AlertResolved
+	}
+	return AlertOpen
+}
+
+// StockNotification tells a staff user that a check of the reorder rules
+// raised low stock alerts.
+type StockNotification struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"column:user_id;not null;index"`
+	Type      string     `gorm:"column:type;size:32;not null"`
+	Message   string     `gorm:"column:message;size:255;not null"`
+	ReadAt    *time.Time `gorm:"column:read_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (StockNotification) TableName() string {
+	return "stock_notifications"
+}
+
+// SuggestQuantity sizes a restock so that the copies available and on order
+// last through the lead time and CoverDays more at the daily sales rate, and
+// do not leave the SKU at its reorder point. It never goes below minQuantity.
+func SuggestQuantity(dailySales float64, leadTimeDays, reorderPoint, position, minQuantity int) int {
+	demand := int(math.Ceil(dailySales * float64(leadTimeDays+CoverDays)))
+	return max(demand+reorderPoint+1-position, minQuantity, 1)
+}
@@ -0,0 +1,223 @@
+package purchasing
+
+import (
+	"bookstore-framework/internal/users"
+	"bookstore-framework/pkg"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Offer is a book in the catalog of an active supplier, with the terms of
+// the supplier.
+type Offer struct {
+	SupplierID   uint   `gorm:"column:supplier_id"`
+	BookID       uint   `gorm:"column:book_id"`
+	SupplierSKU  string `gorm:"column:supplier_sku"`
+	Cost         int64  `gorm:"column:cost"`
+	MinQuantity  int    `gorm:"column:min_quantity"`
+	Currency     string `gorm:"column:currency"`
+	LeadTimeDays int    `gorm:"column:lead_time_days"`
+}
+
+type AlertFilter struct {
+	// Status is open or resolved, any when empty.
+	Status string
+	Offset int
+	Limit  int
+}
+
+type ReorderRepository interface {
+	// SaveRule creates the rule of its SKU or replaces it.
+	SaveRule(ctx context.Context, rule *ReorderRule) (*ReorderRule, error)
+	FindRules(ctx context.Context, offset, limit int) ([]ReorderRule, int64, error)
+	// FindRulesAfter returns up to limit rules in id order, from the first
+	// after afterID.
+	FindRulesAfter(ctx context.Context, afterID uint, limit int) ([]ReorderRule, error)
+	DeleteRule(ctx context.Context, sku string) error
+	// OnOrderBySKU totals the copies of purchase orders that are drafted or
+	// sent and have not arrived yet, SKUs with none are omitted.
+	OnOrderBySKU(ctx context.Context, skus []string) (map[string]int, error)
+	// FindOffers returns the offers of active suppliers for the books, the
+	// suppliers with the shortest lead time first.
+	FindOffers(ctx context.Context, bookIDs []uint) ([]Offer, error)
+	FindOpenAlerts(ctx context.Context, skus []string) ([]LowStockAlert, error)
+	FindAlerts(ctx context.Context, filter AlertFilter) ([]LowStockAlert, int64, error)
+	CreateAlerts(ctx context.Context, alerts []LowStockAlert) error
+	ResolveAlerts(ctx context.Context, ids []uint, now time.Time) error
+	// NotifyStaff sends a notification to every staff user and returns how
+	// many were sent.
+	NotifyStaff(ctx context.Context, notificationType, message string, now time.Time) (int64, error)
+	FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]StockNotification, int64, error)
+	MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error
+}
+
+type reorderRepository struct {
+	db *gorm.DB
+}
+
+func NewReorderRepository(db *gorm.DB) ReorderRepository {
+	return &reorderRepository{
+		db: db,
+	}
+}
+
+func (r *reorderRepository) SaveRule(ctx context.Context, rule *ReorderRule) (*ReorderRule, error) {
+	result := pkg.DB(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "sku"}},
+			DoUpdates: clause.AssignmentColumns([]string{"book_id", "reorder_point", "lead_time_days", "supplier_id", "location_id", "modified_at"}),
+		}).
+		Create(rule)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rule, nil
+}
+
+func (r *reorderRepository) FindRules(ctx context.Context, offset, limit int) ([]ReorderRule, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&ReorderRule{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rules []ReorderRule
+	result := query.Order("sku").Offset(offset).Limit(limit).Find(&rules)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return rules, total, nil
+}
+
+func (r *reorderRepository) FindRulesAfter(ctx context.Context, afterID uint, limit int) ([]ReorderRule, error) {
+	var rules []ReorderRule
+	result := pkg.DB(ctx, r.db).Where("id > ?", afterID).Order("id").Limit(limit).Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rules, nil
+}
+
+func (r *reorderRepository) DeleteRule(ctx context.Context, sku string) error {
+	result := pkg.DB(ctx, r.db).Where("sku = ?", sku).Delete(&ReorderRule{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *reorderRepository) OnOrderBySKU(ctx context.Context, skus []string) (map[string]int, error) {
+	var rows []struct {
+		SKU     string
+		OnOrder int
+	}
+	err := pkg.DB(ctx, r.db).Model(&PurchaseOrderItem{}).
+		Select("purchase_order_items.sku, SUM(purchase_order_items.quantity - purchase_order_items.received_quantity) AS on_order").
+		Joins("JOIN purchase_orders ON purchase_orders.id = purchase_order_items.purchase_order_id").
+		Where("purchase_order_items.sku IN ? AND purchase_orders.status IN ?", skus, []string{StatusDraft, StatusSent, StatusPartiallyReceived}).
+		Group("purchase_order_items.sku").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	onOrder := make(map[string]int, len(rows))
+	for _, row := range rows {
+		onOrder[row.SKU] = row.OnOrder
+	}
+	return onOrder, nil
+}
+
+func (r *reorderRepository) FindOffers(ctx context.Context, bookIDs []uint) ([]Offer, error) {
+	var offers []Offer
+	result := pkg.DB(ctx, r.db).Model(&SupplierItem{}).
+		Select("supplier_items.supplier_id, supplier_items.book_id, supplier_items.supplier_sku, supplier_items.cost, supplier_items.min_quantity, suppliers.currency, suppliers.lead_time_days").
+		Joins("JOIN suppliers ON suppliers.id = supplier_items.supplier_id").
+		Where("supplier_items.book_id IN ? AND suppliers.active", bookIDs).
+		Order("suppliers.lead_time_days, suppliers.id").
+		Scan(&offers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return offers, nil
+}
+
+func (r *reorderRepository) FindOpenAlerts(ctx context.Context, skus []string) ([]LowStockAlert, error) {
+	var alerts []LowStockAlert
+	result := pkg.DB(ctx, r.db).Where("sku IN ? AND resolved_at IS NULL", skus).Find(&alerts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return alerts, nil
+}
+
+func (r *reorderRepository) FindAlerts(ctx context.Context, filter AlertFilter) ([]LowStockAlert, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&LowStockAlert{})
+	switch filter.Status {
+	case AlertOpen:
+		query = query.Where("resolved_at IS NULL")
+	case AlertResolved:
+		query = query.Where("resolved_at IS NOT NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var alerts []LowStockAlert
+	result := query.Order("created_at DESC, id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&alerts)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return alerts, total, nil
+}
+
+func (r *reorderRepository) CreateAlerts(ctx context.Context, alerts []LowStockAlert) error {
+	return pkg.DB(ctx, r.db).Create(&alerts).Error
+}
+
+func (r *reorderRepository) ResolveAlerts(ctx context.Context, ids []uint, now time.Time) error {
+	return pkg.DB(ctx, r.db).Model(&LowStockAlert{}).
+		Where("id IN ? AND resolved_at IS NULL", ids).
+		Update("resolved_at", now).Error
+}
+
+func (r *reorderRepository) NotifyStaff(ctx context.Context, notificationType, message string, now time.Time) (int64, error) {
+	result := pkg.DB(ctx, r.db).Exec(`INSERT INTO stock_notifications (user_id, type, message, created_at)
+		SELECT id, ?, ?, ? FROM users WHERE role = ? AND deleted_at IS NULL`,
+		notificationType, message, now, users.RoleStaff)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *reorderRepository) FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]StockNotification, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&StockNotification{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []StockNotification
+	result := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&notifications)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return notifications, total, nil
+}
+
+func (r *reorderRepository) MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error {
+	return pkg.DB(ctx, r.db).Model(&StockNotification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		UpdateColumn("read_at", now).Error
+}
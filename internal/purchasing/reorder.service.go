@@ -0,0 +1,410 @@
+package purchasing
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/purchasing/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// reorderBatch is how many reorder rules CheckStock looks at at once.
+const reorderBatch = 100
+
+var ErrReorderRuleNotFound = errors.New("reorder rule not found")
+
+type ReorderService interface {
+	SaveRule(ctx context.Context, sku string, req dto.ReorderRuleRequest) (*dto.ReorderRuleResponse, error)
+	GetRules(ctx context.Context, query pkg.PaginationQuery) (*dto.ReorderRuleListResponse, error)
+	DeleteRule(ctx context.Context, sku string) error
+	GetAlerts(ctx context.Context, query dto.LowStockAlertListQuery) (*dto.LowStockAlertListResponse, error)
+	// CheckStock raises an alert for every SKU whose copies available and on
+	// order fell to its reorder point, and proposes draft purchase orders to
+	// restock them, one per supplier and location. Alerts of SKUs that are
+	// back above their reorder point are resolved. Staff are notified when
+	// alerts are raised.
+	CheckStock(ctx context.Context) error
+	GetNotifications(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.StockNotificationListResponse, error)
+	MarkNotificationsRead(ctx context.Context, userID uint) error
+}
+
+type reorderService struct {
+	reorderRepo    ReorderRepository
+	purchasingRepo PurchasingRepository
+	bookRepo       books.BookRepository
+	inventoryRepo  inventory.InventoryRepository
+	transactor     pkg.Transactor
+}
+
+func NewReorderService(reorderRepo ReorderRepository, purchasingRepo PurchasingRepository, bookRepo books.BookRepository, inventoryRepo inventory.InventoryRepository, transactor pkg.Transactor) ReorderService {
+	return &reorderService{
+		reorderRepo:    reorderRepo,
+		purchasingRepo: purchasingRepo,
+		bookRepo:       bookRepo,
+		inventoryRepo:  inventoryRepo,
+		transactor:     transactor,
+	}
+}
+
+func (s *reorderService) SaveRule(ctx context.Context, sku string, req dto.ReorderRuleRequest) (*dto.ReorderRuleResponse, error) {
+	book, err := s.bookRepo.FindBySKU(ctx, sku)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, books.ErrBookNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.SupplierID != nil {
+		_, err := s.purchasingRepo.FindSupplierByID(ctx, *req.SupplierID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSupplierNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	location, err := s.inventoryRepo.FindLocationByID(ctx, req.LocationID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, inventory.ErrLocationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !location.Active {
+		return nil, inventory.ErrLocationInactive
+	}
+
+	rule, err := s.reorderRepo.SaveRule(ctx, &ReorderRule{
+		SKU:          book.SKU,
+		BookID:       book.ID,
+		ReorderPoint: req.ReorderPoint,
+		LeadTimeDays: req.LeadTimeDays,
+		SupplierID:   req.SupplierID,
+		LocationID:   req.LocationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ToReorderRuleResponse(rule), nil
+}
+
+func (s *reorderService) GetRules(ctx context.Context, query pkg.PaginationQuery) (*dto.ReorderRuleListResponse, error) {
+	rules, total, err := s.reorderRepo.FindRules(ctx, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ReorderRuleListResponse{
+		ReorderRules: make([]dto.ReorderRuleResponse, 0, len(rules)),
+		Pagination:   pkg.NewPaginationMeta(query, total),
+	}
+	for i := range rules {
+		response.ReorderRules = append(response.ReorderRules, *ToReorderRuleResponse(&rules[i]))
+	}
+	return response, nil
+}
+
+func (s *reorderService) DeleteRule(ctx context.Context, sku string) error {
+	err := s.reorderRepo.DeleteRule(ctx, sku)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrReorderRuleNotFound
+	}
+	return err
+}
+
+func (s *reorderService) GetAlerts(ctx context.Context, query dto.LowStockAlertListQuery) (*dto.LowStockAlertListResponse, error) {
+	alerts, total, err := s.reorderRepo.FindAlerts(ctx, AlertFilter{
+		Status: query.Status,
+		Offset: query.Offset(),
+		Limit:  query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.LowStockAlertListResponse{
+		Alerts:     make([]dto.LowStockAlertResponse, 0, len(alerts)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range alerts {
+		response.Alerts = append(response.Alerts, *ToLowStockAlertResponse(&alerts[i]))
+	}
+	return response, nil
+}
+
+func (s *reorderService) CheckStock(ctx context.Context) error {
+	var errs []error
+	var raised int
+	var afterID uint
+	for {
+		rules, err := s.reorderRepo.FindRulesAfter(ctx, afterID, reorderBatch)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		if len(rules) == 0 {
+			break
+		}
+		afterID = rules[len(rules)-1].ID
+
+		n, err := s.checkRules(ctx, rules)
+		raised += n
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if len(rules) < reorderBatch {
+			break
+		}
+	}
+	if raised > 0 {
+		log.Printf("Raised %d low stock alerts", raised)
+		if err := s.notifyStaff(ctx, raised); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notifyStaff tells every staff user how many alerts were raised.
+func (s *reorderService) notifyStaff(ctx context.Context, raised int) error {
+	message := "A SKU fell to its reorder point."
+	if raised > 1 {
+		message = fmt.Sprintf("%d SKUs fell to their reorder point.", raised)
+	}
+	message += " See the open stock alerts and the purchase orders drafted to restock them."
+	_, err := s.reorderRepo.NotifyStaff(ctx, NotificationLowStock, message, time.Now())
+	return err
+}
+
+func (s *reorderService) GetNotifications(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.StockNotificationListResponse, error) {
+	notifications, total, err := s.reorderRepo.FindNotifications(ctx, userID, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.StockNotificationListResponse{
+		Notifications: make([]dto.StockNotificationResponse, 0, len(notifications)),
+		Pagination:    pkg.NewPaginationMeta(query, total),
+	}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, dto.StockNotificationResponse{
+			ID:        notification.ID,
+			Type:      notification.Type,
+			Message:   notification.Message,
+			Read:      notification.ReadAt != nil,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+func (s *reorderService) MarkNotificationsRead(ctx context.Context, userID uint) error {
+	return s.reorderRepo.MarkNotificationsRead(ctx, userID, time.Now())
+}
+
+// proposal is a draft purchase order with the alerts it restocks.
+type proposal struct {
+	order  *PurchaseOrder
+	alerts []LowStockAlert
+}
+
+// checkRules raises the alerts of a batch of rules and returns how many.
+func (s *reorderService) checkRules(ctx context.Context, rules []ReorderRule) (int, error) {
+	skus := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		skus = append(skus, rule.SKU)
+	}
+	available, err := s.inventoryRepo.AvailableBySKU(ctx, skus)
+	if err != nil {
+		return 0, err
+	}
+	onOrder, err := s.reorderRepo.OnOrderBySKU(ctx, skus)
+	if err != nil {
+		return 0, err
+	}
+	alerts, err := s.reorderRepo.FindOpenAlerts(ctx, skus)
+	if err != nil {
+		return 0, err
+	}
+	open := make(map[string]LowStockAlert, len(alerts))
+	for _, alert := range alerts {
+		open[alert.SKU] = alert
+	}
+
+	// An alert stays open until copies are back on the shelf: a purchase
+	// order on the way keeps a new one from being raised, but does not
+	// resolve it.
+	var resolved []uint
+	var low []ReorderRule
+	for _, rule := range rules {
+		alert, alerted := open[rule.SKU]
+		switch {
+		case alerted && available[rule.SKU] > rule.ReorderPoint:
+			resolved = append(resolved, alert.ID)
+		case !alerted && available[rule.SKU]+onOrder[rule.SKU] <= rule.ReorderPoint:
+			low = append(low, rule)
+		}
+	}
+	if len(resolved) > 0 {
+		if err := s.reorderRepo.ResolveAlerts(ctx, resolved, time.Now()); err != nil {
+			return 0, err
+		}
+	}
+	if len(low) == 0 {
+		return 0, nil
+	}
+
+	lowSKUs := make([]string, 0, len(low))
+	bookIDs := make([]uint, 0, len(low))
+	for _, rule := range low {
+		lowSKUs = append(lowSKUs, rule.SKU)
+		bookIDs = append(bookIDs, rule.BookID)
+	}
+	sold, err := s.inventoryRepo.SoldBySKU(ctx, lowSKUs, time.Now().Add(-SalesWindow))
+	if err != nil {
+		return 0, err
+	}
+	found, err := s.reorderRepo.FindOffers(ctx, bookIDs)
+	if err != nil {
+		return 0, err
+	}
+	offers := make(map[uint][]Offer)
+	for _, offer := range found {
+		offers[offer.BookID] = append(offers[offer.BookID], offer)
+	}
+
+	type draftKey struct{ supplierID, locationID uint }
+	drafts := make(map[draftKey]*proposal)
+	var proposals []*proposal
+	unassigned := &proposal{}
+	var errs []error
+	for _, rule := range low {
+		position := available[rule.SKU] + onOrder[rule.SKU]
+		alert := LowStockAlert{
+			SKU:          rule.SKU,
+			BookID:       rule.BookID,
+			ReorderPoint: rule.ReorderPoint,
+			Available:    available[rule.SKU],
+			OnOrder:      onOrder[rule.SKU],
+			DailySales:   float64(sold[rule.SKU]) / (SalesWindow.Hours() / 24),
+		}
+
+		offer := pickOffer(rule, offers[rule.BookID])
+		if offer == nil {
+			alert.SuggestedQuantity = SuggestQuantity(alert.DailySales, leadTime(rule, 0), rule.ReorderPoint, position, 1)
+			unassigned.alerts = append(unassigned.alerts, alert)
+			continue
+		}
+		alert.SuggestedQuantity = SuggestQuantity(alert.DailySales, leadTime(rule, offer.LeadTimeDays), rule.ReorderPoint, position, offer.MinQuantity)
+
+		book, err := s.bookRepo.FindByID(ctx, rule.BookID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reorder rule of %s: %w", rule.SKU, err))
+			continue
+		}
+
+		key := draftKey{offer.SupplierID, rule.LocationID}
+		draft, found := drafts[key]
+		if !found {
+			draft = &proposal{order: &PurchaseOrder{
+				SupplierID: offer.SupplierID,
+				LocationID: rule.LocationID,
+				Status:     StatusDraft,
+				Currency:   offer.Currency,
+				Note:       "Proposed for low stock",
+			}}
+			drafts[key] = draft
+			proposals = append(proposals, draft)
+		}
+		lineTotal := offer.Cost * int64(alert.SuggestedQuantity)
+		draft.order.Items = append(draft.order.Items, PurchaseOrderItem{
+			BookID:      book.ID,
+			SKU:         rule.SKU,
+			Title:       book.Title,
+			SupplierSKU: offer.SupplierSKU,
+			Quantity:    alert.SuggestedQuantity,
+			UnitCost:    offer.Cost,
+			LineTotal:   lineTotal,
+		})
+		draft.order.Total += lineTotal
+		draft.alerts = append(draft.alerts, alert)
+	}
+	if len(unassigned.alerts) > 0 {
+		proposals = append(proposals, unassigned)
+	}
+
+	var raised int
+	for _, draft := range proposals {
+		err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if draft.order != nil {
+				if _, err := s.purchasingRepo.CreatePurchaseOrder(ctx, draft.order); err != nil {
+					return err
+				}
+				for i := range draft.alerts {
+					draft.alerts[i].PurchaseOrderID = &draft.order.ID
+				}
+			}
+			return s.reorderRepo.CreateAlerts(ctx, draft.alerts)
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		raised += len(draft.alerts)
+	}
+	return raised, errors.Join(errs...)
+}
+
+// pickOffer returns the offer of the supplier of the rule, or the first
+// offer when the rule names no supplier. Offers come with the shortest lead
+// time first.
+func pickOffer(rule ReorderRule, offers []Offer) *Offer {
+	for i := range offers {
+		if rule.SupplierID == nil || offers[i].SupplierID == *rule.SupplierID {
+			return &offers[i]
+		}
+	}
+	return nil
+}
+
+func leadTime(rule ReorderRule, supplierLeadTime int) int {
+	if rule.LeadTimeDays != nil {
+		return *rule.LeadTimeDays
+	}
+	return supplierLeadTime
+}
+
+func ToReorderRuleResponse(rule *ReorderRule) *dto.ReorderRuleResponse {
+	return &dto.ReorderRuleResponse{
+		SKU:          rule.SKU,
+		BookID:       rule.BookID,
+		ReorderPoint: rule.ReorderPoint,
+		LeadTimeDays: rule.LeadTimeDays,
+		SupplierID:   rule.SupplierID,
+		LocationID:   rule.LocationID,
+		CreatedAt:    rule.CreatedAt,
+		ModifiedAt:   rule.ModifiedAt,
+	}
+}
+
+func ToLowStockAlertResponse(alert *LowStockAlert) *dto.LowStockAlertResponse {
+	return &dto.LowStockAlertResponse{
+		ID:                alert.ID,
+		SKU:               alert.SKU,
+		BookID:            alert.BookID,
+		Status:            alert.Status(),
+		ReorderPoint:      alert.ReorderPoint,
+		Available:         alert.Available,
+		OnOrder:           alert.OnOrder,
+		DailySales:        alert.DailySales,
+		SuggestedQuantity: alert.SuggestedQuantity,
+		PurchaseOrderID:   alert.PurchaseOrderID,
+		ResolvedAt:        alert.ResolvedAt,
+		CreatedAt:         alert.CreatedAt,
+	}
+}
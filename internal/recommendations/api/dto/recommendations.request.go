@@ -0,0 +1,6 @@
+package dto
+
+// RecommendationQuery represents the query string of the recommendation endpoints
+type RecommendationQuery struct {
+	Limit int `form:"limit,default=10" binding:"min=1,max=20"`
+}
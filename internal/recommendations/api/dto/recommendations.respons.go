@@ -0,0 +1,15 @@
+package dto
+
+// RecommendationResponse is a recommended book. Score ranks the
+// recommendations, CoPurchases and CoViews count the customers who bought or
+// viewed it along with the book, or the history, it is recommended for.
+type RecommendationResponse struct {
+	BookID      uint    `json:"book_id"`
+	Title       string  `json:"title"`
+	Subtitle    string  `json:"subtitle,omitempty"`
+	Price       int64   `json:"price"`
+	Currency    string  `json:"currency"`
+	Score       float64 `json:"score"`
+	CoPurchases int     `json:"co_purchases"`
+	CoViews     int     `json:"co_views"`
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"bookstore-framework/internal/recommendations"
+	"bookstore-framework/internal/recommendations/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecommendationHandler struct {
+	recommendationService recommendations.RecommendationService
+}
+
+func NewRecommendationHandler(recommendationService recommendations.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+	}
+}
+
+// GetBookRecommendations godoc
+// @Summary      Customers also bought
+// @Description  Get the books customers bought or viewed along with a book, the most similar first. Recomputed by a batch job
+// @Tags         recommendations
+// @Produce      json
+// @Param        id     path     int true  "Book ID"
+// @Param        limit  query    int false "Number of books" default(10)
+// @Success      200  {object}    pkg.Response{data=[]dto.RecommendationResponse} "Recommendations retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /books/{id}/recommendations [get]
+func (h *RecommendationHandler) GetBookRecommendations(ctx *gin.Context) {
+	bookID, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	var query dto.RecommendationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.recommendationService.GetBookRecommendations(ctx.Request.Context(), bookID, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Recommendations retrieve successfully", response)
+}
+
+// GetUserRecommendations godoc
+// @Summary      Recommended for you
+// @Description  Get books similar to those you bought or recently viewed
+// @Tags         recommendations
+// @Security     BearerAuth
+// @Produce      json
+// @Param        limit  query    int false "Number of books" default(10)
+// @Success      200  {object}    pkg.Response{data=[]dto.RecommendationResponse} "Recommendations retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /recommendations [get]
+func (h *RecommendationHandler) GetUserRecommendations(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var query dto.RecommendationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.recommendationService.GetUserRecommendations(ctx.Request.Context(), userID.(uint), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Recommendations retrieve successfully", response)
+}
+
+// RecordViews records that the logged in user viewed the book in the :id
+// parameter, once the handler served it. A failure to record is logged and
+// never fails the request.
+func (h *RecommendationHandler) RecordViews(ctx *gin.Context) {
+	ctx.Next()
+
+	userID, exist := ctx.Get("userID")
+	if !exist || ctx.Writer.Status() != http.StatusOK {
+		return
+	}
+	bookID, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		return
+	}
+	if err := h.recommendationService.RecordView(ctx.Request.Context(), userID.(uint), bookID); err != nil {
+		log.Printf("Failed to record view of book %d: %v", bookID, err)
+	}
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, recommendations.ErrBookNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
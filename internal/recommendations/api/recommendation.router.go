@@ -0,0 +1,39 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/recommendations"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func newRecommendationHandler(db *gorm.DB) *RecommendationHandler {
+	recommendationService := recommendations.NewRecommendationService(
+		recommendations.NewRecommendationRepository(db),
+		books.NewBookRepository(db),
+	)
+	return NewRecommendationHandler(recommendationService)
+}
+
+// BookRecommendationsRoutes serves the recommendations for the book in the
+// :id parameter of the router.
+func BookRecommendationsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	recommendationHandler := newRecommendationHandler(db)
+
+	router.GET("", recommendationHandler.GetBookRecommendations)
+}
+
+func RecommendationsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	recommendationHandler := newRecommendationHandler(db)
+
+	router.Use(middleware.JWTAuth())
+	router.GET("", recommendationHandler.GetUserRecommendations)
+}
+
+// RecordViews returns the middleware recording the views of logged in users
+// on a book route with an :id parameter.
+func RecordViews(db *gorm.DB) gin.HandlerFunc {
+	return newRecommendationHandler(db).RecordViews
+}
@@ -0,0 +1,36 @@
+package recommendations
+
+import (
+	"bookstore-framework/internal/books"
+	"time"
+)
+
+// BookView records that a logged in user opened a book. Views feed the
+// co-view similarity and are kept for ViewWindow.
+type BookView struct {
+	ID       uint      `gorm:"primaryKey"`
+	UserID   uint      `gorm:"column:user_id;not null;index:idx_book_views_user_viewed"`
+	BookID   uint      `gorm:"column:book_id;not null;index"`
+	ViewedAt time.Time `gorm:"column:viewed_at;not null;index:idx_book_views_user_viewed;index"`
+}
+
+func (BookView) TableName() string {
+	return "book_views"
+}
+
+// BookNeighbour is a book similar to BookID, one of its top neighbours as of
+// the last batch run. Score blends the co-purchase and co-view similarities,
+// CoPurchases and CoViews count the customers behind them.
+type BookNeighbour struct {
+	BookID      uint       `gorm:"primaryKey;autoIncrement:false"`
+	NeighbourID uint       `gorm:"primaryKey;autoIncrement:false"`
+	Neighbour   books.Book `gorm:"foreignKey:NeighbourID"`
+	Score       float64    `gorm:"column:score;not null;index"`
+	CoPurchases int        `gorm:"column:co_purchases;not null;default:0"`
+	CoViews     int        `gorm:"column:co_views;not null;default:0"`
+	ComputedAt  time.Time  `gorm:"column:computed_at;not null"`
+}
+
+func (BookNeighbour) TableName() string {
+	return "book_neighbours"
+}
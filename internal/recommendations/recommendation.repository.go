@@ -0,0 +1,218 @@
+package recommendations
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/pkg"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const neighbourBatch = 1000
+
+// Pair counts the customers who bought, or viewed, both BookID and
+// NeighbourID. Every pair is returned in both directions.
+type Pair struct {
+	BookID      uint
+	NeighbourID uint
+	Count       int
+}
+
+// Popularity counts the customers who bought, or viewed, a book.
+type Popularity struct {
+	BookID uint
+	Count  int
+}
+
+// purchasesSQL lists every customer and book they paid for, once.
+const purchasesSQL = `SELECT DISTINCT orders.user_id, order_items.book_id FROM order_items
+JOIN orders ON orders.id = order_items.order_id WHERE orders.status IN @statuses`
+
+// viewsSQL lists every customer and book they viewed since the window start, once.
+const viewsSQL = `SELECT DISTINCT user_id, book_id FROM book_views WHERE viewed_at >= @since`
+
+type RecommendationRepository interface {
+	RecordView(ctx context.Context, view *BookView) error
+	DeleteViewsBefore(ctx context.Context, before time.Time) (int64, error)
+	// PurchasePairs and ViewPairs return the pairs of books shared by at least
+	// minCount customers. Views count from since on.
+	PurchasePairs(ctx context.Context, minCount int) ([]Pair, error)
+	PurchaseCounts(ctx context.Context) ([]Popularity, error)
+	ViewPairs(ctx context.Context, since time.Time, minCount int) ([]Pair, error)
+	ViewCounts(ctx context.Context, since time.Time) ([]Popularity, error)
+	// ReplaceNeighbours swaps all stored neighbours for the given ones at once,
+	// readers see either the old or the new set.
+	ReplaceNeighbours(ctx context.Context, neighbours []BookNeighbour) error
+	// FindNeighbours returns the top neighbours of a book, best first, leaving
+	// out books removed from the catalog.
+	FindNeighbours(ctx context.Context, bookID uint, limit int) ([]BookNeighbour, error)
+	// FindPurchased returns the books the user paid for.
+	FindPurchased(ctx context.Context, userID uint) ([]uint, error)
+	// FindViewed returns up to limit books the user viewed since, the latest
+	// first.
+	FindViewed(ctx context.Context, userID uint, since time.Time, limit int) ([]uint, error)
+	// FindNeighboursOf sums the scores of the neighbours of the seed books and
+	// returns the best, except the seeds themselves. The result carries no
+	// BookID.
+	FindNeighboursOf(ctx context.Context, seeds []uint, limit int) ([]BookNeighbour, error)
+}
+
+type recommendationRepository struct {
+	db *gorm.DB
+}
+
+func NewRecommendationRepository(db *gorm.DB) RecommendationRepository {
+	return &recommendationRepository{
+		db: db,
+	}
+}
+
+func (r *recommendationRepository) RecordView(ctx context.Context, view *BookView) error {
+	return pkg.DB(ctx, r.db).Create(view).Error
+}
+
+func (r *recommendationRepository) DeleteViewsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := pkg.DB(ctx, r.db).Where("viewed_at < ?", before).Delete(&BookView{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *recommendationRepository) PurchasePairs(ctx context.Context, minCount int) ([]Pair, error) {
+	return r.pairs(ctx, purchasesSQL, map[string]interface{}{
+		"statuses": orders.PurchasedStatuses,
+		"min":      minCount,
+	})
+}
+
+func (r *recommendationRepository) PurchaseCounts(ctx context.Context) ([]Popularity, error) {
+	return r.counts(ctx, purchasesSQL, map[string]interface{}{"statuses": orders.PurchasedStatuses})
+}
+
+func (r *recommendationRepository) ViewPairs(ctx context.Context, since time.Time, minCount int) ([]Pair, error) {
+	return r.pairs(ctx, viewsSQL, map[string]interface{}{"since": since, "min": minCount})
+}
+
+func (r *recommendationRepository) ViewCounts(ctx context.Context, since time.Time) ([]Popularity, error) {
+	return r.counts(ctx, viewsSQL, map[string]interface{}{"since": since})
+}
+
+// pairs joins the customer and book rows of the events query with
+// themselves to count the customers of every pair of books.
+func (r *recommendationRepository) pairs(ctx context.Context, events string, args map[string]interface{}) ([]Pair, error) {
+	var pairs []Pair
+	err := pkg.DB(ctx, r.db).Raw(`WITH events AS (`+events+`)
+SELECT a.book_id, b.book_id AS neighbour_id, COUNT(*) AS count
+FROM events a JOIN events b ON a.user_id = b.user_id AND a.book_id <> b.book_id
+GROUP BY a.book_id, b.book_id HAVING COUNT(*) >= @min`, args).Scan(&pairs).Error
+	if err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+func (r *recommendationRepository) counts(ctx context.Context, events string, args map[string]interface{}) ([]Popularity, error) {
+	var counts []Popularity
+	err := pkg.DB(ctx, r.db).Raw(`WITH events AS (`+events+`)
+SELECT book_id, COUNT(*) AS count FROM events GROUP BY book_id`, args).Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *recommendationRepository) ReplaceNeighbours(ctx context.Context, neighbours []BookNeighbour) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&BookNeighbour{}).Error; err != nil {
+			return err
+		}
+		if len(neighbours) == 0 {
+			return nil
+		}
+		return tx.Omit("Neighbour").CreateInBatches(neighbours, neighbourBatch).Error
+	})
+}
+
+func (r *recommendationRepository) FindNeighbours(ctx context.Context, bookID uint, limit int) ([]BookNeighbour, error) {
+	var neighbours []BookNeighbour
+	result := pkg.DB(ctx, r.db).
+		InnerJoins("Neighbour").
+		Where("book_neighbours.book_id = ?", bookID).
+		Order("book_neighbours.score DESC, book_neighbours.neighbour_id").
+		Limit(limit).
+		Find(&neighbours)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return neighbours, nil
+}
+
+func (r *recommendationRepository) FindPurchased(ctx context.Context, userID uint) ([]uint, error) {
+	var bookIDs []uint
+	err := pkg.DB(ctx, r.db).Model(&orders.OrderItem{}).
+		Distinct("order_items.book_id").
+		Joins("JOIN orders ON orders.id = order_items.order_id").
+		Where("orders.user_id = ? AND orders.status IN ?", userID, orders.PurchasedStatuses).
+		Pluck("order_items.book_id", &bookIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return bookIDs, nil
+}
+
+func (r *recommendationRepository) FindViewed(ctx context.Context, userID uint, since time.Time, limit int) ([]uint, error) {
+	var bookIDs []uint
+	err := pkg.DB(ctx, r.db).Model(&BookView{}).
+		Where("user_id = ? AND viewed_at >= ?", userID, since).
+		Group("book_id").
+		Order("MAX(viewed_at) DESC").
+		Limit(limit).
+		Pluck("book_id", &bookIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return bookIDs, nil
+}
+
+func (r *recommendationRepository) FindNeighboursOf(ctx context.Context, seeds []uint, limit int) ([]BookNeighbour, error) {
+	var scored []BookNeighbour
+	err := pkg.DB(ctx, r.db).Model(&BookNeighbour{}).
+		Select("neighbour_id, SUM(score) AS score, SUM(co_purchases) AS co_purchases, SUM(co_views) AS co_views").
+		Where("book_id IN ? AND neighbour_id NOT IN ?", seeds, seeds).
+		Where("EXISTS (SELECT 1 FROM books WHERE books.id = book_neighbours.neighbour_id AND books.deleted_at IS NULL)").
+		Group("neighbour_id").
+		Order("score DESC, neighbour_id").
+		Limit(limit).
+		Scan(&scored).Error
+	if err != nil || len(scored) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(scored))
+	for _, neighbour := range scored {
+		ids = append(ids, neighbour.NeighbourID)
+	}
+	var found []books.Book
+	if err := pkg.DB(ctx, r.db).Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]books.Book, len(found))
+	for _, book := range found {
+		byID[book.ID] = book
+	}
+
+	neighbours := make([]BookNeighbour, 0, len(scored))
+	for _, neighbour := range scored {
+		// A book removed between the two queries is left out.
+		book, ok := byID[neighbour.NeighbourID]
+		if !ok {
+			continue
+		}
+		neighbour.Neighbour = book
+		neighbours = append(neighbours, neighbour)
+	}
+	return neighbours, nil
+}
@@ -0,0 +1,215 @@
+package recommendations
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/recommendations/api/dto"
+	"context"
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// TopNeighbours is how many neighbours are kept per book.
+	TopNeighbours = 20
+	// ViewWindow is how long views count towards co-view similarity.
+	ViewWindow = 90 * 24 * time.Hour
+
+	// Buying together says more than viewing together, so co-purchases
+	// weigh more in the score.
+	purchaseWeight = 0.7
+	viewWeight     = 0.3
+
+	// Pairs of books shared by fewer customers are left out as noise.
+	minCoPurchases = 2
+	minCoViews     = 3
+
+	// historySize bounds the viewed books a user's recommendations start from.
+	historySize = 50
+)
+
+var ErrBookNotFound = errors.New("book not found")
+
+type RecommendationService interface {
+	// GetBookRecommendations returns the books customers bought or viewed
+	// along with the book.
+	GetBookRecommendations(ctx context.Context, bookID uint, query dto.RecommendationQuery) ([]dto.RecommendationResponse, error)
+	// GetUserRecommendations returns the neighbours of the books the user
+	// bought or recently viewed, except those books themselves. Users without
+	// history get none.
+	GetUserRecommendations(ctx context.Context, userID uint, query dto.RecommendationQuery) ([]dto.RecommendationResponse, error)
+	RecordView(ctx context.Context, userID, bookID uint) error
+	// ComputeNeighbours recomputes the neighbours of every book from the order
+	// history and recent views, it runs as a scheduled job.
+	ComputeNeighbours(ctx context.Context) error
+}
+
+type recommendationService struct {
+	recommendationRepo RecommendationRepository
+	bookRepo           books.BookRepository
+}
+
+func NewRecommendationService(recommendationRepo RecommendationRepository, bookRepo books.BookRepository) RecommendationService {
+	return &recommendationService{
+		recommendationRepo: recommendationRepo,
+		bookRepo:           bookRepo,
+	}
+}
+
+func (s *recommendationService) GetBookRecommendations(ctx context.Context, bookID uint, query dto.RecommendationQuery) ([]dto.RecommendationResponse, error) {
+	if _, err := s.bookRepo.FindByID(ctx, bookID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookNotFound
+		}
+		return nil, err
+	}
+
+	neighbours, err := s.recommendationRepo.FindNeighbours(ctx, bookID, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return toRecommendationResponses(neighbours), nil
+}
+
+func (s *recommendationService) GetUserRecommendations(ctx context.Context, userID uint, query dto.RecommendationQuery) ([]dto.RecommendationResponse, error) {
+	purchased, err := s.recommendationRepo.FindPurchased(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	viewed, err := s.recommendationRepo.FindViewed(ctx, userID, time.Now().Add(-ViewWindow), historySize)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := append(purchased, viewed...)
+	if len(seeds) == 0 {
+		return []dto.RecommendationResponse{}, nil
+	}
+	neighbours, err := s.recommendationRepo.FindNeighboursOf(ctx, seeds, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return toRecommendationResponses(neighbours), nil
+}
+
+func (s *recommendationService) RecordView(ctx context.Context, userID, bookID uint) error {
+	return s.recommendationRepo.RecordView(ctx, &BookView{UserID: userID, BookID: bookID, ViewedAt: time.Now()})
+}
+
+func (s *recommendationService) ComputeNeighbours(ctx context.Context) error {
+	now := time.Now()
+	since := now.Add(-ViewWindow)
+
+	purchasePairs, err := s.recommendationRepo.PurchasePairs(ctx, minCoPurchases)
+	if err != nil {
+		return err
+	}
+	purchaseCounts, err := s.recommendationRepo.PurchaseCounts(ctx)
+	if err != nil {
+		return err
+	}
+	viewPairs, err := s.recommendationRepo.ViewPairs(ctx, since, minCoViews)
+	if err != nil {
+		return err
+	}
+	viewCounts, err := s.recommendationRepo.ViewCounts(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	type pair struct{ book, neighbour uint }
+	scored := make(map[pair]*BookNeighbour)
+	neighbour := func(p Pair) *BookNeighbour {
+		key := pair{p.BookID, p.NeighbourID}
+		if scored[key] == nil {
+			scored[key] = &BookNeighbour{BookID: p.BookID, NeighbourID: p.NeighbourID, ComputedAt: now}
+		}
+		return scored[key]
+	}
+	purchases := popularity(purchaseCounts)
+	for _, p := range purchasePairs {
+		n := neighbour(p)
+		n.CoPurchases = p.Count
+		n.Score += purchaseWeight * cosine(p, purchases)
+	}
+	views := popularity(viewCounts)
+	for _, p := range viewPairs {
+		n := neighbour(p)
+		n.CoViews = p.Count
+		n.Score += viewWeight * cosine(p, views)
+	}
+
+	byBook := make(map[uint][]BookNeighbour)
+	for _, n := range scored {
+		byBook[n.BookID] = append(byBook[n.BookID], *n)
+	}
+	var neighbours []BookNeighbour
+	for _, candidates := range byBook {
+		neighbours = append(neighbours, top(candidates, TopNeighbours)...)
+	}
+	if err := s.recommendationRepo.ReplaceNeighbours(ctx, neighbours); err != nil {
+		return err
+	}
+
+	purged, err := s.recommendationRepo.DeleteViewsBefore(ctx, since)
+	if err != nil {
+		return err
+	}
+	log.Printf("Computed %d neighbours of %d books, purged %d old views", len(neighbours), len(byBook), purged)
+	return nil
+}
+
+func popularity(counts []Popularity) map[uint]int {
+	byBook := make(map[uint]int, len(counts))
+	for _, c := range counts {
+		byBook[c.BookID] = c.Count
+	}
+	return byBook
+}
+
+// cosine is the cosine similarity of the customer sets of the two books of
+// the pair: the customers they share over the geometric mean of their
+// customers. It favours pairs that go together over merely popular books.
+func cosine(p Pair, customers map[uint]int) float64 {
+	denominator := math.Sqrt(float64(customers[p.BookID]) * float64(customers[p.NeighbourID]))
+	if denominator == 0 {
+		return 0
+	}
+	return float64(p.Count) / denominator
+}
+
+// top returns the n best scored candidates, ties broken by book ID so runs
+// over the same data store the same neighbours.
+func top(candidates []BookNeighbour, n int) []BookNeighbour {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].NeighbourID < candidates[j].NeighbourID
+	})
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
+
+func toRecommendationResponses(neighbours []BookNeighbour) []dto.RecommendationResponse {
+	response := make([]dto.RecommendationResponse, 0, len(neighbours))
+	for _, n := range neighbours {
+		response = append(response, dto.RecommendationResponse{
+			BookID:      n.NeighbourID,
+			Title:       n.Neighbour.Title,
+			Subtitle:    n.Neighbour.Subtitle,
+			Price:       n.Neighbour.Price,
+			Currency:    n.Neighbour.Currency,
+			Score:       math.Round(n.Score*1000) / 1000,
+			CoPurchases: n.CoPurchases,
+			CoViews:     n.CoViews,
+		})
+	}
+	return response
+}
@@ -0,0 +1,22 @@
+package dto
+
+// ReportRangeQuery is the date range of a report. From and To are local
+// dates in Timezone, an IANA time zone name, and both days are included.
+type ReportRangeQuery struct {
+	From     string `form:"from" binding:"required,datetime=2006-01-02"`
+	To       string `form:"to" binding:"required,datetime=2006-01-02"`
+	Timezone string `form:"timezone"`
+	Format   string `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+type SalesReportQuery struct {
+	ReportRangeQuery
+	Interval string `form:"interval" binding:"omitempty,oneof=day week month"`
+	Currency string `form:"currency"`
+}
+
+type TopSellersQuery struct {
+	ReportRangeQuery
+	Currency string `form:"currency" binding:"required"`
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+// SalesResponse totals the orders placed in a period, or the whole range when
+// Period is empty, in one currency. Amounts are in minor units of Currency:
+// Revenue is what customers were charged, tax included, after discounts and
+// redeemed points. RefundRate is the part of the revenue refunded since and
+// OrderRefundRate the part of the orders with a refund.
+type SalesResponse struct {
+	Period            string  `json:"period,omitempty"`
+	Currency          string  `json:"currency"`
+	Orders            int64   `json:"orders"`
+	Revenue           int64   `json:"revenue"`
+	Tax               int64   `json:"tax"`
+	Discount          int64   `json:"discount"`
+	AverageOrderValue int64   `json:"average_order_value"`
+	RefundedOrders    int64   `json:"refunded_orders"`
+	RefundedAmount    int64   `json:"refunded_amount"`
+	RefundRate        float64 `json:"refund_rate"`
+	OrderRefundRate   float64 `json:"order_refund_rate"`
+}
+
+// SalesReportResponse lists the sales of each period, named by its first
+// day, with the totals of the range per currency.
+type SalesReportResponse struct {
+	From     string          `json:"from"`
+	To       string          `json:"to"`
+	Timezone string          `json:"timezone"`
+	Interval string          `json:"interval"`
+	Periods  []SalesResponse `json:"periods"`
+	Totals   []SalesResponse `json:"totals"`
+}
+
+// TopSellerResponse is a book, author or category with the copies of it
+// sold. Revenue is in minor units of the currency of the report.
+type TopSellerResponse struct {
+	Rank     int    `json:"rank"`
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity"`
+	Orders   int64  `json:"orders"`
+	Revenue  int64  `json:"revenue"`
+}
+
+type TopSellersResponse struct {
+	Dimension string              `json:"dimension"`
+	From      string              `json:"from"`
+	To        string              `json:"to"`
+	Timezone  string              `json:"timezone"`
+	Currency  string              `json:"currency"`
+	Sellers   []TopSellerResponse `json:"sellers"`
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/reports"
+	"bookstore-framework/internal/reports/api/dto"
+	"bookstore-framework/pkg"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler struct {
+	reportService reports.ReportService
+}
+
+func NewReportHandler(reportService reports.ReportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// GetSalesReport godoc
+// @Summary      Report sales
+// @Description  Total the orders placed between two dates, both included, by day, week or month and currency: orders, revenue, tax, discounts, average order value, and what was refunded of them since. Days start at midnight in the time zone given. Periods without sales are left out (staff only)
+// @Tags         reports
+// @Security     BearerAuth
+// @Produce      json,text/csv
+// @Param        from     query   string true  "First day, YYYY-MM-DD"
+// @Param        to       query   string true  "Last day, YYYY-MM-DD"
+// @Param        timezone query   string false "IANA time zone" default(UTC)
+// @Param        interval query   string false "Period" Enums(day, week, month) default(day)
+// @Param        currency query   string false "Only orders paid in this currency"
+// @Param        format   query   string false "Response format" Enums(json, csv) default(json)
+// @Success      200  {object}    pkg.Response{data=dto.SalesReportResponse} "Sales report retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /reports/sales [get]
+func (h *ReportHandler) GetSalesReport(ctx *gin.Context) {
+	var query dto.SalesReportQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reportService.GetSales(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	if query.Format == reports.FormatCSV {
+		var buf bytes.Buffer
+		if err := reports.WriteSalesCSV(&buf, response); err != nil {
+			handleError(ctx, err)
+			return
+		}
+		sendCSV(ctx, fmt.Sprintf("sales-%s-%s.csv", query.From, query.To), buf.Bytes())
+		return
+	}
+
+	pkg.OkResponse(ctx, "Sales report retrieve successfully", response)
+}
+
+// GetTopBooks godoc
+// @Summary      Report the best selling books
+// @Description  Rank the books sold between two dates, both included, in a currency by copies sold, then revenue after discounts (staff only)
+// @Tags         reports
+// @Security     BearerAuth
+// @Produce      json,text/csv
+// @Param        from     query   string true  "First day, YYYY-MM-DD"
+// @Param        to       query   string true  "Last day, YYYY-MM-DD"
+// @Param        currency query   string true  "Currency of the orders"
+// @Param        timezone query   string false "IANA time zone" default(UTC)
+// @Param        limit    query   int    false "Number of books" default(10)
+// @Param        format   query   string false "Response format" Enums(json, csv) default(json)
+// @Success      200  {object}    pkg.Response{data=dto.TopSellersResponse} "Top sellers retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /reports/top-books [get]
+func (h *ReportHandler) GetTopBooks(ctx *gin.Context) {
+	h.topSellers(ctx, reports.DimensionBook, "books")
+}
+
+// GetTopAuthors godoc
+// @Summary      Report the best selling authors
+// @Description  Rank the authors of the books sold between two dates, both included, in a currency by copies sold, then revenue after discounts. A book by several authors counts for each of them (staff only)
+// @Tags         reports
+// @Security     BearerAuth
+// @Produce      json,text/csv
+// @Param        from     query   string true  "First day, YYYY-MM-DD"
+// @Param        to       query   string true  "Last day, YYYY-MM-DD"
+// @Param        currency query   string true  "Currency of the orders"
+// @Param        timezone query   string false "IANA time zone" default(UTC)
+// @Param        limit    query   int    false "Number of authors" default(10)
+// @Param        format   query   string false "Response format" Enums(json, csv) default(json)
+// @Success      200  {object}    pkg.Response{data=dto.TopSellersResponse} "Top sellers retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /reports/top-authors [get]
+func (h *ReportHandler) GetTopAuthors(ctx *gin.Context) {
+	h.topSellers(ctx, reports.DimensionAuthor, "authors")
+}
+
+// GetTopCategories godoc
+// @Summary      Report the best selling categories
+// @Description  Rank the categories of the books sold between two dates, both included, in a currency by copies sold, then revenue after discounts. Books count for the categories they are filed in, not their parents (staff only)
+// @Tags         reports
+// @Security     BearerAuth
+// @Produce      json,text/csv
+// @Param        from     query   string true  "First day, YYYY-MM-DD"
+// @Param        to       query   string true  "Last day, YYYY-MM-DD"
+// @Param        currency query   string true  "Currency of the orders"
+// @Param        timezone query   string false "IANA time zone" default(UTC)
+// @Param        limit    query   int    false "Number of categories" default(10)
+// @Param        format   query   string false "Response format" Enums(json, csv) default(json)
+// @Success      200  {object}    pkg.Response{data=dto.TopSellersResponse} "Top sellers retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /reports/top-categories [get]
+func (h *ReportHandler) GetTopCategories(ctx *gin.Context) {
+	h.topSellers(ctx, reports.DimensionCategory, "categories")
+}
+
+// topSellers serves the ranking of a dimension, named plural in CSV files.
+func (h *ReportHandler) topSellers(ctx *gin.Context, dimension, plural string) {
+	var query dto.TopSellersQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reportService.GetTopSellers(ctx.Request.Context(), dimension, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	if query.Format == reports.FormatCSV {
+		var buf bytes.Buffer
+		if err := reports.WriteTopSellersCSV(&buf, response); err != nil {
+			handleError(ctx, err)
+			return
+		}
+		sendCSV(ctx, fmt.Sprintf("top-%s-%s-%s.csv", plural, query.From, query.To), buf.Bytes())
+		return
+	}
+
+	pkg.OkResponse(ctx, "Top sellers retrieve successfully", response)
+}
+
+func sendCSV(ctx *gin.Context, filename string, data []byte) {
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", data)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, reports.ErrInvalidRange), errors.Is(err, reports.ErrRangeTooLong),
+		errors.Is(err, reports.ErrUnknownTimezone), errors.Is(err, pricing.ErrUnsupportedCurrency):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"bookstore-framework/internal/reports"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func ReportsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	reportRepository := reports.NewReportRepository(db)
+	reportService := reports.NewReportService(reportRepository)
+	reportHandler := NewReportHandler(reportService)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.GET("/sales", reportHandler.GetSalesReport)
+	router.GET("/top-books", reportHandler.GetTopBooks)
+	router.GET("/top-authors", reportHandler.GetTopAuthors)
+	router.GET("/top-categories", reportHandler.GetTopCategories)
+}
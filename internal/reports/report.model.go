@@ -0,0 +1,66 @@
+package reports
+
+import (
+	"bookstore-framework/internal/orders"
+	"time"
+)
+
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+)
+
+const (
+	DimensionBook     = "book"
+	DimensionAuthor   = "author"
+	DimensionCategory = "category"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// MaxRangeDays bounds the date range of a report, so a single request cannot
+// aggregate the whole order history day by day.
+const MaxRangeDays = 3 * 366
+
+// SoldStatuses are the statuses of orders counted as sales: every order the
+// customer paid for, including those refunded since, so that refunds can be
+// set against the sales they undo.
+var SoldStatuses = append(append([]string{}, orders.PurchasedStatuses...), orders.StatusRefunded)
+
+// SalesFilter selects the orders placed from Start up to End. Periods are
+// cut at midnight in Timezone.
+type SalesFilter struct {
+	Start    time.Time
+	End      time.Time
+	Timezone string
+	// Currency limits the report to orders paid in it, any when empty.
+	Currency string
+}
+
+// SalesRow totals the orders placed in a period in one currency. Amounts are
+// in minor units of Currency. RefundedAmount is what was refunded of these
+// orders so far, by the payment provider or in store credit.
+type SalesRow struct {
+	Period         time.Time `gorm:"column:period"`
+	Currency       string    `gorm:"column:currency"`
+	Orders         int64     `gorm:"column:orders"`
+	Revenue        int64     `gorm:"column:revenue"`
+	Tax            int64     `gorm:"column:tax"`
+	Discount       int64     `gorm:"column:discount"`
+	RefundedOrders int64     `gorm:"column:refunded_orders"`
+	RefundedAmount int64     `gorm:"column:refunded_amount"`
+}
+
+// SellerRow totals the copies sold of a book, or of the books of an author or
+// category. Revenue is what customers paid for the copies after discounts.
+type SellerRow struct {
+	ID       uint   `gorm:"column:id"`
+	Name     string `gorm:"column:name"`
+	Quantity int64  `gorm:"column:quantity"`
+	Orders   int64  `gorm:"column:orders"`
+	Revenue  int64  `gorm:"column:revenue"`
+}
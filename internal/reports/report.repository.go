@@ -0,0 +1,105 @@
+package reports
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/pkg"
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type ReportRepository interface {
+	// SalesByPeriod totals the orders sold in each period of interval, day,
+	// week or month, and currency. Periods without sales are left out.
+	SalesByPeriod(ctx context.Context, interval string, filter SalesFilter) ([]SalesRow, error)
+	// TopSellers ranks the books, authors or categories by copies sold, then
+	// by revenue, and returns the first limit of them.
+	TopSellers(ctx context.Context, dimension string, filter SalesFilter, limit int) ([]SellerRow, error)
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{
+		db: db,
+	}
+}
+
+// sold selects the orders of the filter.
+func (r *reportRepository) sold(ctx context.Context, model interface{}, filter SalesFilter) *gorm.DB {
+	query := pkg.DB(ctx, r.db).Model(model).
+		Where("orders.status IN ? AND orders.created_at >= ? AND orders.created_at < ?", SoldStatuses, filter.Start, filter.End)
+	if filter.Currency != "" {
+		query = query.Where("orders.currency = ?", filter.Currency)
+	}
+	return query
+}
+
+func (r *reportRepository) SalesByPeriod(ctx context.Context, interval string, filter SalesFilter) ([]SalesRow, error) {
+	// Each order is bucketed by its local date and carries what was refunded
+	// of it, so the outer query only has to add up the buckets: through its
+	// payments, to the wallet by its returns, and to the wallet by the
+	// reversal of what a refunded order took from a gift card or the wallet,
+	// posted under wallet.OrderReference.
+	sales := r.sold(ctx, &orders.Order{}, filter).
+		Select(`date_trunc(?, orders.created_at AT TIME ZONE ?) AS period, orders.currency, orders.total, orders.tax,
+			orders.discount + orders.points_discount AS discount,
+			(SELECT COALESCE(SUM(payments.refunded_amount), 0) FROM payments WHERE payments.order_id = orders.id) +
+			(SELECT COALESCE(SUM(returns.store_credit), 0) FROM returns WHERE returns.order_id = orders.id) +
+			(SELECT COALESCE(SUM(ledger_postings.amount), 0) FROM ledger_transactions
+				JOIN ledger_postings ON ledger_postings.transaction_id = ledger_transactions.id
+				WHERE ledger_transactions.type = ? AND ledger_transactions.reference = 'order:' || orders.id
+				AND ledger_postings.amount > 0) AS refunded`,
+			interval, filter.Timezone, wallet.TransactionCheckoutReversal)
+
+	var rows []SalesRow
+	result := pkg.DB(ctx, r.db).Table("(?) AS sales", sales).
+		Select(`period, currency, COUNT(*) AS orders, SUM(total) AS revenue, SUM(tax) AS tax, SUM(discount) AS discount,
+			COUNT(*) FILTER (WHERE refunded > 0) AS refunded_orders, SUM(refunded) AS refunded_amount`).
+		Group("period, currency").
+		Order("period, currency").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rows, nil
+}
+
+func (r *reportRepository) TopSellers(ctx context.Context, dimension string, filter SalesFilter, limit int) ([]SellerRow, error) {
+	query := r.sold(ctx, &orders.OrderItem{}, filter).
+		Joins("JOIN orders ON orders.id = order_items.order_id")
+	const totals = "SUM(order_items.quantity) AS quantity, COUNT(DISTINCT orders.id) AS orders, SUM(order_items.line_total - order_items.discount) AS revenue"
+	switch dimension {
+	case DimensionBook:
+		query = query.
+			Select("order_items.book_id AS id, MAX(order_items.title) AS name, " + totals).
+			Group("order_items.book_id")
+	case DimensionAuthor:
+		// A book written by several authors counts in full for each of them.
+		query = query.
+			Joins("JOIN book_authors ON book_authors.book_id = order_items.book_id AND book_authors.role = ?", books.RoleAuthor).
+			Joins("JOIN authors ON authors.id = book_authors.author_id").
+			Select("authors.id, authors.name, " + totals).
+			Group("authors.id, authors.name")
+	case DimensionCategory:
+		query = query.
+			Joins("JOIN book_categories ON book_categories.book_id = order_items.book_id").
+			Joins("JOIN categories ON categories.id = book_categories.category_id").
+			Select("categories.id, categories.name, " + totals).
+			Group("categories.id, categories.name")
+	default:
+		return nil, fmt.Errorf("unknown report dimension %q", dimension)
+	}
+
+	var rows []SellerRow
+	result := query.Order("quantity DESC, revenue DESC, id").Limit(limit).Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rows, nil
+}
@@ -0,0 +1,208 @@
+package reports
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/reports/api/dto"
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	// Reports are cut at midnight in the time zone asked for, which must be
+	// known even where the host has no zone database.
+	_ "time/tzdata"
+)
+
+const (
+	dateLayout       = "2006-01-02"
+	defaultTimezone  = "UTC"
+	defaultTopSeller = 10
+)
+
+var (
+	ErrInvalidRange    = errors.New("to must not be before from")
+	ErrRangeTooLong    = errors.New("date range is too long")
+	ErrUnknownTimezone = errors.New("unknown time zone")
+)
+
+type ReportService interface {
+	// GetSales totals the orders placed between two dates by day, week or
+	// month and currency.
+	GetSales(ctx context.Context, query dto.SalesReportQuery) (*dto.SalesReportResponse, error)
+	// GetTopSellers ranks the books, authors or categories sold between two
+	// dates in a currency by copies sold.
+	GetTopSellers(ctx context.Context, dimension string, query dto.TopSellersQuery) (*dto.TopSellersResponse, error)
+}
+
+type reportService struct {
+	reportRepo ReportRepository
+}
+
+func NewReportService(reportRepo ReportRepository) ReportService {
+	return &reportService{
+		reportRepo: reportRepo,
+	}
+}
+
+func (s *reportService) GetSales(ctx context.Context, query dto.SalesReportQuery) (*dto.SalesReportResponse, error) {
+	filter, err := resolveRange(query.ReportRangeQuery)
+	if err != nil {
+		return nil, err
+	}
+	if query.Currency != "" {
+		filter.Currency, err = pricing.Supported(query.Currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	interval := query.Interval
+	if interval == "" {
+		interval = IntervalDay
+	}
+
+	rows, err := s.reportRepo.SalesByPeriod(ctx, interval, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SalesReportResponse{
+		From:     query.From,
+		To:       query.To,
+		Timezone: filter.Timezone,
+		Interval: interval,
+		Periods:  make([]dto.SalesResponse, 0, len(rows)),
+		Totals:   []dto.SalesResponse{},
+	}
+	totals := make(map[string]*SalesRow)
+	for _, row := range rows {
+		period := toSalesResponse(row)
+		period.Period = row.Period.Format(dateLayout)
+		response.Periods = append(response.Periods, period)
+
+		total, found := totals[row.Currency]
+		if !found {
+			total = &SalesRow{Currency: row.Currency}
+			totals[row.Currency] = total
+		}
+		total.Orders += row.Orders
+		total.Revenue += row.Revenue
+		total.Tax += row.Tax
+		total.Discount += row.Discount
+		total.RefundedOrders += row.RefundedOrders
+		total.RefundedAmount += row.RefundedAmount
+	}
+	for _, total := range totals {
+		response.Totals = append(response.Totals, toSalesResponse(*total))
+	}
+	sort.Slice(response.Totals, func(i, j int) bool {
+		return response.Totals[i].Currency < response.Totals[j].Currency
+	})
+	return response, nil
+}
+
+func (s *reportService) GetTopSellers(ctx context.Context, dimension string, query dto.TopSellersQuery) (*dto.TopSellersResponse, error) {
+	filter, err := resolveRange(query.ReportRangeQuery)
+	if err != nil {
+		return nil, err
+	}
+	filter.Currency, err = pricing.Supported(query.Currency)
+	if err != nil {
+		return nil, err
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultTopSeller
+	}
+
+	rows, err := s.reportRepo.TopSellers(ctx, dimension, filter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TopSellersResponse{
+		Dimension: dimension,
+		From:      query.From,
+		To:        query.To,
+		Timezone:  filter.Timezone,
+		Currency:  filter.Currency,
+		Sellers:   make([]dto.TopSellerResponse, 0, len(rows)),
+	}
+	for i, row := range rows {
+		response.Sellers = append(response.Sellers, dto.TopSellerResponse{
+			Rank:     i + 1,
+			ID:       row.ID,
+			Name:     row.Name,
+			Quantity: row.Quantity,
+			Orders:   row.Orders,
+			Revenue:  row.Revenue,
+		})
+	}
+	return response, nil
+}
+
+// resolveRange turns the local dates of a report into the instants its
+// orders are placed between: from midnight of the first day up to midnight
+// after the last, in the time zone of the report.
+func resolveRange(query dto.ReportRangeQuery) (SalesFilter, error) {
+	timezone := query.Timezone
+	if timezone == "" {
+		timezone = defaultTimezone
+	}
+	// Local would be the time zone of the server, which callers cannot know.
+	if timezone == "Local" {
+		return SalesFilter{}, ErrUnknownTimezone
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return SalesFilter{}, ErrUnknownTimezone
+	}
+
+	from, err := time.ParseInLocation(dateLayout, query.From, location)
+	if err != nil {
+		return SalesFilter{}, ErrInvalidRange
+	}
+	to, err := time.ParseInLocation(dateLayout, query.To, location)
+	if err != nil {
+		return SalesFilter{}, ErrInvalidRange
+	}
+	if to.Before(from) {
+		return SalesFilter{}, ErrInvalidRange
+	}
+	end := to.AddDate(0, 0, 1)
+	if from.AddDate(0, 0, MaxRangeDays).Before(end) {
+		return SalesFilter{}, ErrRangeTooLong
+	}
+
+	return SalesFilter{
+		Start:    from,
+		End:      end,
+		Timezone: location.String(),
+	}, nil
+}
+
+func toSalesResponse(row SalesRow) dto.SalesResponse {
+	response := dto.SalesResponse{
+		Currency:        row.Currency,
+		Orders:          row.Orders,
+		Revenue:         row.Revenue,
+		Tax:             row.Tax,
+		Discount:        row.Discount,
+		RefundedOrders:  row.RefundedOrders,
+		RefundedAmount:  row.RefundedAmount,
+		RefundRate:      rate(row.RefundedAmount, row.Revenue),
+		OrderRefundRate: rate(row.RefundedOrders, row.Orders),
+	}
+	if row.Orders > 0 {
+		response.AverageOrderValue = (row.Revenue + row.Orders/2) / row.Orders
+	}
+	return response
+}
+
+// rate returns part of whole as a fraction rounded to four decimals.
+func rate(part, whole int64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(whole)*10000) / 10000
+}
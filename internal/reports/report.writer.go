@@ -0,0 +1,83 @@
+package reports
+
+import (
+	"bookstore-framework/internal/reports/api/dto"
+	"bookstore-framework/pkg/money"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var salesColumns = []string{
+	"period", "currency", "orders", "revenue", "tax", "discount", "average_order_value",
+	"refunded_orders", "refunded_amount", "refund_rate", "order_refund_rate",
+}
+
+var topSellerColumns = []string{"rank", "id", "name", "quantity", "orders", "revenue", "currency"}
+
+// WriteSalesCSV writes a row per period and currency, followed by the totals
+// of each currency with "total" for period. Amounts are in major units.
+func WriteSalesCSV(w io.Writer, report *dto.SalesReportResponse) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(salesColumns); err != nil {
+		return err
+	}
+	write := func(period string, sales dto.SalesResponse) error {
+		return out.Write([]string{
+			period,
+			sales.Currency,
+			strconv.FormatInt(sales.Orders, 10),
+			amount(sales.Revenue, sales.Currency),
+			amount(sales.Tax, sales.Currency),
+			amount(sales.Discount, sales.Currency),
+			amount(sales.AverageOrderValue, sales.Currency),
+			strconv.FormatInt(sales.RefundedOrders, 10),
+			amount(sales.RefundedAmount, sales.Currency),
+			strconv.FormatFloat(sales.RefundRate, 'f', -1, 64),
+			strconv.FormatFloat(sales.OrderRefundRate, 'f', -1, 64),
+		})
+	}
+	for _, sales := range report.Periods {
+		if err := write(sales.Period, sales); err != nil {
+			return err
+		}
+	}
+	for _, sales := range report.Totals {
+		if err := write("total", sales); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// WriteTopSellersCSV writes a row per seller, revenue in major units.
+func WriteTopSellersCSV(w io.Writer, report *dto.TopSellersResponse) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(topSellerColumns); err != nil {
+		return err
+	}
+	for _, seller := range report.Sellers {
+		err := out.Write([]string{
+			strconv.Itoa(seller.Rank),
+			strconv.FormatUint(uint64(seller.ID), 10),
+			seller.Name,
+			strconv.FormatInt(seller.Quantity, 10),
+			strconv.FormatInt(seller.Orders, 10),
+			amount(seller.Revenue, report.Currency),
+			report.Currency,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// amount writes minor units in major units without the currency code, which
+// has a column of its own.
+func amount(minor int64, currency string) string {
+	return strings.TrimSuffix(money.Format(minor, currency), " "+currency)
+}
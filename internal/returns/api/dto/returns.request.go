@@ -0,0 +1,48 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+type ReturnItemRequest struct {
+	BookID   uint `json:"book_id" binding:"required" example:"1"`
+	Quantity int  `json:"quantity" binding:"required,min=1" example:"1"`
+}
+
+// CreateReturnRequest represents a customer's request to return copies of an order
+// @Description Copies of a delivered order to send back, within the return window after delivery.
+type CreateReturnRequest struct {
+	OrderID uint                `json:"order_id" binding:"required" example:"42"`
+	Reason  string              `json:"reason" binding:"required,max=255" example:"Pages missing"`
+	Items   []ReturnItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// ReviewReturnRequest represents staff approving or rejecting a return
+type ReviewReturnRequest struct {
+	Note string `json:"note" binding:"max=255" example:"Send it back with the original packing slip"`
+}
+
+type ReceiveItemRequest struct {
+	BookID      uint   `json:"book_id" binding:"required" example:"1"`
+	Disposition string `json:"disposition" binding:"required,oneof=restock write_off" example:"restock"`
+	LocationID  uint   `json:"location_id" example:"1"`
+}
+
+// ReceiveReturnRequest represents staff receiving the copies of a return
+// @Description What happens to the copies of each book of the return. Restocked copies go back to the location, written off copies do not go back into stock.
+type ReceiveReturnRequest struct {
+	Items []ReceiveItemRequest `json:"items" binding:"required,min=1,dive"`
+	Note  string               `json:"note" binding:"max=255" example:"Two copies water damaged"`
+}
+
+// RefundReturnRequest represents a request to refund a received return
+// @Description Refund in minor currency units through the payment provider, or as store credit to the customer's wallet when store_credit is set. Leave the amount out to refund what the customer paid for the copies.
+type RefundReturnRequest struct {
+	Amount      int64 `json:"amount" binding:"omitempty,min=1" example:"1299"`
+	StoreCredit bool  `json:"store_credit" example:"false"`
+}
+
+// ReturnListQuery represents the query string of the return list endpoint
+type ReturnListQuery struct {
+	pkg.PaginationQuery
+	OrderID uint   `form:"order_id"`
+	Status  string `form:"status" binding:"omitempty,oneof=requested approved rejected received refunded"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type ReturnItemResponse struct {
+	BookID      uint   `json:"book_id"`
+	SKU         string `json:"sku"`
+	Title       string `json:"title"`
+	Quantity    int    `json:"quantity"`
+	Amount      int64  `json:"amount"`
+	Disposition string `json:"disposition,omitempty"`
+	LocationID  *uint  `json:"location_id,omitempty"`
+}
+
+// ReturnResponse carries amounts in minor currency units. Amount is what the
+// customer paid for the returned copies, RefundedAmount what was refunded,
+// through the payment provider or as store credit, and StoreCredit the part
+// of it that went to the wallet.
+type ReturnResponse struct {
+	ID             uint                 `json:"id"`
+	OrderID        uint                 `json:"order_id"`
+	UserID         uint                 `json:"user_id"`
+	Status         string               `json:"status"`
+	Reason         string               `json:"reason"`
+	Note           string               `json:"note,omitempty"`
+	Currency       string               `json:"currency"`
+	Amount         int64                `json:"amount"`
+	RefundedAmount int64                `json:"refunded_amount"`
+	RefundMethod   string               `json:"refund_method,omitempty"`
+	StoreCredit    int64                `json:"store_credit"`
+	Items          []ReturnItemResponse `json:"items"`
+	CreatedAt      time.Time            `json:"created_at"`
+	ModifiedAt     time.Time            `json:"modified_at"`
+}
+
+type ReturnListResponse struct {
+	Returns    []ReturnResponse   `json:"returns"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
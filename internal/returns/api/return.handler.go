@@ -0,0 +1,318 @@
+package api
+
+import (
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/returns"
+	"bookstore-framework/internal/returns/api/dto"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReturnHandler struct {
+	returnService returns.ReturnService
+}
+
+func NewReturnHandler(returnService returns.ReturnService) *ReturnHandler {
+	return &ReturnHandler{
+		returnService: returnService,
+	}
+}
+
+// RequestReturn godoc
+// @Summary      Request a return
+// @Description  Ask to return copies of a delivered order, within 30 days of the delivery. Staff approve or reject the request
+// @Tags         returns
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.CreateReturnRequest true "Order, reason and copies"
+// @Success      201  {object}    pkg.Response{data=dto.ReturnResponse} "Return requested successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Order not found"
+// @Failure      409  {object}    pkg.Response "Order not delivered or return window closed"
+// @Router       /returns [post]
+func (h *ReturnHandler) RequestReturn(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.CreateReturnRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.returnService.RequestReturn(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Return requested successfully", response)
+}
+
+// GetReturns godoc
+// @Summary      List returns
+// @Description  List the returns of the logged in user, newest first. Staff see the returns of every customer
+// @Tags         returns
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query   int    false "Page number" default(1)
+// @Param        limit    query   int    false "Page size" default(20)
+// @Param        order_id query   int    false "Filter by order"
+// @Param        status   query   string false "Filter by status"
+// @Success      200  {object}    pkg.Response{data=dto.ReturnListResponse} "Returns retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /returns [get]
+func (h *ReturnHandler) GetReturns(ctx *gin.Context) {
+	actor, ok := returnActor(ctx)
+	if !ok {
+		return
+	}
+
+	var query dto.ReturnListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.returnService.GetReturns(ctx.Request.Context(), actor, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Returns retrieve successfully", response)
+}
+
+// GetReturn godoc
+// @Summary      Get a return
+// @Description  Get a return with its items
+// @Tags         returns
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Return ID"
+// @Success      200  {object}    pkg.Response{data=dto.ReturnResponse} "Return retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Return not found"
+// @Router       /returns/{id} [get]
+func (h *ReturnHandler) GetReturn(ctx *gin.Context) {
+	actor, ok := returnActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid return id", err.Error())
+		return
+	}
+
+	response, err := h.returnService.GetReturn(ctx.Request.Context(), actor, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Return retrieve successfully", response)
+}
+
+// ApproveReturn godoc
+// @Summary      Approve a return
+// @Description  Approve a requested return, so the customer can send the copies back
+// @Tags         returns
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Return ID"
+// @Param        request body     dto.ReviewReturnRequest false "Note to the customer"
+// @Success      200  {object}    pkg.Response{data=dto.ReturnResponse} "Return approved successfully"
+// @Failure      404  {object}    pkg.Response "Return not found"
+// @Failure      409  {object}    pkg.Response "Return cannot move to this status"
+// @Router       /returns/{id}/approve [post]
+func (h *ReturnHandler) ApproveReturn(ctx *gin.Context) {
+	h.review(ctx, h.returnService.Approve, "Return approved successfully")
+}
+
+// RejectReturn godoc
+// @Summary      Reject a return
+// @Description  Reject a requested return. The copies can be requested again in another return
+// @Tags         returns
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Return ID"
+// @Param        request body     dto.ReviewReturnRequest false "Note to the customer"
+// @Success      200  {object}    pkg.Response{data=dto.ReturnResponse} "Return rejected successfully"
+// @Failure      404  {object}    pkg.Response "Return not found"
+// @Failure      409  {object}    pkg.Response "Return cannot move to this status"
+// @Router       /returns/{id}/reject [post]
+func (h *ReturnHandler) RejectReturn(ctx *gin.Context) {
+	h.review(ctx, h.returnService.Reject, "Return rejected successfully")
+}
+
+type reviewFunc func(ctx context.Context, actorID uint, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error)
+
+func (h *ReturnHandler) review(ctx *gin.Context, review reviewFunc, message string) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid return id", err.Error())
+		return
+	}
+
+	var req dto.ReviewReturnRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+			return
+		}
+	}
+
+	var response *dto.ReturnResponse
+	response, err = review(ctx.Request.Context(), userID.(uint), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, message, response)
+}
+
+// ReceiveReturn godoc
+// @Summary      Receive a return
+// @Description  Record the copies of an approved return as received. Restocked copies are returned into the stock ledger at the given location, written off copies are not
+// @Tags         returns
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Return ID"
+// @Param        request body     dto.ReceiveReturnRequest true "What happens to the copies of each book"
+// @Success      200  {object}    pkg.Response{data=dto.ReturnResponse} "Return received successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Return or location not found"
+// @Failure      409  {object}    pkg.Response "Return cannot move to this status"
+// @Router       /returns/{id}/receive [post]
+func (h *ReturnHandler) ReceiveReturn(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid return id", err.Error())
+		return
+	}
+
+	var req dto.ReceiveReturnRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.returnService.Receive(ctx.Request.Context(), userID.(uint), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Return received successfully", response)
+}
+
+// RefundReturn godoc
+// @Summary      Refund a return
+// @Description  Refund a received return through the payment provider of the order, or as store credit to the customer's wallet, in full or in part. Refunding what is left of the payment through the provider of the payment also moves the order to refunded
+// @Tags         returns
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Return ID"
+// @Param        request body     dto.RefundReturnRequest false "Amount"
+// @Success      200  {object}    pkg.Response{data=dto.ReturnResponse} "Return refunded successfully"
+// @Failure      400  {object}    pkg.Response "Refund amount exceeds the return"
+// @Failure      404  {object}    pkg.Response "Return not found"
+// @Failure      409  {object}    pkg.Response "Return cannot move to this status or payment cannot be refunded"
+// @Router       /returns/{id}/refund [post]
+func (h *ReturnHandler) RefundReturn(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid return id", err.Error())
+		return
+	}
+
+	var req dto.RefundReturnRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+			return
+		}
+	}
+
+	response, err := h.returnService.Refund(ctx.Request.Context(), userID.(uint), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Return refunded successfully", response)
+}
+
+// returnActor reads the user set by the JWT middleware, answering the
+// request itself when there is none.
+func returnActor(ctx *gin.Context) (orders.Actor, bool) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return orders.Actor{}, false
+	}
+	return orders.Actor{
+		UserID: userID.(uint),
+		Staff:  ctx.GetString("role") == users.RoleStaff,
+	}, true
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, returns.ErrReturnNotFound),
+		errors.Is(err, orders.ErrOrderNotFound),
+		errors.Is(err, inventory.ErrLocationNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, returns.ErrInvalidReturnItems),
+		errors.Is(err, returns.ErrLocationRequired),
+		errors.Is(err, returns.ErrRefundExceedsReturn),
+		errors.Is(err, inventory.ErrLocationInactive):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, returns.ErrNotReturnable),
+		errors.Is(err, returns.ErrReturnWindowClosed),
+		errors.Is(err, returns.ErrInvalidTransition),
+		errors.Is(err, returns.ErrNoCapturedPayment),
+		errors.Is(err, returns.ErrRefundRetryMismatch),
+		errors.Is(err, payments.ErrNotRefundable),
+		errors.Is(err, payments.ErrInvalidRefundAmount),
+		errors.Is(err, orders.ErrInvalidTransition):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
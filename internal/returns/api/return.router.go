@@ -0,0 +1,27 @@
+package api
+
+import (
+	"bookstore-framework/internal/returns"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReturnsRoutes serves the return service built in main, which refunds
+// through the same payment service as the payment routes.
+func ReturnsRoutes(router *gin.RouterGroup, returnService returns.ReturnService) {
+	returnHandler := NewReturnHandler(returnService)
+
+	router.Use(middleware.JWTAuth())
+	router.POST("", returnHandler.RequestReturn)
+	router.GET("", returnHandler.GetReturns)
+	router.GET("/:id", returnHandler.GetReturn)
+
+	staff := router.Group("")
+	staff.Use(middleware.RequireRole(users.RoleStaff))
+	staff.POST("/:id/approve", returnHandler.ApproveReturn)
+	staff.POST("/:id/reject", returnHandler.RejectReturn)
+	staff.POST("/:id/receive", returnHandler.ReceiveReturn)
+	staff.POST("/:id/refund", returnHandler.RefundReturn)
+}
@@ -0,0 +1,95 @@
+package returns
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	StatusRequested = "requested"
+	StatusApproved  = "approved"
+	StatusRejected  = "rejected"
+	StatusReceived  = "received"
+	StatusRefunded  = "refunded"
+)
+
+const (
+	DispositionRestock  = "restock"
+	DispositionWriteOff = "write_off"
+)
+
+// A return is refunded through the payment provider of the order, or as
+// store credit to the customer's wallet.
+const (
+	RefundPayment     = "payment"
+	RefundStoreCredit = "store_credit"
+)
+
+// transitions lists the statuses each status can move to. Rejected and
+// refunded returns are final.
+var transitions = map[string][]string{
+	StatusRequested: {StatusApproved, StatusRejected},
+	StatusApproved:  {StatusReceived},
+	StatusReceived:  {StatusRefunded},
+}
+
+// CanTransition reports whether a return may move from one status to another.
+func CanTransition(from, to string) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// Return is a return merchandise authorization: the customer asks to send
+// back copies of a delivered order, staff approve it, receive the copies and
+// refund them. Amount is what the customer paid for the copies, tax included
+// and the points redeemed on them taken off, in minor units of Currency;
+// RefundedAmount is what was refunded of it, the way RefundMethod says.
+// StoreCredit is the part of RefundedAmount that went to the wallet: the
+// share of the copies paid with a gift card or store credit always does. A
+// return reopened after its payment refund failed keeps the share the wallet
+// got, and a retry must give it the same share.
+type Return struct {
+	ID             uint         `gorm:"primaryKey"`
+	OrderID        uint         `gorm:"column:order_id;not null;index"`
+	UserID         uint         `gorm:"column:user_id;not null;index"`
+	Status         string       `gorm:"column:status;size:20;not null;index"`
+	Reason         string       `gorm:"column:reason;size:255;not null"`
+	Note           string       `gorm:"column:note;size:255"`
+	Currency       string       `gorm:"column:currency;size:3;not null"`
+	Amount         int64        `gorm:"column:amount;not null"`
+	RefundedAmount int64        `gorm:"column:refunded_amount;not null;default:0"`
+	RefundMethod   string       `gorm:"column:refund_method;size:20"`
+	StoreCredit    int64        `gorm:"column:store_credit;not null;default:0"`
+	ActorID        *uint        `gorm:"column:actor_id"`
+	Items          []ReturnItem `gorm:"foreignKey:ReturnID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time    `gorm:"column:created_at;autoCreateTime;index"`
+	ModifiedAt     time.Time    `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Return) TableName() string {
+	return "returns"
+}
+
+// Reference identifies the return in the stock ledger.
+func (r Return) Reference() string {
+	return fmt.Sprintf("return:%d", r.ID)
+}
+
+// ReturnItem is a number of copies of one line of the order. Disposition and
+// LocationID are set when the copies are received: restocked copies go back
+// to the location, written off copies do not go back into stock.
+type ReturnItem struct {
+	ID          uint   `gorm:"primaryKey"`
+	ReturnID    uint   `gorm:"column:return_id;not null;index"`
+	BookID      uint   `gorm:"column:book_id;not null;index"`
+	SKU         string `gorm:"column:sku;size:64;not null"`
+	Title       string `gorm:"column:title;size:255;not null"`
+	Quantity    int    `gorm:"column:quantity;not null;check:chk_return_items_quantity,quantity > 0"`
+	Amount      int64  `gorm:"column:amount;not null"`
+	Disposition string `gorm:"column:disposition;size:20"`
+	LocationID  *uint  `gorm:"column:location_id"`
+}
+
+func (ReturnItem) TableName() string {
+	return "return_items"
+}
@@ -0,0 +1,137 @@
+package returns
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ReturnFilter struct {
+	// UserID limits the list to the returns of one customer when set.
+	UserID  *uint
+	OrderID uint
+	Status  string
+	Offset  int
+	Limit   int
+}
+
+type ReturnRepository interface {
+	// Create saves the return with its items.
+	Create(ctx context.Context, ret *Return) (*Return, error)
+	FindByID(ctx context.Context, id uint) (*Return, error)
+	FindAll(ctx context.Context, filter ReturnFilter) ([]Return, int64, error)
+	// ReturnedQuantities sums, by book, the copies of the order that are in
+	// returns that were not rejected.
+	ReturnedQuantities(ctx context.Context, orderID uint) (map[uint]int, error)
+	// Update saves the return and the disposition of its items if its status
+	// is still from, returning gorm.ErrRecordNotFound otherwise.
+	Update(ctx context.Context, ret *Return, from string) error
+}
+
+type returnRepository struct {
+	db *gorm.DB
+}
+
+func NewReturnRepository(db *gorm.DB) ReturnRepository {
+	return &returnRepository{
+		db: db,
+	}
+}
+
+func (r *returnRepository) Create(ctx context.Context, ret *Return) (*Return, error) {
+	result := pkg.DB(ctx, r.db).Create(ret)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ret, nil
+}
+
+func (r *returnRepository) FindByID(ctx context.Context, id uint) (*Return, error) {
+	var ret *Return
+	result := pkg.DB(ctx, r.db).Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("return_items.id")
+	}).First(&ret, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ret, nil
+}
+
+func (r *returnRepository) FindAll(ctx context.Context, filter ReturnFilter) ([]Return, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Return{})
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.OrderID != 0 {
+		query = query.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var returns []Return
+	result := query.Preload("Items").Order("id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&returns)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return returns, total, nil
+}
+
+func (r *returnRepository) ReturnedQuantities(ctx context.Context, orderID uint) (map[uint]int, error) {
+	var rows []struct {
+		BookID   uint
+		Quantity int
+	}
+	err := pkg.DB(ctx, r.db).Model(&ReturnItem{}).
+		Select("return_items.book_id, SUM(return_items.quantity) AS quantity").
+		Joins("JOIN returns ON returns.id = return_items.return_id").
+		Where("returns.order_id = ? AND returns.status <> ?", orderID, StatusRejected).
+		Group("return_items.book_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	quantities := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		quantities[row.BookID] = row.Quantity
+	}
+	return quantities, nil
+}
+
+func (r *returnRepository) Update(ctx context.Context, ret *Return, from string) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Return{}).
+			Where("id = ? AND status = ?", ret.ID, from).
+			Updates(map[string]interface{}{
+				"status":          ret.Status,
+				"note":            ret.Note,
+				"refunded_amount": ret.RefundedAmount,
+				"refund_method":   ret.RefundMethod,
+				"actor_id":        ret.ActorID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		for _, item := range ret.Items {
+			err := tx.Model(&ReturnItem{}).Where("id = ?", item.ID).Updates(map[string]interface{}{
+				"disposition": item.Disposition,
+				"location_id": item.LocationID,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
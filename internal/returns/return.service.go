@@ -0,0 +1,499 @@
+package returns
+
+import (
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	paymentsDto "bookstore-framework/internal/payments/api/dto"
+	"bookstore-framework/internal/returns/api/dto"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReturnWindow is how long after delivery customers may ask to return an order.
+const ReturnWindow = 30 * 24 * time.Hour
+
+var (
+	ErrReturnNotFound      = errors.New("return not found")
+	ErrNotReturnable       = errors.New("only delivered orders can be returned")
+	ErrReturnWindowClosed  = errors.New("the return window of this order has closed")
+	ErrInvalidReturnItems  = errors.New("return items do not match the order")
+	ErrInvalidTransition   = errors.New("return cannot move to this status")
+	ErrLocationRequired    = errors.New("a location is required for restocked items")
+	ErrRefundExceedsReturn = errors.New("refund amount exceeds what was paid for the returned copies")
+	ErrNoCapturedPayment   = errors.New("the order has no captured payment to refund")
+	ErrRefundRetryMismatch = errors.New("a retried refund must give the wallet the same share as the failed attempt")
+)
+
+type ReturnService interface {
+	// RequestReturn opens a return for copies of a delivered order of the
+	// user, within ReturnWindow of the delivery.
+	RequestReturn(ctx context.Context, userID uint, req dto.CreateReturnRequest) (*dto.ReturnResponse, error)
+	GetReturn(ctx context.Context, actor orders.Actor, id uint) (*dto.ReturnResponse, error)
+	GetReturns(ctx context.Context, actor orders.Actor, query dto.ReturnListQuery) (*dto.ReturnListResponse, error)
+	Approve(ctx context.Context, actorID uint, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error)
+	Reject(ctx context.Context, actorID uint, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error)
+	// Receive records what happened to the copies sent back: restocked
+	// copies are returned into the stock ledger in the same transaction.
+	Receive(ctx context.Context, actorID uint, id uint, req dto.ReceiveReturnRequest) (*dto.ReturnResponse, error)
+	// Refund refunds a received return through the payment provider of the
+	// order or as store credit, in full or in part. The share of the refund
+	// the order paid with a gift card or store credit goes to the wallet.
+	Refund(ctx context.Context, actorID uint, id uint, req dto.RefundReturnRequest) (*dto.ReturnResponse, error)
+}
+
+type returnService struct {
+	returnRepo     ReturnRepository
+	orderRepo      orders.OrderRepository
+	inventoryRepo  inventory.InventoryRepository
+	paymentRepo    payments.PaymentRepository
+	paymentService payments.PaymentService
+	walletService  wallet.WalletService
+	transactor     pkg.Transactor
+}
+
+func NewReturnService(returnRepo ReturnRepository, orderRepo orders.OrderRepository, inventoryRepo inventory.InventoryRepository, paymentRepo payments.PaymentRepository, paymentService payments.PaymentService, walletService wallet.WalletService, transactor pkg.Transactor) ReturnService {
+	return &returnService{
+		returnRepo:     returnRepo,
+		orderRepo:      orderRepo,
+		inventoryRepo:  inventoryRepo,
+		paymentRepo:    paymentRepo,
+		paymentService: paymentService,
+		walletService:  walletService,
+		transactor:     transactor,
+	}
+}
+
+func (s *returnService) RequestReturn(ctx context.Context, userID uint, req dto.CreateReturnRequest) (*dto.ReturnResponse, error) {
+	order, err := s.orderRepo.FindByID(ctx, req.OrderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && order.UserID != userID) {
+		return nil, orders.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != orders.StatusDelivered {
+		return nil, ErrNotReturnable
+	}
+	if deliveredAt(order).Add(ReturnWindow).Before(time.Now()) {
+		return nil, ErrReturnWindowClosed
+	}
+
+	ret := &Return{
+		OrderID:  order.ID,
+		UserID:   userID,
+		Status:   StatusRequested,
+		Reason:   req.Reason,
+		Currency: order.Currency,
+	}
+	paid := refundable(order)
+	lines := make(map[uint]orders.OrderItem, len(order.Items))
+	ordered := make(map[uint]int, len(order.Items))
+	for _, item := range order.Items {
+		lines[item.BookID] = item
+		ordered[item.BookID] = item.Quantity
+	}
+	for _, item := range req.Items {
+		line, found := lines[item.BookID]
+		if !found {
+			return nil, fmt.Errorf("%w: book %d is not in the order or listed twice", ErrInvalidReturnItems, item.BookID)
+		}
+		delete(lines, item.BookID)
+
+		amount := paid[line.BookID] * int64(item.Quantity) / int64(line.Quantity)
+		ret.Items = append(ret.Items, ReturnItem{
+			BookID:   line.BookID,
+			SKU:      line.SKU,
+			Title:    line.Title,
+			Quantity: item.Quantity,
+			Amount:   amount,
+		})
+		ret.Amount += amount
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Locking the order keeps concurrent requests from returning the
+		// same copies twice.
+		if _, err := s.orderRepo.FindByIDForUpdate(ctx, order.ID); err != nil {
+			return err
+		}
+		returned, err := s.returnRepo.ReturnedQuantities(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		for _, item := range ret.Items {
+			if left := ordered[item.BookID] - returned[item.BookID]; item.Quantity > left {
+				return fmt.Errorf("%w: only %d copies of book %d can be returned", ErrInvalidReturnItems, left, item.BookID)
+			}
+		}
+		_, err = s.returnRepo.Create(ctx, ret)
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return ToReturnResponse(ret), nil
+}
+
+func (s *returnService) GetReturn(ctx context.Context, actor orders.Actor, id uint) (*dto.ReturnResponse, error) {
+	ret, err := s.returnRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if !actor.Staff && ret.UserID != actor.UserID {
+		return nil, ErrReturnNotFound
+	}
+	return ToReturnResponse(ret), nil
+}
+
+func (s *returnService) GetReturns(ctx context.Context, actor orders.Actor, query dto.ReturnListQuery) (*dto.ReturnListResponse, error) {
+	filter := ReturnFilter{
+		OrderID: query.OrderID,
+		Status:  query.Status,
+		Offset:  query.Offset(),
+		Limit:   query.Limit,
+	}
+	if !actor.Staff {
+		filter.UserID = &actor.UserID
+	}
+
+	returns, total, err := s.returnRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ReturnListResponse{
+		Returns:    make([]dto.ReturnResponse, 0, len(returns)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for _, ret := range returns {
+		response.Returns = append(response.Returns, *ToReturnResponse(&ret))
+	}
+	return response, nil
+}
+
+func (s *returnService) Approve(ctx context.Context, actorID uint, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error) {
+	return s.review(ctx, actorID, id, StatusApproved, req.Note)
+}
+
+func (s *returnService) Reject(ctx context.Context, actorID uint, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error) {
+	return s.review(ctx, actorID, id, StatusRejected, req.Note)
+}
+
+func (s *returnService) review(ctx context.Context, actorID uint, id uint, status, note string) (*dto.ReturnResponse, error) {
+	ret, err := s.returnRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if err := s.advance(ctx, ret, status, actorID, note); err != nil {
+		return nil, translateError(err)
+	}
+	return ToReturnResponse(ret), nil
+}
+
+func (s *returnService) Receive(ctx context.Context, actorID uint, id uint, req dto.ReceiveReturnRequest) (*dto.ReturnResponse, error) {
+	ret, err := s.returnRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if !CanTransition(ret.Status, StatusReceived) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, ret.Status, StatusReceived)
+	}
+
+	received := make(map[uint]dto.ReceiveItemRequest, len(req.Items))
+	for _, item := range req.Items {
+		received[item.BookID] = item
+	}
+	if len(received) != len(req.Items) || len(received) != len(ret.Items) {
+		return nil, fmt.Errorf("%w: list every book of the return once", ErrInvalidReturnItems)
+	}
+
+	var movements []inventory.StockMovement
+	checked := make(map[uint]bool)
+	for i, item := range ret.Items {
+		disposition, found := received[item.BookID]
+		if !found {
+			return nil, fmt.Errorf("%w: book %d is missing", ErrInvalidReturnItems, item.BookID)
+		}
+		ret.Items[i].Disposition = disposition.Disposition
+		if disposition.Disposition != DispositionRestock {
+			continue
+		}
+
+		if disposition.LocationID == 0 {
+			return nil, ErrLocationRequired
+		}
+		if !checked[disposition.LocationID] {
+			if err := s.checkLocation(ctx, disposition.LocationID); err != nil {
+				return nil, err
+			}
+			checked[disposition.LocationID] = true
+		}
+		ret.Items[i].LocationID = &disposition.LocationID
+		movements = append(movements, inventory.StockMovement{
+			SKU:        item.SKU,
+			LocationID: disposition.LocationID,
+			BookID:     item.BookID,
+			Type:       inventory.MovementReturn,
+			Quantity:   item.Quantity,
+			Reason:     fmt.Sprintf("Returned from order %d", ret.OrderID),
+			Reference:  ret.Reference(),
+			ActorID:    &actorID,
+		})
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.advance(ctx, ret, StatusReceived, actorID, req.Note); err != nil {
+			return err
+		}
+		if len(movements) == 0 {
+			return nil
+		}
+		_, err := s.inventoryRepo.RecordMovements(ctx, movements)
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return ToReturnResponse(ret), nil
+}
+
+func (s *returnService) Refund(ctx context.Context, actorID uint, id uint, req dto.RefundReturnRequest) (*dto.ReturnResponse, error) {
+	ret, err := s.returnRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if !CanTransition(ret.Status, StatusRefunded) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, ret.Status, StatusRefunded)
+	}
+	amount := req.Amount
+	if amount == 0 {
+		amount = ret.Amount
+	}
+	if amount > ret.Amount {
+		return nil, ErrRefundExceedsReturn
+	}
+
+	order, err := s.orderRepo.FindByID(ctx, ret.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	paid, credit := splitRefund(order, amount)
+	// A refund that failed after posting its wallet share left it on the
+	// return: the retry posts nothing new, so it must refund the same share.
+	posted := ret.StoreCredit
+	if posted > 0 && credit != posted {
+		return nil, fmt.Errorf("%w: %d was credited", ErrRefundRetryMismatch, posted)
+	}
+
+	var payment *payments.Payment
+	if !req.StoreCredit && paid > 0 {
+		attempts, err := s.paymentRepo.FindByOrderID(ctx, ret.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range attempts {
+			if attempts[i].Status == payments.PaymentCaptured {
+				payment = &attempts[i]
+			}
+		}
+		if payment == nil {
+			return nil, ErrNoCapturedPayment
+		}
+	}
+
+	// The return is marked refunded before the money moves, so that a
+	// concurrent request cannot refund it a second time.
+	ret.RefundedAmount = amount
+	ret.RefundMethod = RefundPayment
+	ret.StoreCredit = credit
+	if req.StoreCredit {
+		ret.RefundMethod = RefundStoreCredit
+		ret.StoreCredit = amount
+	}
+	if err := s.advance(ctx, ret, StatusRefunded, actorID, ""); err != nil {
+		return nil, translateError(err)
+	}
+
+	// The wallet share goes first: it is posted once per return, so a
+	// refund retried after the payment provider failed does not post it
+	// twice.
+	if credit > 0 {
+		err = s.walletService.RefundOrder(ctx, ret.UserID, ret.OrderID, credit, ret.Reference())
+		if err == nil {
+			posted = credit
+		}
+	}
+	if err == nil && paid > 0 {
+		if req.StoreCredit {
+			err = s.walletService.CreditRefund(ctx, ret.UserID, ret.Currency, paid, ret.Reference())
+		} else {
+			_, err = s.paymentService.Refund(ctx, actorID, payment.ID, paymentsDto.RefundRequest{
+				Amount: paid,
+				Reason: fmt.Sprintf("Return %d", ret.ID),
+			})
+		}
+	}
+	if err != nil {
+		// The return is reopened with what the wallet got, which stays there.
+		ret.Status = StatusReceived
+		ret.RefundedAmount = posted
+		ret.RefundMethod = ""
+		ret.StoreCredit = posted
+		if err := s.returnRepo.Update(ctx, ret, StatusRefunded); err != nil {
+			log.Printf("Failed to reopen return %d after a failed refund: %v", ret.ID, err)
+		}
+		return nil, err
+	}
+
+	return ToReturnResponse(ret), nil
+}
+
+// advance moves the return to status if it has not changed since it was read.
+func (s *returnService) advance(ctx context.Context, ret *Return, status string, actorID uint, note string) error {
+	from := ret.Status
+	if !CanTransition(from, status) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, status)
+	}
+	ret.Status = status
+	ret.ActorID = &actorID
+	if note != "" {
+		ret.Note = note
+	}
+
+	err := s.returnRepo.Update(ctx, ret, from)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: it was changed by someone else", ErrInvalidTransition)
+	}
+	return err
+}
+
+func (s *returnService) checkLocation(ctx context.Context, id uint) error {
+	location, err := s.inventoryRepo.FindLocationByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return inventory.ErrLocationNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !location.Active {
+		return inventory.ErrLocationInactive
+	}
+	return nil
+}
+
+// deliveredAt returns when the order was delivered, going by its history.
+func deliveredAt(order *orders.Order) time.Time {
+	var at time.Time
+	for _, transition := range order.Transitions {
+		if transition.ToStatus == orders.StatusDelivered {
+			at = transition.CreatedAt
+		}
+	}
+	return at
+}
+
+// refundable returns what the customer paid for each line of the order, by
+// book. Redeemed points came off the order as a whole, so they are spread
+// over the lines by what the lines cost: the part of a line paid with points
+// is not refunded in money.
+func refundable(order *orders.Order) map[uint]int64 {
+	costs := make([]int64, len(order.Items))
+	for i, line := range order.Items {
+		costs[i] = line.LineTotal - line.Discount
+		if !order.PricesIncludeTax {
+			costs[i] += line.Tax
+		}
+	}
+	points := spread(order.PointsDiscount, costs)
+
+	paid := make(map[uint]int64, len(order.Items))
+	for i, line := range order.Items {
+		paid[line.BookID] = costs[i] - points[i]
+	}
+	return paid
+}
+
+// splitRefund splits a refund of an order by how the order was paid: paid is
+// the share of what was due after the gift card and store credit, credit the
+// share they paid. Rounding favours the gift card and store credit, so that
+// the refunds of the payment never add up to more than was due.
+func splitRefund(order *orders.Order, amount int64) (paid, credit int64) {
+	if order.Total <= 0 {
+		return amount, 0
+	}
+	paid = amount * order.AmountDue() / order.Total
+	return paid, amount - paid
+}
+
+// spread divides amount over parts in proportion to their weights, rounding
+// down and giving what is left to the last part, so that the shares add up
+// to amount.
+func spread(amount int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+	if amount == 0 || len(weights) == 0 {
+		return shares
+	}
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+	left := amount
+	if total > 0 {
+		for i, weight := range weights[:len(weights)-1] {
+			shares[i] = amount * weight / total
+			left -= shares[i]
+		}
+	}
+	shares[len(shares)-1] = left
+	return shares
+}
+
+func ToReturnResponse(ret *Return) *dto.ReturnResponse {
+	response := &dto.ReturnResponse{
+		ID:             ret.ID,
+		OrderID:        ret.OrderID,
+		UserID:         ret.UserID,
+		Status:         ret.Status,
+		Reason:         ret.Reason,
+		Note:           ret.Note,
+		Currency:       ret.Currency,
+		Amount:         ret.Amount,
+		RefundedAmount: ret.RefundedAmount,
+		RefundMethod:   ret.RefundMethod,
+		StoreCredit:    ret.StoreCredit,
+		Items:          make([]dto.ReturnItemResponse, 0, len(ret.Items)),
+		CreatedAt:      ret.CreatedAt,
+		ModifiedAt:     ret.ModifiedAt,
+	}
+	for _, item := range ret.Items {
+		response.Items = append(response.Items, dto.ReturnItemResponse{
+			BookID:      item.BookID,
+			SKU:         item.SKU,
+			Title:       item.Title,
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+			Disposition: item.Disposition,
+			LocationID:  item.LocationID,
+		})
+	}
+	return response
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrReturnNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// ReviewRequest represents a create or update review request
+// @Description A rating from 1 to 5 stars with an optional title and text. A user reviews a book once and edits that review afterwards.
+type ReviewRequest struct {
+	Rating int    `json:"rating" binding:"required,min=1,max=5" example:"5"`
+	Title  string `json:"title" binding:"max=120" example:"A timeless adventure"`
+	Body   string `json:"body" binding:"max=10000" example:"Read it to my kids, and loved it all over again."`
+}
+
+// ReviewListQuery represents the query string of the review list endpoint
+type ReviewListQuery struct {
+	pkg.PaginationQuery
+	Rating   int    `form:"rating" binding:"omitempty,min=1,max=5"`
+	Verified bool   `form:"verified"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=helpful recent highest lowest"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	booksDto "bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type ReviewResponse struct {
+	ID               uint      `json:"id"`
+	BookID           uint      `json:"book_id"`
+	UserID           uint      `json:"user_id"`
+	Reviewer         string    `json:"reviewer"`
+	Rating           int       `json:"rating"`
+	Title            string    `json:"title,omitempty"`
+	Body             string    `json:"body,omitempty"`
+	VerifiedPurchase bool      `json:"verified_purchase"`
+	HelpfulCount     int       `json:"helpful_count"`
+	CreatedAt        time.Time `json:"created_at"`
+	ModifiedAt       time.Time `json:"modified_at"`
+}
+
+type ReviewListResponse struct {
+	Rating     booksDto.RatingResponse `json:"rating"`
+	Reviews    []ReviewResponse        `json:"reviews"`
+	Pagination pkg.PaginationMeta      `json:"pagination"`
+}
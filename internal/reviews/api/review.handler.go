@@ -0,0 +1,265 @@
+package api
+
+import (
+	"bookstore-framework/internal/reviews"
+	"bookstore-framework/internal/reviews/api/dto"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewHandler struct {
+	reviewService reviews.ReviewService
+}
+
+func NewReviewHandler(reviewService reviews.ReviewService) *ReviewHandler {
+	return &ReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+// CreateReview godoc
+// @Summary      Review a book
+// @Description  Rate a book from 1 to 5 stars, with an optional title and text. Each user reviews a book once; the review is flagged as a verified purchase when the user paid for the book
+// @Tags         reviews
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int               true "Book ID"
+// @Param        request body     dto.ReviewRequest true "Review"
+// @Success      201  {object}    pkg.Response{data=dto.ReviewResponse} "Review created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Failure      409  {object}    pkg.Response "Book already reviewed"
+// @Router       /books/{id}/reviews [post]
+func (h *ReviewHandler) CreateReview(ctx *gin.Context) {
+	actor, ok := reviewActor(ctx)
+	if !ok {
+		return
+	}
+
+	bookID, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	var req dto.ReviewRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reviewService.CreateReview(ctx.Request.Context(), actor.UserID, bookID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Review created successfully", response)
+}
+
+// GetReviews godoc
+// @Summary      List the reviews of a book
+// @Description  Get the rating summary of a book and its reviews, the most helpful first unless sorted otherwise
+// @Tags         reviews
+// @Produce      json
+// @Param        id       path     int    true  "Book ID"
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        limit    query    int    false "Page size" default(20)
+// @Param        rating   query    int    false "Only reviews with this many stars"
+// @Param        verified query    bool   false "Only verified purchases"
+// @Param        sort     query    string false "Order of the reviews" Enums(helpful, recent, highest, lowest)
+// @Success      200  {object}    pkg.Response{data=dto.ReviewListResponse} "Reviews retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Book not found"
+// @Router       /books/{id}/reviews [get]
+func (h *ReviewHandler) GetReviews(ctx *gin.Context) {
+	bookID, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return
+	}
+
+	var query dto.ReviewListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reviewService.GetReviews(ctx.Request.Context(), bookID, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Reviews retrieve successfully", response)
+}
+
+// UpdateReview godoc
+// @Summary      Edit a review
+// @Description  Change the rating and text of your own review
+// @Tags         reviews
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int               true "Review ID"
+// @Param        request body     dto.ReviewRequest true "Review"
+// @Success      200  {object}    pkg.Response{data=dto.ReviewResponse} "Review updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Review belongs to another user"
+// @Failure      404  {object}    pkg.Response "Review not found"
+// @Router       /reviews/{id} [put]
+func (h *ReviewHandler) UpdateReview(ctx *gin.Context) {
+	actor, ok := reviewActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid review id", err.Error())
+		return
+	}
+
+	var req dto.ReviewRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.reviewService.UpdateReview(ctx.Request.Context(), actor, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Review updated successfully", response)
+}
+
+// DeleteReview godoc
+// @Summary      Delete a review
+// @Description  Delete your own review. Staff may delete any review
+// @Tags         reviews
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Review ID"
+// @Success      200  {object}    pkg.Response "Review deleted successfully"
+// @Failure      403  {object}    pkg.Response "Review belongs to another user"
+// @Failure      404  {object}    pkg.Response "Review not found"
+// @Router       /reviews/{id} [delete]
+func (h *ReviewHandler) DeleteReview(ctx *gin.Context) {
+	actor, ok := reviewActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid review id", err.Error())
+		return
+	}
+
+	if err := h.reviewService.DeleteReview(ctx.Request.Context(), actor, id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Review deleted successfully", nil)
+}
+
+// Vote godoc
+// @Summary      Mark a review as helpful
+// @Description  Vote for a review of another user. Voting again has no effect
+// @Tags         reviews
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Review ID"
+// @Success      200  {object}    pkg.Response{data=dto.ReviewResponse} "Vote saved successfully"
+// @Failure      400  {object}    pkg.Response "Own review"
+// @Failure      404  {object}    pkg.Response "Review not found"
+// @Router       /reviews/{id}/vote [put]
+func (h *ReviewHandler) Vote(ctx *gin.Context) {
+	actor, ok := reviewActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid review id", err.Error())
+		return
+	}
+
+	response, err := h.reviewService.Vote(ctx.Request.Context(), actor.UserID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Vote saved successfully", response)
+}
+
+// Unvote godoc
+// @Summary      Take back a helpful vote
+// @Description  Remove your vote from a review
+// @Tags         reviews
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Review ID"
+// @Success      200  {object}    pkg.Response{data=dto.ReviewResponse} "Vote removed successfully"
+// @Failure      404  {object}    pkg.Response "Review not found"
+// @Router       /reviews/{id}/vote [delete]
+func (h *ReviewHandler) Unvote(ctx *gin.Context) {
+	actor, ok := reviewActor(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid review id", err.Error())
+		return
+	}
+
+	response, err := h.reviewService.Unvote(ctx.Request.Context(), actor.UserID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Vote removed successfully", response)
+}
+
+// reviewActor reads the user set by the JWT middleware, answering the request
+// itself when there is none.
+func reviewActor(ctx *gin.Context) (reviews.Actor, bool) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return reviews.Actor{}, false
+	}
+	return reviews.Actor{
+		UserID: userID.(uint),
+		Staff:  ctx.GetString("role") == users.RoleStaff,
+	}, true
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, reviews.ErrReviewNotFound),
+		errors.Is(err, reviews.ErrBookNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, reviews.ErrOwnReview):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, reviews.ErrNotReviewOwner):
+		pkg.ErrorResponse(ctx, http.StatusForbidden, err.Error(), nil)
+	case errors.Is(err, reviews.ErrDuplicateReview):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/reviews"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func newReviewHandler(db *gorm.DB) *ReviewHandler {
+	reviewService := reviews.NewReviewService(
+		reviews.NewReviewRepository(db),
+		books.NewBookRepository(db),
+		orders.NewOrderRepository(db),
+		loyalty.NewLoyaltyService(
+			loyalty.NewLoyaltyRepository(db),
+			pricing.NewPricingService(pricing.NewPricingRepository(db)),
+		),
+	)
+	return NewReviewHandler(reviewService)
+}
+
+// BookReviewsRoutes serves the reviews of the book in the :id parameter of
+// the router.
+func BookReviewsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	reviewHandler := newReviewHandler(db)
+
+	router.GET("", reviewHandler.GetReviews)
+	router.POST("", middleware.JWTAuth(), reviewHandler.CreateReview)
+}
+
+func ReviewsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	reviewHandler := newReviewHandler(db)
+
+	router.Use(middleware.JWTAuth())
+	router.PUT("/:id", reviewHandler.UpdateReview)
+	router.DELETE("/:id", reviewHandler.DeleteReview)
+	router.PUT("/:id/vote", reviewHandler.Vote)
+	router.DELETE("/:id/vote", reviewHandler.Unvote)
+}
@@ -0,0 +1,38 @@
+package reviews
+
+import (
+	"bookstore-framework/internal/users"
+	"time"
+)
+
+// Review is the rating and opinion of a user on a book, one per user and book.
+// VerifiedPurchase is set when the user paid for the book.
+type Review struct {
+	ID               uint         `gorm:"primaryKey"`
+	BookID           uint         `gorm:"column:book_id;not null;uniqueIndex:idx_reviews_book_user"`
+	UserID           uint         `gorm:"column:user_id;not null;uniqueIndex:idx_reviews_book_user;index"`
+	User             users.User   `gorm:"foreignKey:UserID"`
+	Rating           int          `gorm:"column:rating;not null;check:chk_reviews_rating,rating BETWEEN 1 AND 5"`
+	Title            string       `gorm:"column:title;size:120"`
+	Body             string       `gorm:"column:body;type:text"`
+	VerifiedPurchase bool         `gorm:"column:verified_purchase;not null;default:false"`
+	HelpfulCount     int          `gorm:"column:helpful_count;not null;default:0"`
+	Votes            []ReviewVote `gorm:"foreignKey:ReviewID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time    `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt       time.Time    `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Review) TableName() string {
+	return "reviews"
+}
+
+// ReviewVote records that a user found a review helpful, once per user.
+type ReviewVote struct {
+	ReviewID  uint      `gorm:"primaryKey;autoIncrement:false"`
+	UserID    uint      `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ReviewVote) TableName() string {
+	return "review_votes"
+}
@@ -0,0 +1,197 @@
+package reviews
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/pkg"
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SortHelpful = "helpful"
+	SortRecent  = "recent"
+	SortHighest = "highest"
+	SortLowest  = "lowest"
+)
+
+type ReviewFilter struct {
+	BookID   uint
+	Rating   int
+	Verified bool
+	Sort     string
+	Offset   int
+	Limit    int
+}
+
+type ReviewRepository interface {
+	// Create saves the review and counts its rating on the book.
+	Create(ctx context.Context, review *Review) (*Review, error)
+	FindByID(ctx context.Context, id uint) (*Review, error)
+	FindAll(ctx context.Context, filter ReviewFilter) ([]Review, int64, error)
+	// Update saves the review and moves its rating on the book from the
+	// stored rating to the new one.
+	Update(ctx context.Context, review *Review) (*Review, error)
+	// Delete removes the review and its rating from the book.
+	Delete(ctx context.Context, id uint) error
+	// Vote counts the user's vote on the review, once. It tells whether the
+	// vote is new.
+	Vote(ctx context.Context, reviewID, userID uint) (bool, error)
+	// Unvote takes back the user's vote. It tells whether there was one.
+	Unvote(ctx context.Context, reviewID, userID uint) (bool, error)
+}
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return &reviewRepository{
+		db: db,
+	}
+}
+
+func (r *reviewRepository) Create(ctx context.Context, review *Review) (*Review, error) {
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Create(review).Error; err != nil {
+			return err
+		}
+		return countRating(tx, review.BookID, review.Rating, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r *reviewRepository) FindByID(ctx context.Context, id uint) (*Review, error) {
+	var review *Review
+	result := pkg.DB(ctx, r.db).Preload("User").First(&review, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return review, nil
+}
+
+func (r *reviewRepository) FindAll(ctx context.Context, filter ReviewFilter) ([]Review, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Review{}).Where("book_id = ?", filter.BookID)
+	if filter.Rating != 0 {
+		query = query.Where("rating = ?", filter.Rating)
+	}
+	if filter.Verified {
+		query = query.Where("verified_purchase")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var reviews []Review
+	result := query.Preload("User").
+		Order(reviewOrder(filter.Sort)).Offset(filter.Offset).Limit(filter.Limit).
+		Find(&reviews)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return reviews, total, nil
+}
+
+func (r *reviewRepository) Update(ctx context.Context, review *Review) (*Review, error) {
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Locking the stored review makes concurrent updates of it move the
+		// rating one after the other.
+		var stored Review
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "book_id", "rating").First(&stored, review.ID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(review).
+			Select("rating", "title", "body", "verified_purchase", "modified_at").
+			Updates(review).Error
+		if err != nil {
+			return err
+		}
+		if stored.Rating == review.Rating {
+			return nil
+		}
+		if err := countRating(tx, stored.BookID, stored.Rating, -1); err != nil {
+			return err
+		}
+		return countRating(tx, stored.BookID, review.Rating, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r *reviewRepository) Delete(ctx context.Context, id uint) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var stored Review
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "book_id", "rating").First(&stored, id).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(&stored).Error; err != nil {
+			return err
+		}
+		return countRating(tx, stored.BookID, stored.Rating, -1)
+	})
+}
+
+func (r *reviewRepository) Vote(ctx context.Context, reviewID, userID uint) (bool, error) {
+	var voted bool
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&ReviewVote{ReviewID: reviewID, UserID: userID})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		voted = true
+		return tx.Model(&Review{}).Where("id = ?", reviewID).
+			UpdateColumn("helpful_count", gorm.Expr("helpful_count + 1")).Error
+	})
+	return voted, err
+}
+
+func (r *reviewRepository) Unvote(ctx context.Context, reviewID, userID uint) (bool, error) {
+	var unvoted bool
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("review_id = ? AND user_id = ?", reviewID, userID).Delete(&ReviewVote{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		unvoted = true
+		return tx.Model(&Review{}).Where("id = ?", reviewID).
+			UpdateColumn("helpful_count", gorm.Expr("helpful_count - 1")).Error
+	})
+	return unvoted, err
+}
+
+// countRating adds delta reviews of the rating to the aggregates of the book.
+// The columns are incremented in place rather than recomputed, so concurrent
+// transactions do not overwrite each other's counts.
+func countRating(tx *gorm.DB, bookID uint, rating, delta int) error {
+	return tx.Table(books.Book{}.TableName()).Where("id = ?", bookID).UpdateColumns(map[string]interface{}{
+		"rating_count":                         gorm.Expr("rating_count + ?", delta),
+		"rating_sum":                           gorm.Expr("rating_sum + ?", delta*rating),
+		fmt.Sprintf("rating_%d_count", rating): gorm.Expr(fmt.Sprintf("rating_%d_count + ?", rating), delta),
+	}).Error
+}
+
+func reviewOrder(sort string) string {
+	switch sort {
+	case SortRecent:
+		return "created_at DESC, id DESC"
+	case SortHighest:
+		return "rating DESC, helpful_count DESC, id DESC"
+	case SortLowest:
+		return "rating, helpful_count DESC, id DESC"
+	default:
+		return "helpful_count DESC, created_at DESC, id DESC"
+	}
+}
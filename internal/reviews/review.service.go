@@ -0,0 +1,212 @@
+package reviews
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/reviews/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrReviewNotFound  = errors.New("review not found")
+	ErrBookNotFound    = errors.New("book not found")
+	ErrDuplicateReview = errors.New("you already reviewed this book, edit your review instead")
+	ErrNotReviewOwner  = errors.New("review belongs to another user")
+	ErrOwnReview       = errors.New("you cannot vote for your own review")
+)
+
+// Actor is the user acting on a review. Staff may delete any review, other
+// users only edit and delete their own.
+type Actor struct {
+	UserID uint
+	Staff  bool
+}
+
+type ReviewService interface {
+	// CreateReview reviews a book, flagging the review as a verified purchase
+	// when the user paid for the book. Verified reviews earn loyalty points.
+	CreateReview(ctx context.Context, userID, bookID uint, req dto.ReviewRequest) (*dto.ReviewResponse, error)
+	GetReviews(ctx context.Context, bookID uint, query dto.ReviewListQuery) (*dto.ReviewListResponse, error)
+	UpdateReview(ctx context.Context, actor Actor, id uint, req dto.ReviewRequest) (*dto.ReviewResponse, error)
+	DeleteReview(ctx context.Context, actor Actor, id uint) error
+	// Vote marks a review as helpful. Voting twice counts once.
+	Vote(ctx context.Context, userID, id uint) (*dto.ReviewResponse, error)
+	Unvote(ctx context.Context, userID, id uint) (*dto.ReviewResponse, error)
+}
+
+type reviewService struct {
+	reviewRepo     ReviewRepository
+	bookRepo       books.BookRepository
+	orderRepo      orders.OrderRepository
+	loyaltyService loyalty.LoyaltyService
+}
+
+func NewReviewService(reviewRepo ReviewRepository, bookRepo books.BookRepository, orderRepo orders.OrderRepository, loyaltyService loyalty.LoyaltyService) ReviewService {
+	return &reviewService{
+		reviewRepo:     reviewRepo,
+		bookRepo:       bookRepo,
+		orderRepo:      orderRepo,
+		loyaltyService: loyaltyService,
+	}
+}
+
+func (s *reviewService) CreateReview(ctx context.Context, userID, bookID uint, req dto.ReviewRequest) (*dto.ReviewResponse, error) {
+	if _, err := s.bookRepo.FindByID(ctx, bookID); err != nil {
+		return nil, translateBookError(err)
+	}
+	verified, err := s.orderRepo.HasPurchased(ctx, userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &Review{BookID: bookID, UserID: userID, VerifiedPurchase: verified}
+	apply(review, req)
+	if _, err := s.reviewRepo.Create(ctx, review); err != nil {
+		return nil, translateError(err)
+	}
+	if verified {
+		// The review stands even when its points could not be awarded.
+		if err := s.loyaltyService.AwardReview(ctx, userID, bookID); err != nil {
+			log.Printf("Failed to award loyalty points for review %d: %v", review.ID, err)
+		}
+	}
+	return s.getReview(ctx, review.ID)
+}
+
+func (s *reviewService) GetReviews(ctx context.Context, bookID uint, query dto.ReviewListQuery) (*dto.ReviewListResponse, error) {
+	book, err := s.bookRepo.FindByID(ctx, bookID)
+	if err != nil {
+		return nil, translateBookError(err)
+	}
+
+	reviews, total, err := s.reviewRepo.FindAll(ctx, ReviewFilter{
+		BookID:   bookID,
+		Rating:   query.Rating,
+		Verified: query.Verified,
+		Sort:     query.Sort,
+		Offset:   query.Offset(),
+		Limit:    query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ReviewListResponse{
+		Rating:     books.ToRatingResponse(book),
+		Reviews:    make([]dto.ReviewResponse, 0, len(reviews)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range reviews {
+		response.Reviews = append(response.Reviews, *ToReviewResponse(&reviews[i]))
+	}
+	return response, nil
+}
+
+func (s *reviewService) UpdateReview(ctx context.Context, actor Actor, id uint, req dto.ReviewRequest) (*dto.ReviewResponse, error) {
+	review, err := s.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	// Staff moderate reviews by deleting them, never by rewording them.
+	if review.UserID != actor.UserID {
+		return nil, ErrNotReviewOwner
+	}
+
+	// The purchase may have been made, or refunded, since the review.
+	if review.VerifiedPurchase, err = s.orderRepo.HasPurchased(ctx, review.UserID, review.BookID); err != nil {
+		return nil, err
+	}
+	apply(review, req)
+	if _, err := s.reviewRepo.Update(ctx, review); err != nil {
+		return nil, translateError(err)
+	}
+	return ToReviewResponse(review), nil
+}
+
+func (s *reviewService) DeleteReview(ctx context.Context, actor Actor, id uint) error {
+	review, err := s.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return translateError(err)
+	}
+	if review.UserID != actor.UserID && !actor.Staff {
+		return ErrNotReviewOwner
+	}
+	return translateError(s.reviewRepo.Delete(ctx, id))
+}
+
+func (s *reviewService) Vote(ctx context.Context, userID, id uint) (*dto.ReviewResponse, error) {
+	review, err := s.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if review.UserID == userID {
+		return nil, ErrOwnReview
+	}
+
+	if _, err := s.reviewRepo.Vote(ctx, id, userID); err != nil {
+		return nil, translateError(err)
+	}
+	return s.getReview(ctx, id)
+}
+
+func (s *reviewService) Unvote(ctx context.Context, userID, id uint) (*dto.ReviewResponse, error) {
+	if _, err := s.reviewRepo.Unvote(ctx, id, userID); err != nil {
+		return nil, translateError(err)
+	}
+	return s.getReview(ctx, id)
+}
+
+func (s *reviewService) getReview(ctx context.Context, id uint) (*dto.ReviewResponse, error) {
+	review, err := s.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToReviewResponse(review), nil
+}
+
+func apply(review *Review, req dto.ReviewRequest) {
+	review.Rating = req.Rating
+	review.Title = strings.TrimSpace(req.Title)
+	review.Body = strings.TrimSpace(req.Body)
+}
+
+func ToReviewResponse(review *Review) *dto.ReviewResponse {
+	return &dto.ReviewResponse{
+		ID:               review.ID,
+		BookID:           review.BookID,
+		UserID:           review.UserID,
+		Reviewer:         review.User.Name,
+		Rating:           review.Rating,
+		Title:            review.Title,
+		Body:             review.Body,
+		VerifiedPurchase: review.VerifiedPurchase,
+		HelpfulCount:     review.HelpfulCount,
+		CreatedAt:        review.CreatedAt,
+		ModifiedAt:       review.ModifiedAt,
+	}
+}
+
+func translateBookError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrBookNotFound
+	}
+	return err
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrReviewNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateReview
+	default:
+		return err
+	}
+}
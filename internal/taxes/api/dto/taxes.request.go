@@ -0,0 +1,30 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// TaxRegionRequest represents a create or update tax region request
+// @Description Tax rates of a destination. Leave region empty for the rates of the whole country; a region, such as
+// @Description a US state code, takes precedence over its country. Set prices_include_tax when catalog prices already
+// @Description include the tax, as with VAT. An update replaces all the rates of the region.
+type TaxRegionRequest struct {
+	Country          string           `json:"country" binding:"required,iso3166_1_alpha2" example:"FR"`
+	Region           string           `json:"region" binding:"max=100" example:""`
+	Name             string           `json:"name" binding:"required,max=100" example:"France"`
+	PricesIncludeTax bool             `json:"prices_include_tax" example:"true"`
+	Rates            []TaxRateRequest `json:"rates" binding:"required,min=1,dive"`
+}
+
+// TaxRateRequest is the rate of one tax class
+// @Description rate is in basis points: 2000 is 20%, 550 is 5.5%. The "default" tax class applies to every class
+// @Description without a rate of its own; classes with neither are not taxed in the region.
+type TaxRateRequest struct {
+	TaxClass string `json:"tax_class" binding:"required,max=32" example:"book"`
+	Name     string `json:"name" binding:"required,max=50" example:"TVA"`
+	Rate     int    `json:"rate" binding:"min=0,max=10000" example:"550"`
+}
+
+// TaxRegionListQuery represents the query string of the tax region list endpoint
+type TaxRegionListQuery struct {
+	pkg.PaginationQuery
+	Country string `form:"country" binding:"omitempty,iso3166_1_alpha2"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type TaxRateResponse struct {
+	TaxClass string `json:"tax_class"`
+	Name     string `json:"name"`
+	Rate     int    `json:"rate"`
+}
+
+type TaxRegionResponse struct {
+	ID               uint              `json:"id"`
+	Country          string            `json:"country"`
+	Region           string            `json:"region,omitempty"`
+	Name             string            `json:"name"`
+	PricesIncludeTax bool              `json:"prices_include_tax"`
+	Rates            []TaxRateResponse `json:"rates"`
+	CreatedAt        time.Time         `json:"created_at"`
+	ModifiedAt       time.Time         `json:"modified_at"`
+}
+
+type TaxRegionListResponse struct {
+	Regions    []TaxRegionResponse `json:"regions"`
+	Pagination pkg.PaginationMeta  `json:"pagination"`
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/taxes/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TaxHandler struct {
+	taxService taxes.TaxService
+}
+
+func NewTaxHandler(taxService taxes.TaxService) *TaxHandler {
+	return &TaxHandler{
+		taxService: taxService,
+	}
+}
+
+// CreateRegion godoc
+// @Summary      Create a tax region
+// @Description  Add the tax rates of a country or of a region of a country (staff only). Orders shipped to a destination without rates are not taxed
+// @Tags         taxes
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.TaxRegionRequest true "Tax region"
+// @Success      201  {object}    pkg.Response{data=dto.TaxRegionResponse} "Tax region created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Access forbidden"
+// @Failure      409  {object}    pkg.Response "Tax rates for this region already exist"
+// @Router       /tax-regions [post]
+func (h *TaxHandler) CreateRegion(ctx *gin.Context) {
+	var req dto.TaxRegionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.taxService.CreateRegion(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Tax region created successfully", response)
+}
+
+// GetRegions godoc
+// @Summary      List tax regions
+// @Description  List tax regions with their rates, by country then region (staff only)
+// @Tags         taxes
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page    query     int    false "Page number" default(1)
+// @Param        limit   query     int    false "Page size" default(20)
+// @Param        country query     string false "Filter by ISO 3166-1 alpha-2 country code"
+// @Success      200  {object}    pkg.Response{data=dto.TaxRegionListResponse} "Tax regions retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /tax-regions [get]
+func (h *TaxHandler) GetRegions(ctx *gin.Context) {
+	var query dto.TaxRegionListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.taxService.GetRegions(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Tax regions retrieve successfully", response)
+}
+
+// GetRegion godoc
+// @Summary      Get a tax region
+// @Description  Get a single tax region with its rates (staff only)
+// @Tags         taxes
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Tax region ID"
+// @Success      200  {object}    pkg.Response{data=dto.TaxRegionResponse} "Tax region retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Tax region not found"
+// @Router       /tax-regions/{id} [get]
+func (h *TaxHandler) GetRegion(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid tax region id", err.Error())
+		return
+	}
+
+	response, err := h.taxService.GetRegion(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Tax region retrieve successfully", response)
+}
+
+// UpdateRegion godoc
+// @Summary      Update a tax region
+// @Description  Replace a tax region and all its rates (staff only). Orders already placed keep the tax they were charged
+// @Tags         taxes
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int true "Tax region ID"
+// @Param        request body     dto.TaxRegionRequest true "Tax region"
+// @Success      200  {object}    pkg.Response{data=dto.TaxRegionResponse} "Tax region updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Tax region not found"
+// @Failure      409  {object}    pkg.Response "Tax rates for this region already exist"
+// @Router       /tax-regions/{id} [put]
+func (h *TaxHandler) UpdateRegion(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid tax region id", err.Error())
+		return
+	}
+
+	var req dto.TaxRegionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.taxService.UpdateRegion(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Tax region updated successfully", response)
+}
+
+// DeleteRegion godoc
+// @Summary      Delete a tax region
+// @Description  Remove a tax region and its rates (staff only)
+// @Tags         taxes
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Tax region ID"
+// @Success      200  {object}    pkg.Response "Tax region deleted successfully"
+// @Failure      404  {object}    pkg.Response "Tax region not found"
+// @Router       /tax-regions/{id} [delete]
+func (h *TaxHandler) DeleteRegion(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid tax region id", err.Error())
+		return
+	}
+
+	if err := h.taxService.DeleteRegion(ctx.Request.Context(), id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Tax region deleted successfully", nil)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, taxes.ErrTaxRegionNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, taxes.ErrInvalidTaxRegion):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, taxes.ErrDuplicateRegion):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
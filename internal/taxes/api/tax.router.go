@@ -0,0 +1,22 @@
+package api
+
+import (
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/users"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func TaxRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	taxService := taxes.NewTaxService(taxes.NewTaxRepository(db))
+	taxHandler := NewTaxHandler(taxService)
+
+	router.Use(middleware.JWTAuth(), middleware.RequireRole(users.RoleStaff))
+	router.POST("", taxHandler.CreateRegion)
+	router.GET("", taxHandler.GetRegions)
+	router.GET("/:id", taxHandler.GetRegion)
+	router.PUT("/:id", taxHandler.UpdateRegion)
+	router.DELETE("/:id", taxHandler.DeleteRegion)
+}
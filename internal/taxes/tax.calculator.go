@@ -0,0 +1,85 @@
+package taxes
+
+// Destination is where an order ships, which decides its tax.
+type Destination struct {
+	Country string
+	Region  string
+}
+
+// Line is a line to tax. Amount is what the customer pays for it after
+// discounts, with or without tax depending on the pricing of the region.
+type Line struct {
+	BookID   uint
+	TaxClass string
+	Amount   int64
+}
+
+type LineTax struct {
+	BookID   uint
+	TaxClass string
+	Name     string
+	Rate     int
+	Tax      int64
+}
+
+// Calculation is the tax of a set of lines, in the order of the lines.
+// RegionID is nil when no tax applies to the destination.
+type Calculation struct {
+	RegionID         *uint
+	PricesIncludeTax bool
+	Lines            []LineTax
+	Tax              int64
+}
+
+// Calculate taxes each line at the rate of its class in the region, rounding
+// each line half up. With tax-inclusive prices the tax is the part of the
+// amount that is tax, otherwise it comes on top of the amount. A nil region
+// taxes nothing.
+func Calculate(region *TaxRegion, lines []Line) *Calculation {
+	calculation := &Calculation{Lines: make([]LineTax, len(lines))}
+	if region != nil {
+		calculation.RegionID = &region.ID
+		calculation.PricesIncludeTax = region.PricesIncludeTax
+	}
+
+	for i, line := range lines {
+		result := LineTax{BookID: line.BookID, TaxClass: line.TaxClass}
+		if rate := region.RateFor(line.TaxClass); rate != nil {
+			result.Name = rate.Name
+			result.Rate = rate.Rate
+			result.Tax = tax(line.Amount, rate.Rate, region.PricesIncludeTax)
+		}
+		calculation.Lines[i] = result
+		calculation.Tax += result.Tax
+	}
+	return calculation
+}
+
+// RateFor returns the rate of a tax class, falling back to the default rate
+// of the region, or nil when the class is not taxed.
+func (r *TaxRegion) RateFor(class string) *TaxRate {
+	if r == nil {
+		return nil
+	}
+	var fallback *TaxRate
+	for i := range r.Rates {
+		switch r.Rates[i].TaxClass {
+		case class:
+			return &r.Rates[i]
+		case ClassDefault:
+			fallback = &r.Rates[i]
+		}
+	}
+	return fallback
+}
+
+func tax(amount int64, rate int, inclusive bool) int64 {
+	if amount <= 0 || rate <= 0 {
+		return 0
+	}
+	divisor := int64(10000)
+	if inclusive {
+		divisor += int64(rate)
+	}
+	return (2*amount*int64(rate) + divisor) / (2 * divisor)
+}
@@ -0,0 +1,42 @@
+package taxes
+
+import "time"
+
+// ClassDefault names the rate of a region that applies to every tax class the
+// region has no rate of its own for.
+const ClassDefault = "default"
+
+// TaxRegion is a destination with its own tax rates: a whole country, or a
+// region of a country such as a US state, which takes precedence over its
+// country.
+type TaxRegion struct {
+	ID      uint   `gorm:"primaryKey"`
+	Country string `gorm:"column:country;size:2;not null;uniqueIndex:idx_tax_regions_destination"`
+	// Region is empty for the rates of the whole country.
+	Region string `gorm:"column:region;size:100;not null;uniqueIndex:idx_tax_regions_destination"`
+	Name   string `gorm:"column:name;size:100;not null"`
+	// PricesIncludeTax tells whether catalog prices already include the tax
+	// of the region, as is usual with VAT, or the tax is added on top.
+	PricesIncludeTax bool      `gorm:"column:prices_include_tax;not null;default:false"`
+	Rates            []TaxRate `gorm:"foreignKey:RegionID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt       time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (TaxRegion) TableName() string {
+	return "tax_regions"
+}
+
+// TaxRate is the rate of one tax class in a region. Rate is in basis points:
+// 2000 is 20%, 550 is 5.5%.
+type TaxRate struct {
+	ID       uint   `gorm:"primaryKey"`
+	RegionID uint   `gorm:"column:region_id;not null;uniqueIndex:idx_tax_rates_region_class"`
+	TaxClass string `gorm:"column:tax_class;size:32;not null;uniqueIndex:idx_tax_rates_region_class"`
+	Name     string `gorm:"column:name;size:50;not null"`
+	Rate     int    `gorm:"column:rate;not null"`
+}
+
+func (TaxRate) TableName() string {
+	return "tax_rates"
+}
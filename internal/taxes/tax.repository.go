@@ -0,0 +1,125 @@
+package taxes
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type TaxRegionFilter struct {
+	Country string
+	Offset  int
+	Limit   int
+}
+
+type TaxRepository interface {
+	Create(ctx context.Context, region *TaxRegion) (*TaxRegion, error)
+	FindByID(ctx context.Context, id uint) (*TaxRegion, error)
+	FindAll(ctx context.Context, filter TaxRegionFilter) ([]TaxRegion, int64, error)
+	// FindForDestination returns the region of the destination, or of its
+	// country when the region has no rates of its own.
+	FindForDestination(ctx context.Context, country, region string) (*TaxRegion, error)
+	// Update saves the region and replaces its rates.
+	Update(ctx context.Context, region *TaxRegion) (*TaxRegion, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type taxRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRepository(db *gorm.DB) TaxRepository {
+	return &taxRepository{
+		db: db,
+	}
+}
+
+func (r *taxRepository) Create(ctx context.Context, region *TaxRegion) (*TaxRegion, error) {
+	result := pkg.DB(ctx, r.db).Create(region)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return region, nil
+}
+
+func (r *taxRepository) FindByID(ctx context.Context, id uint) (*TaxRegion, error) {
+	var region *TaxRegion
+	result := pkg.DB(ctx, r.db).Preload("Rates", orderRates).First(&region, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return region, nil
+}
+
+func (r *taxRepository) FindAll(ctx context.Context, filter TaxRegionFilter) ([]TaxRegion, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&TaxRegion{})
+	if filter.Country != "" {
+		query = query.Where("country = ?", filter.Country)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var regions []TaxRegion
+	result := query.Preload("Rates", orderRates).
+		Order("country, region").Offset(filter.Offset).Limit(filter.Limit).
+		Find(&regions)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return regions, total, nil
+}
+
+func (r *taxRepository) FindForDestination(ctx context.Context, country, region string) (*TaxRegion, error) {
+	var taxRegion *TaxRegion
+	// The empty region of the country sorts last.
+	result := pkg.DB(ctx, r.db).Preload("Rates").
+		Where("country = ? AND region IN ?", country, []string{region, ""}).
+		Order("region DESC").
+		First(&taxRegion)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return taxRegion, nil
+}
+
+func (r *taxRepository) Update(ctx context.Context, region *TaxRegion) (*TaxRegion, error) {
+	err := pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Rates").Save(region).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("region_id = ?", region.ID).Delete(&TaxRate{}).Error; err != nil {
+			return err
+		}
+		for i := range region.Rates {
+			region.Rates[i].ID = 0
+			region.Rates[i].RegionID = region.ID
+		}
+		if len(region.Rates) == 0 {
+			return nil
+		}
+		return tx.Create(&region.Rates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return region, nil
+}
+
+func (r *taxRepository) Delete(ctx context.Context, id uint) error {
+	result := pkg.DB(ctx, r.db).Delete(&TaxRegion{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func orderRates(db *gorm.DB) *gorm.DB {
+	return db.Order("tax_class")
+}
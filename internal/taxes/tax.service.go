@@ -0,0 +1,168 @@
+package taxes
+
+import (
+	"bookstore-framework/internal/taxes/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTaxRegionNotFound = errors.New("tax region not found")
+	ErrDuplicateRegion   = errors.New("tax rates for this region already exist")
+	ErrInvalidTaxRegion  = errors.New("invalid tax region")
+)
+
+type TaxService interface {
+	CreateRegion(ctx context.Context, req dto.TaxRegionRequest) (*dto.TaxRegionResponse, error)
+	GetRegions(ctx context.Context, query dto.TaxRegionListQuery) (*dto.TaxRegionListResponse, error)
+	GetRegion(ctx context.Context, id uint) (*dto.TaxRegionResponse, error)
+	UpdateRegion(ctx context.Context, id uint, req dto.TaxRegionRequest) (*dto.TaxRegionResponse, error)
+	DeleteRegion(ctx context.Context, id uint) error
+	// Calculate taxes lines shipped to a destination. Destinations without
+	// tax rates are not taxed.
+	Calculate(ctx context.Context, destination Destination, lines []Line) (*Calculation, error)
+}
+
+type taxService struct {
+	taxRepo TaxRepository
+}
+
+func NewTaxService(taxRepo TaxRepository) TaxService {
+	return &taxService{
+		taxRepo: taxRepo,
+	}
+}
+
+// NormalizeRegion makes region codes case insensitive.
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+func (s *taxService) CreateRegion(ctx context.Context, req dto.TaxRegionRequest) (*dto.TaxRegionResponse, error) {
+	region := &TaxRegion{}
+	if err := apply(region, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.taxRepo.Create(ctx, region)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToTaxRegionResponse(created), nil
+}
+
+func (s *taxService) GetRegions(ctx context.Context, query dto.TaxRegionListQuery) (*dto.TaxRegionListResponse, error) {
+	regions, total, err := s.taxRepo.FindAll(ctx, TaxRegionFilter{
+		Country: strings.ToUpper(query.Country),
+		Offset:  query.Offset(),
+		Limit:   query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TaxRegionListResponse{
+		Regions:    make([]dto.TaxRegionResponse, 0, len(regions)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range regions {
+		response.Regions = append(response.Regions, *ToTaxRegionResponse(&regions[i]))
+	}
+	return response, nil
+}
+
+func (s *taxService) GetRegion(ctx context.Context, id uint) (*dto.TaxRegionResponse, error) {
+	region, err := s.taxRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToTaxRegionResponse(region), nil
+}
+
+func (s *taxService) UpdateRegion(ctx context.Context, id uint, req dto.TaxRegionRequest) (*dto.TaxRegionResponse, error) {
+	region, err := s.taxRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if err := apply(region, req); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.taxRepo.Update(ctx, region)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToTaxRegionResponse(updated), nil
+}
+
+func (s *taxService) DeleteRegion(ctx context.Context, id uint) error {
+	return translateError(s.taxRepo.Delete(ctx, id))
+}
+
+func (s *taxService) Calculate(ctx context.Context, destination Destination, lines []Line) (*Calculation, error) {
+	region, err := s.taxRepo.FindForDestination(ctx, strings.ToUpper(destination.Country), NormalizeRegion(destination.Region))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		region = nil
+	} else if err != nil {
+		return nil, err
+	}
+	return Calculate(region, lines), nil
+}
+
+// apply validates the request and copies it onto the region.
+func apply(region *TaxRegion, req dto.TaxRegionRequest) error {
+	rates := make([]TaxRate, 0, len(req.Rates))
+	seen := make(map[string]bool, len(req.Rates))
+	for _, rate := range req.Rates {
+		class := strings.ToLower(strings.TrimSpace(rate.TaxClass))
+		if seen[class] {
+			return fmt.Errorf("%w: tax class %q is listed twice", ErrInvalidTaxRegion, class)
+		}
+		seen[class] = true
+		rates = append(rates, TaxRate{TaxClass: class, Name: rate.Name, Rate: rate.Rate})
+	}
+
+	region.Country = strings.ToUpper(req.Country)
+	region.Region = NormalizeRegion(req.Region)
+	region.Name = req.Name
+	region.PricesIncludeTax = req.PricesIncludeTax
+	region.Rates = rates
+	return nil
+}
+
+func ToTaxRegionResponse(region *TaxRegion) *dto.TaxRegionResponse {
+	response := &dto.TaxRegionResponse{
+		ID:               region.ID,
+		Country:          region.Country,
+		Region:           region.Region,
+		Name:             region.Name,
+		PricesIncludeTax: region.PricesIncludeTax,
+		Rates:            make([]dto.TaxRateResponse, 0, len(region.Rates)),
+		CreatedAt:        region.CreatedAt,
+		ModifiedAt:       region.ModifiedAt,
+	}
+	for _, rate := range region.Rates {
+		response.Rates = append(response.Rates, dto.TaxRateResponse{
+			TaxClass: rate.TaxClass,
+			Name:     rate.Name,
+			Rate:     rate.Rate,
+		})
+	}
+	return response
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrTaxRegionNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateRegion
+	default:
+		return err
+	}
+}
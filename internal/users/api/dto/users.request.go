@@ -14,4 +14,6 @@ type RegisterRequest struct {
 type LoginRequest struct {
 	Username string `json:"username" binding:"required" example:"johndoe"`
 	Password string `json:"password" binding:"required" example:"xxxxxxx"`
+	// GuestCartToken comes from the guest cart cookie, not from the body.
+	GuestCartToken string `json:"-"`
 }
@@ -18,6 +18,7 @@ type ProfileResponse struct {
 	Name       string    `json:"name"`
 	Username   string    `json:"username"`
 	Email      string    `json:"email"`
+	Role       string    `json:"role"`
 	CreatedAt  time.Time `json:"created_at"`
 	ModifiedAt time.Time `json:"modified_at"`
 }
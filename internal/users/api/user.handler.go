@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bookstore-framework/internal/carts"
 	"bookstore-framework/internal/users"
 	"bookstore-framework/internal/users/api/dto"
 	"bookstore-framework/pkg"
@@ -47,7 +48,7 @@ func (h *UserHandler) RegisterHandler(ctx *gin.Context) {
 
 // LoginHandler godoc
 // @Summary      Login user
-// @Description  Login user account
+// @Description  Login user account. A guest cart from the cart_token cookie is merged into the user's cart
 // @Tags         users
 // @Accept       json
 // @Produce      json
@@ -62,12 +63,19 @@ func (h *UserHandler) LoginHandler(ctx *gin.Context) {
 		return
 	}
 
+	req.GuestCartToken, _ = ctx.Cookie(carts.TokenCookie)
+
 	response, err := h.userService.Login(ctx.Request.Context(), req)
 	if err != nil {
 		pkg.ErrorResponse(ctx, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 
+	if req.GuestCartToken != "" {
+		// The guest cart now belongs to the user, so the cookie is dropped.
+		ctx.SetCookie(carts.TokenCookie, "", -1, "/", "", ctx.Request.TLS != nil, true)
+	}
+
 	pkg.OkResponse(ctx, "Login Successfully", response)
 }
 
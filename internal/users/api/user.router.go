@@ -1,6 +1,11 @@
 package api
 
 import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
 	"bookstore-framework/internal/users"
 	"bookstore-framework/middleware"
 	"bookstore-framework/pkg"
@@ -12,7 +17,18 @@ import (
 func UsersRoutes(router *gin.RouterGroup, db *gorm.DB) {
 	userRepository := users.NewUserRepository(db)
 	jwtGenerator := &pkg.Claims{}
-	userService := users.NewUserService(userRepository, jwtGenerator)
+	cartService := carts.NewCartService(
+		carts.NewCartRepository(db),
+		books.NewBookRepository(db),
+		inventory.NewInventoryRepository(db),
+		promotions.NewPromotionService(
+			promotions.NewPromotionRepository(db),
+			books.NewCategoryRepository(db),
+			books.NewAuthorRepository(db),
+		),
+		pricing.NewPricingService(pricing.NewPricingRepository(db)),
+	)
+	userService := users.NewUserService(userRepository, jwtGenerator, cartService)
 	userHandler := NewUserHandler(userService)
 
 	router.POST("/register", userHandler.RegisterHandler)
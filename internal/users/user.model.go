@@ -6,12 +6,18 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	RoleCustomer = "customer"
+	RoleStaff    = "staff"
+)
+
 type User struct {
 	ID         uint           `gorm:"primaryKey"`
 	Name       string         `gorm:"column:name;not null"`
 	Username   string         `gorm:"column:username;uniqueIndex;not null"`
 	Email      string         `gorm:"column:email;uniqueIndex;not null"`
 	Password   string         `gorm:"column:password;not null"`
+	Role       string         `gorm:"column:role;size:20;not null;default:customer"`
 	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
 	ModifiedAt time.Time      `gorm:"column:modified_at;autoUpdateTime"`
 	DeletedAt  gorm.DeletedAt `gorm:"index"`
@@ -5,6 +5,7 @@ import (
 	"bookstore-framework/pkg"
 	"context"
 	"errors"
+	"log"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -15,15 +16,22 @@ type UserService interface {
 	GetProfile(ctx context.Context, userId uint) (*dto.ProfileResponse, error)
 }
 
+// CartMerger moves a guest cart into the cart of the user who just logged in.
+type CartMerger interface {
+	MergeGuestCart(ctx context.Context, guestToken string, userID uint) error
+}
+
 type userService struct {
-	userRepo UserRepository
-	jwtGen   pkg.JWTGenerator
+	userRepo   UserRepository
+	jwtGen     pkg.JWTGenerator
+	cartMerger CartMerger
 }
 
-func NewUserService(userRepo UserRepository, jwtGen pkg.JWTGenerator) UserService {
+func NewUserService(userRepo UserRepository, jwtGen pkg.JWTGenerator, cartMerger CartMerger) UserService {
 	return &userService{
-		userRepo: userRepo,
-		jwtGen:   jwtGen,
+		userRepo:   userRepo,
+		jwtGen:     jwtGen,
+		cartMerger: cartMerger,
 	}
 }
 
@@ -37,6 +45,7 @@ func (s *userService) Register(ctx context.Context, req dto.RegisterRequest) (*d
 		Name:     req.Name,
 		Password: string(hashedPassword),
 		Email:    req.Email,
+		Role:     RoleCustomer,
 	}
 
 	registerUser, err := s.userRepo.Register(ctx, &user)
@@ -65,10 +74,17 @@ func (s *userService) Login(ctx context.Context, req dto.LoginRequest) (*dto.Log
 		return nil, errors.New("invalid password or username")
 	}
 
-	token, err := s.jwtGen.GenerateToken(user.ID, user.Username, user.Email)
+	token, err := s.jwtGen.GenerateToken(user.ID, user.Username, user.Email, user.Role)
 	if err != nil {
 		return nil, err
 	}
+
+	if req.GuestCartToken != "" && s.cartMerger != nil {
+		// The guest cart stays behind if the merge fails, which must not block the login.
+		if err := s.cartMerger.MergeGuestCart(ctx, req.GuestCartToken, user.ID); err != nil {
+			log.Printf("Failed to merge guest cart into the cart of user %d: %v", user.ID, err)
+		}
+	}
 	respose := &dto.LoginResponse{
 		TokenAccess: token,
 	}
@@ -88,6 +104,7 @@ func (s *userService) GetProfile(ctx context.Context, userId uint) (*dto.Profile
 		Username:   user.Username,
 		Name:       user.Name,
 		Email:      user.Email,
+		Role:       user.Role,
 		CreatedAt:  user.CreatedAt,
 		ModifiedAt: user.ModifiedAt,
 	}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// IssueGiftCardRequest represents staff selling a gift card
+// @Description Balance in minor units of the currency. A card without an expiry date can be spent for three years.
+type IssueGiftCardRequest struct {
+	Amount         int64      `json:"amount" binding:"required,min=1" example:"5000"`
+	Currency       string     `json:"currency" binding:"required,len=3" example:"EUR"`
+	ExpiresAt      *time.Time `json:"expires_at" example:"2029-12-31T23:59:59Z"`
+	RecipientEmail string     `json:"recipient_email" binding:"omitempty,email,max=255" example:"reader@example.com"`
+	Message        string     `json:"message" binding:"max=255" example:"Happy birthday!"`
+}
+
+// GiftCardCodeRequest represents a gift card code typed by a customer
+type GiftCardCodeRequest struct {
+	Code string `json:"code" binding:"required,max=32" example:"7KQM-X2PD-H9RT-4WLB"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+// GiftCardResponse describes a gift card. The code is only returned when the
+// card is issued.
+type GiftCardResponse struct {
+	ID             uint      `json:"id"`
+	Code           string    `json:"code,omitempty"`
+	Last4          string    `json:"last4"`
+	Currency       string    `json:"currency"`
+	Amount         int64     `json:"amount"`
+	Balance        int64     `json:"balance"`
+	Expired        bool      `json:"expired"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	RecipientEmail string    `json:"recipient_email,omitempty"`
+	Message        string    `json:"message,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type GiftCardListResponse struct {
+	GiftCards  []GiftCardResponse `json:"gift_cards"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
+
+// GiftCardBalanceResponse is what a customer holding the code may see.
+type GiftCardBalanceResponse struct {
+	Last4     string    `json:"last4"`
+	Currency  string    `json:"currency"`
+	Balance   int64     `json:"balance"`
+	Expired   bool      `json:"expired"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type BalanceResponse struct {
+	Currency string `json:"currency"`
+	Balance  int64  `json:"balance"`
+}
+
+// WalletResponse lists the store credit of the logged in user by currency.
+type WalletResponse struct {
+	Balances []BalanceResponse `json:"balances"`
+}
+
+// MovementResponse is one change of the store credit, positive when credit
+// was added.
+type MovementResponse struct {
+	ID        uint      `json:"id"`
+	Type      string    `json:"type"`
+	Reference string    `json:"reference"`
+	Currency  string    `json:"currency"`
+	Amount    int64     `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MovementListResponse struct {
+	Movements  []MovementResponse `json:"movements"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
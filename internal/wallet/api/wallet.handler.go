@@ -0,0 +1,249 @@
+package api
+
+import (
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/internal/wallet/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WalletHandler struct {
+	walletService wallet.WalletService
+}
+
+func NewWalletHandler(walletService wallet.WalletService) *WalletHandler {
+	return &WalletHandler{
+		walletService: walletService,
+	}
+}
+
+// IssueGiftCard godoc
+// @Summary      Issue a gift card
+// @Description  Sell a gift card with a balance in one currency. The code is only returned in this response, keep it safe
+// @Tags         gift-cards
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.IssueGiftCardRequest true "Balance, currency and recipient"
+// @Success      201  {object}    pkg.Response{data=dto.GiftCardResponse} "Gift card issued successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Staff only"
+// @Router       /gift-cards [post]
+func (h *WalletHandler) IssueGiftCard(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.IssueGiftCardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.walletService.IssueGiftCard(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Gift card issued successfully", response)
+}
+
+// GetGiftCards godoc
+// @Summary      List gift cards
+// @Description  List the gift cards sold, newest first, with their balances
+// @Tags         gift-cards
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int    false "Page number" default(1)
+// @Param        limit  query     int    false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.GiftCardListResponse} "Gift cards retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      403  {object}    pkg.Response "Staff only"
+// @Router       /gift-cards [get]
+func (h *WalletHandler) GetGiftCards(ctx *gin.Context) {
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.walletService.GetGiftCards(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Gift cards retrieve successfully", response)
+}
+
+// GetGiftCard godoc
+// @Summary      Get a gift card
+// @Description  Get a gift card with its balance
+// @Tags         gift-cards
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Gift card ID"
+// @Success      200  {object}    pkg.Response{data=dto.GiftCardResponse} "Gift card retrieve successfully"
+// @Failure      403  {object}    pkg.Response "Staff only"
+// @Failure      404  {object}    pkg.Response "Gift card not found"
+// @Router       /gift-cards/{id} [get]
+func (h *WalletHandler) GetGiftCard(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid gift card id", err.Error())
+		return
+	}
+
+	var response *dto.GiftCardResponse
+	response, err = h.walletService.GetGiftCard(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Gift card retrieve successfully", response)
+}
+
+// CheckGiftCard godoc
+// @Summary      Check a gift card balance
+// @Description  Get the balance and expiry date of the gift card of a code
+// @Tags         gift-cards
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.GiftCardCodeRequest true "Gift card code"
+// @Success      200  {object}    pkg.Response{data=dto.GiftCardBalanceResponse} "Gift card balance retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Gift card not found"
+// @Router       /gift-cards/balance [post]
+func (h *WalletHandler) CheckGiftCard(ctx *gin.Context) {
+	var req dto.GiftCardCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.walletService.CheckGiftCard(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Gift card balance retrieve successfully", response)
+}
+
+// GetWallet godoc
+// @Summary      Get store credit
+// @Description  Get the store credit of the logged in user by currency. Redeemed gift cards, refunds and cancelled orders paid with credit add to it, and checkout can spend it
+// @Tags         wallet
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=dto.WalletResponse} "Wallet retrieve successfully"
+// @Failure      401  {object}    pkg.Response "User not found"
+// @Router       /wallet [get]
+func (h *WalletHandler) GetWallet(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var response *dto.WalletResponse
+	response, err := h.walletService.GetWallet(ctx.Request.Context(), userID.(uint))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wallet retrieve successfully", response)
+}
+
+// GetMovements godoc
+// @Summary      List store credit history
+// @Description  List what was added to and spent from the store credit of the logged in user, newest first
+// @Tags         wallet
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page   query     int    false "Page number" default(1)
+// @Param        limit  query     int    false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.MovementListResponse} "Wallet history retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /wallet/movements [get]
+func (h *WalletHandler) GetMovements(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.walletService.GetMovements(ctx.Request.Context(), userID.(uint), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wallet history retrieve successfully", response)
+}
+
+// RedeemGiftCard godoc
+// @Summary      Redeem a gift card
+// @Description  Move what is left on a gift card into the store credit of the logged in user. Gift cards can also be spent at checkout without redeeming them
+// @Tags         wallet
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.GiftCardCodeRequest true "Gift card code"
+// @Success      200  {object}    pkg.Response{data=dto.WalletResponse} "Gift card redeemed successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Gift card not found"
+// @Failure      409  {object}    pkg.Response "Gift card expired or empty"
+// @Router       /wallet/redeem [post]
+func (h *WalletHandler) RedeemGiftCard(ctx *gin.Context) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return
+	}
+
+	var req dto.GiftCardCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.walletService.RedeemGiftCard(ctx.Request.Context(), userID.(uint), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Gift card redeemed successfully", response)
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, wallet.ErrGiftCardNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, wallet.ErrUnsupportedCurrency),
+		errors.Is(err, wallet.ErrInvalidExpiry):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, wallet.ErrGiftCardExpired),
+		errors.Is(err, wallet.ErrGiftCardEmpty),
+		errors.Is(err, wallet.ErrInsufficientFunds):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
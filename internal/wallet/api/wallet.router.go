@@ -0,0 +1,38 @@
+package api
+
+import (
+	"bookstore-framework/internal/users"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/middleware"
+	"bookstore-framework/pkg"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func newWalletHandler(db *gorm.DB) *WalletHandler {
+	walletService := wallet.NewWalletService(wallet.NewWalletRepository(db), pkg.NewTransactor(db))
+	return NewWalletHandler(walletService)
+}
+
+func WalletRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	walletHandler := newWalletHandler(db)
+
+	router.Use(middleware.JWTAuth())
+	router.GET("", walletHandler.GetWallet)
+	router.GET("/movements", walletHandler.GetMovements)
+	router.POST("/redeem", walletHandler.RedeemGiftCard)
+}
+
+func GiftCardsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	walletHandler := newWalletHandler(db)
+
+	router.Use(middleware.JWTAuth())
+	router.POST("/balance", walletHandler.CheckGiftCard)
+
+	staff := router.Group("")
+	staff.Use(middleware.RequireRole(users.RoleStaff))
+	staff.POST("", walletHandler.IssueGiftCard)
+	staff.GET("", walletHandler.GetGiftCards)
+	staff.GET("/:id", walletHandler.GetGiftCard)
+}
@@ -0,0 +1,87 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GiftCardLifetime is how long a gift card can be spent when it is issued
+// without an expiry date.
+const GiftCardLifetime = 3 * 365 * 24 * time.Hour
+
+// codeAlphabet leaves out the letters and digits that are easily mistaken for
+// one another. It has 32 symbols, so a random byte maps to one without bias.
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const codeLength = 16
+
+// GiftCard is a balance that whoever holds its code can spend. Only a hash of
+// the code is stored; the code is shown once, when the card is issued. The
+// balance is that of the card's ledger account.
+type GiftCard struct {
+	ID             uint      `gorm:"primaryKey"`
+	CodeHash       string    `gorm:"column:code_hash;size:64;not null;uniqueIndex"`
+	Last4          string    `gorm:"column:last4;size:4;not null"`
+	Currency       string    `gorm:"column:currency;size:3;not null"`
+	Amount         int64     `gorm:"column:amount;not null"`
+	RecipientEmail string    `gorm:"column:recipient_email;size:255"`
+	Message        string    `gorm:"column:message;size:255"`
+	IssuedBy       *uint     `gorm:"column:issued_by"`
+	ExpiresAt      time.Time `gorm:"column:expires_at;not null;index"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (GiftCard) TableName() string {
+	return "gift_cards"
+}
+
+func (c GiftCard) AccountKey() string {
+	return fmt.Sprintf("gift_card:%d", c.ID)
+}
+
+// Reference identifies the card in the ledger.
+func (c GiftCard) Reference() string {
+	return fmt.Sprintf("gift_card:%d", c.ID)
+}
+
+func (c GiftCard) Expired(now time.Time) bool {
+	return !c.ExpiresAt.After(now)
+}
+
+// NewGiftCardCode returns a random code of 80 bits in groups of four
+// characters, such as 7KQM-X2PD-H9RT-4WLB.
+func NewGiftCardCode() (string, error) {
+	b := make([]byte, codeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	var code strings.Builder
+	for i, v := range b {
+		if i > 0 && i%4 == 0 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(codeAlphabet[int(v)%len(codeAlphabet)])
+	}
+	return code.String(), nil
+}
+
+// NormalizeGiftCardCode removes the separators and case customers may type a
+// code with.
+func NormalizeGiftCardCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// HashGiftCardCode returns what is stored to find the card of a code.
+func HashGiftCardCode(code string) string {
+	sum := sha256.Sum256([]byte(NormalizeGiftCardCode(code)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,130 @@
+package wallet
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// AccountWallet holds the store credit of a user in one currency.
+	AccountWallet   = "wallet"
+	AccountGiftCard = "gift_card"
+	// AccountSystem is the other side of money entering or leaving the
+	// customer accounts. Its balance is the negative of what it gave out.
+	AccountSystem = "system"
+)
+
+// Accounts of the store that customer accounts are funded from or spent into.
+const (
+	SystemGiftCardSales = "gift_card_sales"
+	SystemRefunds       = "refunds"
+	SystemOrders        = "orders"
+	SystemBreakage      = "breakage"
+)
+
+const (
+	TransactionGiftCardIssue  = "gift_card_issue"
+	TransactionGiftCardRedeem = "gift_card_redeem"
+	TransactionGiftCardExpiry = "gift_card_expiry"
+	TransactionCheckout       = "checkout"
+	// TransactionCheckoutReversal gives back to the wallet what a cancelled
+	// or refunded order took from a gift card or the wallet.
+	TransactionCheckoutReversal = "checkout_reversal"
+	// TransactionCheckoutReturn gives back to the wallet the part of a
+	// return that was paid from a gift card or the wallet.
+	TransactionCheckoutReturn = "checkout_return"
+	TransactionRefund         = "refund"
+)
+
+// Account is one side of the double-entry ledger. Key names the account:
+// wallet:<user>:<currency>, gift_card:<id> or system:<name>:<currency>.
+// Balance caches the sum of its postings and only customer accounts are kept
+// from going negative.
+type Account struct {
+	ID         uint      `gorm:"primaryKey"`
+	Key        string    `gorm:"column:key;size:64;not null;uniqueIndex"`
+	Type       string    `gorm:"column:type;size:20;not null"`
+	UserID     *uint     `gorm:"column:user_id;index"`
+	Currency   string    `gorm:"column:currency;size:3;not null"`
+	Balance    int64     `gorm:"column:balance;not null;default:0;check:chk_ledger_accounts_balance,type = 'system' OR balance >= 0"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Account) TableName() string {
+	return "ledger_accounts"
+}
+
+func WalletAccount(userID uint, currency string) Account {
+	return Account{
+		Key:      fmt.Sprintf("wallet:%d:%s", userID, currency),
+		Type:     AccountWallet,
+		UserID:   &userID,
+		Currency: currency,
+	}
+}
+
+func GiftCardAccount(card *GiftCard) Account {
+	return Account{
+		Key:      card.AccountKey(),
+		Type:     AccountGiftCard,
+		Currency: card.Currency,
+	}
+}
+
+func SystemAccount(name, currency string) Account {
+	return Account{
+		Key:      fmt.Sprintf("system:%s:%s", name, currency),
+		Type:     AccountSystem,
+		Currency: currency,
+	}
+}
+
+// Transaction moves money between accounts. Its postings sum to zero and are
+// never changed. There is one transaction of each type per reference, so
+// posting twice has no effect.
+type Transaction struct {
+	ID        uint      `gorm:"primaryKey"`
+	Type      string    `gorm:"column:type;size:32;not null;uniqueIndex:idx_ledger_transactions_type_reference"`
+	Reference string    `gorm:"column:reference;size:64;not null;uniqueIndex:idx_ledger_transactions_type_reference"`
+	Postings  []Posting `gorm:"foreignKey:TransactionID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (Transaction) TableName() string {
+	return "ledger_transactions"
+}
+
+// Posting is the signed amount a transaction adds to an account, in minor
+// units of the account currency. Account is only used to name the account
+// when posting.
+type Posting struct {
+	ID            uint    `gorm:"primaryKey"`
+	TransactionID uint    `gorm:"column:transaction_id;not null;index"`
+	AccountID     uint    `gorm:"column:account_id;not null;index"`
+	Amount        int64   `gorm:"column:amount;not null"`
+	Account       Account `gorm:"foreignKey:AccountID"`
+}
+
+func (Posting) TableName() string {
+	return "ledger_postings"
+}
+
+// Transfer returns the postings moving amount from one account to another.
+func Transfer(from, to Account, amount int64) []Posting {
+	return []Posting{
+		{Account: from, Amount: -amount},
+		{Account: to, Amount: amount},
+	}
+}
+
+// OrderReference identifies an order in the ledger.
+func OrderReference(orderID uint) string {
+	return fmt.Sprintf("order:%d", orderID)
+}
+
+// OrderReturnReference identifies a return of an order in the ledger, under
+// the reference of the order.
+func OrderReturnReference(orderID uint, reference string) string {
+	return fmt.Sprintf("%s:%s", OrderReference(orderID), reference)
+}
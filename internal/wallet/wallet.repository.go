@@ -0,0 +1,275 @@
+package wallet
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrUnbalancedTransaction = errors.New("ledger transaction does not balance")
+
+// Movement is a posting to a wallet of the user, with its transaction.
+type Movement struct {
+	ID        uint      `gorm:"column:id"`
+	Type      string    `gorm:"column:type"`
+	Reference string    `gorm:"column:reference"`
+	Currency  string    `gorm:"column:currency"`
+	Amount    int64     `gorm:"column:amount"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+type WalletRepository interface {
+	CreateGiftCard(ctx context.Context, card *GiftCard) (*GiftCard, error)
+	FindGiftCardByID(ctx context.Context, id uint) (*GiftCard, error)
+	FindGiftCardByCodeHash(ctx context.Context, codeHash string) (*GiftCard, error)
+	FindGiftCards(ctx context.Context, offset, limit int) ([]GiftCard, int64, error)
+	// FindExpiredGiftCards returns up to limit cards that expired by now with
+	// a balance left.
+	FindExpiredGiftCards(ctx context.Context, now time.Time, limit int) ([]GiftCard, error)
+	// FindAccounts returns the accounts of the keys that have one.
+	FindAccounts(ctx context.Context, keys []string) ([]Account, error)
+	FindWallets(ctx context.Context, userID uint) ([]Account, error)
+	FindMovements(ctx context.Context, userID uint, offset, limit int) ([]Movement, int64, error)
+	// FindTransaction returns a transaction with its postings and their
+	// accounts.
+	FindTransaction(ctx context.Context, txType, reference string) (*Transaction, error)
+	// FindTransactionsByPrefix returns the transactions of a type whose
+	// reference starts with prefix, with their postings and accounts.
+	FindTransactionsByPrefix(ctx context.Context, txType, prefix string) ([]Transaction, error)
+	// Post records a transaction and adds its postings to the balances of
+	// their accounts, which are created on first use. Postings name their
+	// account with Account. It fails with ErrInsufficientFunds when a
+	// customer account would go negative, and with gorm.ErrDuplicatedKey when
+	// a transaction of the same type and reference was posted.
+	Post(ctx context.Context, transaction *Transaction) error
+}
+
+type walletRepository struct {
+	db *gorm.DB
+}
+
+func NewWalletRepository(db *gorm.DB) WalletRepository {
+	return &walletRepository{
+		db: db,
+	}
+}
+
+func (r *walletRepository) CreateGiftCard(ctx context.Context, card *GiftCard) (*GiftCard, error) {
+	result := pkg.DB(ctx, r.db).Create(card)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return card, nil
+}
+
+func (r *walletRepository) FindGiftCardByID(ctx context.Context, id uint) (*GiftCard, error) {
+	var card *GiftCard
+	result := pkg.DB(ctx, r.db).First(&card, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return card, nil
+}
+
+func (r *walletRepository) FindGiftCardByCodeHash(ctx context.Context, codeHash string) (*GiftCard, error) {
+	var card *GiftCard
+	result := pkg.DB(ctx, r.db).Where("code_hash = ?", codeHash).First(&card)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return card, nil
+}
+
+func (r *walletRepository) FindGiftCards(ctx context.Context, offset, limit int) ([]GiftCard, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&GiftCard{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var cards []GiftCard
+	result := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&cards)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return cards, total, nil
+}
+
+func (r *walletRepository) FindExpiredGiftCards(ctx context.Context, now time.Time, limit int) ([]GiftCard, error) {
+	var cards []GiftCard
+	result := pkg.DB(ctx, r.db).
+		Joins("JOIN ledger_accounts ON ledger_accounts.key = 'gift_card:' || gift_cards.id").
+		Where("gift_cards.expires_at <= ? AND ledger_accounts.balance > 0", now).
+		Order("gift_cards.id").
+		Limit(limit).
+		Find(&cards)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cards, nil
+}
+
+func (r *walletRepository) FindAccounts(ctx context.Context, keys []string) ([]Account, error) {
+	var accounts []Account
+	result := pkg.DB(ctx, r.db).Where("key IN ?", keys).Find(&accounts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return accounts, nil
+}
+
+func (r *walletRepository) FindWallets(ctx context.Context, userID uint) ([]Account, error) {
+	var accounts []Account
+	result := pkg.DB(ctx, r.db).
+		Where("type = ? AND user_id = ?", AccountWallet, userID).
+		Order("currency").
+		Find(&accounts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return accounts, nil
+}
+
+func (r *walletRepository) FindMovements(ctx context.Context, userID uint, offset, limit int) ([]Movement, int64, error) {
+	query := pkg.DB(ctx, r.db).Table("ledger_postings").
+		Joins("JOIN ledger_accounts ON ledger_accounts.id = ledger_postings.account_id").
+		Joins("JOIN ledger_transactions ON ledger_transactions.id = ledger_postings.transaction_id").
+		Where("ledger_accounts.type = ? AND ledger_accounts.user_id = ?", AccountWallet, userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var movements []Movement
+	result := query.
+		Select("ledger_postings.id, ledger_transactions.type, ledger_transactions.reference, ledger_accounts.currency, ledger_postings.amount, ledger_transactions.created_at").
+		Order("ledger_transactions.created_at DESC, ledger_postings.id DESC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&movements)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return movements, total, nil
+}
+
+func (r *walletRepository) FindTransaction(ctx context.Context, txType, reference string) (*Transaction, error) {
+	var transaction *Transaction
+	result := pkg.DB(ctx, r.db).
+		Preload("Postings", func(db *gorm.DB) *gorm.DB {
+			return db.Order("ledger_postings.id")
+		}).
+		Preload("Postings.Account").
+		Where("type = ? AND reference = ?", txType, reference).
+		First(&transaction)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return transaction, nil
+}
+
+func (r *walletRepository) FindTransactionsByPrefix(ctx context.Context, txType, prefix string) ([]Transaction, error) {
+	var transactions []Transaction
+	result := pkg.DB(ctx, r.db).
+		Preload("Postings", func(db *gorm.DB) *gorm.DB {
+			return db.Order("ledger_postings.id")
+		}).
+		Preload("Postings.Account").
+		Where("type = ? AND reference LIKE ?", txType, prefix+"%").
+		Order("id").
+		Find(&transactions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return transactions, nil
+}
+
+func (r *walletRepository) Post(ctx context.Context, transaction *Transaction) error {
+	deltas := make(map[string]int64)
+	named := make(map[string]Account)
+	var sum int64
+	for _, posting := range transaction.Postings {
+		deltas[posting.Account.Key] += posting.Amount
+		named[posting.Account.Key] = posting.Account
+		sum += posting.Amount
+	}
+	if sum != 0 || len(transaction.Postings) == 0 {
+		return ErrUnbalancedTransaction
+	}
+
+	keys := make([]string, 0, len(named))
+	for key := range named {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, key := range keys {
+			account := named[key]
+			err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "key"}}, DoNothing: true}).
+				Create(&account).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// Locking in id order keeps concurrent transfers from deadlocking.
+		var accounts []Account
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("key IN ?", keys).
+			Order("id").
+			Find(&accounts).Error
+		if err != nil {
+			return err
+		}
+		byKey := make(map[string]*Account, len(accounts))
+		for i := range accounts {
+			byKey[accounts[i].Key] = &accounts[i]
+		}
+
+		for _, key := range keys {
+			account, ok := byKey[key]
+			if !ok {
+				return fmt.Errorf("ledger account %s: %w", key, gorm.ErrRecordNotFound)
+			}
+			if account.Currency != named[key].Currency {
+				return fmt.Errorf("%w: %s is in %s", ErrCurrencyMismatch, key, account.Currency)
+			}
+			balance := account.Balance + deltas[key]
+			if account.Type != AccountSystem && balance < 0 {
+				return ErrInsufficientFunds
+			}
+			err := tx.Model(&Account{}).Where("id = ?", account.ID).Updates(map[string]interface{}{
+				"balance":     balance,
+				"modified_at": time.Now(),
+			}).Error
+			if err != nil {
+				return err
+			}
+			account.Balance = balance
+		}
+
+		if err := tx.Omit("Postings").Create(transaction).Error; err != nil {
+			return err
+		}
+		for i := range transaction.Postings {
+			posting := &transaction.Postings[i]
+			account := byKey[posting.Account.Key]
+			posting.TransactionID = transaction.ID
+			posting.AccountID = account.ID
+			posting.Account = *account
+			if err := tx.Omit("Account").Create(posting).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,482 @@
+package wallet
+
+import (
+	"bookstore-framework/internal/wallet/api/dto"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const expireBatch = 100
+
+var (
+	ErrGiftCardNotFound    = errors.New("gift card not found")
+	ErrGiftCardExpired     = errors.New("gift card has expired")
+	ErrGiftCardEmpty       = errors.New("gift card has no balance left")
+	ErrInsufficientFunds   = errors.New("not enough balance left")
+	ErrCurrencyMismatch    = errors.New("gift card is in another currency")
+	ErrUnsupportedCurrency = errors.New("currency is not supported")
+	ErrInvalidExpiry       = errors.New("gift card must expire in the future")
+)
+
+// Funds is what a gift card and the store credit of a user pay of an order,
+// in minor units of Currency.
+type Funds struct {
+	Currency    string
+	GiftCardID  *uint
+	GiftCard    int64
+	StoreCredit int64
+}
+
+func (f Funds) Total() int64 {
+	return f.GiftCard + f.StoreCredit
+}
+
+// FundsRequest asks how much of Amount the gift card of Code, when set, and
+// the wallet of the user, when UseStoreCredit is set, can pay.
+type FundsRequest struct {
+	Currency       string
+	Amount         int64
+	Code           string
+	UseStoreCredit bool
+}
+
+type WalletService interface {
+	// IssueGiftCard sells a new gift card, returning its code. The code
+	// cannot be read back later.
+	IssueGiftCard(ctx context.Context, actorID uint, req dto.IssueGiftCardRequest) (*dto.GiftCardResponse, error)
+	GetGiftCard(ctx context.Context, id uint) (*dto.GiftCardResponse, error)
+	GetGiftCards(ctx context.Context, query pkg.PaginationQuery) (*dto.GiftCardListResponse, error)
+	CheckGiftCard(ctx context.Context, req dto.GiftCardCodeRequest) (*dto.GiftCardBalanceResponse, error)
+	// RedeemGiftCard moves what is left on a gift card into the wallet of
+	// the user, in the currency of the card.
+	RedeemGiftCard(ctx context.Context, userID uint, req dto.GiftCardCodeRequest) (*dto.WalletResponse, error)
+	GetWallet(ctx context.Context, userID uint) (*dto.WalletResponse, error)
+	GetMovements(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.MovementListResponse, error)
+	// Funds works out what a gift card and the store credit of the user pay
+	// of an amount, the gift card first. Nothing is taken until Charge.
+	Funds(ctx context.Context, userID uint, req FundsRequest) (*Funds, error)
+	// Charge takes the funds of an order from the gift card and the wallet,
+	// failing with ErrInsufficientFunds when they were spent in the meantime.
+	Charge(ctx context.Context, userID, orderID uint, funds Funds) error
+	// ReverseOrder gives what a cancelled or refunded order took from a gift
+	// card or the wallet back to the wallet of the user, less what
+	// RefundOrder already gave back of it.
+	ReverseOrder(ctx context.Context, userID, orderID uint) error
+	// RefundOrder gives amount of what an order took from a gift card or the
+	// wallet back to the wallet of the user, for a return of the order. A
+	// reference is credited once.
+	RefundOrder(ctx context.Context, userID, orderID uint, amount int64, reference string) error
+	// CreditRefund adds a refund to the wallet of the user. A reference is
+	// credited once.
+	CreditRefund(ctx context.Context, userID uint, currency string, amount int64, reference string) error
+	// ExpireGiftCards moves the balance left on expired gift cards to the
+	// breakage account.
+	ExpireGiftCards(ctx context.Context) error
+}
+
+type walletService struct {
+	walletRepo WalletRepository
+	transactor pkg.Transactor
+}
+
+func NewWalletService(walletRepo WalletRepository, transactor pkg.Transactor) WalletService {
+	return &walletService{
+		walletRepo: walletRepo,
+		transactor: transactor,
+	}
+}
+
+func (s *walletService) IssueGiftCard(ctx context.Context, actorID uint, req dto.IssueGiftCardRequest) (*dto.GiftCardResponse, error) {
+	if _, ok := money.Lookup(req.Currency); !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, req.Currency)
+	}
+	now := time.Now()
+	expiresAt := now.Add(GiftCardLifetime)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return nil, ErrInvalidExpiry
+		}
+		expiresAt = *req.ExpiresAt
+	}
+
+	code, err := NewGiftCardCode()
+	if err != nil {
+		return nil, err
+	}
+	normalized := NormalizeGiftCardCode(code)
+	card := &GiftCard{
+		CodeHash:       HashGiftCardCode(code),
+		Last4:          normalized[len(normalized)-4:],
+		Currency:       req.Currency,
+		Amount:         req.Amount,
+		RecipientEmail: req.RecipientEmail,
+		Message:        req.Message,
+		IssuedBy:       &actorID,
+		ExpiresAt:      expiresAt,
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.walletRepo.CreateGiftCard(ctx, card); err != nil {
+			return err
+		}
+		return s.walletRepo.Post(ctx, &Transaction{
+			Type:      TransactionGiftCardIssue,
+			Reference: card.Reference(),
+			Postings:  Transfer(SystemAccount(SystemGiftCardSales, card.Currency), GiftCardAccount(card), card.Amount),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toGiftCardResponse(card, card.Amount, now)
+	response.Code = code
+	return response, nil
+}
+
+func (s *walletService) GetGiftCard(ctx context.Context, id uint) (*dto.GiftCardResponse, error) {
+	card, err := s.walletRepo.FindGiftCardByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	balances, err := s.giftCardBalances(ctx, []GiftCard{*card})
+	if err != nil {
+		return nil, err
+	}
+	return toGiftCardResponse(card, balances[card.AccountKey()], time.Now()), nil
+}
+
+func (s *walletService) GetGiftCards(ctx context.Context, query pkg.PaginationQuery) (*dto.GiftCardListResponse, error) {
+	cards, total, err := s.walletRepo.FindGiftCards(ctx, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := s.giftCardBalances(ctx, cards)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	response := &dto.GiftCardListResponse{
+		GiftCards:  make([]dto.GiftCardResponse, 0, len(cards)),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}
+	for _, card := range cards {
+		response.GiftCards = append(response.GiftCards, *toGiftCardResponse(&card, balances[card.AccountKey()], now))
+	}
+	return response, nil
+}
+
+func (s *walletService) CheckGiftCard(ctx context.Context, req dto.GiftCardCodeRequest) (*dto.GiftCardBalanceResponse, error) {
+	card, balance, err := s.findGiftCard(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.GiftCardBalanceResponse{
+		Last4:     card.Last4,
+		Currency:  card.Currency,
+		Balance:   balance,
+		Expired:   card.Expired(time.Now()),
+		ExpiresAt: card.ExpiresAt,
+	}, nil
+}
+
+func (s *walletService) RedeemGiftCard(ctx context.Context, userID uint, req dto.GiftCardCodeRequest) (*dto.WalletResponse, error) {
+	card, balance, err := s.findGiftCard(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if card.Expired(time.Now()) {
+		return nil, ErrGiftCardExpired
+	}
+	if balance == 0 {
+		return nil, ErrGiftCardEmpty
+	}
+
+	err = s.walletRepo.Post(ctx, &Transaction{
+		Type:      TransactionGiftCardRedeem,
+		Reference: card.Reference(),
+		Postings:  Transfer(GiftCardAccount(card), WalletAccount(userID, card.Currency), balance),
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, ErrGiftCardEmpty
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.GetWallet(ctx, userID)
+}
+
+func (s *walletService) GetWallet(ctx context.Context, userID uint) (*dto.WalletResponse, error) {
+	accounts, err := s.walletRepo.FindWallets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.WalletResponse{Balances: make([]dto.BalanceResponse, 0, len(accounts))}
+	for _, account := range accounts {
+		response.Balances = append(response.Balances, dto.BalanceResponse{
+			Currency: account.Currency,
+			Balance:  account.Balance,
+		})
+	}
+	return response, nil
+}
+
+func (s *walletService) GetMovements(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.MovementListResponse, error) {
+	movements, total, err := s.walletRepo.FindMovements(ctx, userID, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.MovementListResponse{
+		Movements:  make([]dto.MovementResponse, 0, len(movements)),
+		Pagination: pkg.NewPaginationMeta(query, total),
+	}
+	for _, movement := range movements {
+		response.Movements = append(response.Movements, dto.MovementResponse{
+			ID:        movement.ID,
+			Type:      movement.Type,
+			Reference: movement.Reference,
+			Currency:  movement.Currency,
+			Amount:    movement.Amount,
+			CreatedAt: movement.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+func (s *walletService) Funds(ctx context.Context, userID uint, req FundsRequest) (*Funds, error) {
+	funds := &Funds{Currency: req.Currency}
+	left := req.Amount
+
+	if req.Code != "" {
+		card, balance, err := s.findGiftCard(ctx, req.Code)
+		if err != nil {
+			return nil, err
+		}
+		if card.Currency != req.Currency {
+			return nil, fmt.Errorf("%w: %s", ErrCurrencyMismatch, card.Currency)
+		}
+		if card.Expired(time.Now()) {
+			return nil, ErrGiftCardExpired
+		}
+		if balance == 0 {
+			return nil, ErrGiftCardEmpty
+		}
+		funds.GiftCardID = &card.ID
+		funds.GiftCard = min(balance, left)
+		left -= funds.GiftCard
+	}
+
+	if req.UseStoreCredit && left > 0 {
+		wallet := WalletAccount(userID, req.Currency)
+		accounts, err := s.walletRepo.FindAccounts(ctx, []string{wallet.Key})
+		if err != nil {
+			return nil, err
+		}
+		for _, account := range accounts {
+			funds.StoreCredit = min(account.Balance, left)
+		}
+	}
+	return funds, nil
+}
+
+func (s *walletService) Charge(ctx context.Context, userID, orderID uint, funds Funds) error {
+	orders := SystemAccount(SystemOrders, funds.Currency)
+	var postings []Posting
+	if funds.GiftCard > 0 {
+		card := &GiftCard{ID: *funds.GiftCardID, Currency: funds.Currency}
+		postings = append(postings, Transfer(GiftCardAccount(card), orders, funds.GiftCard)...)
+	}
+	if funds.StoreCredit > 0 {
+		postings = append(postings, Transfer(WalletAccount(userID, funds.Currency), orders, funds.StoreCredit)...)
+	}
+	if len(postings) == 0 {
+		return nil
+	}
+	return s.walletRepo.Post(ctx, &Transaction{
+		Type:      TransactionCheckout,
+		Reference: OrderReference(orderID),
+		Postings:  postings,
+	})
+}
+
+func (s *walletService) ReverseOrder(ctx context.Context, userID, orderID uint) error {
+	checkout, err := s.walletRepo.FindTransaction(ctx, TransactionCheckout, OrderReference(orderID))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	returned, err := s.walletRepo.FindTransactionsByPrefix(ctx, TransactionCheckoutReturn, OrderReturnReference(orderID, ""))
+	if err != nil {
+		return err
+	}
+
+	amount, currency := credited(*checkout)
+	for _, transaction := range returned {
+		given, _ := credited(transaction)
+		amount -= given
+	}
+	if amount <= 0 {
+		return nil
+	}
+	err = s.walletRepo.Post(ctx, &Transaction{
+		Type:      TransactionCheckoutReversal,
+		Reference: checkout.Reference,
+		Postings:  Transfer(SystemAccount(SystemOrders, currency), WalletAccount(userID, currency), amount),
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil
+	}
+	return err
+}
+
+func (s *walletService) RefundOrder(ctx context.Context, userID, orderID uint, amount int64, reference string) error {
+	checkout, err := s.walletRepo.FindTransaction(ctx, TransactionCheckout, OrderReference(orderID))
+	if err != nil {
+		return err
+	}
+	_, currency := credited(*checkout)
+	err = s.walletRepo.Post(ctx, &Transaction{
+		Type:      TransactionCheckoutReturn,
+		Reference: OrderReturnReference(orderID, reference),
+		Postings:  Transfer(SystemAccount(SystemOrders, currency), WalletAccount(userID, currency), amount),
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil
+	}
+	return err
+}
+
+func (s *walletService) CreditRefund(ctx context.Context, userID uint, currency string, amount int64, reference string) error {
+	err := s.walletRepo.Post(ctx, &Transaction{
+		Type:      TransactionRefund,
+		Reference: reference,
+		Postings:  Transfer(SystemAccount(SystemRefunds, currency), WalletAccount(userID, currency), amount),
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil
+	}
+	return err
+}
+
+func (s *walletService) ExpireGiftCards(ctx context.Context) error {
+	expired := 0
+	for {
+		cards, err := s.walletRepo.FindExpiredGiftCards(ctx, time.Now(), expireBatch)
+		if err != nil {
+			return err
+		}
+		balances, err := s.giftCardBalances(ctx, cards)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, card := range cards {
+			err := s.walletRepo.Post(ctx, &Transaction{
+				Type:      TransactionGiftCardExpiry,
+				Reference: card.Reference(),
+				Postings:  Transfer(GiftCardAccount(&card), SystemAccount(SystemBreakage, card.Currency), balances[card.AccountKey()]),
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("gift card %d: %w", card.ID, err))
+				continue
+			}
+			expired++
+		}
+		// A card that failed would be found again, so the run stops here.
+		if len(errs) > 0 {
+			return errors.Join(errs...)
+		}
+		if len(cards) < expireBatch {
+			break
+		}
+	}
+	if expired > 0 {
+		log.Printf("Expired the balance of %d gift cards", expired)
+	}
+	return nil
+}
+
+// findGiftCard returns the card of a code with its balance.
+func (s *walletService) findGiftCard(ctx context.Context, code string) (*GiftCard, int64, error) {
+	card, err := s.walletRepo.FindGiftCardByCodeHash(ctx, HashGiftCardCode(code))
+	if err != nil {
+		return nil, 0, translateError(err)
+	}
+	balances, err := s.giftCardBalances(ctx, []GiftCard{*card})
+	if err != nil {
+		return nil, 0, err
+	}
+	return card, balances[card.AccountKey()], nil
+}
+
+// giftCardBalances returns the balances of the cards by account key.
+func (s *walletService) giftCardBalances(ctx context.Context, cards []GiftCard) (map[string]int64, error) {
+	if len(cards) == 0 {
+		return map[string]int64{}, nil
+	}
+	keys := make([]string, 0, len(cards))
+	for _, card := range cards {
+		keys = append(keys, card.AccountKey())
+	}
+	accounts, err := s.walletRepo.FindAccounts(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]int64, len(accounts))
+	for _, account := range accounts {
+		balances[account.Key] = account.Balance
+	}
+	return balances, nil
+}
+
+// credited returns what a transaction added to the accounts it moved money
+// to, and their currency.
+func credited(transaction Transaction) (int64, string) {
+	var amount int64
+	var currency string
+	for _, posting := range transaction.Postings {
+		if posting.Amount > 0 {
+			amount += posting.Amount
+			currency = posting.Account.Currency
+		}
+	}
+	return amount, currency
+}
+
+func toGiftCardResponse(card *GiftCard, balance int64, now time.Time) *dto.GiftCardResponse {
+	return &dto.GiftCardResponse{
+		ID:             card.ID,
+		Last4:          card.Last4,
+		Currency:       card.Currency,
+		Amount:         card.Amount,
+		Balance:        balance,
+		Expired:        card.Expired(now),
+		ExpiresAt:      card.ExpiresAt,
+		RecipientEmail: card.RecipientEmail,
+		Message:        card.Message,
+		CreatedAt:      card.CreatedAt,
+	}
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrGiftCardNotFound
+	default:
+		return err
+	}
+}
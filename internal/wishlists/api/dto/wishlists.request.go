@@ -0,0 +1,28 @@
+package dto
+
+import "bookstore-framework/pkg"
+
+// WishlistRequest represents a create or update wishlist request
+// @Description A named list of books. Privacy is private unless set: link shares the list with anyone who has its link, public also lists it for everyone. Making a list private revokes its link.
+type WishlistRequest struct {
+	Name    string `json:"name" binding:"required,max=100" example:"Summer reading"`
+	Privacy string `json:"privacy" binding:"omitempty,oneof=private link public" example:"link"`
+}
+
+// AddWishlistItemRequest represents a request to save a book on a wishlist
+// @Description Saving a book already on the list updates its note.
+type AddWishlistItemRequest struct {
+	BookID uint   `json:"book_id" binding:"required" example:"1"`
+	Note   string `json:"note" binding:"max=255" example:"For the beach"`
+}
+
+// MoveToCartRequest represents a request to move a book from a wishlist to the cart
+type MoveToCartRequest struct {
+	Quantity int `json:"quantity" binding:"required,min=1,max=99" example:"1"`
+}
+
+// PublicWishlistQuery represents the query string of the public wishlist list
+type PublicWishlistQuery struct {
+	pkg.PaginationQuery
+	UserID uint `form:"user_id"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"bookstore-framework/pkg"
+	"time"
+)
+
+type WishlistItemResponse struct {
+	BookID    uint      `json:"book_id"`
+	Title     string    `json:"title"`
+	Price     int64     `json:"price"`
+	Currency  string    `json:"currency"`
+	Note      string    `json:"note,omitempty"`
+	Available bool      `json:"available"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+// WishlistResponse carries the share token to the owner of the list only.
+type WishlistResponse struct {
+	ID         uint                   `json:"id"`
+	UserID     uint                   `json:"user_id"`
+	Owner      string                 `json:"owner"`
+	Name       string                 `json:"name"`
+	Privacy    string                 `json:"privacy"`
+	ShareToken string                 `json:"share_token,omitempty"`
+	Items      []WishlistItemResponse `json:"items"`
+	CreatedAt  time.Time              `json:"created_at"`
+	ModifiedAt time.Time              `json:"modified_at"`
+}
+
+type WishlistListResponse struct {
+	Wishlists  []WishlistResponse `json:"wishlists"`
+	Pagination pkg.PaginationMeta `json:"pagination"`
+}
+
+type PriceDropResponse struct {
+	ID         uint      `json:"id"`
+	WishlistID uint      `json:"wishlist_id"`
+	BookID     uint      `json:"book_id"`
+	Title      string    `json:"title"`
+	OldPrice   int64     `json:"old_price"`
+	NewPrice   int64     `json:"new_price"`
+	Currency   string    `json:"currency"`
+	Read       bool      `json:"read"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type PriceDropListResponse struct {
+	Notifications []PriceDropResponse `json:"notifications"`
+	Pagination    pkg.PaginationMeta  `json:"pagination"`
+}
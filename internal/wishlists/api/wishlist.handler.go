@@ -0,0 +1,437 @@
+package api
+
+import (
+	"bookstore-framework/internal/carts"
+	cartsDto "bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/internal/wishlists"
+	"bookstore-framework/internal/wishlists/api/dto"
+	"bookstore-framework/pkg"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WishlistHandler struct {
+	wishlistService wishlists.WishlistService
+}
+
+func NewWishlistHandler(wishlistService wishlists.WishlistService) *WishlistHandler {
+	return &WishlistHandler{
+		wishlistService: wishlistService,
+	}
+}
+
+// CreateWishlist godoc
+// @Summary      Create a wishlist
+// @Description  Create a named list to save books for later, private unless set otherwise
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body     dto.WishlistRequest true "Wishlist"
+// @Success      201  {object}    pkg.Response{data=dto.WishlistResponse} "Wishlist created successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      409  {object}    pkg.Response "Wishlist name already used"
+// @Router       /wishlists [post]
+func (h *WishlistHandler) CreateWishlist(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.WishlistRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.wishlistService.CreateWishlist(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.CreatedResponse(ctx, "Wishlist created successfully", response)
+}
+
+// GetWishlists godoc
+// @Summary      List your wishlists
+// @Description  Get all wishlists of the logged in user with their books
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response{data=[]dto.WishlistResponse} "Wishlists retrieve successfully"
+// @Router       /wishlists [get]
+func (h *WishlistHandler) GetWishlists(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := h.wishlistService.GetWishlists(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wishlists retrieve successfully", response)
+}
+
+// GetWishlist godoc
+// @Summary      Get a wishlist
+// @Description  Get one of your wishlists, or a public wishlist of anyone
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Wishlist ID"
+// @Success      200  {object}    pkg.Response{data=dto.WishlistResponse} "Wishlist retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Wishlist not found"
+// @Router       /wishlists/{id} [get]
+func (h *WishlistHandler) GetWishlist(ctx *gin.Context) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid wishlist id", err.Error())
+		return
+	}
+
+	var viewer *uint
+	if userID, exist := ctx.Get("userID"); exist {
+		id := userID.(uint)
+		viewer = &id
+	}
+
+	response, err := h.wishlistService.GetWishlist(ctx.Request.Context(), viewer, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wishlist retrieve successfully", response)
+}
+
+// GetSharedWishlist godoc
+// @Summary      Open a shared wishlist
+// @Description  Get the wishlist of a share link. Links of lists made private no longer work
+// @Tags         wishlists
+// @Produce      json
+// @Param        token path       string true "Share token"
+// @Success      200  {object}    pkg.Response{data=dto.WishlistResponse} "Wishlist retrieve successfully"
+// @Failure      404  {object}    pkg.Response "Wishlist not found"
+// @Router       /wishlists/shared/{token} [get]
+func (h *WishlistHandler) GetSharedWishlist(ctx *gin.Context) {
+	response, err := h.wishlistService.GetSharedWishlist(ctx.Request.Context(), ctx.Param("token"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wishlist retrieve successfully", response)
+}
+
+// GetPublicWishlists godoc
+// @Summary      Browse public wishlists
+// @Description  List the public wishlists, the most recently changed first
+// @Tags         wishlists
+// @Produce      json
+// @Param        page     query    int false "Page number" default(1)
+// @Param        limit    query    int false "Page size" default(20)
+// @Param        user_id  query    int false "Only the lists of this user"
+// @Success      200  {object}    pkg.Response{data=dto.WishlistListResponse} "Wishlists retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /wishlists/public [get]
+func (h *WishlistHandler) GetPublicWishlists(ctx *gin.Context) {
+	var query dto.PublicWishlistQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.wishlistService.GetPublicWishlists(ctx.Request.Context(), query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wishlists retrieve successfully", response)
+}
+
+// UpdateWishlist godoc
+// @Summary      Rename a wishlist or change its privacy
+// @Description  Making a list private revokes its share link
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int                 true "Wishlist ID"
+// @Param        request body     dto.WishlistRequest true "Wishlist"
+// @Success      200  {object}    pkg.Response{data=dto.WishlistResponse} "Wishlist updated successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Wishlist not found"
+// @Failure      409  {object}    pkg.Response "Wishlist name already used"
+// @Router       /wishlists/{id} [put]
+func (h *WishlistHandler) UpdateWishlist(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid wishlist id", err.Error())
+		return
+	}
+
+	var req dto.WishlistRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.wishlistService.UpdateWishlist(ctx.Request.Context(), userID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wishlist updated successfully", response)
+}
+
+// DeleteWishlist godoc
+// @Summary      Delete a wishlist
+// @Description  Delete one of your wishlists with the books saved on it
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path        int true "Wishlist ID"
+// @Success      200  {object}    pkg.Response "Wishlist deleted successfully"
+// @Failure      404  {object}    pkg.Response "Wishlist not found"
+// @Router       /wishlists/{id} [delete]
+func (h *WishlistHandler) DeleteWishlist(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid wishlist id", err.Error())
+		return
+	}
+
+	if err := h.wishlistService.DeleteWishlist(ctx.Request.Context(), userID, id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Wishlist deleted successfully", nil)
+}
+
+// AddItem godoc
+// @Summary      Save a book on a wishlist
+// @Description  Save a book on one of your wishlists. Saving it again updates its note
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int                        true "Wishlist ID"
+// @Param        request body     dto.AddWishlistItemRequest true "Book"
+// @Success      200  {object}    pkg.Response{data=dto.WishlistResponse} "Book saved successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Wishlist or book not found"
+// @Router       /wishlists/{id}/items [post]
+func (h *WishlistHandler) AddItem(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid wishlist id", err.Error())
+		return
+	}
+
+	var req dto.AddWishlistItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.wishlistService.AddItem(ctx.Request.Context(), userID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book saved successfully", response)
+}
+
+// RemoveItem godoc
+// @Summary      Remove a book from a wishlist
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id      path     int true "Wishlist ID"
+// @Param        bookId  path     int true "Book ID"
+// @Success      200  {object}    pkg.Response{data=dto.WishlistResponse} "Book removed successfully"
+// @Failure      404  {object}    pkg.Response "Wishlist or book not found"
+// @Router       /wishlists/{id}/items/{bookId} [delete]
+func (h *WishlistHandler) RemoveItem(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	id, bookID, ok := itemParams(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := h.wishlistService.RemoveItem(ctx.Request.Context(), userID, id, bookID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book removed successfully", response)
+}
+
+// MoveToCart godoc
+// @Summary      Move a book from a wishlist to the cart
+// @Description  Add copies of the book to your cart and remove it from the wishlist. The book stays on the list when it cannot be added
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path     int                   true "Wishlist ID"
+// @Param        bookId  path     int                   true "Book ID"
+// @Param        request body     dto.MoveToCartRequest true "Quantity"
+// @Success      200  {object}    pkg.Response{data=cartsDto.CartResponse} "Book moved to cart successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Failure      404  {object}    pkg.Response "Wishlist or book not found"
+// @Failure      409  {object}    pkg.Response "Not enough stock"
+// @Router       /wishlists/{id}/items/{bookId}/move-to-cart [post]
+func (h *WishlistHandler) MoveToCart(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	id, bookID, ok := itemParams(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.MoveToCartRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	var response *cartsDto.CartResponse
+	response, err := h.wishlistService.MoveToCart(ctx.Request.Context(), userID, id, bookID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Book moved to cart successfully", response)
+}
+
+// GetPriceDrops godoc
+// @Summary      List price drop notifications
+// @Description  Get the notifications about books on your wishlists that got cheaper, the latest first
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query    int false "Page number" default(1)
+// @Param        limit    query    int false "Page size" default(20)
+// @Success      200  {object}    pkg.Response{data=dto.PriceDropListResponse} "Notifications retrieve successfully"
+// @Failure      400  {object}    pkg.Response "Invalid Request format"
+// @Router       /wishlists/price-drops [get]
+func (h *WishlistHandler) GetPriceDrops(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	var query pkg.PaginationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid Request format", err.Error())
+		return
+	}
+
+	response, err := h.wishlistService.GetPriceDrops(ctx.Request.Context(), userID, query)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Notifications retrieve successfully", response)
+}
+
+// MarkPriceDropsRead godoc
+// @Summary      Mark price drop notifications as read
+// @Tags         wishlists
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}    pkg.Response "Notifications marked as read"
+// @Router       /wishlists/price-drops/read [put]
+func (h *WishlistHandler) MarkPriceDropsRead(ctx *gin.Context) {
+	userID, ok := wishlistUser(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.wishlistService.MarkPriceDropsRead(ctx.Request.Context(), userID); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	pkg.OkResponse(ctx, "Notifications marked as read", nil)
+}
+
+// wishlistUser reads the user set by the JWT middleware, answering the
+// request itself when there is none.
+func wishlistUser(ctx *gin.Context) (uint, bool) {
+	userID, exist := ctx.Get("userID")
+	if !exist {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "User not found", nil)
+		return 0, false
+	}
+	return userID.(uint), true
+}
+
+func itemParams(ctx *gin.Context) (uint, uint, bool) {
+	id, err := pkg.ParamID(ctx, "id")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid wishlist id", err.Error())
+		return 0, 0, false
+	}
+	bookID, err := pkg.ParamID(ctx, "bookId")
+	if err != nil {
+		pkg.BadRequestResponse(ctx, "Invalid book id", err.Error())
+		return 0, 0, false
+	}
+	return id, bookID, true
+}
+
+func handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, wishlists.ErrWishlistNotFound),
+		errors.Is(err, wishlists.ErrBookNotFound),
+		errors.Is(err, wishlists.ErrItemNotFound),
+		errors.Is(err, carts.ErrBookNotFound):
+		pkg.NotFoundResponse(ctx, err.Error())
+	case errors.Is(err, wishlists.ErrDuplicateName):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	case errors.Is(err, carts.ErrQuantityLimit):
+		pkg.BadRequestResponse(ctx, err.Error(), nil)
+	case errors.Is(err, carts.ErrInsufficientStock),
+		errors.Is(err, carts.ErrPriceNotAvailable):
+		pkg.ErrorResponse(ctx, http.StatusConflict, err.Error(), nil)
+	default:
+		pkg.InternalServerErrorResponse(ctx, err.Error())
+	}
+}
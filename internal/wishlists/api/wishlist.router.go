@@ -0,0 +1,47 @@
+package api
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/wishlists"
+	"bookstore-framework/middleware"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func WishlistsRoutes(router *gin.RouterGroup, db *gorm.DB) {
+	bookRepository := books.NewBookRepository(db)
+	promotionService := promotions.NewPromotionService(
+		promotions.NewPromotionRepository(db),
+		books.NewCategoryRepository(db),
+		books.NewAuthorRepository(db),
+	)
+	cartService := carts.NewCartService(
+		carts.NewCartRepository(db),
+		bookRepository,
+		inventory.NewInventoryRepository(db),
+		promotionService,
+		pricing.NewPricingService(pricing.NewPricingRepository(db)),
+	)
+	wishlistService := wishlists.NewWishlistService(wishlists.NewWishlistRepository(db), bookRepository, cartService)
+	wishlistHandler := NewWishlistHandler(wishlistService)
+
+	router.GET("/public", wishlistHandler.GetPublicWishlists)
+	router.GET("/shared/:token", wishlistHandler.GetSharedWishlist)
+	router.GET("/:id", middleware.OptionalJWTAuth(), wishlistHandler.GetWishlist)
+
+	auth := router.Group("", middleware.JWTAuth())
+	auth.POST("", wishlistHandler.CreateWishlist)
+	auth.GET("", wishlistHandler.GetWishlists)
+	auth.GET("/price-drops", wishlistHandler.GetPriceDrops)
+	auth.PUT("/price-drops/read", wishlistHandler.MarkPriceDropsRead)
+	auth.PUT("/:id", wishlistHandler.UpdateWishlist)
+	auth.DELETE("/:id", wishlistHandler.DeleteWishlist)
+	auth.POST("/:id/items", wishlistHandler.AddItem)
+	auth.DELETE("/:id/items/:bookId", wishlistHandler.RemoveItem)
+	auth.POST("/:id/items/:bookId/move-to-cart", wishlistHandler.MoveToCart)
+}
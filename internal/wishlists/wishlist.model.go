@@ -0,0 +1,71 @@
+package wishlists
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/users"
+	"time"
+)
+
+// Privacy settings of a wishlist. Link-shared lists are readable by anyone
+// with their share link, public lists are also listed for everyone.
+const (
+	PrivacyPrivate = "private"
+	PrivacyLink    = "link"
+	PrivacyPublic  = "public"
+)
+
+// Wishlist is a named list of books a user saves for later. ShareToken makes
+// up its share link, it is replaced whenever the list is made private so old
+// links stop working.
+type Wishlist struct {
+	ID         uint           `gorm:"primaryKey"`
+	UserID     uint           `gorm:"column:user_id;not null;uniqueIndex:idx_wishlists_user_name"`
+	User       users.User     `gorm:"foreignKey:UserID"`
+	Name       string         `gorm:"column:name;size:100;not null;uniqueIndex:idx_wishlists_user_name"`
+	Privacy    string         `gorm:"column:privacy;size:10;not null;default:private;index;check:chk_wishlists_privacy,privacy IN ('private','link','public')"`
+	ShareToken string         `gorm:"column:share_token;size:48;not null;uniqueIndex"`
+	Items      []WishlistItem `gorm:"foreignKey:WishlistID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
+	ModifiedAt time.Time      `gorm:"column:modified_at;autoUpdateTime"`
+}
+
+func (Wishlist) TableName() string {
+	return "wishlists"
+}
+
+// WishlistItem is a book saved on a list. Price and Currency are the catalog
+// price of the book when it was last checked, a lower catalog price later on
+// is a price drop.
+type WishlistItem struct {
+	ID         uint       `gorm:"primaryKey"`
+	WishlistID uint       `gorm:"column:wishlist_id;not null;uniqueIndex:idx_wishlist_items_list_book"`
+	BookID     uint       `gorm:"column:book_id;not null;uniqueIndex:idx_wishlist_items_list_book;index"`
+	Book       books.Book `gorm:"foreignKey:BookID"`
+	Note       string     `gorm:"column:note;size:255"`
+	Price      int64      `gorm:"column:price;not null"`
+	Currency   string     `gorm:"column:currency;size:3;not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (WishlistItem) TableName() string {
+	return "wishlist_items"
+}
+
+// PriceDropNotification tells a user that a book on one of their wishlists
+// got cheaper. Prices are in minor units of Currency.
+type PriceDropNotification struct {
+	ID         uint       `gorm:"primaryKey"`
+	UserID     uint       `gorm:"column:user_id;not null;index"`
+	WishlistID uint       `gorm:"column:wishlist_id;not null"`
+	BookID     uint       `gorm:"column:book_id;not null"`
+	Book       books.Book `gorm:"foreignKey:BookID"`
+	OldPrice   int64      `gorm:"column:old_price;not null"`
+	NewPrice   int64      `gorm:"column:new_price;not null"`
+	Currency   string     `gorm:"column:currency;size:3;not null"`
+	ReadAt     *time.Time `gorm:"column:read_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (PriceDropNotification) TableName() string {
+	return "price_drop_notifications"
+}
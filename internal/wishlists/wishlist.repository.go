@@ -0,0 +1,212 @@
+package wishlists
+
+import (
+	"bookstore-framework/pkg"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RepricedItem is a wishlist item whose book no longer sells at the price
+// stored with the item.
+type RepricedItem struct {
+	ID          uint
+	WishlistID  uint
+	UserID      uint
+	BookID      uint
+	Price       int64
+	Currency    string
+	NewPrice    int64
+	NewCurrency string
+}
+
+type WishlistRepository interface {
+	Create(ctx context.Context, wishlist *Wishlist) (*Wishlist, error)
+	// FindByID and FindByShareToken load the items with their books,
+	// including books removed from the catalog since they were saved.
+	FindByID(ctx context.Context, id uint) (*Wishlist, error)
+	FindByShareToken(ctx context.Context, token string) (*Wishlist, error)
+	FindByUserID(ctx context.Context, userID uint) ([]Wishlist, error)
+	// FindPublic lists the public wishlists, of one user when userID is set.
+	FindPublic(ctx context.Context, userID uint, offset, limit int) ([]Wishlist, int64, error)
+	Update(ctx context.Context, wishlist *Wishlist) error
+	Delete(ctx context.Context, id uint) error
+	// SaveItem adds the book to the list, or updates its note when the book is
+	// already on it.
+	SaveItem(ctx context.Context, item *WishlistItem) error
+	DeleteItem(ctx context.Context, wishlistID, bookID uint) error
+	// FindRepriced returns up to limit repriced items after the item afterID,
+	// in the order of their IDs. Books removed from the catalog are left out.
+	FindRepriced(ctx context.Context, afterID uint, limit int) ([]RepricedItem, error)
+	// Reprice stores the new price on the item, together with the
+	// notification of the drop when there is one.
+	Reprice(ctx context.Context, item RepricedItem, notification *PriceDropNotification) error
+	FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]PriceDropNotification, int64, error)
+	MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error
+}
+
+type wishlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistRepository(db *gorm.DB) WishlistRepository {
+	return &wishlistRepository{
+		db: db,
+	}
+}
+
+func (r *wishlistRepository) Create(ctx context.Context, wishlist *Wishlist) (*Wishlist, error) {
+	result := pkg.DB(ctx, r.db).Omit(clause.Associations).Create(wishlist)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return wishlist, nil
+}
+
+func (r *wishlistRepository) FindByID(ctx context.Context, id uint) (*Wishlist, error) {
+	var wishlist *Wishlist
+	result := preloadWishlist(pkg.DB(ctx, r.db)).First(&wishlist, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return wishlist, nil
+}
+
+func (r *wishlistRepository) FindByShareToken(ctx context.Context, token string) (*Wishlist, error) {
+	var wishlist *Wishlist
+	result := preloadWishlist(pkg.DB(ctx, r.db)).Where("share_token = ?", token).First(&wishlist)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return wishlist, nil
+}
+
+func (r *wishlistRepository) FindByUserID(ctx context.Context, userID uint) ([]Wishlist, error) {
+	var wishlists []Wishlist
+	result := preloadWishlist(pkg.DB(ctx, r.db)).Where("user_id = ?", userID).Order("name").Find(&wishlists)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return wishlists, nil
+}
+
+func (r *wishlistRepository) FindPublic(ctx context.Context, userID uint, offset, limit int) ([]Wishlist, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&Wishlist{}).Where("privacy = ?", PrivacyPublic)
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var wishlists []Wishlist
+	result := preloadWishlist(query).Order("modified_at DESC, id DESC").Offset(offset).Limit(limit).Find(&wishlists)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return wishlists, total, nil
+}
+
+func (r *wishlistRepository) Update(ctx context.Context, wishlist *Wishlist) error {
+	return pkg.DB(ctx, r.db).Model(wishlist).
+		Select("name", "privacy", "share_token", "modified_at").
+		Updates(wishlist).Error
+}
+
+func (r *wishlistRepository) Delete(ctx context.Context, id uint) error {
+	result := pkg.DB(ctx, r.db).Delete(&Wishlist{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *wishlistRepository) SaveItem(ctx context.Context, item *WishlistItem) error {
+	return pkg.DB(ctx, r.db).Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "wishlist_id"}, {Name: "book_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"note"}),
+		}).
+		Create(item).Error
+}
+
+func (r *wishlistRepository) DeleteItem(ctx context.Context, wishlistID, bookID uint) error {
+	result := pkg.DB(ctx, r.db).Where("wishlist_id = ? AND book_id = ?", wishlistID, bookID).Delete(&WishlistItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *wishlistRepository) FindRepriced(ctx context.Context, afterID uint, limit int) ([]RepricedItem, error) {
+	var items []RepricedItem
+	result := pkg.DB(ctx, r.db).Table("wishlist_items").
+		Select("wishlist_items.id, wishlist_items.wishlist_id, wishlists.user_id, wishlist_items.book_id, "+
+			"wishlist_items.price, wishlist_items.currency, books.price AS new_price, books.currency AS new_currency").
+		Joins("JOIN wishlists ON wishlists.id = wishlist_items.wishlist_id").
+		Joins("JOIN books ON books.id = wishlist_items.book_id AND books.deleted_at IS NULL").
+		Where("wishlist_items.id > ?", afterID).
+		Where("books.price <> wishlist_items.price OR books.currency <> wishlist_items.currency").
+		Order("wishlist_items.id").
+		Limit(limit).
+		Scan(&items)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return items, nil
+}
+
+func (r *wishlistRepository) Reprice(ctx context.Context, item RepricedItem, notification *PriceDropNotification) error {
+	return pkg.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&WishlistItem{}).Where("id = ?", item.ID).
+			UpdateColumns(map[string]interface{}{"price": item.NewPrice, "currency": item.NewCurrency}).Error
+		if err != nil || notification == nil {
+			return err
+		}
+		return tx.Omit(clause.Associations).Create(notification).Error
+	})
+}
+
+func (r *wishlistRepository) FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]PriceDropNotification, int64, error) {
+	query := pkg.DB(ctx, r.db).Model(&PriceDropNotification{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []PriceDropNotification
+	result := query.Preload("Book", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}).Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&notifications)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return notifications, total, nil
+}
+
+func (r *wishlistRepository) MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error {
+	return pkg.DB(ctx, r.db).Model(&PriceDropNotification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		UpdateColumn("read_at", now).Error
+}
+
+// preloadWishlist loads the owner and the items with their books, including
+// books removed from the catalog since they were saved.
+func preloadWishlist(db *gorm.DB) *gorm.DB {
+	return db.Preload("User").Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("wishlist_items.created_at DESC, wishlist_items.id DESC")
+	}).Preload("Items.Book", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	})
+}
@@ -0,0 +1,390 @@
+package wishlists
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	cartsDto "bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/internal/wishlists/api/dto"
+	"bookstore-framework/pkg"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const repriceBatch = 500
+
+var (
+	ErrWishlistNotFound = errors.New("wishlist not found")
+	ErrBookNotFound     = errors.New("book not found")
+	ErrItemNotFound     = errors.New("book is not on the wishlist")
+	ErrDuplicateName    = errors.New("you already have a wishlist with this name")
+)
+
+type WishlistService interface {
+	CreateWishlist(ctx context.Context, userID uint, req dto.WishlistRequest) (*dto.WishlistResponse, error)
+	GetWishlists(ctx context.Context, userID uint) ([]dto.WishlistResponse, error)
+	// GetWishlist returns a list of the viewer, or a public list of anyone.
+	// Viewer is nil for guests.
+	GetWishlist(ctx context.Context, viewer *uint, id uint) (*dto.WishlistResponse, error)
+	// GetSharedWishlist returns the list with the share token, unless it is
+	// private.
+	GetSharedWishlist(ctx context.Context, token string) (*dto.WishlistResponse, error)
+	GetPublicWishlists(ctx context.Context, query dto.PublicWishlistQuery) (*dto.WishlistListResponse, error)
+	UpdateWishlist(ctx context.Context, userID, id uint, req dto.WishlistRequest) (*dto.WishlistResponse, error)
+	DeleteWishlist(ctx context.Context, userID, id uint) error
+	AddItem(ctx context.Context, userID, id uint, req dto.AddWishlistItemRequest) (*dto.WishlistResponse, error)
+	RemoveItem(ctx context.Context, userID, id, bookID uint) (*dto.WishlistResponse, error)
+	// MoveToCart puts the book in the user's cart and takes it off the list.
+	// The book stays on the list when it cannot be added to the cart.
+	MoveToCart(ctx context.Context, userID, id, bookID uint, req dto.MoveToCartRequest) (*cartsDto.CartResponse, error)
+	GetPriceDrops(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.PriceDropListResponse, error)
+	MarkPriceDropsRead(ctx context.Context, userID uint) error
+	// NotifyPriceDrops compares the saved books with the catalog and notifies
+	// their owners of lower prices, it runs as a scheduled job. A user is
+	// notified once per book however many of their lists it is on.
+	NotifyPriceDrops(ctx context.Context) error
+}
+
+type wishlistService struct {
+	wishlistRepo WishlistRepository
+	bookRepo     books.BookRepository
+	cartService  carts.CartService
+}
+
+func NewWishlistService(wishlistRepo WishlistRepository, bookRepo books.BookRepository, cartService carts.CartService) WishlistService {
+	return &wishlistService{
+		wishlistRepo: wishlistRepo,
+		bookRepo:     bookRepo,
+		cartService:  cartService,
+	}
+}
+
+func (s *wishlistService) CreateWishlist(ctx context.Context, userID uint, req dto.WishlistRequest) (*dto.WishlistResponse, error) {
+	token, err := newShareToken()
+	if err != nil {
+		return nil, err
+	}
+	wishlist := &Wishlist{
+		UserID:     userID,
+		Name:       strings.TrimSpace(req.Name),
+		Privacy:    PrivacyPrivate,
+		ShareToken: token,
+	}
+	if req.Privacy != "" {
+		wishlist.Privacy = req.Privacy
+	}
+
+	if _, err := s.wishlistRepo.Create(ctx, wishlist); err != nil {
+		return nil, translateError(err)
+	}
+	return s.getWishlist(ctx, wishlist.ID)
+}
+
+func (s *wishlistService) GetWishlists(ctx context.Context, userID uint) ([]dto.WishlistResponse, error) {
+	wishlists, err := s.wishlistRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.WishlistResponse, 0, len(wishlists))
+	for i := range wishlists {
+		response = append(response, *ToWishlistResponse(&wishlists[i], true))
+	}
+	return response, nil
+}
+
+func (s *wishlistService) GetWishlist(ctx context.Context, viewer *uint, id uint) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	owner := viewer != nil && *viewer == wishlist.UserID
+	// Lists the viewer may not see are reported missing, so their IDs do
+	// not reveal that they exist.
+	if !owner && wishlist.Privacy != PrivacyPublic {
+		return nil, ErrWishlistNotFound
+	}
+	return ToWishlistResponse(wishlist, owner), nil
+}
+
+func (s *wishlistService) GetSharedWishlist(ctx context.Context, token string) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.FindByShareToken(ctx, token)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if wishlist.Privacy == PrivacyPrivate {
+		return nil, ErrWishlistNotFound
+	}
+	return ToWishlistResponse(wishlist, false), nil
+}
+
+func (s *wishlistService) GetPublicWishlists(ctx context.Context, query dto.PublicWishlistQuery) (*dto.WishlistListResponse, error) {
+	wishlists, total, err := s.wishlistRepo.FindPublic(ctx, query.UserID, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.WishlistListResponse{
+		Wishlists:  make([]dto.WishlistResponse, 0, len(wishlists)),
+		Pagination: pkg.NewPaginationMeta(query.PaginationQuery, total),
+	}
+	for i := range wishlists {
+		response.Wishlists = append(response.Wishlists, *ToWishlistResponse(&wishlists[i], false))
+	}
+	return response, nil
+}
+
+func (s *wishlistService) UpdateWishlist(ctx context.Context, userID, id uint, req dto.WishlistRequest) (*dto.WishlistResponse, error) {
+	wishlist, err := s.ownWishlist(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist.Name = strings.TrimSpace(req.Name)
+	if req.Privacy != "" && req.Privacy != wishlist.Privacy {
+		if req.Privacy == PrivacyPrivate {
+			if wishlist.ShareToken, err = newShareToken(); err != nil {
+				return nil, err
+			}
+		}
+		wishlist.Privacy = req.Privacy
+	}
+	if err := s.wishlistRepo.Update(ctx, wishlist); err != nil {
+		return nil, translateError(err)
+	}
+	return ToWishlistResponse(wishlist, true), nil
+}
+
+func (s *wishlistService) DeleteWishlist(ctx context.Context, userID, id uint) error {
+	if _, err := s.ownWishlist(ctx, userID, id); err != nil {
+		return err
+	}
+	return translateError(s.wishlistRepo.Delete(ctx, id))
+}
+
+func (s *wishlistService) AddItem(ctx context.Context, userID, id uint, req dto.AddWishlistItemRequest) (*dto.WishlistResponse, error) {
+	if _, err := s.ownWishlist(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	book, err := s.bookRepo.FindByID(ctx, req.BookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookNotFound
+		}
+		return nil, err
+	}
+
+	item := &WishlistItem{
+		WishlistID: id,
+		BookID:     book.ID,
+		Note:       strings.TrimSpace(req.Note),
+		Price:      book.Price,
+		Currency:   book.Currency,
+	}
+	if err := s.wishlistRepo.SaveItem(ctx, item); err != nil {
+		return nil, err
+	}
+	return s.getWishlist(ctx, id)
+}
+
+func (s *wishlistService) RemoveItem(ctx context.Context, userID, id, bookID uint) (*dto.WishlistResponse, error) {
+	if _, err := s.ownWishlist(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if err := s.wishlistRepo.DeleteItem(ctx, id, bookID); err != nil {
+		return nil, translateItemError(err)
+	}
+	return s.getWishlist(ctx, id)
+}
+
+func (s *wishlistService) MoveToCart(ctx context.Context, userID, id, bookID uint, req dto.MoveToCartRequest) (*cartsDto.CartResponse, error) {
+	wishlist, err := s.ownWishlist(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !hasBook(wishlist, bookID) {
+		return nil, ErrItemNotFound
+	}
+
+	cart, err := s.cartService.AddItem(ctx, carts.Owner{UserID: &userID}, "", cartsDto.AddCartItemRequest{
+		BookID:   bookID,
+		Quantity: req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The book is in the cart by now, a list that changed meanwhile is fine.
+	if err := s.wishlistRepo.DeleteItem(ctx, id, bookID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return cart, nil
+}
+
+func (s *wishlistService) GetPriceDrops(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.PriceDropListResponse, error) {
+	notifications, total, err := s.wishlistRepo.FindNotifications(ctx, userID, query.Offset(), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PriceDropListResponse{
+		Notifications: make([]dto.PriceDropResponse, 0, len(notifications)),
+		Pagination:    pkg.NewPaginationMeta(query, total),
+	}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, dto.PriceDropResponse{
+			ID:         notification.ID,
+			WishlistID: notification.WishlistID,
+			BookID:     notification.BookID,
+			Title:      notification.Book.Title,
+			OldPrice:   notification.OldPrice,
+			NewPrice:   notification.NewPrice,
+			Currency:   notification.Currency,
+			Read:       notification.ReadAt != nil,
+			CreatedAt:  notification.CreatedAt,
+		})
+	}
+	return response, nil
+}
+
+func (s *wishlistService) MarkPriceDropsRead(ctx context.Context, userID uint) error {
+	return s.wishlistRepo.MarkNotificationsRead(ctx, userID, time.Now())
+}
+
+func (s *wishlistService) NotifyPriceDrops(ctx context.Context) error {
+	type userBook struct{ userID, bookID uint }
+	notified := make(map[userBook]bool)
+
+	var errs []error
+	var afterID uint
+	for {
+		items, err := s.wishlistRepo.FindRepriced(ctx, afterID, repriceBatch)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		for _, item := range items {
+			afterID = item.ID
+
+			var notification *PriceDropNotification
+			key := userBook{item.UserID, item.BookID}
+			// A change of currency is no drop, the item just follows the book.
+			if item.NewCurrency == item.Currency && item.NewPrice < item.Price && !notified[key] {
+				notification = &PriceDropNotification{
+					UserID:     item.UserID,
+					WishlistID: item.WishlistID,
+					BookID:     item.BookID,
+					OldPrice:   item.Price,
+					NewPrice:   item.NewPrice,
+					Currency:   item.NewCurrency,
+				}
+			}
+			if err := s.wishlistRepo.Reprice(ctx, item, notification); err != nil {
+				errs = append(errs, fmt.Errorf("wishlist item %d: %w", item.ID, err))
+				continue
+			}
+			if notification != nil {
+				notified[key] = true
+			}
+		}
+		if len(items) < repriceBatch {
+			break
+		}
+	}
+	if len(notified) > 0 {
+		log.Printf("Notified %d wishlist price drops", len(notified))
+	}
+	return errors.Join(errs...)
+}
+
+// ownWishlist loads a list of the user. Lists of other users are reported
+// missing.
+func (s *wishlistService) ownWishlist(ctx context.Context, userID, id uint) (*Wishlist, error) {
+	wishlist, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if wishlist.UserID != userID {
+		return nil, ErrWishlistNotFound
+	}
+	return wishlist, nil
+}
+
+func (s *wishlistService) getWishlist(ctx context.Context, id uint) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return ToWishlistResponse(wishlist, true), nil
+}
+
+// newShareToken returns a random token for the share link of a list.
+func newShareToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hasBook(wishlist *Wishlist, bookID uint) bool {
+	for _, item := range wishlist.Items {
+		if item.BookID == bookID {
+			return true
+		}
+	}
+	return false
+}
+
+// ToWishlistResponse shows the share token to the owner of the list only.
+func ToWishlistResponse(wishlist *Wishlist, owner bool) *dto.WishlistResponse {
+	response := &dto.WishlistResponse{
+		ID:         wishlist.ID,
+		UserID:     wishlist.UserID,
+		Owner:      wishlist.User.Name,
+		Name:       wishlist.Name,
+		Privacy:    wishlist.Privacy,
+		Items:      make([]dto.WishlistItemResponse, 0, len(wishlist.Items)),
+		CreatedAt:  wishlist.CreatedAt,
+		ModifiedAt: wishlist.ModifiedAt,
+	}
+	if owner {
+		response.ShareToken = wishlist.ShareToken
+	}
+	for _, item := range wishlist.Items {
+		response.Items = append(response.Items, dto.WishlistItemResponse{
+			BookID:    item.BookID,
+			Title:     item.Book.Title,
+			Price:     item.Book.Price,
+			Currency:  item.Book.Currency,
+			Note:      item.Note,
+			Available: !item.Book.DeletedAt.Valid,
+			AddedAt:   item.CreatedAt,
+		})
+	}
+	return response
+}
+
+func translateItemError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrItemNotFound
+	}
+	return err
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrWishlistNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicateName
+	default:
+		return err
+	}
+}
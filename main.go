@@ -2,10 +2,28 @@ package main
 
 import (
 	"bookstore-framework/configs"
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/recommendations"
+	"bookstore-framework/internal/returns"
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/internal/wishlists"
 	"bookstore-framework/migrations"
 	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/blob"
+	"bookstore-framework/pkg/scheduler"
 	"bookstore-framework/routes"
+	"context"
 	"log"
+	"time"
 
 	_ "bookstore-framework/docs"
 
@@ -46,7 +64,81 @@ func main() {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
-	router := routes.Router(db)
+	promotionService := promotions.NewPromotionService(
+		promotions.NewPromotionRepository(db),
+		books.NewCategoryRepository(db),
+		books.NewAuthorRepository(db),
+	)
+	pricingService := pricing.NewPricingService(pricing.NewPricingRepository(db))
+	cartService := carts.NewCartService(
+		carts.NewCartRepository(db),
+		books.NewBookRepository(db),
+		inventory.NewInventoryRepository(db),
+		promotionService,
+		pricingService,
+	)
+	go scheduler.Every(context.Background(), "cart expiry", time.Hour, cartService.PurgeExpired)
+
+	wishlistService := wishlists.NewWishlistService(wishlists.NewWishlistRepository(db), books.NewBookRepository(db), cartService)
+	go scheduler.Every(context.Background(), "wishlist price drops", time.Hour, wishlistService.NotifyPriceDrops)
+
+	recommendationService := recommendations.NewRecommendationService(recommendations.NewRecommendationRepository(db), books.NewBookRepository(db))
+	go scheduler.Every(context.Background(), "recommendations", 24*time.Hour, recommendationService.ComputeNeighbours)
+
+	paymentProvider, err := payments.NewProvider(cfg.PaymentProvider, cfg.PaymentWebhookSecret)
+	if err != nil {
+		log.Fatalf("Failed to set up payments: %v", err)
+	}
+	transactor := pkg.NewTransactor(db)
+	loyaltyService := loyalty.NewLoyaltyService(loyalty.NewLoyaltyRepository(db), pricingService)
+	go scheduler.Every(context.Background(), "loyalty points expiry", 24*time.Hour, loyaltyService.ExpirePoints)
+	walletService := wallet.NewWalletService(wallet.NewWalletRepository(db), transactor)
+	go scheduler.Every(context.Background(), "gift card expiry", 24*time.Hour, walletService.ExpireGiftCards)
+	orderService := orders.NewOrderService(
+		orders.NewOrderRepository(db),
+		carts.NewCartRepository(db),
+		inventory.NewInventoryRepository(db),
+		promotionService,
+		taxes.NewTaxService(taxes.NewTaxRepository(db)),
+		pricingService,
+		loyaltyService,
+		walletService,
+		transactor,
+	)
+	go scheduler.Every(context.Background(), "backorder allocation", 15*time.Minute, orderService.AllocateBackorders)
+	paymentRepository := payments.NewPaymentRepository(db)
+	paymentService := payments.NewPaymentService(paymentRepository, paymentProvider, orderService, transactor)
+	go scheduler.Every(context.Background(), "payment reconciliation", 5*time.Minute, paymentService.Reconcile)
+	reorderService := purchasing.NewReorderService(
+		purchasing.NewReorderRepository(db),
+		purchasing.NewPurchasingRepository(db),
+		books.NewBookRepository(db),
+		inventory.NewInventoryRepository(db),
+		transactor,
+	)
+	go scheduler.Every(context.Background(), "low stock check", time.Hour, reorderService.CheckStock)
+
+	returnService := returns.NewReturnService(
+		returns.NewReturnRepository(db),
+		orders.NewOrderRepository(db),
+		inventory.NewInventoryRepository(db),
+		paymentRepository,
+		paymentService,
+		walletService,
+		transactor,
+	)
+
+	blobs, err := blob.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to set up blob storage: %v", err)
+	}
+
+	router := routes.Router(db, routes.Services{
+		Blobs:           blobs,
+		PaymentProvider: paymentProvider,
+		Payments:        paymentService,
+		Returns:         returnService,
+	})
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	router.Run(":8080")
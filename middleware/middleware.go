@@ -23,41 +23,79 @@ func JWTAuth() gin.HandlerFunc {
 			return
 		}
 
-		parts := strings.SplitN(authHeader, " ", 2)
-		if !(len(parts) == 2 && parts[0] == "Bearer") {
-			pkg.ErrorResponse(ctx, http.StatusUnauthorized, "invalid authorization format", nil)
-			ctx.Abort()
-			return
-		}
+		authenticate(ctx, cfg, authHeader)
+	}
+}
 
-		tokenString := parts[1]
-
-		token, err := jwt.ParseWithClaims(
-			tokenString,
-			&pkg.Claims{},
-			func(t *jwt.Token) (interface{}, error) {
-				if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, jwt.ErrSignatureInvalid
-				}
-				return []byte(cfg.SecretKey), nil
-			},
-		)
-
-		if err != nil {
-			pkg.ErrorResponse(ctx, http.StatusUnauthorized, "invalid or expired token", err.Error())
-			ctx.Abort()
+// OptionalJWTAuth authenticates requests that carry a token and lets anonymous
+// requests through without user context, for endpoints open to guests.
+func OptionalJWTAuth() gin.HandlerFunc {
+	cfg, err := configs.LoadConfig()
+	if err != nil {
+		panic("error when load config")
+	}
+	return func(ctx *gin.Context) {
+		authHeader := ctx.GetHeader("Authorization")
+		if authHeader == "" {
+			ctx.Next()
 			return
 		}
 
-		if claims, ok := token.Claims.(*pkg.Claims); ok && token.Valid {
-			ctx.Set("userID", claims.UserID)
-			ctx.Set("username", claims.Username)
-			ctx.Set("email", claims.Email)
-			ctx.Next()
-		} else {
-			pkg.UnauthorizedResponse(ctx)
-			ctx.Abort()
-			return
+		authenticate(ctx, cfg, authHeader)
+	}
+}
+
+func authenticate(ctx *gin.Context, cfg *configs.Config, authHeader string) {
+	parts := strings.SplitN(authHeader, " ", 2)
+	if !(len(parts) == 2 && parts[0] == "Bearer") {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "invalid authorization format", nil)
+		ctx.Abort()
+		return
+	}
+
+	tokenString := parts[1]
+
+	token, err := jwt.ParseWithClaims(
+		tokenString,
+		&pkg.Claims{},
+		func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, jwt.ErrSignatureInvalid
+			}
+			return []byte(cfg.SecretKey), nil
+		},
+	)
+
+	if err != nil {
+		pkg.ErrorResponse(ctx, http.StatusUnauthorized, "invalid or expired token", err.Error())
+		ctx.Abort()
+		return
+	}
+
+	if claims, ok := token.Claims.(*pkg.Claims); ok && token.Valid {
+		ctx.Set("userID", claims.UserID)
+		ctx.Set("username", claims.Username)
+		ctx.Set("email", claims.Email)
+		ctx.Set("role", claims.Role)
+		ctx.Next()
+	} else {
+		pkg.UnauthorizedResponse(ctx)
+		ctx.Abort()
+		return
+	}
+}
+
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		role := ctx.GetString("role")
+		for _, allowed := range roles {
+			if role == allowed {
+				ctx.Next()
+				return
+			}
 		}
+
+		pkg.ForbiddenResponse(ctx)
+		ctx.Abort()
 	}
 }
@@ -0,0 +1,142 @@
+package migrations
+
+import (
+	"bookstore-framework/pkg/isbn"
+	"errors"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// catalogIndexes are indexes GORM tags cannot express.
+var catalogIndexes = []string{
+	// Prefix matches on the materialized path (path LIKE '3/7/%') need the
+	// pattern operator class to use the index under non-C collations.
+	`CREATE INDEX IF NOT EXISTS idx_categories_path ON categories (path text_pattern_ops)`,
+}
+
+// catalogSearchStatements maintain books.search_vector, the weighted document
+// behind full-text search. Titles, ISBN and SKU weigh most (A), then subtitle
+// and author names (B), then the description (C). Prose is stemmed with the
+// dictionary of the book's language; identifiers and names use the simple
+// configuration so they are never stemmed.
+var catalogSearchStatements = []string{
+	`ALTER TABLE books ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+	`CREATE OR REPLACE FUNCTION catalog_search_config(lang text) RETURNS regconfig AS $$
+		SELECT CASE lower(split_part(coalesce(lang, ''), '-', 1))
+			WHEN 'da' THEN 'danish'
+			WHEN 'de' THEN 'german'
+			WHEN 'en' THEN 'english'
+			WHEN 'es' THEN 'spanish'
+			WHEN 'fi' THEN 'finnish'
+			WHEN 'fr' THEN 'french'
+			WHEN 'hu' THEN 'hungarian'
+			WHEN 'it' THEN 'italian'
+			WHEN 'nl' THEN 'dutch'
+			WHEN 'no' THEN 'norwegian'
+			WHEN 'pt' THEN 'portuguese'
+			WHEN 'ro' THEN 'romanian'
+			WHEN 'ru' THEN 'russian'
+			WHEN 'sv' THEN 'swedish'
+			WHEN 'tr' THEN 'turkish'
+			ELSE 'simple'
+		END::regconfig
+	$$ LANGUAGE sql IMMUTABLE`,
+	`CREATE OR REPLACE FUNCTION books_search_vector(b books) RETURNS tsvector AS $$
+		SELECT
+			setweight(to_tsvector(catalog_search_config(b.language), coalesce(b.title, '')), 'A') ||
+			setweight(to_tsvector('simple', coalesce(b.isbn, '') || ' ' || coalesce(b.sku, '')), 'A') ||
+			setweight(to_tsvector(catalog_search_config(b.language), coalesce(b.subtitle, '')), 'B') ||
+			setweight(to_tsvector('simple', coalesce((
+				SELECT string_agg(a.name, ' ')
+				FROM book_authors ba JOIN authors a ON a.id = ba.author_id
+				WHERE ba.book_id = b.id
+			), '')), 'B') ||
+			setweight(to_tsvector(catalog_search_config(b.language), coalesce(b.description, '')), 'C')
+	$$ LANGUAGE sql STABLE`,
+
+	// Searchable book columns refresh the vector in place.
+	`CREATE OR REPLACE FUNCTION books_search_vector_refresh() RETURNS trigger AS $$
+	BEGIN
+		NEW.search_vector := books_search_vector(NEW);
+		RETURN NEW;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS trg_books_search_vector ON books`,
+	`CREATE TRIGGER trg_books_search_vector
+	BEFORE INSERT OR UPDATE OF title, subtitle, isbn, sku, description, language ON books
+	FOR EACH ROW EXECUTE FUNCTION books_search_vector_refresh()`,
+
+	// Contributor links are written after the book row, and author names can
+	// change later, so both re-index the affected books.
+	`CREATE OR REPLACE FUNCTION book_authors_search_vector_refresh() RETURNS trigger AS $$
+	BEGIN
+		UPDATE books SET search_vector = books_search_vector(books)
+		WHERE id = COALESCE(NEW.book_id, OLD.book_id);
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS trg_book_authors_search_vector ON book_authors`,
+	`CREATE TRIGGER trg_book_authors_search_vector
+	AFTER INSERT OR DELETE ON book_authors
+	FOR EACH ROW EXECUTE FUNCTION book_authors_search_vector_refresh()`,
+	`CREATE OR REPLACE FUNCTION authors_search_vector_refresh() RETURNS trigger AS $$
+	BEGIN
+		UPDATE books SET search_vector = books_search_vector(books)
+		WHERE id IN (SELECT book_id FROM book_authors WHERE author_id = NEW.id);
+		RETURN NULL;
+	END;
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS trg_authors_search_vector ON authors`,
+	`CREATE TRIGGER trg_authors_search_vector
+	AFTER UPDATE OF name ON authors
+	FOR EACH ROW EXECUTE FUNCTION authors_search_vector_refresh()`,
+
+	// Backfill rows written before the triggers existed.
+	`UPDATE books SET search_vector = books_search_vector(books) WHERE search_vector IS NULL`,
+	`CREATE INDEX IF NOT EXISTS idx_books_search_vector ON books USING GIN (search_vector)`,
+}
+
+func migrateCatalog(db *gorm.DB) error {
+	if err := normalizeISBNs(db); err != nil {
+		return err
+	}
+	if err := execStatements(db, catalogIndexes); err != nil {
+		return err
+	}
+	return execStatements(db, catalogSearchStatements)
+}
+
+// normalizeISBNs rewrites ISBNs saved before normalization was enforced to the
+// compact ISBN-13, so the unique index also catches a book entered once as
+// ISBN-10 and once as ISBN-13. Values that are invalid or collide with another
+// book are logged and left for staff to resolve.
+func normalizeISBNs(db *gorm.DB) error {
+	var rows []struct {
+		ID   uint
+		ISBN string
+	}
+	err := db.Table("books").Select("id, isbn").
+		Where("isbn !~ '^97[89][0-9]{10}$'").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		normalized, err := isbn.Normalize(row.ISBN)
+		if err != nil {
+			log.Printf("Skipping book %d: invalid ISBN %q", row.ID, row.ISBN)
+			continue
+		}
+		err = db.Table("books").Where("id = ?", row.ID).Update("isbn", normalized).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			log.Printf("Skipping book %d: ISBN %s is already used by another book", row.ID, normalized)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package migrations
+
+import "gorm.io/gorm"
+
+// inventoryStatements make the stock ledger append-only: corrections must be
+// recorded as new adjustment movements, never by editing history.
+var inventoryStatements = []string{
+	`CREATE OR REPLACE FUNCTION stock_movements_append_only() RETURNS trigger AS $$
+	BEGIN
+		RAISE EXCEPTION 'stock_movements is append-only';
+	END;
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS trg_stock_movements_append_only ON stock_movements`,
+	`CREATE TRIGGER trg_stock_movements_append_only
+	BEFORE UPDATE OR DELETE ON stock_movements
+	FOR EACH ROW EXECUTE FUNCTION stock_movements_append_only()`,
+}
+
+func migrateInventory(db *gorm.DB) error {
+	return execStatements(db, inventoryStatements)
+}
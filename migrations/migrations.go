@@ -1,7 +1,23 @@
 package migrations
 
 import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/imports"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/recommendations"
+	"bookstore-framework/internal/returns"
+	"bookstore-framework/internal/reviews"
+	"bookstore-framework/internal/taxes"
 	"bookstore-framework/internal/users"
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/internal/wishlists"
 	"fmt"
 	"log"
 
@@ -12,11 +28,78 @@ func Migrate(db *gorm.DB) error {
 	log.Println("Running database migrations...")
 	err := db.AutoMigrate(
 		&users.User{},
+		&books.Publisher{},
+		&books.Author{},
+		&books.Category{},
+		&books.Book{},
+		&books.BookAuthor{},
+		&books.BookCategory{},
+		&inventory.Location{},
+		&inventory.StockLevel{},
+		&inventory.StockMovement{},
+		&inventory.StockReservation{},
+		&imports.ImportJob{},
+		&imports.ImportError{},
+		&carts.Cart{},
+		&carts.CartItem{},
+		&orders.Order{},
+		&orders.OrderItem{},
+		&orders.OrderTransition{},
+		&orders.OrderDiscount{},
+		&orders.OrderNotification{},
+		&loyalty.Account{},
+		&loyalty.Entry{},
+		&wallet.GiftCard{},
+		&wallet.Account{},
+		&wallet.Transaction{},
+		&wallet.Posting{},
+		&payments.Payment{},
+		&payments.PaymentEvent{},
+		&payments.PaymentRefund{},
+		&returns.Return{},
+		&returns.ReturnItem{},
+		&purchasing.Supplier{},
+		&purchasing.SupplierItem{},
+		&purchasing.PurchaseOrder{},
+		&purchasing.PurchaseOrderItem{},
+		&purchasing.ReorderRule{},
+		&purchasing.LowStockAlert{},
+		&purchasing.StockNotification{},
+		&promotions.Promotion{},
+		&promotions.PromotionRedemption{},
+		&taxes.TaxRegion{},
+		&taxes.TaxRate{},
+		&pricing.BookPrice{},
+		&pricing.ExchangeRate{},
+		&reviews.Review{},
+		&reviews.ReviewVote{},
+		&wishlists.Wishlist{},
+		&wishlists.WishlistItem{},
+		&wishlists.PriceDropNotification{},
+		&recommendations.BookView{},
+		&recommendations.BookNeighbour{},
 	)
 	if err != nil {
 		return fmt.Errorf("Failed to run migrations: %w", err)
 	}
 
+	if err := migrateCatalog(db); err != nil {
+		return fmt.Errorf("Failed to run catalog migrations: %w", err)
+	}
+
+	if err := migrateInventory(db); err != nil {
+		return fmt.Errorf("Failed to run inventory migrations: %w", err)
+	}
+
 	log.Println("Database migrations completed successfully")
 	return nil
 }
+
+func execStatements(db *gorm.DB, statements []string) error {
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
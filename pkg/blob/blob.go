@@ -0,0 +1,77 @@
+// Package blob stores binary objects, such as cover images, under string keys
+// on the local filesystem or in an S3-compatible bucket.
+package blob
+
+import (
+	"bookstore-framework/configs"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	StoreLocal = "local"
+	StoreS3    = "s3"
+
+	defaultDir = "data/blobs"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Object is a stored blob. The caller closes Body.
+type Object struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+}
+
+// BlobStore keeps blobs under slash separated keys such as
+// "covers/1/original.jpg". Putting a key that exists replaces the blob.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (*Object, error)
+	// Delete removes the blob, deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the store selected by BLOB_STORE, the local filesystem unless
+// set otherwise.
+func New(cfg *configs.Config) (BlobStore, error) {
+	switch cfg.BlobStore {
+	case "", StoreLocal:
+		dir := cfg.BlobDir
+		if dir == "" {
+			dir = defaultDir
+		}
+		return NewLocalStore(dir), nil
+	case StoreS3:
+		return NewS3Store(S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", cfg.BlobStore)
+	}
+}
+
+// validKey rejects keys that could escape the store, such as "../x", and
+// keys with empty segments.
+func validKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
@@ -17,7 +17,8 @@ func ConnectDB(cfg *configs.Config) (*gorm.DB, error) {
 	dialector := postgres.Open(dsn)
 
 	db, err := gorm.Open(dialector, &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 	})
 
 	if err != nil {
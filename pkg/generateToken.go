@@ -8,17 +8,18 @@ import (
 )
 
 type JWTGenerator interface {
-	GenerateToken(userId uint, username, email string) (string, error)
+	GenerateToken(userId uint, username, email, role string) (string, error)
 }
 
 type Claims struct {
 	UserID   uint   `json:"userID"`
 	Username string `json:"username"`
 	Email    string `json:"email"`
+	Role     string `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func (c *Claims) GenerateToken(userId uint, username, email string) (string, error) {
+func (c *Claims) GenerateToken(userId uint, username, email, role string) (string, error) {
 	cfg, err := configs.LoadConfig()
 	if err != nil {
 		return "", err
@@ -27,6 +28,7 @@ func (c *Claims) GenerateToken(userId uint, username, email string) (string, err
 		UserID:   userId,
 		Username: username,
 		Email:    email,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
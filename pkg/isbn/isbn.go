@@ -0,0 +1,182 @@
+// Package isbn validates, converts and formats International Standard Book
+// Numbers. The canonical form used across the catalog is the compact ISBN-13,
+// which is also the EAN-13 printed in the book's barcode.
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalid        = errors.New("invalid ISBN")
+	ErrNotConvertible = errors.New("only 978 ISBN-13 values have an ISBN-10 form")
+	ErrUnknownRange   = errors.New("ISBN is outside the known registration ranges")
+)
+
+// Clean strips the separators people and scanners put into ISBNs and
+// upper-cases the ISBN-10 check character.
+func Clean(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == 'x' || r == 'X':
+			b.WriteByte('X')
+		case r == '-' || r == ' ' || r == '\t':
+		default:
+			// Keep unexpected characters so validation rejects them.
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Valid reports whether s is a valid ISBN-10 or ISBN-13, with or without
+// hyphens.
+func Valid(s string) bool {
+	s = Clean(s)
+	return ValidISBN10(s) || ValidISBN13(s)
+}
+
+// ValidISBN10 checks the length, characters and mod 11 check digit of a
+// compact ISBN-10.
+func ValidISBN10(s string) bool {
+	if len(s) != 10 || !digits(s[:9]) {
+		return false
+	}
+	return s[9] == checkDigit10(s[:9])
+}
+
+// ValidISBN13 checks the Bookland prefix and mod 10 check digit of a compact
+// ISBN-13.
+func ValidISBN13(s string) bool {
+	if len(s) != 13 || !digits(s) {
+		return false
+	}
+	if !strings.HasPrefix(s, "978") && !strings.HasPrefix(s, "979") {
+		return false
+	}
+	return s[12] == checkDigit13(s[:12])
+}
+
+// Normalize returns the compact ISBN-13 for any valid ISBN-10 or ISBN-13.
+func Normalize(s string) (string, error) {
+	s = Clean(s)
+	switch {
+	case ValidISBN13(s):
+		return s, nil
+	case ValidISBN10(s):
+		return "978" + s[:9] + string(checkDigit13("978"+s[:9])), nil
+	default:
+		return "", ErrInvalid
+	}
+}
+
+// ToISBN13 converts a valid ISBN-10 (or passes through an ISBN-13) to the
+// compact ISBN-13.
+func ToISBN13(s string) (string, error) {
+	return Normalize(s)
+}
+
+// ToISBN10 converts a 978-prefixed ISBN-13 to its compact ISBN-10. Numbers in
+// the 979 range were never issued as ISBN-10 and return ErrNotConvertible.
+func ToISBN10(s string) (string, error) {
+	s, err := Normalize(s)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(s, "978") {
+		return "", ErrNotConvertible
+	}
+	return s[3:12] + string(checkDigit10(s[3:12])), nil
+}
+
+// FromBarcode reads the digits decoded by a barcode scanner. Scanners may
+// append the 2 or 5 digit price add-on to the EAN-13, which is dropped.
+func FromBarcode(code string) (string, error) {
+	code = Clean(code)
+	if digits(code) && (len(code) == 15 || len(code) == 18) {
+		code = code[:13]
+	}
+	return Normalize(code)
+}
+
+// Hyphenate formats an ISBN with hyphens between the prefix, registration
+// group, registrant, publication and check digit. The result has the same
+// length as the input, so ISBN-10 values stay ISBN-10.
+func Hyphenate(s string) (string, error) {
+	clean := Clean(s)
+	isbn13, err := Normalize(clean)
+	if err != nil {
+		return "", err
+	}
+
+	prefix, group, rest := isbn13[:3], "", isbn13[3:12]
+	var rules []rangeRule
+	// Registration groups are prefix-free, so at most one entry matches.
+	for key, r := range registrationRanges {
+		if strings.HasPrefix(isbn13, strings.Replace(key, "-", "", 1)) {
+			group, rules = key[4:], r
+			break
+		}
+	}
+	if group == "" {
+		return "", ErrUnknownRange
+	}
+	rest = rest[len(group):]
+
+	// Ranges are defined over the next seven digits, padded on the right.
+	key := (rest + "0000000")[:7]
+	length := 0
+	for _, rule := range rules {
+		if key >= rule.from && key <= rule.to {
+			length = rule.length
+			break
+		}
+	}
+	if length == 0 {
+		return "", ErrUnknownRange
+	}
+
+	registrant, publication := rest[:length], rest[length:]
+	if len(clean) == 10 {
+		isbn10, _ := ToISBN10(isbn13)
+		return strings.Join([]string{group, registrant, publication, isbn10[9:]}, "-"), nil
+	}
+	return strings.Join([]string{prefix, group, registrant, publication, isbn13[12:]}, "-"), nil
+}
+
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func checkDigit10(s string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(s[i]-'0') * (10 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+func checkDigit13(s string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(s[i]-'0') * weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
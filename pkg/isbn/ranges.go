@@ -0,0 +1,84 @@
+package isbn
+
+type rangeRule struct {
+	from, to string
+	length   int
+}
+
+// registrationRanges maps "prefix-group" to the registrant ranges published by
+// the International ISBN Agency. Only the groups we stock are listed; extend
+// the table from the agency's RangeMessage when new markets are added.
+//
+// A range must be copied whole from the RangeMessage or left out: an ISBN in
+// a gap is reported as ErrUnknownRange and left unhyphenated, which is better
+// than hyphenating it at the wrong place. The English language groups have
+// many small ranges of single title publishers, of which only those checked
+// so far are listed. The French language group is left out until its ranges
+// are copied from the RangeMessage.
+var registrationRanges = map[string][]rangeRule{
+	// English language
+	"978-0": {
+		{"0000000", "1999999", 2},
+		{"2000000", "2279999", 3},
+		{"2280000", "2289999", 4},
+		{"2290000", "3689999", 3},
+		{"3700000", "6389999", 3},
+		{"6400000", "6449999", 3},
+		{"6460000", "6479999", 3},
+		{"6480000", "6489999", 7},
+		{"6490000", "6999999", 3},
+		{"7000000", "8499999", 4},
+		{"8500000", "8999999", 5},
+		{"9000000", "9499999", 6},
+		{"9500000", "9999999", 7},
+	},
+	"978-1": {
+		{"0000000", "0999999", 2},
+		{"1000000", "3999999", 3},
+		{"4000000", "5499999", 4},
+		{"5500000", "7319999", 5},
+		{"7320000", "7399999", 7},
+		{"7400000", "7749999", 5},
+		{"8000000", "8697999", 5},
+		{"8698000", "9159999", 6},
+		{"9170000", "9195999", 6},
+		{"9197000", "9729999", 6},
+		{"9730000", "9877999", 4},
+		{"9878000", "9910999", 6},
+		{"9912000", "9979999", 6},
+		{"9990000", "9999999", 7},
+	},
+	// German language
+	"978-3": {
+		{"0000000", "0299999", 2},
+		{"0300000", "0339999", 3},
+		{"0340000", "0369999", 4},
+		{"0370000", "0399999", 5},
+		{"0400000", "1999999", 2},
+		{"2000000", "6999999", 3},
+		{"7000000", "8499999", 4},
+		{"8500000", "8999999", 5},
+		{"9000000", "9499999", 6},
+		{"9500000", "9539999", 7},
+		{"9540000", "9699999", 5},
+		{"9700000", "9849999", 7},
+		{"9850000", "9999999", 5},
+	},
+	// Japan
+	"978-4": {
+		{"0000000", "1999999", 2},
+		{"2000000", "6999999", 3},
+		{"7000000", "8499999", 4},
+		{"8500000", "8999999", 5},
+		{"9000000", "9499999", 6},
+		{"9500000", "9999999", 7},
+	},
+	// France
+	"979-10": {
+		{"0000000", "1999999", 2},
+		{"2000000", "6999999", 3},
+		{"7000000", "8999999", 4},
+		{"9000000", "9759999", 5},
+		{"9760000", "9999999", 6},
+	},
+}
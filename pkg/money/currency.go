@@ -0,0 +1,49 @@
+package money
+
+import "sort"
+
+// Currency describes how amounts of a currency are counted and rounded.
+type Currency struct {
+	Code string
+	// Exponent is the number of minor unit digits: 2 for USD, 0 for JPY.
+	Exponent int
+	// Increment is the step, in minor units, that converted prices are
+	// rounded to: 5 rounds Swiss francs to 0.05.
+	Increment int64
+}
+
+// currencies lists the currencies the store can price in. Extend it when a
+// new market is opened.
+var currencies = map[string]Currency{
+	"AUD": {Code: "AUD", Exponent: 2, Increment: 1},
+	"CAD": {Code: "CAD", Exponent: 2, Increment: 1},
+	"CHF": {Code: "CHF", Exponent: 2, Increment: 5},
+	"CZK": {Code: "CZK", Exponent: 2, Increment: 100},
+	"DKK": {Code: "DKK", Exponent: 2, Increment: 1},
+	"EUR": {Code: "EUR", Exponent: 2, Increment: 1},
+	"GBP": {Code: "GBP", Exponent: 2, Increment: 1},
+	"HUF": {Code: "HUF", Exponent: 2, Increment: 100},
+	"JPY": {Code: "JPY", Exponent: 0, Increment: 1},
+	"KWD": {Code: "KWD", Exponent: 3, Increment: 1},
+	"NOK": {Code: "NOK", Exponent: 2, Increment: 1},
+	"NZD": {Code: "NZD", Exponent: 2, Increment: 1},
+	"PLN": {Code: "PLN", Exponent: 2, Increment: 1},
+	"SEK": {Code: "SEK", Exponent: 2, Increment: 1},
+	"USD": {Code: "USD", Exponent: 2, Increment: 1},
+}
+
+// Lookup returns a supported currency by its ISO 4217 code.
+func Lookup(code string) (Currency, bool) {
+	currency, ok := currencies[code]
+	return currency, ok
+}
+
+// Currencies returns the supported currencies sorted by code.
+func Currencies() []Currency {
+	list := make([]Currency, 0, len(currencies))
+	for _, currency := range currencies {
+		list = append(list, currency)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
@@ -0,0 +1,148 @@
+// Package money handles amounts of money as integers of minor units, such as
+// cents, tagged with their currency. Conversions use exact rational
+// arithmetic, never floats, and round to the rules of the target currency.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+	ErrInvalidRate     = errors.New("exchange rate must be a positive decimal number")
+	ErrInvalidAmount   = errors.New("amount must be a decimal number with at most the decimals of its currency")
+)
+
+// amountFormat allows a non-negative amount in major units, such as "12.99".
+var amountFormat = regexp.MustCompile(`^[0-9]+(\.[0-9]*)?$`)
+
+// rateFormat allows up to 10 decimal places, the precision rates are stored at.
+var rateFormat = regexp.MustCompile(`^[0-9]{1,10}(\.[0-9]{1,10})?$`)
+
+type Money struct {
+	// Amount is in minor units of Currency.
+	Amount   int64
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// String writes the amount in major units followed by the currency code, such
+// as "10.99 USD" or "1650 JPY".
+func (m Money) String() string {
+	return Format(m.Amount, m.Currency)
+}
+
+// Format writes an amount of minor units of a currency in major units.
+// Unknown currencies are taken to have two decimals.
+func Format(amount int64, currency string) string {
+	return FormatAmount(amount, currency) + " " + currency
+}
+
+// FormatAmount writes an amount like Format does, without the currency code,
+// such as "10.99" or "1650".
+func FormatAmount(amount int64, currency string) string {
+	exponent := 2
+	if c, ok := Lookup(currency); ok {
+		exponent = c.Exponent
+	}
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	if exponent == 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+	scale := pow10(exponent)
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, exponent, amount%scale)
+}
+
+// Parse reads an amount written in major units of a currency, such as
+// "12.99" USD or "1500" JPY, into minor units. Decimals beyond those of the
+// currency are only allowed when they are zeros.
+func Parse(s, currency string) (int64, error) {
+	c, ok := Lookup(currency)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, currency)
+	}
+	s = strings.TrimSpace(s)
+	if !amountFormat.MatchString(s) {
+		return 0, ErrInvalidAmount
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if len(fraction) > c.Exponent {
+		if strings.Trim(fraction[c.Exponent:], "0") != "" {
+			return 0, ErrInvalidAmount
+		}
+		fraction = fraction[:c.Exponent]
+	}
+	fraction += strings.Repeat("0", c.Exponent-len(fraction))
+
+	amount, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+	return amount, nil
+}
+
+// ParseRate reads an exchange rate written as a decimal number, such as
+// "0.9215".
+func ParseRate(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if !rateFormat.MatchString(s) {
+		return nil, ErrInvalidRate
+	}
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+	return rate, nil
+}
+
+// Convert turns the amount into another currency, where one unit of the
+// amount's currency is worth rate units of the other, and rounds the result
+// half up to the increment of that currency.
+func (m Money) Convert(to string, rate *big.Rat) (Money, error) {
+	from, ok := Lookup(m.Currency)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s", ErrUnknownCurrency, m.Currency)
+	}
+	target, ok := Lookup(to)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s", ErrUnknownCurrency, to)
+	}
+
+	// minor units of the target = amount / 10^from * rate * 10^to
+	value := new(big.Rat).Mul(big.NewRat(m.Amount, pow10(from.Exponent)), rate)
+	value.Mul(value, big.NewRat(pow10(target.Exponent), 1))
+	return Money{Amount: roundTo(value, target.Increment), Currency: to}, nil
+}
+
+// roundTo rounds a value half away from zero to a multiple of increment.
+func roundTo(value *big.Rat, increment int64) int64 {
+	steps := new(big.Rat).Quo(value, big.NewRat(increment, 1))
+	num, den := new(big.Int).Abs(steps.Num()), steps.Denom()
+	// floor(|steps| + 1/2) = (2|num| + den) / 2den
+	rounded := new(big.Int).Add(new(big.Int).Lsh(num, 1), den)
+	rounded.Quo(rounded, new(big.Int).Lsh(den, 1))
+	if steps.Sign() < 0 {
+		rounded.Neg(rounded)
+	}
+	return rounded.Int64() * increment
+}
+
+func pow10(n int) int64 {
+	result := int64(1)
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+	return result
+}
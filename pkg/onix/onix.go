@@ -0,0 +1,41 @@
+// Package onix holds the ONIX 3.0 code lists shared by catalog imports and
+// exports.
+package onix
+
+// Namespace of ONIX 3.0 messages using reference tag names.
+const Namespace = "http://ns.editeur.org/onix/3.0/reference"
+
+// languages maps the ISO 639-2/B codes used by ONIX (code list 74) to the
+// ISO 639-1 codes stored on books.
+var languages = map[string]string{
+	"chi": "zh", "dan": "da", "dut": "nl", "eng": "en", "fin": "fi",
+	"fre": "fr", "ger": "de", "hun": "hu", "ita": "it", "jpn": "ja",
+	"kor": "ko", "nor": "no", "pol": "pl", "por": "pt", "rum": "ro",
+	"rus": "ru", "spa": "es", "swe": "sv", "tur": "tr",
+}
+
+var languageCodes = func() map[string]string {
+	codes := make(map[string]string, len(languages))
+	for onixCode, code := range languages {
+		codes[code] = onixCode
+	}
+	return codes
+}()
+
+// FromLanguage converts an ONIX language code to ISO 639-1. Unknown codes are
+// returned unchanged.
+func FromLanguage(code string) string {
+	if short, ok := languages[code]; ok {
+		return short
+	}
+	return code
+}
+
+// ToLanguage converts an ISO 639-1 code to its ONIX form. Unknown codes are
+// returned unchanged.
+func ToLanguage(code string) string {
+	if onixCode, ok := languageCodes[code]; ok {
+		return onixCode
+	}
+	return code
+}
@@ -0,0 +1,30 @@
+package pkg
+
+type PaginationQuery struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+func (q PaginationQuery) Offset() int {
+	return (q.Page - 1) * q.Limit
+}
+
+type PaginationMeta struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+}
+
+func NewPaginationMeta(q PaginationQuery, total int64) PaginationMeta {
+	totalPages := 0
+	if q.Limit > 0 {
+		totalPages = int((total + int64(q.Limit) - 1) / int64(q.Limit))
+	}
+	return PaginationMeta{
+		Page:       q.Page,
+		Limit:      q.Limit,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
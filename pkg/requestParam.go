@@ -0,0 +1,19 @@
+package pkg
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidID = errors.New("invalid id")
+
+// ParamID parses a positive numeric path parameter such as ":id".
+func ParamID(ctx *gin.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(ctx.Param(name), 10, 64)
+	if err != nil || id == 0 {
+		return 0, ErrInvalidID
+	}
+	return uint(id), nil
+}
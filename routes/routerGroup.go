@@ -1,17 +1,76 @@
 package routes
 
 import (
-	"bookstore-framework/internal/users/api"
+	booksApi "bookstore-framework/internal/books/api"
+	cartsApi "bookstore-framework/internal/carts/api"
+	exportsApi "bookstore-framework/internal/exports/api"
+	importsApi "bookstore-framework/internal/imports/api"
+	inventoryApi "bookstore-framework/internal/inventory/api"
+	loyaltyApi "bookstore-framework/internal/loyalty/api"
+	ordersApi "bookstore-framework/internal/orders/api"
+	"bookstore-framework/internal/payments"
+	paymentsApi "bookstore-framework/internal/payments/api"
+	pricingApi "bookstore-framework/internal/pricing/api"
+	promotionsApi "bookstore-framework/internal/promotions/api"
+	purchasingApi "bookstore-framework/internal/purchasing/api"
+	recommendationsApi "bookstore-framework/internal/recommendations/api"
+	reportsApi "bookstore-framework/internal/reports/api"
+	"bookstore-framework/internal/returns"
+	returnsApi "bookstore-framework/internal/returns/api"
+	reviewsApi "bookstore-framework/internal/reviews/api"
+	taxesApi "bookstore-framework/internal/taxes/api"
+	usersApi "bookstore-framework/internal/users/api"
+	walletApi "bookstore-framework/internal/wallet/api"
+	wishlistsApi "bookstore-framework/internal/wishlists/api"
+
+	"bookstore-framework/pkg/blob"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
-func Router(db *gorm.DB) *gin.Engine {
+// Services are built once in main and shared by the routes and the
+// scheduled jobs, so that both work on the same instances.
+type Services struct {
+	Blobs           blob.BlobStore
+	PaymentProvider payments.PaymentProvider
+	Payments        payments.PaymentService
+	Returns         returns.ReturnService
+}
+
+func Router(db *gorm.DB, services Services) *gin.Engine {
 	router := gin.Default()
 	group := router.Group("/api/v1")
 
-	api.UsersRoutes(group.Group("/users"), db)
+	usersApi.UsersRoutes(group.Group("/users"), db)
+	booksApi.BooksRoutes(group.Group("/books"), db, services.Blobs)
+	reviewsApi.BookReviewsRoutes(group.Group("/books/:id/reviews"), db)
+	reviewsApi.ReviewsRoutes(group.Group("/reviews"), db)
+	recommendationsApi.BookRecommendationsRoutes(group.Group("/books/:id/recommendations"), db)
+	recommendationsApi.RecommendationsRoutes(group.Group("/recommendations"), db)
+	booksApi.AuthorsRoutes(group.Group("/authors"), db)
+	booksApi.PublishersRoutes(group.Group("/publishers"), db)
+	booksApi.CategoriesRoutes(group.Group("/categories"), db)
+	booksApi.CoversRoutes(group.Group("/covers"), db, services.Blobs)
+	inventoryApi.InventoryRoutes(group.Group("/inventory"), db)
+	importsApi.ImportsRoutes(group.Group("/imports"), db)
+	exportsApi.ExportsRoutes(group.Group("/exports"), db)
+	reportsApi.ReportsRoutes(group.Group("/reports"), db)
+	cartsApi.CartRoutes(group.Group("/cart"), db)
+	wishlistsApi.WishlistsRoutes(group.Group("/wishlists"), db)
+	ordersApi.OrdersRoutes(group.Group("/orders"), db)
+	paymentsApi.PaymentsRoutes(group.Group("/payments"), services.Payments, services.PaymentProvider)
+	returnsApi.ReturnsRoutes(group.Group("/returns"), services.Returns)
+	purchasingApi.SuppliersRoutes(group.Group("/suppliers"), db)
+	purchasingApi.PurchaseOrdersRoutes(group.Group("/purchase-orders"), db)
+	purchasingApi.ReorderRulesRoutes(group.Group("/reorder-rules"), db)
+	purchasingApi.StockAlertsRoutes(group.Group("/stock-alerts"), db)
+	loyaltyApi.LoyaltyRoutes(group.Group("/loyalty"), db)
+	walletApi.WalletRoutes(group.Group("/wallet"), db)
+	walletApi.GiftCardsRoutes(group.Group("/gift-cards"), db)
+	promotionsApi.PromotionsRoutes(group.Group("/promotions"), db)
+	pricingApi.PricingRoutes(group.Group("/pricing"), db)
+	taxesApi.TaxRoutes(group.Group("/tax-regions"), db)
 
 	return router
 }
@@ -0,0 +1,260 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBookService(ctrl)
+	handler := api.NewBookHandler(mockService)
+
+	t.Run("CreateBook", func(t *testing.T) {
+		req := dto.BookRequest{
+			Title: "The Hobbit",
+			ISBN:  "9780547928227",
+			Price: 1099,
+		}
+		mockService.EXPECT().CreateBook(gomock.Any(), gomock.Eq(req)).
+			Return(&dto.BookResponse{ID: 1, Title: req.Title, ISBN: req.ISBN}, nil)
+
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/books", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateBook(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response pkg.Response
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "Book created successfully", response.Message)
+	})
+
+	t.Run("GetBook", func(t *testing.T) {
+		mockService.EXPECT().GetBook(gomock.Any(), uint(1), "").
+			Return(&dto.BookResponse{ID: 1, Title: "The Hobbit"}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/1", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.GetBook(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response pkg.Response
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		var book dto.BookResponse
+		dataBytes, _ := json.Marshal(response.Data)
+		json.Unmarshal(dataBytes, &book)
+		assert.Equal(t, "The Hobbit", book.Title)
+	})
+
+	t.Run("GetBooks", func(t *testing.T) {
+		mockService.EXPECT().GetBooks(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, query dto.BookListQuery) (*dto.BookListResponse, error) {
+				assert.Equal(t, 1, query.Page)
+				assert.Equal(t, 20, query.Limit)
+				assert.Equal(t, "id", query.Language)
+				return &dto.BookListResponse{Books: []dto.BookResponse{}}, nil
+			})
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books?language=id", nil)
+
+		handler.GetBooks(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestBookHandler_Search(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBookService(ctrl)
+	handler := api.NewBookHandler(mockService)
+
+	t.Run("SearchBooks", func(t *testing.T) {
+		mockService.EXPECT().SearchBooks(gomock.Any(), dto.BookSearchQuery{
+			PaginationQuery: pkg.PaginationQuery{Page: 1, Limit: 20},
+			Q:               "hobbit",
+		}).Return(&dto.BookSearchResponse{Results: []dto.BookSearchResult{{
+			Book:           dto.BookResponse{ID: 1, Title: "The Hobbit"},
+			TitleHighlight: "The <mark>Hobbit</mark>",
+		}}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/search?q=hobbit", nil)
+
+		handler.SearchBooks(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("SearchBooks_MissingQuery", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/search", nil)
+
+		handler.SearchBooks(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("SearchBooks_NoTerms", func(t *testing.T) {
+		mockService.EXPECT().SearchBooks(gomock.Any(), gomock.Any()).Return(nil, books.ErrEmptySearch)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/search?q=%21%21", nil)
+
+		handler.SearchBooks(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response pkg.Response
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, books.ErrEmptySearch.Error(), response.Message)
+	})
+}
+
+func TestBookHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBookService(ctrl)
+	handler := api.NewBookHandler(mockService)
+
+	t.Run("CreateBook_MissingRequiredField", func(t *testing.T) {
+		body, err := json.Marshal(map[string]interface{}{"title": "The Hobbit"})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/books", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateBook(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreateBook_DuplicateISBN", func(t *testing.T) {
+		req := dto.BookRequest{Title: "The Hobbit", ISBN: "9780547928227"}
+		mockService.EXPECT().CreateBook(gomock.Any(), gomock.Eq(req)).
+			Return(nil, books.ErrDuplicateISBN)
+
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/books", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateBook(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("GetBook_InvalidID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/abc", nil)
+		c.Params = gin.Params{{Key: "id", Value: "abc"}}
+
+		handler.GetBook(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetBook_CurrencyNotAvailable", func(t *testing.T) {
+		mockService.EXPECT().GetBook(gomock.Any(), uint(1), "JPY").Return(nil, books.ErrCurrencyNotAvailable)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/1?currency=JPY", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.GetBook(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetBook_InvalidCurrency", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/1?currency=dollars", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.GetBook(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetBookByBarcode_InvalidISBN", func(t *testing.T) {
+		mockService.EXPECT().GetBookByBarcode(gomock.Any(), "12345").Return(nil, books.ErrInvalidISBN)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/by-barcode/12345", nil)
+		c.Params = gin.Params{{Key: "ean", Value: "12345"}}
+
+		handler.GetBookByBarcode(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("DeleteBook_NotFound", func(t *testing.T) {
+		mockService.EXPECT().DeleteBook(gomock.Any(), uint(9)).Return(books.ErrBookNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/books/9", nil)
+		c.Params = gin.Params{{Key: "id", Value: "9"}}
+
+		handler.DeleteBook(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var response pkg.Response
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, books.ErrBookNotFound.Error(), response.Message)
+	})
+}
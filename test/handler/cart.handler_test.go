@@ -0,0 +1,150 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/carts/api"
+	"bookstore-framework/internal/carts/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCartService(ctrl)
+	handler := api.NewCartHandler(mockService)
+
+	t.Run("AddItem_GuestGetsCookie", func(t *testing.T) {
+		req := dto.AddCartItemRequest{BookID: 1, Quantity: 2}
+		var token string
+		mockService.EXPECT().AddItem(gomock.Any(), gomock.Any(), "", req).
+			DoAndReturn(func(_ context.Context, owner carts.Owner, _ string, _ dto.AddCartItemRequest) (*dto.CartResponse, error) {
+				assert.Nil(t, owner.UserID)
+				assert.NotEmpty(t, owner.Token)
+				token = owner.Token
+				return &dto.CartResponse{ID: 5, Currency: "USD", Subtotal: 2198}, nil
+			})
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/cart/items", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.AddItem(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, carts.TokenCookie, cookies[0].Name)
+		assert.Equal(t, token, cookies[0].Value)
+		assert.True(t, cookies[0].HttpOnly)
+	})
+
+	t.Run("GetCart_User", func(t *testing.T) {
+		userID := uint(7)
+		mockService.EXPECT().GetCart(gomock.Any(), carts.Owner{UserID: &userID}, "EUR").
+			Return(&dto.CartResponse{Currency: "EUR", Items: []dto.CartItemResponse{}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/cart?currency=EUR", nil)
+		c.Set("userID", userID)
+
+		handler.GetCart(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Result().Cookies())
+	})
+}
+
+func TestCartHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCartService(ctrl)
+	handler := api.NewCartHandler(mockService)
+
+	t.Run("AddItem_InvalidQuantity", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/cart/items", bytes.NewBufferString(`{"book_id":1,"quantity":100}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.AddItem(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetCart_CurrencyNotAvailable", func(t *testing.T) {
+		mockService.EXPECT().GetCart(gomock.Any(), carts.Owner{}, "XAU").Return(nil, carts.ErrCurrencyNotAvailable)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/cart?currency=XAU", nil)
+
+		handler.GetCart(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("UpdateItem_InsufficientStock", func(t *testing.T) {
+		mockService.EXPECT().UpdateItem(gomock.Any(), carts.Owner{Token: "guest-token"}, uint(1), dto.UpdateCartItemRequest{Quantity: 5}).
+			Return(nil, carts.ErrInsufficientStock)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/cart/items/1", bytes.NewBufferString(`{"quantity":5}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Request.AddCookie(&http.Cookie{Name: carts.TokenCookie, Value: "guest-token"})
+		c.Params = gin.Params{{Key: "bookId", Value: "1"}}
+
+		handler.UpdateItem(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("RemoveItem_NotInCart", func(t *testing.T) {
+		mockService.EXPECT().RemoveItem(gomock.Any(), carts.Owner{}, uint(3)).Return(nil, carts.ErrItemNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/cart/items/3", nil)
+		c.Params = gin.Params{{Key: "bookId", Value: "3"}}
+
+		handler.RemoveItem(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("ApplyCoupon_NotFound", func(t *testing.T) {
+		userID := uint(7)
+		mockService.EXPECT().ApplyCoupon(gomock.Any(), carts.Owner{UserID: &userID}, dto.ApplyCouponRequest{Code: "NOPE"}).
+			Return(nil, carts.ErrCouponNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/cart/coupon", bytes.NewBufferString(`{"code":"NOPE"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.ApplyCoupon(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
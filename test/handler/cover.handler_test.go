@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg/blob"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func coverRequest(t *testing.T, data []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("cover", "cover.png")
+	assert.NoError(t, err)
+	part.Write(data)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/books/1/cover", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestCoverHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCoverService(ctrl)
+	handler := api.NewCoverHandler(mockService)
+
+	t.Run("UploadCover", func(t *testing.T) {
+		mockService.EXPECT().UploadCover(gomock.Any(), uint(1), []byte("\x89PNG")).
+			Return(&dto.CoverResponse{Width: 400, Height: 600, Original: "/api/v1/covers/1/abc/original.png"}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = coverRequest(t, []byte("\x89PNG"))
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.UploadCover(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "/api/v1/covers/1/abc/original.png")
+	})
+
+	t.Run("GetCover_Cacheable", func(t *testing.T) {
+		mockService.EXPECT().GetImage(gomock.Any(), "/1/abc/small.jpg").
+			Return(&blob.Object{Body: io.NopCloser(strings.NewReader("jpeg")), ContentType: "image/jpeg", Size: 4}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/covers/1/abc/small.jpg", nil)
+		c.Params = gin.Params{{Key: "path", Value: "/1/abc/small.jpg"}}
+
+		handler.GetCover(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "jpeg", w.Body.String())
+		assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+		assert.Equal(t, "public, max-age=31536000, immutable", w.Header().Get("Cache-Control"))
+		assert.Equal(t, `"1/abc/small.jpg"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("GetCover_NotModified", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/covers/1/abc/small.jpg", nil)
+		c.Request.Header.Set("If-None-Match", `"1/abc/small.jpg"`)
+		c.Params = gin.Params{{Key: "path", Value: "/1/abc/small.jpg"}}
+
+		handler.GetCover(c)
+
+		assert.Equal(t, http.StatusNotModified, c.Writer.Status())
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+func TestCoverHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCoverService(ctrl)
+	handler := api.NewCoverHandler(mockService)
+
+	t.Run("UploadCover_Unsupported", func(t *testing.T) {
+		mockService.EXPECT().UploadCover(gomock.Any(), uint(1), gomock.Any()).Return(nil, books.ErrCoverUnsupported)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = coverRequest(t, []byte("GIF89a"))
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.UploadCover(c)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("UploadCover_TooLarge", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = coverRequest(t, make([]byte, books.MaxCoverSize+1))
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.UploadCover(c)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("UploadCover_MissingFile", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/books/1/cover", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.UploadCover(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetCover_NotFound", func(t *testing.T) {
+		mockService.EXPECT().GetImage(gomock.Any(), "/1/abc/huge.jpg").Return(nil, books.ErrCoverNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/covers/1/abc/huge.jpg", nil)
+		c.Params = gin.Params{{Key: "path", Value: "/1/abc/huge.jpg"}}
+
+		handler.GetCover(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	})
+}
@@ -0,0 +1,80 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/exports"
+	"bookstore-framework/internal/exports/api"
+	"bookstore-framework/internal/exports/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockExportService(ctrl)
+	handler := api.NewExportHandler(mockService)
+
+	t.Run("ExportCatalog", func(t *testing.T) {
+		mockService.EXPECT().ResolveFilter(gomock.Any(), dto.ExportQuery{Format: "jsonl", Category: "fantasy"}).
+			Return(&exports.ExportFilter{CategoryPath: "1/3/"}, nil)
+		mockService.EXPECT().Export(gomock.Any(), "jsonl", exports.ExportFilter{CategoryPath: "1/3/"}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ exports.ExportFilter, w io.Writer) error {
+				_, err := io.WriteString(w, `{"isbn":"9780547928227"}`+"\n")
+				return err
+			})
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/exports/catalog?format=jsonl&category=fantasy", nil)
+
+		handler.ExportCatalog(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `.jsonl"`)
+		assert.Equal(t, `{"isbn":"9780547928227"}`+"\n", w.Body.String())
+	})
+}
+
+func TestExportHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockExportService(ctrl)
+	handler := api.NewExportHandler(mockService)
+
+	t.Run("ExportCatalog_InvalidFormat", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/exports/catalog?format=xlsx", nil)
+
+		handler.ExportCatalog(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ExportCatalog_CategoryNotFound", func(t *testing.T) {
+		mockService.EXPECT().ResolveFilter(gomock.Any(), gomock.Any()).Return(nil, exports.ErrCategoryNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/exports/catalog?format=csv&category=missing", nil)
+
+		handler.ExportCatalog(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,86 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/imports/api"
+	"bookstore-framework/internal/imports/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockImportService(ctrl)
+	handler := api.NewImportHandler(mockService)
+
+	t.Run("CreateImport", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "backlist.csv")
+		require.NoError(t, err)
+		part.Write([]byte("isbn,title,price\n9780547928227,The Hobbit,10.99\n"))
+		require.NoError(t, writer.Close())
+
+		mockService.EXPECT().CreateJob(gomock.Any(), uint(1), "", "backlist.csv", gomock.Any()).
+			Return(&dto.ImportJobResponse{ID: 1, Format: "csv", Status: "pending"}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/imports", body)
+		c.Request.Header.Set("Content-Type", writer.FormDataContentType())
+		c.Set("userID", uint(1))
+
+		handler.CreateImport(c)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+}
+
+func TestImportHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockImportService(ctrl)
+	handler := api.NewImportHandler(mockService)
+
+	t.Run("CreateImport_JWTError", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/imports", nil)
+
+		handler.CreateImport(c)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("CreateImport_MissingFile", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		writer.WriteField("format", "csv")
+		require.NoError(t, writer.Close())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/imports", body)
+		c.Request.Header.Set("Content-Type", writer.FormDataContentType())
+		c.Set("userID", uint(1))
+
+		handler.CreateImport(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,64 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/loyalty/api"
+	"bookstore-framework/internal/loyalty/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoyaltyHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockLoyaltyService(ctrl)
+	handler := api.NewLoyaltyHandler(mockService)
+
+	t.Run("GetAccount", func(t *testing.T) {
+		mockService.EXPECT().GetAccount(gomock.Any(), uint(7)).Return(&dto.AccountResponse{
+			Balance: 1200, Earned: 1500, Tier: "silver", Multiplier: 125,
+		}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/loyalty", nil)
+		c.Set("userID", uint(7))
+
+		handler.GetAccount(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"tier":"silver"`)
+	})
+
+	t.Run("GetAccount_Unauthorized", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/loyalty", nil)
+
+		handler.GetAccount(c)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("GetEntries", func(t *testing.T) {
+		mockService.EXPECT().GetEntries(gomock.Any(), uint(7), gomock.Any()).
+			Return(&dto.EntryListResponse{Entries: []dto.EntryResponse{{ID: 1, Type: "purchase", Points: 25}}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/loyalty/entries?page=1&limit=20", nil)
+		c.Set("userID", uint(7))
+
+		handler.GetEntries(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,167 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/orders/api"
+	"bookstore-framework/internal/orders/api/dto"
+	"bookstore-framework/internal/users"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const checkoutBody = `{"shipping_address":{"name":"Bilbo Baggins","line1":"Bag End","city":"Hobbiton","postal_code":"SH1 1BE","country":"GB"}}`
+
+func TestOrderHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOrderService(ctrl)
+	handler := api.NewOrderHandler(mockService)
+
+	t.Run("Checkout", func(t *testing.T) {
+		mockService.EXPECT().Checkout(gomock.Any(), uint(7), gomock.Any()).
+			Return(&dto.OrderResponse{ID: 42, Status: orders.StatusPending}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/checkout", bytes.NewBufferString(checkoutBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.Checkout(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("GetOrder_Staff", func(t *testing.T) {
+		mockService.EXPECT().GetOrder(gomock.Any(), orders.Actor{UserID: 2, Staff: true}, uint(42)).
+			Return(&dto.OrderResponse{ID: 42}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/42", nil)
+		c.Params = gin.Params{{Key: "id", Value: "42"}}
+		c.Set("userID", uint(2))
+		c.Set("role", users.RoleStaff)
+
+		handler.GetOrder(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("CancelOrder_WithoutBody", func(t *testing.T) {
+		mockService.EXPECT().Cancel(gomock.Any(), orders.Actor{UserID: 7}, uint(42), dto.CancelOrderRequest{}).
+			Return(&dto.OrderResponse{ID: 42, Status: orders.StatusCancelled}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/42/cancel", nil)
+		c.Params = gin.Params{{Key: "id", Value: "42"}}
+		c.Set("userID", uint(7))
+
+		handler.CancelOrder(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("GetNotifications", func(t *testing.T) {
+		mockService.EXPECT().GetNotifications(gomock.Any(), uint(7), gomock.Any()).
+			Return(&dto.OrderNotificationListResponse{
+				Notifications: []dto.OrderNotificationResponse{{ID: 1, OrderID: 42, Type: orders.NotificationFulfillable}},
+			}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/notifications?page=1&limit=20", nil)
+		c.Set("userID", uint(7))
+
+		handler.GetNotifications(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("MarkNotificationsRead", func(t *testing.T) {
+		mockService.EXPECT().MarkNotificationsRead(gomock.Any(), uint(7)).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/orders/notifications/read", nil)
+		c.Set("userID", uint(7))
+
+		handler.MarkNotificationsRead(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestOrderHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOrderService(ctrl)
+	handler := api.NewOrderHandler(mockService)
+
+	t.Run("Checkout_JWTError", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/checkout", bytes.NewBufferString(checkoutBody))
+
+		handler.Checkout(c)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Checkout_CartChanged", func(t *testing.T) {
+		mockService.EXPECT().Checkout(gomock.Any(), uint(7), gomock.Any()).Return(nil, orders.ErrCartChanged)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/checkout", bytes.NewBufferString(checkoutBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.Checkout(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("TransitionOrder_UnknownStatus", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/42/transitions", bytes.NewBufferString(`{"status":"lost"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "42"}}
+		c.Set("userID", uint(2))
+
+		handler.TransitionOrder(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("TransitionOrder_NotAllowed", func(t *testing.T) {
+		mockService.EXPECT().Transition(gomock.Any(), gomock.Any(), uint(42), dto.TransitionRequest{Status: orders.StatusShipped}).
+			Return(nil, orders.ErrInvalidTransition)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/42/transitions", bytes.NewBufferString(`{"status":"shipped"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "42"}}
+		c.Set("userID", uint(2))
+
+		handler.TransitionOrder(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
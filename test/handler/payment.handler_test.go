@@ -0,0 +1,141 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/payments/api"
+	"bookstore-framework/internal/payments/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPaymentService(ctrl)
+	handler := api.NewPaymentHandler(mockService, nil)
+
+	t.Run("CreatePayment", func(t *testing.T) {
+		mockService.EXPECT().CreatePayment(gomock.Any(), orders.Actor{UserID: 7}, dto.CreatePaymentRequest{OrderID: 42}).
+			Return(&dto.PaymentResponse{ID: 1, OrderID: 42, Status: payments.PaymentPending, ClientSecret: "secret"}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments", bytes.NewBufferString(`{"order_id":42}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.CreatePayment(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"client_secret":"secret"`)
+	})
+
+	t.Run("Webhook", func(t *testing.T) {
+		body := `{"id":"evt_1","type":"payment.succeeded","intent_id":"pi_1"}`
+		mockService.EXPECT().HandleWebhook(gomock.Any(), []byte(body), gomock.Any()).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments/webhook", bytes.NewBufferString(body))
+		c.Request.Header.Set(payments.FakeSignatureHeader, "abc")
+
+		handler.Webhook(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RefundPayment_WithoutBody", func(t *testing.T) {
+		mockService.EXPECT().Refund(gomock.Any(), uint(2), uint(1), dto.RefundRequest{}).
+			Return(&dto.PaymentResponse{ID: 1, Status: payments.PaymentRefunded}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments/1/refunds", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Set("userID", uint(2))
+
+		handler.RefundPayment(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("CompleteFakePayment", func(t *testing.T) {
+		provider := payments.NewFakeProvider("webhook-secret")
+		intent, err := provider.CreateIntent(context.Background(), payments.IntentRequest{Amount: 2198, Currency: "USD"})
+		assert.NoError(t, err)
+		fakeHandler := api.NewPaymentHandler(mockService, provider)
+		mockService.EXPECT().HandleWebhook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments/fake/intents/"+intent.ID+"/complete", bytes.NewBufferString(`{"outcome":"succeeded"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "intentId", Value: intent.ID}}
+		c.Set("userID", uint(7))
+
+		fakeHandler.CompleteFakePayment(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestPaymentHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPaymentService(ctrl)
+	handler := api.NewPaymentHandler(mockService, nil)
+
+	t.Run("Webhook_InvalidSignature", func(t *testing.T) {
+		mockService.EXPECT().HandleWebhook(gomock.Any(), gomock.Any(), gomock.Any()).Return(payments.ErrInvalidSignature)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments/webhook", bytes.NewBufferString(`{}`))
+
+		handler.Webhook(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreatePayment_OrderNotPayable", func(t *testing.T) {
+		mockService.EXPECT().CreatePayment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, payments.ErrOrderNotPayable)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments", bytes.NewBufferString(`{"order_id":42}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.CreatePayment(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("RefundPayment_InvalidAmount", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/payments/1/refunds", bytes.NewBufferString(`{"amount":-5}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Set("userID", uint(2))
+
+		handler.RefundPayment(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
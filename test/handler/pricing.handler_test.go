@@ -0,0 +1,101 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/pricing/api"
+	"bookstore-framework/internal/pricing/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPricingService(ctrl)
+	handler := api.NewPricingHandler(mockService)
+
+	t.Run("SavePriceList", func(t *testing.T) {
+		mockService.EXPECT().SavePriceList(gomock.Any(), "GBP", dto.PriceListRequest{Prices: []dto.BookPriceRequest{{BookID: 1, Amount: 899}}}).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/pricing/price-lists/GBP", bytes.NewBufferString(`{"prices":[{"book_id":1,"amount":899}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "currency", Value: "GBP"}}
+
+		handler.SavePriceList(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("SaveExchangeRate", func(t *testing.T) {
+		mockService.EXPECT().SaveExchangeRate(gomock.Any(), dto.ExchangeRateRequest{Base: "USD", Quote: "EUR", Rate: "0.9215"}).
+			Return(&dto.ExchangeRateResponse{Base: "USD", Quote: "EUR", Rate: "0.9215"}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/pricing/exchange-rates", bytes.NewBufferString(`{"base":"USD","quote":"EUR","rate":"0.9215"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.SaveExchangeRate(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestPricingHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPricingService(ctrl)
+	handler := api.NewPricingHandler(mockService)
+
+	t.Run("SaveExchangeRate_SameCurrency", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/pricing/exchange-rates", bytes.NewBufferString(`{"base":"USD","quote":"USD","rate":"1"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.SaveExchangeRate(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetPriceList_UnsupportedCurrency", func(t *testing.T) {
+		mockService.EXPECT().GetPriceList(gomock.Any(), "XAU", gomock.Any()).Return(nil, pricing.ErrUnsupportedCurrency)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/pricing/price-lists/XAU", nil)
+		c.Params = gin.Params{{Key: "currency", Value: "XAU"}}
+
+		handler.GetPriceList(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("DeleteExchangeRate_NotFound", func(t *testing.T) {
+		mockService.EXPECT().DeleteExchangeRate(gomock.Any(), "USD", "EUR").Return(pricing.ErrExchangeRateNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/pricing/exchange-rates/USD/EUR", nil)
+		c.Params = gin.Params{{Key: "base", Value: "USD"}, {Key: "quote", Value: "EUR"}}
+
+		handler.DeleteExchangeRate(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
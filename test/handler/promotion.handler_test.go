@@ -0,0 +1,104 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/promotions/api"
+	"bookstore-framework/internal/promotions/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const promotionBody = `{"name":"Summer fantasy sale","code":"SUMMER10","type":"percentage","value":10}`
+
+func TestPromotionHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPromotionService(ctrl)
+	handler := api.NewPromotionHandler(mockService)
+
+	t.Run("CreatePromotion", func(t *testing.T) {
+		mockService.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).
+			Return(&dto.PromotionResponse{ID: 1, Code: "SUMMER10", Type: promotions.TypePercentage, Value: 10}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/promotions", bytes.NewBufferString(promotionBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreatePromotion(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+}
+
+func TestPromotionHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPromotionService(ctrl)
+	handler := api.NewPromotionHandler(mockService)
+
+	t.Run("CreatePromotion_UnknownType", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/promotions", bytes.NewBufferString(`{"name":"Mystery","type":"lottery"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreatePromotion(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreatePromotion_Invalid", func(t *testing.T) {
+		mockService.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("%w: percentage value must be between 1 and 100", promotions.ErrInvalidPromotion))
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/promotions", bytes.NewBufferString(promotionBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreatePromotion(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreatePromotion_DuplicateCode", func(t *testing.T) {
+		mockService.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).Return(nil, promotions.ErrDuplicateCode)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/promotions", bytes.NewBufferString(promotionBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreatePromotion(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("GetPromotion_NotFound", func(t *testing.T) {
+		mockService.EXPECT().GetPromotion(gomock.Any(), uint(9)).Return(nil, promotions.ErrPromotionNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/promotions/9", nil)
+		c.Params = gin.Params{{Key: "id", Value: "9"}}
+
+		handler.GetPromotion(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
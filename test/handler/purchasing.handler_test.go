@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/purchasing/api"
+	"bookstore-framework/internal/purchasing/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchasingHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPurchasingService(ctrl)
+	handler := api.NewPurchasingHandler(mockService)
+
+	t.Run("CreateSupplier_InvalidEmail", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/suppliers",
+			bytes.NewBufferString(`{"name":"Northern Books","email":"orders","currency":"GBP"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateSupplier(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreatePurchaseOrder", func(t *testing.T) {
+		mockService.EXPECT().CreatePurchaseOrder(gomock.Any(), uint(1), dto.PurchaseOrderRequest{
+			SupplierID: 2,
+			LocationID: 3,
+			Items:      []dto.PurchaseOrderItemRequest{{BookID: 1, Quantity: 20}},
+		}).Return(&dto.PurchaseOrderResponse{ID: 9, Status: purchasing.StatusDraft}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/purchase-orders",
+			bytes.NewBufferString(`{"supplier_id":2,"location_id":3,"items":[{"book_id":1,"quantity":20}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(1))
+
+		handler.CreatePurchaseOrder(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("SendPurchaseOrder_AlreadySent", func(t *testing.T) {
+		mockService.EXPECT().Send(gomock.Any(), uint(1), uint(9)).Return(nil, purchasing.ErrInvalidTransition)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/purchase-orders/9/send", nil)
+		c.Params = gin.Params{{Key: "id", Value: "9"}}
+		c.Set("userID", uint(1))
+
+		handler.SendPurchaseOrder(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("ReceivePurchaseOrder_OverReceipt", func(t *testing.T) {
+		mockService.EXPECT().Receive(gomock.Any(), uint(1), uint(9), dto.ReceivePurchaseOrderRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Quantity: 30}},
+		}).Return(nil, purchasing.ErrOverReceipt)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/purchase-orders/9/receive",
+			bytes.NewBufferString(`{"items":[{"book_id":1,"quantity":30}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "9"}}
+		c.Set("userID", uint(1))
+
+		handler.ReceivePurchaseOrder(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
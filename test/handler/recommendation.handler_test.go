@@ -0,0 +1,120 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/recommendations"
+	"bookstore-framework/internal/recommendations/api"
+	"bookstore-framework/internal/recommendations/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecommendationHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRecommendationService(ctrl)
+	handler := api.NewRecommendationHandler(mockService)
+
+	t.Run("GetBookRecommendations", func(t *testing.T) {
+		mockService.EXPECT().GetBookRecommendations(gomock.Any(), uint(1), dto.RecommendationQuery{Limit: 10}).
+			Return([]dto.RecommendationResponse{{BookID: 2}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/1/recommendations", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.GetBookRecommendations(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("GetUserRecommendations", func(t *testing.T) {
+		mockService.EXPECT().GetUserRecommendations(gomock.Any(), uint(7), dto.RecommendationQuery{Limit: 5}).
+			Return([]dto.RecommendationResponse{}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/recommendations?limit=5", nil)
+		c.Set("userID", uint(7))
+
+		handler.GetUserRecommendations(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RecordViews", func(t *testing.T) {
+		mockService.EXPECT().RecordView(gomock.Any(), uint(7), uint(1)).Return(nil)
+
+		router := gin.New()
+		router.GET("/books/:id", func(c *gin.Context) { c.Set("userID", uint(7)) }, handler.RecordViews, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RecordViews_SkipsGuestsAndMisses", func(t *testing.T) {
+		router := gin.New()
+		router.GET("/books/:id", handler.RecordViews, func(c *gin.Context) {
+			if c.Param("id") == "99" {
+				c.Status(http.StatusNotFound)
+				return
+			}
+			c.Status(http.StatusOK)
+		})
+		router.GET("/users/books/:id", func(c *gin.Context) { c.Set("userID", uint(7)) }, handler.RecordViews, func(c *gin.Context) {
+			c.Status(http.StatusNotFound)
+		})
+
+		for _, path := range []string{"/books/1", "/books/99", "/users/books/99"} {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		}
+	})
+}
+
+func TestRecommendationHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRecommendationService(ctrl)
+	handler := api.NewRecommendationHandler(mockService)
+
+	t.Run("GetBookRecommendations_LimitTooHigh", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/1/recommendations?limit=50", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.GetBookRecommendations(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetBookRecommendations_BookNotFound", func(t *testing.T) {
+		mockService.EXPECT().GetBookRecommendations(gomock.Any(), uint(99), gomock.Any()).Return(nil, recommendations.ErrBookNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/99/recommendations", nil)
+		c.Params = gin.Params{{Key: "id", Value: "99"}}
+
+		handler.GetBookRecommendations(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/purchasing/api"
+	"bookstore-framework/internal/purchasing/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReorderHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReorderService(ctrl)
+	handler := api.NewReorderHandler(mockService)
+
+	t.Run("SaveReorderRule_NegativeReorderPoint", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/reorder-rules/SKU-1",
+			bytes.NewBufferString(`{"reorder_point":-1,"location_id":3}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "sku", Value: "SKU-1"}}
+
+		handler.SaveReorderRule(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("SaveReorderRule_UnknownSKU", func(t *testing.T) {
+		mockService.EXPECT().SaveRule(gomock.Any(), "SKU-404", dto.ReorderRuleRequest{ReorderPoint: 5, LocationID: 3}).
+			Return(nil, books.ErrBookNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/reorder-rules/SKU-404",
+			bytes.NewBufferString(`{"reorder_point":5,"location_id":3}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "sku", Value: "SKU-404"}}
+
+		handler.SaveReorderRule(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("GetLowStockAlerts_InvalidStatus", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/stock-alerts?status=snoozed", nil)
+
+		handler.GetLowStockAlerts(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetLowStockAlerts_Open", func(t *testing.T) {
+		mockService.EXPECT().GetAlerts(gomock.Any(), gomock.Any()).Return(&dto.LowStockAlertListResponse{
+			Alerts: []dto.LowStockAlertResponse{{ID: 4, SKU: "SKU-1", Status: purchasing.AlertOpen}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/stock-alerts?status=open", nil)
+
+		handler.GetLowStockAlerts(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("GetStockNotifications", func(t *testing.T) {
+		mockService.EXPECT().GetNotifications(gomock.Any(), uint(1), gomock.Any()).Return(&dto.StockNotificationListResponse{
+			Notifications: []dto.StockNotificationResponse{{ID: 2, Type: purchasing.NotificationLowStock}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/stock-alerts/notifications", nil)
+		c.Set("userID", uint(1))
+
+		handler.GetStockNotifications(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
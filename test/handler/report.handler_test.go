@@ -0,0 +1,79 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/reports"
+	"bookstore-framework/internal/reports/api"
+	"bookstore-framework/internal/reports/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	handler := api.NewReportHandler(mockService)
+
+	t.Run("GetSalesReport_InvalidDate", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/reports/sales?from=2026-10-01&to=31/10/2026", nil)
+
+		handler.GetSalesReport(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetSalesReport_UnknownTimezone", func(t *testing.T) {
+		mockService.EXPECT().GetSales(gomock.Any(), gomock.Any()).Return(nil, reports.ErrUnknownTimezone)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/reports/sales?from=2026-10-01&to=2026-10-31&timezone=Mars", nil)
+
+		handler.GetSalesReport(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GetTopBooks_CSV", func(t *testing.T) {
+		mockService.EXPECT().GetTopSellers(gomock.Any(), reports.DimensionBook, dto.TopSellersQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2026-10-01", To: "2026-10-31", Format: reports.FormatCSV},
+			Currency:         "GBP",
+		}).Return(&dto.TopSellersResponse{
+			Dimension: reports.DimensionBook,
+			Currency:  "GBP",
+			Sellers:   []dto.TopSellerResponse{{Rank: 1, ID: 1, Name: "The Hobbit", Quantity: 40, Orders: 31, Revenue: 39960}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/reports/top-books?from=2026-10-01&to=2026-10-31&currency=GBP&format=csv", nil)
+
+		handler.GetTopBooks(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="top-books-2026-10-01-2026-10-31.csv"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "rank,id,name,quantity,orders,revenue,currency\n1,1,The Hobbit,40,31,399.60,GBP\n", w.Body.String())
+	})
+
+	t.Run("GetTopAuthors_MissingCurrency", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/reports/top-authors?from=2026-10-01&to=2026-10-31", nil)
+
+		handler.GetTopAuthors(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/returns"
+	"bookstore-framework/internal/returns/api"
+	"bookstore-framework/internal/returns/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReturnService(ctrl)
+	handler := api.NewReturnHandler(mockService)
+
+	t.Run("RequestReturn", func(t *testing.T) {
+		mockService.EXPECT().RequestReturn(gomock.Any(), uint(7), dto.CreateReturnRequest{
+			OrderID: 42,
+			Reason:  "Pages missing",
+			Items:   []dto.ReturnItemRequest{{BookID: 1, Quantity: 1}},
+		}).Return(&dto.ReturnResponse{ID: 5, OrderID: 42, Status: returns.StatusRequested}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/returns",
+			bytes.NewBufferString(`{"order_id":42,"reason":"Pages missing","items":[{"book_id":1,"quantity":1}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.RequestReturn(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("RequestReturn_WindowClosed", func(t *testing.T) {
+		mockService.EXPECT().RequestReturn(gomock.Any(), uint(7), gomock.Any()).Return(nil, returns.ErrReturnWindowClosed)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/returns",
+			bytes.NewBufferString(`{"order_id":42,"reason":"Pages missing","items":[{"book_id":1,"quantity":1}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.RequestReturn(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("ReceiveReturn_InvalidDisposition", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/returns/5/receive",
+			bytes.NewBufferString(`{"items":[{"book_id":1,"disposition":"resell"}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "5"}}
+		c.Set("userID", uint(1))
+
+		handler.ReceiveReturn(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("RefundReturn_WithoutBody", func(t *testing.T) {
+		mockService.EXPECT().Refund(gomock.Any(), uint(1), uint(5), dto.RefundReturnRequest{}).
+			Return(&dto.ReturnResponse{ID: 5, Status: returns.StatusRefunded, RefundedAmount: 990}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/returns/5/refund", nil)
+		c.Params = gin.Params{{Key: "id", Value: "5"}}
+		c.Set("userID", uint(1))
+
+		handler.RefundReturn(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("ApproveReturn_NotRequested", func(t *testing.T) {
+		mockService.EXPECT().Approve(gomock.Any(), uint(1), uint(5), dto.ReviewReturnRequest{}).
+			Return(nil, fmt.Errorf("%w: received to approved", returns.ErrInvalidTransition))
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/returns/5/approve", nil)
+		c.Params = gin.Params{{Key: "id", Value: "5"}}
+		c.Set("userID", uint(1))
+
+		handler.ApproveReturn(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,122 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/reviews"
+	"bookstore-framework/internal/reviews/api"
+	"bookstore-framework/internal/reviews/api/dto"
+	"bookstore-framework/internal/users"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReviewService(ctrl)
+	handler := api.NewReviewHandler(mockService)
+
+	t.Run("CreateReview", func(t *testing.T) {
+		mockService.EXPECT().CreateReview(gomock.Any(), uint(7), uint(1), dto.ReviewRequest{Rating: 5, Title: "Loved it"}).
+			Return(&dto.ReviewResponse{ID: 3, BookID: 1, Rating: 5}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/books/1/reviews", bytes.NewBufferString(`{"rating":5,"title":"Loved it"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Set("userID", uint(7))
+
+		handler.CreateReview(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("DeleteReview_Staff", func(t *testing.T) {
+		mockService.EXPECT().DeleteReview(gomock.Any(), reviews.Actor{UserID: 1, Staff: true}, uint(3)).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/reviews/3", nil)
+		c.Params = gin.Params{{Key: "id", Value: "3"}}
+		c.Set("userID", uint(1))
+		c.Set("role", users.RoleStaff)
+
+		handler.DeleteReview(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestReviewHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReviewService(ctrl)
+	handler := api.NewReviewHandler(mockService)
+
+	t.Run("CreateReview_RatingOutOfRange", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/books/1/reviews", bytes.NewBufferString(`{"rating":6}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Set("userID", uint(7))
+
+		handler.CreateReview(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreateReview_AlreadyReviewed", func(t *testing.T) {
+		mockService.EXPECT().CreateReview(gomock.Any(), uint(7), uint(1), gomock.Any()).Return(nil, reviews.ErrDuplicateReview)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/books/1/reviews", bytes.NewBufferString(`{"rating":4}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+		c.Set("userID", uint(7))
+
+		handler.CreateReview(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("UpdateReview_NotOwner", func(t *testing.T) {
+		mockService.EXPECT().UpdateReview(gomock.Any(), reviews.Actor{UserID: 8}, uint(3), gomock.Any()).Return(nil, reviews.ErrNotReviewOwner)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/reviews/3", bytes.NewBufferString(`{"rating":1}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "3"}}
+		c.Set("userID", uint(8))
+
+		handler.UpdateReview(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("GetReviews_InvalidSort", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/books/1/reviews?sort=random", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.GetReviews(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/taxes/api"
+	"bookstore-framework/internal/taxes/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const taxRegionBody = `{"country":"FR","name":"France","prices_include_tax":true,"rates":[{"tax_class":"book","name":"TVA","rate":550}]}`
+
+func TestTaxHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaxService(ctrl)
+	handler := api.NewTaxHandler(mockService)
+
+	t.Run("CreateRegion", func(t *testing.T) {
+		mockService.EXPECT().CreateRegion(gomock.Any(), gomock.Any()).
+			Return(&dto.TaxRegionResponse{ID: 1, Country: "FR", Name: "France", PricesIncludeTax: true}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/tax-regions", bytes.NewBufferString(taxRegionBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateRegion(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("DeleteRegion", func(t *testing.T) {
+		mockService.EXPECT().DeleteRegion(gomock.Any(), uint(1)).Return(nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/tax-regions/1", nil)
+		c.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler.DeleteRegion(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestTaxHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaxService(ctrl)
+	handler := api.NewTaxHandler(mockService)
+
+	t.Run("CreateRegion_RateAboveHundredPercent", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/tax-regions",
+			bytes.NewBufferString(`{"country":"FR","name":"France","rates":[{"tax_class":"book","name":"TVA","rate":10001}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateRegion(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreateRegion_Duplicate", func(t *testing.T) {
+		mockService.EXPECT().CreateRegion(gomock.Any(), gomock.Any()).Return(nil, taxes.ErrDuplicateRegion)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/tax-regions", bytes.NewBufferString(taxRegionBody))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateRegion(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("GetRegion_NotFound", func(t *testing.T) {
+		mockService.EXPECT().GetRegion(gomock.Any(), uint(9)).Return(nil, taxes.ErrTaxRegionNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/tax-regions/9", nil)
+		c.Params = gin.Params{{Key: "id", Value: "9"}}
+
+		handler.GetRegion(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
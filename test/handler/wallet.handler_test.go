@@ -0,0 +1,100 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/internal/wallet/api"
+	"bookstore-framework/internal/wallet/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockWalletService(ctrl)
+	handler := api.NewWalletHandler(mockService)
+
+	t.Run("IssueGiftCard", func(t *testing.T) {
+		mockService.EXPECT().IssueGiftCard(gomock.Any(), uint(1), dto.IssueGiftCardRequest{Amount: 5000, Currency: "USD"}).
+			Return(&dto.GiftCardResponse{ID: 3, Code: "7KQM-X2PD-H9RT-4WLB", Last4: "4WLB", Balance: 5000}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/gift-cards",
+			bytes.NewBufferString(`{"amount":5000,"currency":"USD"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(1))
+
+		handler.IssueGiftCard(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"7KQM-X2PD-H9RT-4WLB"`)
+	})
+
+	t.Run("IssueGiftCard_InvalidAmount", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/gift-cards",
+			bytes.NewBufferString(`{"amount":0,"currency":"USD"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(1))
+
+		handler.IssueGiftCard(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CheckGiftCard_NotFound", func(t *testing.T) {
+		mockService.EXPECT().CheckGiftCard(gomock.Any(), dto.GiftCardCodeRequest{Code: "AAAA-BBBB-CCCC-DDDD"}).
+			Return(nil, wallet.ErrGiftCardNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/gift-cards/balance",
+			bytes.NewBufferString(`{"code":"AAAA-BBBB-CCCC-DDDD"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CheckGiftCard(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("RedeemGiftCard_Empty", func(t *testing.T) {
+		mockService.EXPECT().RedeemGiftCard(gomock.Any(), uint(7), gomock.Any()).Return(nil, wallet.ErrGiftCardEmpty)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wallet/redeem",
+			bytes.NewBufferString(`{"code":"7KQM-X2PD-H9RT-4WLB"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.RedeemGiftCard(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("GetWallet", func(t *testing.T) {
+		mockService.EXPECT().GetWallet(gomock.Any(), uint(7)).
+			Return(&dto.WalletResponse{Balances: []dto.BalanceResponse{{Currency: "USD", Balance: 1200}}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/wallet", nil)
+		c.Set("userID", uint(7))
+
+		handler.GetWallet(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
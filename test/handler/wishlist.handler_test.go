@@ -0,0 +1,147 @@
+package handler_test
+
+import (
+	"bookstore-framework/internal/carts"
+	cartsDto "bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/internal/wishlists"
+	"bookstore-framework/internal/wishlists/api"
+	"bookstore-framework/internal/wishlists/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWishlistHandler_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockWishlistService(ctrl)
+	handler := api.NewWishlistHandler(mockService)
+
+	t.Run("CreateWishlist", func(t *testing.T) {
+		mockService.EXPECT().CreateWishlist(gomock.Any(), uint(7), dto.WishlistRequest{Name: "Summer reading", Privacy: "link"}).
+			Return(&dto.WishlistResponse{ID: 2, Name: "Summer reading", Privacy: "link"}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wishlists", bytes.NewBufferString(`{"name":"Summer reading","privacy":"link"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.CreateWishlist(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("GetWishlist_Guest", func(t *testing.T) {
+		mockService.EXPECT().GetWishlist(gomock.Any(), nil, uint(2)).Return(&dto.WishlistResponse{ID: 2}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/wishlists/2", nil)
+		c.Params = gin.Params{{Key: "id", Value: "2"}}
+
+		handler.GetWishlist(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("MoveToCart", func(t *testing.T) {
+		mockService.EXPECT().MoveToCart(gomock.Any(), uint(7), uint(2), uint(1), dto.MoveToCartRequest{Quantity: 1}).
+			Return(&cartsDto.CartResponse{ID: 4}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wishlists/2/items/1/move-to-cart", bytes.NewBufferString(`{"quantity":1}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "2"}, {Key: "bookId", Value: "1"}}
+		c.Set("userID", uint(7))
+
+		handler.MoveToCart(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestWishlistHandler_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockWishlistService(ctrl)
+	handler := api.NewWishlistHandler(mockService)
+
+	t.Run("CreateWishlist_InvalidPrivacy", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wishlists", bytes.NewBufferString(`{"name":"Summer reading","privacy":"friends"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.CreateWishlist(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CreateWishlist_DuplicateName", func(t *testing.T) {
+		mockService.EXPECT().CreateWishlist(gomock.Any(), uint(7), gomock.Any()).Return(nil, wishlists.ErrDuplicateName)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wishlists", bytes.NewBufferString(`{"name":"Summer reading"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("userID", uint(7))
+
+		handler.CreateWishlist(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("GetSharedWishlist_NotFound", func(t *testing.T) {
+		mockService.EXPECT().GetSharedWishlist(gomock.Any(), "secret").Return(nil, wishlists.ErrWishlistNotFound)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/wishlists/shared/secret", nil)
+		c.Params = gin.Params{{Key: "token", Value: "secret"}}
+
+		handler.GetSharedWishlist(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("MoveToCart_OutOfStock", func(t *testing.T) {
+		mockService.EXPECT().MoveToCart(gomock.Any(), uint(7), uint(2), uint(1), gomock.Any()).Return(nil, carts.ErrInsufficientStock)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wishlists/2/items/1/move-to-cart", bytes.NewBufferString(`{"quantity":3}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: "2"}, {Key: "bookId", Value: "1"}}
+		c.Set("userID", uint(7))
+
+		handler.MoveToCart(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("CreateWishlist_NoUser", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/wishlists", bytes.NewBufferString(`{"name":"Summer reading"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handler.CreateWishlist(c)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/author.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/books/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthorService is a mock of AuthorService interface.
+type MockAuthorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorServiceMockRecorder
+}
+
+// MockAuthorServiceMockRecorder is the mock recorder for MockAuthorService.
+type MockAuthorServiceMockRecorder struct {
+	mock *MockAuthorService
+}
+
+// NewMockAuthorService creates a new mock instance.
+func NewMockAuthorService(ctrl *gomock.Controller) *MockAuthorService {
+	mock := &MockAuthorService{ctrl: ctrl}
+	mock.recorder = &MockAuthorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorService) EXPECT() *MockAuthorServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAuthor mocks base method.
+func (m *MockAuthorService) CreateAuthor(ctx context.Context, req dto.AuthorRequest) (*dto.AuthorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthor", ctx, req)
+	ret0, _ := ret[0].(*dto.AuthorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuthor indicates an expected call of CreateAuthor.
+func (mr *MockAuthorServiceMockRecorder) CreateAuthor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthor", reflect.TypeOf((*MockAuthorService)(nil).CreateAuthor), ctx, req)
+}
+
+// DeleteAuthor mocks base method.
+func (m *MockAuthorService) DeleteAuthor(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthor", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthor indicates an expected call of DeleteAuthor.
+func (mr *MockAuthorServiceMockRecorder) DeleteAuthor(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthor", reflect.TypeOf((*MockAuthorService)(nil).DeleteAuthor), ctx, id)
+}
+
+// GetAuthor mocks base method.
+func (m *MockAuthorService) GetAuthor(ctx context.Context, id uint) (*dto.AuthorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthor", ctx, id)
+	ret0, _ := ret[0].(*dto.AuthorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthor indicates an expected call of GetAuthor.
+func (mr *MockAuthorServiceMockRecorder) GetAuthor(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthor", reflect.TypeOf((*MockAuthorService)(nil).GetAuthor), ctx, id)
+}
+
+// GetAuthors mocks base method.
+func (m *MockAuthorService) GetAuthors(ctx context.Context, query dto.NameListQuery) (*dto.AuthorListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthors", ctx, query)
+	ret0, _ := ret[0].(*dto.AuthorListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthors indicates an expected call of GetAuthors.
+func (mr *MockAuthorServiceMockRecorder) GetAuthors(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthors", reflect.TypeOf((*MockAuthorService)(nil).GetAuthors), ctx, query)
+}
+
+// GetBibliography mocks base method.
+func (m *MockAuthorService) GetBibliography(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.BibliographyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBibliography", ctx, id, query)
+	ret0, _ := ret[0].(*dto.BibliographyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBibliography indicates an expected call of GetBibliography.
+func (mr *MockAuthorServiceMockRecorder) GetBibliography(ctx, id, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBibliography", reflect.TypeOf((*MockAuthorService)(nil).GetBibliography), ctx, id, query)
+}
+
+// UpdateAuthor mocks base method.
+func (m *MockAuthorService) UpdateAuthor(ctx context.Context, id uint, req dto.AuthorRequest) (*dto.AuthorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAuthor", ctx, id, req)
+	ret0, _ := ret[0].(*dto.AuthorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAuthor indicates an expected call of UpdateAuthor.
+func (mr *MockAuthorServiceMockRecorder) UpdateAuthor(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthor", reflect.TypeOf((*MockAuthorService)(nil).UpdateAuthor), ctx, id, req)
+}
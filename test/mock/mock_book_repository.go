@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/book.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	books "bookstore-framework/internal/books"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBookRepository is a mock of BookRepository interface.
+type MockBookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookRepositoryMockRecorder
+}
+
+// MockBookRepositoryMockRecorder is the mock recorder for MockBookRepository.
+type MockBookRepositoryMockRecorder struct {
+	mock *MockBookRepository
+}
+
+// NewMockBookRepository creates a new mock instance.
+func NewMockBookRepository(ctrl *gomock.Controller) *MockBookRepository {
+	mock := &MockBookRepository{ctrl: ctrl}
+	mock.recorder = &MockBookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookRepository) EXPECT() *MockBookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBookRepository) Create(ctx context.Context, book *books.Book) (*books.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, book)
+	ret0, _ := ret[0].(*books.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookRepositoryMockRecorder) Create(ctx, book interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookRepository)(nil).Create), ctx, book)
+}
+
+// Delete mocks base method.
+func (m *MockBookRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockBookRepository) FindAll(ctx context.Context, filter books.BookFilter) ([]books.Book, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]books.Book)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockBookRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBookRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindByID mocks base method.
+func (m *MockBookRepository) FindByID(ctx context.Context, id uint) (*books.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*books.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockBookRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBookRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByISBN mocks base method.
+func (m *MockBookRepository) FindByISBN(ctx context.Context, isbn string) (*books.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByISBN", ctx, isbn)
+	ret0, _ := ret[0].(*books.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByISBN indicates an expected call of FindByISBN.
+func (mr *MockBookRepositoryMockRecorder) FindByISBN(ctx, isbn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByISBN", reflect.TypeOf((*MockBookRepository)(nil).FindByISBN), ctx, isbn)
+}
+
+// FindBySKU mocks base method.
+func (m *MockBookRepository) FindBySKU(ctx context.Context, sku string) (*books.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySKU", ctx, sku)
+	ret0, _ := ret[0].(*books.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySKU indicates an expected call of FindBySKU.
+func (mr *MockBookRepositoryMockRecorder) FindBySKU(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySKU", reflect.TypeOf((*MockBookRepository)(nil).FindBySKU), ctx, sku)
+}
+
+// Search mocks base method.
+func (m *MockBookRepository) Search(ctx context.Context, filter books.BookSearchFilter) ([]books.BookSearchHit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]books.BookSearchHit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockBookRepositoryMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockBookRepository)(nil).Search), ctx, filter)
+}
+
+// SetCover mocks base method.
+func (m *MockBookRepository) SetCover(ctx context.Context, id uint, key string, width, height int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCover", ctx, id, key, width, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCover indicates an expected call of SetCover.
+func (mr *MockBookRepositoryMockRecorder) SetCover(ctx, id, key, width, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCover", reflect.TypeOf((*MockBookRepository)(nil).SetCover), ctx, id, key, width, height)
+}
+
+// Update mocks base method.
+func (m *MockBookRepository) Update(ctx context.Context, book *books.Book) (*books.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, book)
+	ret0, _ := ret[0].(*books.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookRepositoryMockRecorder) Update(ctx, book interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookRepository)(nil).Update), ctx, book)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/book.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/books/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBookService is a mock of BookService interface.
+type MockBookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookServiceMockRecorder
+}
+
+// MockBookServiceMockRecorder is the mock recorder for MockBookService.
+type MockBookServiceMockRecorder struct {
+	mock *MockBookService
+}
+
+// NewMockBookService creates a new mock instance.
+func NewMockBookService(ctrl *gomock.Controller) *MockBookService {
+	mock := &MockBookService{ctrl: ctrl}
+	mock.recorder = &MockBookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookService) EXPECT() *MockBookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateBook mocks base method.
+func (m *MockBookService) CreateBook(ctx context.Context, req dto.BookRequest) (*dto.BookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBook", ctx, req)
+	ret0, _ := ret[0].(*dto.BookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBook indicates an expected call of CreateBook.
+func (mr *MockBookServiceMockRecorder) CreateBook(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBook", reflect.TypeOf((*MockBookService)(nil).CreateBook), ctx, req)
+}
+
+// DeleteBook mocks base method.
+func (m *MockBookService) DeleteBook(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBook indicates an expected call of DeleteBook.
+func (mr *MockBookServiceMockRecorder) DeleteBook(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBook", reflect.TypeOf((*MockBookService)(nil).DeleteBook), ctx, id)
+}
+
+// GetBook mocks base method.
+func (m *MockBookService) GetBook(ctx context.Context, id uint, currency string) (*dto.BookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBook", ctx, id, currency)
+	ret0, _ := ret[0].(*dto.BookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBook indicates an expected call of GetBook.
+func (mr *MockBookServiceMockRecorder) GetBook(ctx, id, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBook", reflect.TypeOf((*MockBookService)(nil).GetBook), ctx, id, currency)
+}
+
+// GetBookByBarcode mocks base method.
+func (m *MockBookService) GetBookByBarcode(ctx context.Context, code string) (*dto.BookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookByBarcode", ctx, code)
+	ret0, _ := ret[0].(*dto.BookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookByBarcode indicates an expected call of GetBookByBarcode.
+func (mr *MockBookServiceMockRecorder) GetBookByBarcode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookByBarcode", reflect.TypeOf((*MockBookService)(nil).GetBookByBarcode), ctx, code)
+}
+
+// GetBooks mocks base method.
+func (m *MockBookService) GetBooks(ctx context.Context, query dto.BookListQuery) (*dto.BookListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBooks", ctx, query)
+	ret0, _ := ret[0].(*dto.BookListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBooks indicates an expected call of GetBooks.
+func (mr *MockBookServiceMockRecorder) GetBooks(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBooks", reflect.TypeOf((*MockBookService)(nil).GetBooks), ctx, query)
+}
+
+// SearchBooks mocks base method.
+func (m *MockBookService) SearchBooks(ctx context.Context, query dto.BookSearchQuery) (*dto.BookSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBooks", ctx, query)
+	ret0, _ := ret[0].(*dto.BookSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchBooks indicates an expected call of SearchBooks.
+func (mr *MockBookServiceMockRecorder) SearchBooks(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBooks", reflect.TypeOf((*MockBookService)(nil).SearchBooks), ctx, query)
+}
+
+// UpdateBook mocks base method.
+func (m *MockBookService) UpdateBook(ctx context.Context, id uint, req dto.BookRequest) (*dto.BookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBook", ctx, id, req)
+	ret0, _ := ret[0].(*dto.BookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBook indicates an expected call of UpdateBook.
+func (mr *MockBookServiceMockRecorder) UpdateBook(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBook", reflect.TypeOf((*MockBookService)(nil).UpdateBook), ctx, id, req)
+}
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/carts/cart.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	carts "bookstore-framework/internal/carts"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCartRepository is a mock of CartRepository interface.
+type MockCartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartRepositoryMockRecorder
+}
+
+// MockCartRepositoryMockRecorder is the mock recorder for MockCartRepository.
+type MockCartRepositoryMockRecorder struct {
+	mock *MockCartRepository
+}
+
+// NewMockCartRepository creates a new mock instance.
+func NewMockCartRepository(ctrl *gomock.Controller) *MockCartRepository {
+	mock := &MockCartRepository{ctrl: ctrl}
+	mock.recorder = &MockCartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCartRepository) Create(ctx context.Context, cart *carts.Cart) (*carts.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cart)
+	ret0, _ := ret[0].(*carts.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCartRepositoryMockRecorder) Create(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCartRepository)(nil).Create), ctx, cart)
+}
+
+// Delete mocks base method.
+func (m *MockCartRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCartRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCartRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockCartRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockCartRepositoryMockRecorder) DeleteExpired(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockCartRepository)(nil).DeleteExpired), ctx, now)
+}
+
+// DeleteItem mocks base method.
+func (m *MockCartRepository) DeleteItem(ctx context.Context, cartID, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, cartID, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockCartRepositoryMockRecorder) DeleteItem(ctx, cartID, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockCartRepository)(nil).DeleteItem), ctx, cartID, bookID)
+}
+
+// FindByToken mocks base method.
+func (m *MockCartRepository) FindByToken(ctx context.Context, token string) (*carts.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByToken", ctx, token)
+	ret0, _ := ret[0].(*carts.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByToken indicates an expected call of FindByToken.
+func (mr *MockCartRepositoryMockRecorder) FindByToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByToken", reflect.TypeOf((*MockCartRepository)(nil).FindByToken), ctx, token)
+}
+
+// FindByUserID mocks base method.
+func (m *MockCartRepository) FindByUserID(ctx context.Context, userID uint) (*carts.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].(*carts.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockCartRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockCartRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// Merge mocks base method.
+func (m *MockCartRepository) Merge(ctx context.Context, guestToken string, userID uint, expiresAt time.Time, maxQuantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, guestToken, userID, expiresAt, maxQuantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockCartRepositoryMockRecorder) Merge(ctx, guestToken, userID, expiresAt, maxQuantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockCartRepository)(nil).Merge), ctx, guestToken, userID, expiresAt, maxQuantity)
+}
+
+// Save mocks base method.
+func (m *MockCartRepository) Save(ctx context.Context, cart *carts.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCartRepositoryMockRecorder) Save(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCartRepository)(nil).Save), ctx, cart)
+}
+
+// SaveItem mocks base method.
+func (m *MockCartRepository) SaveItem(ctx context.Context, item *carts.CartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveItem indicates an expected call of SaveItem.
+func (mr *MockCartRepositoryMockRecorder) SaveItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItem", reflect.TypeOf((*MockCartRepository)(nil).SaveItem), ctx, item)
+}
+
+// UpdatePrices mocks base method.
+func (m *MockCartRepository) UpdatePrices(ctx context.Context, items []carts.CartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrices", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrices indicates an expected call of UpdatePrices.
+func (mr *MockCartRepositoryMockRecorder) UpdatePrices(ctx, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrices", reflect.TypeOf((*MockCartRepository)(nil).UpdatePrices), ctx, items)
+}
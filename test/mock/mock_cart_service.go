@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/carts/cart.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	carts "bookstore-framework/internal/carts"
+	dto "bookstore-framework/internal/carts/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCartService is a mock of CartService interface.
+type MockCartService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartServiceMockRecorder
+}
+
+// MockCartServiceMockRecorder is the mock recorder for MockCartService.
+type MockCartServiceMockRecorder struct {
+	mock *MockCartService
+}
+
+// NewMockCartService creates a new mock instance.
+func NewMockCartService(ctrl *gomock.Controller) *MockCartService {
+	mock := &MockCartService{ctrl: ctrl}
+	mock.recorder = &MockCartServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartService) EXPECT() *MockCartServiceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockCartService) AddItem(ctx context.Context, owner carts.Owner, currency string, req dto.AddCartItemRequest) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, owner, currency, req)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockCartServiceMockRecorder) AddItem(ctx, owner, currency, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockCartService)(nil).AddItem), ctx, owner, currency, req)
+}
+
+// ApplyCoupon mocks base method.
+func (m *MockCartService) ApplyCoupon(ctx context.Context, owner carts.Owner, req dto.ApplyCouponRequest) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyCoupon", ctx, owner, req)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyCoupon indicates an expected call of ApplyCoupon.
+func (mr *MockCartServiceMockRecorder) ApplyCoupon(ctx, owner, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyCoupon", reflect.TypeOf((*MockCartService)(nil).ApplyCoupon), ctx, owner, req)
+}
+
+// GetCart mocks base method.
+func (m *MockCartService) GetCart(ctx context.Context, owner carts.Owner, currency string) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", ctx, owner, currency)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockCartServiceMockRecorder) GetCart(ctx, owner, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockCartService)(nil).GetCart), ctx, owner, currency)
+}
+
+// MergeGuestCart mocks base method.
+func (m *MockCartService) MergeGuestCart(ctx context.Context, guestToken string, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuestCart", ctx, guestToken, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuestCart indicates an expected call of MergeGuestCart.
+func (mr *MockCartServiceMockRecorder) MergeGuestCart(ctx, guestToken, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuestCart", reflect.TypeOf((*MockCartService)(nil).MergeGuestCart), ctx, guestToken, userID)
+}
+
+// PurgeExpired mocks base method.
+func (m *MockCartService) PurgeExpired(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockCartServiceMockRecorder) PurgeExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockCartService)(nil).PurgeExpired), ctx)
+}
+
+// RemoveCoupon mocks base method.
+func (m *MockCartService) RemoveCoupon(ctx context.Context, owner carts.Owner) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCoupon", ctx, owner)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCoupon indicates an expected call of RemoveCoupon.
+func (mr *MockCartServiceMockRecorder) RemoveCoupon(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCoupon", reflect.TypeOf((*MockCartService)(nil).RemoveCoupon), ctx, owner)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartService) RemoveItem(ctx context.Context, owner carts.Owner, bookID uint) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, owner, bookID)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartServiceMockRecorder) RemoveItem(ctx, owner, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartService)(nil).RemoveItem), ctx, owner, bookID)
+}
+
+// UpdateItem mocks base method.
+func (m *MockCartService) UpdateItem(ctx context.Context, owner carts.Owner, bookID uint, req dto.UpdateCartItemRequest) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, owner, bookID, req)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockCartServiceMockRecorder) UpdateItem(ctx, owner, bookID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockCartService)(nil).UpdateItem), ctx, owner, bookID, req)
+}
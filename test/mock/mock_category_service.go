@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/category.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/books/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCategoryService is a mock of CategoryService interface.
+type MockCategoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryServiceMockRecorder
+}
+
+// MockCategoryServiceMockRecorder is the mock recorder for MockCategoryService.
+type MockCategoryServiceMockRecorder struct {
+	mock *MockCategoryService
+}
+
+// NewMockCategoryService creates a new mock instance.
+func NewMockCategoryService(ctrl *gomock.Controller) *MockCategoryService {
+	mock := &MockCategoryService{ctrl: ctrl}
+	mock.recorder = &MockCategoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryService) EXPECT() *MockCategoryServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryService) CreateCategory(ctx context.Context, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, req)
+	ret0, _ := ret[0].(*dto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryServiceMockRecorder) CreateCategory(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryService)(nil).CreateCategory), ctx, req)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryService) DeleteCategory(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryServiceMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryService)(nil).DeleteCategory), ctx, id)
+}
+
+// GetCategory mocks base method.
+func (m *MockCategoryService) GetCategory(ctx context.Context, slug string) (*dto.CategoryDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, slug)
+	ret0, _ := ret[0].(*dto.CategoryDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockCategoryServiceMockRecorder) GetCategory(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockCategoryService)(nil).GetCategory), ctx, slug)
+}
+
+// GetCategoryBooks mocks base method.
+func (m *MockCategoryService) GetCategoryBooks(ctx context.Context, slug string, query pkg.PaginationQuery) (*dto.CategoryBooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryBooks", ctx, slug, query)
+	ret0, _ := ret[0].(*dto.CategoryBooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryBooks indicates an expected call of GetCategoryBooks.
+func (mr *MockCategoryServiceMockRecorder) GetCategoryBooks(ctx, slug, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBooks", reflect.TypeOf((*MockCategoryService)(nil).GetCategoryBooks), ctx, slug, query)
+}
+
+// GetCategoryTree mocks base method.
+func (m *MockCategoryService) GetCategoryTree(ctx context.Context) ([]dto.CategoryTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx)
+	ret0, _ := ret[0].([]dto.CategoryTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockCategoryServiceMockRecorder) GetCategoryTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockCategoryService)(nil).GetCategoryTree), ctx)
+}
+
+// MoveCategory mocks base method.
+func (m *MockCategoryService) MoveCategory(ctx context.Context, id uint, req dto.MoveCategoryRequest) (*dto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCategory", ctx, id, req)
+	ret0, _ := ret[0].(*dto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCategory indicates an expected call of MoveCategory.
+func (mr *MockCategoryServiceMockRecorder) MoveCategory(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCategory", reflect.TypeOf((*MockCategoryService)(nil).MoveCategory), ctx, id, req)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryService) UpdateCategory(ctx context.Context, id uint, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, id, req)
+	ret0, _ := ret[0].(*dto.CategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryServiceMockRecorder) UpdateCategory(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryService)(nil).UpdateCategory), ctx, id, req)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/cover.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/books/api/dto"
+	blob "bookstore-framework/pkg/blob"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCoverService is a mock of CoverService interface.
+type MockCoverService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoverServiceMockRecorder
+}
+
+// MockCoverServiceMockRecorder is the mock recorder for MockCoverService.
+type MockCoverServiceMockRecorder struct {
+	mock *MockCoverService
+}
+
+// NewMockCoverService creates a new mock instance.
+func NewMockCoverService(ctrl *gomock.Controller) *MockCoverService {
+	mock := &MockCoverService{ctrl: ctrl}
+	mock.recorder = &MockCoverServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoverService) EXPECT() *MockCoverServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteCover mocks base method.
+func (m *MockCoverService) DeleteCover(ctx context.Context, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCover", ctx, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCover indicates an expected call of DeleteCover.
+func (mr *MockCoverServiceMockRecorder) DeleteCover(ctx, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCover", reflect.TypeOf((*MockCoverService)(nil).DeleteCover), ctx, bookID)
+}
+
+// GetImage mocks base method.
+func (m *MockCoverService) GetImage(ctx context.Context, path string) (*blob.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, path)
+	ret0, _ := ret[0].(*blob.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockCoverServiceMockRecorder) GetImage(ctx, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockCoverService)(nil).GetImage), ctx, path)
+}
+
+// UploadCover mocks base method.
+func (m *MockCoverService) UploadCover(ctx context.Context, bookID uint, data []byte) (*dto.CoverResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCover", ctx, bookID, data)
+	ret0, _ := ret[0].(*dto.CoverResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCover indicates an expected call of UploadCover.
+func (mr *MockCoverServiceMockRecorder) UploadCover(ctx, bookID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCover", reflect.TypeOf((*MockCoverService)(nil).UploadCover), ctx, bookID, data)
+}
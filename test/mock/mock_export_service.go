@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/exports/export.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	exports "bookstore-framework/internal/exports"
+	dto "bookstore-framework/internal/exports/api/dto"
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExportService is a mock of ExportService interface.
+type MockExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceMockRecorder
+}
+
+// MockExportServiceMockRecorder is the mock recorder for MockExportService.
+type MockExportServiceMockRecorder struct {
+	mock *MockExportService
+}
+
+// NewMockExportService creates a new mock instance.
+func NewMockExportService(ctrl *gomock.Controller) *MockExportService {
+	mock := &MockExportService{ctrl: ctrl}
+	mock.recorder = &MockExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportService) EXPECT() *MockExportServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportService) Export(ctx context.Context, format string, filter exports.ExportFilter, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, format, filter, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportServiceMockRecorder) Export(ctx, format, filter, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportService)(nil).Export), ctx, format, filter, w)
+}
+
+// ResolveFilter mocks base method.
+func (m *MockExportService) ResolveFilter(ctx context.Context, query dto.ExportQuery) (*exports.ExportFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFilter", ctx, query)
+	ret0, _ := ret[0].(*exports.ExportFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFilter indicates an expected call of ResolveFilter.
+func (mr *MockExportServiceMockRecorder) ResolveFilter(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFilter", reflect.TypeOf((*MockExportService)(nil).ResolveFilter), ctx, query)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/imports/import.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	imports "bookstore-framework/internal/imports"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImportRepository is a mock of ImportRepository interface.
+type MockImportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportRepositoryMockRecorder
+}
+
+// MockImportRepositoryMockRecorder is the mock recorder for MockImportRepository.
+type MockImportRepositoryMockRecorder struct {
+	mock *MockImportRepository
+}
+
+// NewMockImportRepository creates a new mock instance.
+func NewMockImportRepository(ctrl *gomock.Controller) *MockImportRepository {
+	mock := &MockImportRepository{ctrl: ctrl}
+	mock.recorder = &MockImportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportRepository) EXPECT() *MockImportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddErrors mocks base method.
+func (m *MockImportRepository) AddErrors(ctx context.Context, errors []imports.ImportError) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddErrors", ctx, errors)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddErrors indicates an expected call of AddErrors.
+func (mr *MockImportRepositoryMockRecorder) AddErrors(ctx, errors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddErrors", reflect.TypeOf((*MockImportRepository)(nil).AddErrors), ctx, errors)
+}
+
+// CreateJob mocks base method.
+func (m *MockImportRepository) CreateJob(ctx context.Context, job *imports.ImportJob) (*imports.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, job)
+	ret0, _ := ret[0].(*imports.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockImportRepositoryMockRecorder) CreateJob(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockImportRepository)(nil).CreateJob), ctx, job)
+}
+
+// FindErrors mocks base method.
+func (m *MockImportRepository) FindErrors(ctx context.Context, jobID uint, offset, limit int) ([]imports.ImportError, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindErrors", ctx, jobID, offset, limit)
+	ret0, _ := ret[0].([]imports.ImportError)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindErrors indicates an expected call of FindErrors.
+func (mr *MockImportRepositoryMockRecorder) FindErrors(ctx, jobID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindErrors", reflect.TypeOf((*MockImportRepository)(nil).FindErrors), ctx, jobID, offset, limit)
+}
+
+// FindJobByID mocks base method.
+func (m *MockImportRepository) FindJobByID(ctx context.Context, id uint) (*imports.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindJobByID", ctx, id)
+	ret0, _ := ret[0].(*imports.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindJobByID indicates an expected call of FindJobByID.
+func (mr *MockImportRepositoryMockRecorder) FindJobByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindJobByID", reflect.TypeOf((*MockImportRepository)(nil).FindJobByID), ctx, id)
+}
+
+// UpdateJob mocks base method.
+func (m *MockImportRepository) UpdateJob(ctx context.Context, job *imports.ImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob.
+func (mr *MockImportRepositoryMockRecorder) UpdateJob(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockImportRepository)(nil).UpdateJob), ctx, job)
+}
+
+// UpsertBatch mocks base method.
+func (m *MockImportRepository) UpsertBatch(ctx context.Context, records []imports.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBatch", ctx, records)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBatch indicates an expected call of UpsertBatch.
+func (mr *MockImportRepositoryMockRecorder) UpsertBatch(ctx, records interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBatch", reflect.TypeOf((*MockImportRepository)(nil).UpsertBatch), ctx, records)
+}
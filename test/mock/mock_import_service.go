@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/imports/import.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/imports/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImportService is a mock of ImportService interface.
+type MockImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceMockRecorder
+}
+
+// MockImportServiceMockRecorder is the mock recorder for MockImportService.
+type MockImportServiceMockRecorder struct {
+	mock *MockImportService
+}
+
+// NewMockImportService creates a new mock instance.
+func NewMockImportService(ctrl *gomock.Controller) *MockImportService {
+	mock := &MockImportService{ctrl: ctrl}
+	mock.recorder = &MockImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportService) EXPECT() *MockImportServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateJob mocks base method.
+func (m *MockImportService) CreateJob(ctx context.Context, actorID uint, format, filename string, file io.Reader) (*dto.ImportJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, actorID, format, filename, file)
+	ret0, _ := ret[0].(*dto.ImportJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockImportServiceMockRecorder) CreateJob(ctx, actorID, format, filename, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockImportService)(nil).CreateJob), ctx, actorID, format, filename, file)
+}
+
+// GetJob mocks base method.
+func (m *MockImportService) GetJob(ctx context.Context, id uint) (*dto.ImportJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, id)
+	ret0, _ := ret[0].(*dto.ImportJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockImportServiceMockRecorder) GetJob(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockImportService)(nil).GetJob), ctx, id)
+}
+
+// GetJobErrors mocks base method.
+func (m *MockImportService) GetJobErrors(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.ImportErrorListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobErrors", ctx, id, query)
+	ret0, _ := ret[0].(*dto.ImportErrorListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobErrors indicates an expected call of GetJobErrors.
+func (mr *MockImportServiceMockRecorder) GetJobErrors(ctx, id, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobErrors", reflect.TypeOf((*MockImportService)(nil).GetJobErrors), ctx, id, query)
+}
+
+// Import mocks base method.
+func (m *MockImportService) Import(ctx context.Context, actorID uint, format, filename string, file io.Reader) (*dto.ImportJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, actorID, format, filename, file)
+	ret0, _ := ret[0].(*dto.ImportJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockImportServiceMockRecorder) Import(ctx, actorID, format, filename, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockImportService)(nil).Import), ctx, actorID, format, filename, file)
+}
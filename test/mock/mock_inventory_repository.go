@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/inventory/inventory.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	inventory "bookstore-framework/internal/inventory"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInventoryRepository is a mock of InventoryRepository interface.
+type MockInventoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryRepositoryMockRecorder
+}
+
+// MockInventoryRepositoryMockRecorder is the mock recorder for MockInventoryRepository.
+type MockInventoryRepositoryMockRecorder struct {
+	mock *MockInventoryRepository
+}
+
+// NewMockInventoryRepository creates a new mock instance.
+func NewMockInventoryRepository(ctrl *gomock.Controller) *MockInventoryRepository {
+	mock := &MockInventoryRepository{ctrl: ctrl}
+	mock.recorder = &MockInventoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryRepository) EXPECT() *MockInventoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Allocate mocks base method.
+func (m *MockInventoryRepository) Allocate(ctx context.Context, sku string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allocate", ctx, sku)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allocate indicates an expected call of Allocate.
+func (mr *MockInventoryRepositoryMockRecorder) Allocate(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allocate", reflect.TypeOf((*MockInventoryRepository)(nil).Allocate), ctx, sku)
+}
+
+// AvailableBySKU mocks base method.
+func (m *MockInventoryRepository) AvailableBySKU(ctx context.Context, skus []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableBySKU", ctx, skus)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableBySKU indicates an expected call of AvailableBySKU.
+func (mr *MockInventoryRepositoryMockRecorder) AvailableBySKU(ctx, skus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableBySKU", reflect.TypeOf((*MockInventoryRepository)(nil).AvailableBySKU), ctx, skus)
+}
+
+// CommitReservations mocks base method.
+func (m *MockInventoryRepository) CommitReservations(ctx context.Context, reference string, actorID *uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitReservations", ctx, reference, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitReservations indicates an expected call of CommitReservations.
+func (mr *MockInventoryRepositoryMockRecorder) CommitReservations(ctx, reference, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitReservations", reflect.TypeOf((*MockInventoryRepository)(nil).CommitReservations), ctx, reference, actorID)
+}
+
+// CreateLocation mocks base method.
+func (m *MockInventoryRepository) CreateLocation(ctx context.Context, location *inventory.Location) (*inventory.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", ctx, location)
+	ret0, _ := ret[0].(*inventory.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockInventoryRepositoryMockRecorder) CreateLocation(ctx, location interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockInventoryRepository)(nil).CreateLocation), ctx, location)
+}
+
+// FindAllocatableSKUs mocks base method.
+func (m *MockInventoryRepository) FindAllocatableSKUs(ctx context.Context, now time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllocatableSKUs", ctx, now)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllocatableSKUs indicates an expected call of FindAllocatableSKUs.
+func (mr *MockInventoryRepositoryMockRecorder) FindAllocatableSKUs(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllocatableSKUs", reflect.TypeOf((*MockInventoryRepository)(nil).FindAllocatableSKUs), ctx, now)
+}
+
+// FindLocationByID mocks base method.
+func (m *MockInventoryRepository) FindLocationByID(ctx context.Context, id uint) (*inventory.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLocationByID", ctx, id)
+	ret0, _ := ret[0].(*inventory.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLocationByID indicates an expected call of FindLocationByID.
+func (mr *MockInventoryRepositoryMockRecorder) FindLocationByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLocationByID", reflect.TypeOf((*MockInventoryRepository)(nil).FindLocationByID), ctx, id)
+}
+
+// FindLocations mocks base method.
+func (m *MockInventoryRepository) FindLocations(ctx context.Context) ([]inventory.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLocations", ctx)
+	ret0, _ := ret[0].([]inventory.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLocations indicates an expected call of FindLocations.
+func (mr *MockInventoryRepositoryMockRecorder) FindLocations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLocations", reflect.TypeOf((*MockInventoryRepository)(nil).FindLocations), ctx)
+}
+
+// FindMovements mocks base method.
+func (m *MockInventoryRepository) FindMovements(ctx context.Context, filter inventory.MovementFilter) ([]inventory.StockMovement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMovements", ctx, filter)
+	ret0, _ := ret[0].([]inventory.StockMovement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindMovements indicates an expected call of FindMovements.
+func (mr *MockInventoryRepositoryMockRecorder) FindMovements(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMovements", reflect.TypeOf((*MockInventoryRepository)(nil).FindMovements), ctx, filter)
+}
+
+// FindStockLevels mocks base method.
+func (m *MockInventoryRepository) FindStockLevels(ctx context.Context, sku string) ([]inventory.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStockLevels", ctx, sku)
+	ret0, _ := ret[0].([]inventory.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStockLevels indicates an expected call of FindStockLevels.
+func (mr *MockInventoryRepositoryMockRecorder) FindStockLevels(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStockLevels", reflect.TypeOf((*MockInventoryRepository)(nil).FindStockLevels), ctx, sku)
+}
+
+// Recompute mocks base method.
+func (m *MockInventoryRepository) Recompute(ctx context.Context, sku string) ([]inventory.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recompute", ctx, sku)
+	ret0, _ := ret[0].([]inventory.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recompute indicates an expected call of Recompute.
+func (mr *MockInventoryRepositoryMockRecorder) Recompute(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recompute", reflect.TypeOf((*MockInventoryRepository)(nil).Recompute), ctx, sku)
+}
+
+// RecordMovements mocks base method.
+func (m *MockInventoryRepository) RecordMovements(ctx context.Context, movements []inventory.StockMovement) ([]inventory.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMovements", ctx, movements)
+	ret0, _ := ret[0].([]inventory.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordMovements indicates an expected call of RecordMovements.
+func (mr *MockInventoryRepositoryMockRecorder) RecordMovements(ctx, movements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMovements", reflect.TypeOf((*MockInventoryRepository)(nil).RecordMovements), ctx, movements)
+}
+
+// ReleaseReservations mocks base method.
+func (m *MockInventoryRepository) ReleaseReservations(ctx context.Context, reference string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseReservations", ctx, reference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseReservations indicates an expected call of ReleaseReservations.
+func (mr *MockInventoryRepositoryMockRecorder) ReleaseReservations(ctx, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseReservations", reflect.TypeOf((*MockInventoryRepository)(nil).ReleaseReservations), ctx, reference)
+}
+
+// Reserve mocks base method.
+func (m *MockInventoryRepository) Reserve(ctx context.Context, reservations []inventory.StockReservation) ([]inventory.StockReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, reservations)
+	ret0, _ := ret[0].([]inventory.StockReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockInventoryRepositoryMockRecorder) Reserve(ctx, reservations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockInventoryRepository)(nil).Reserve), ctx, reservations)
+}
+
+// SoldBySKU mocks base method.
+func (m *MockInventoryRepository) SoldBySKU(ctx context.Context, skus []string, since time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoldBySKU", ctx, skus, since)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoldBySKU indicates an expected call of SoldBySKU.
+func (mr *MockInventoryRepositoryMockRecorder) SoldBySKU(ctx, skus, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoldBySKU", reflect.TypeOf((*MockInventoryRepository)(nil).SoldBySKU), ctx, skus, since)
+}
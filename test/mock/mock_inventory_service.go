@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/inventory/inventory.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/inventory/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInventoryService is a mock of InventoryService interface.
+type MockInventoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceMockRecorder
+}
+
+// MockInventoryServiceMockRecorder is the mock recorder for MockInventoryService.
+type MockInventoryServiceMockRecorder struct {
+	mock *MockInventoryService
+}
+
+// NewMockInventoryService creates a new mock instance.
+func NewMockInventoryService(ctrl *gomock.Controller) *MockInventoryService {
+	mock := &MockInventoryService{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryService) EXPECT() *MockInventoryServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLocation mocks base method.
+func (m *MockInventoryService) CreateLocation(ctx context.Context, req dto.LocationRequest) (*dto.LocationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", ctx, req)
+	ret0, _ := ret[0].(*dto.LocationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockInventoryServiceMockRecorder) CreateLocation(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockInventoryService)(nil).CreateLocation), ctx, req)
+}
+
+// GetLocations mocks base method.
+func (m *MockInventoryService) GetLocations(ctx context.Context) ([]dto.LocationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocations", ctx)
+	ret0, _ := ret[0].([]dto.LocationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocations indicates an expected call of GetLocations.
+func (mr *MockInventoryServiceMockRecorder) GetLocations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocations", reflect.TypeOf((*MockInventoryService)(nil).GetLocations), ctx)
+}
+
+// GetMovements mocks base method.
+func (m *MockInventoryService) GetMovements(ctx context.Context, query dto.MovementListQuery) (*dto.MovementListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovements", ctx, query)
+	ret0, _ := ret[0].(*dto.MovementListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockInventoryServiceMockRecorder) GetMovements(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockInventoryService)(nil).GetMovements), ctx, query)
+}
+
+// GetStock mocks base method.
+func (m *MockInventoryService) GetStock(ctx context.Context, sku string) (*dto.StockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStock", ctx, sku)
+	ret0, _ := ret[0].(*dto.StockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStock indicates an expected call of GetStock.
+func (mr *MockInventoryServiceMockRecorder) GetStock(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStock", reflect.TypeOf((*MockInventoryService)(nil).GetStock), ctx, sku)
+}
+
+// RecomputeStock mocks base method.
+func (m *MockInventoryService) RecomputeStock(ctx context.Context, sku string) (*dto.StockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeStock", ctx, sku)
+	ret0, _ := ret[0].(*dto.StockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeStock indicates an expected call of RecomputeStock.
+func (mr *MockInventoryServiceMockRecorder) RecomputeStock(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeStock", reflect.TypeOf((*MockInventoryService)(nil).RecomputeStock), ctx, sku)
+}
+
+// RecordMovement mocks base method.
+func (m *MockInventoryService) RecordMovement(ctx context.Context, actorID uint, req dto.MovementRequest) (*dto.MovementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMovement", ctx, actorID, req)
+	ret0, _ := ret[0].(*dto.MovementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordMovement indicates an expected call of RecordMovement.
+func (mr *MockInventoryServiceMockRecorder) RecordMovement(ctx, actorID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMovement", reflect.TypeOf((*MockInventoryService)(nil).RecordMovement), ctx, actorID, req)
+}
+
+// Transfer mocks base method.
+func (m *MockInventoryService) Transfer(ctx context.Context, actorID uint, req dto.TransferRequest) ([]dto.MovementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, actorID, req)
+	ret0, _ := ret[0].([]dto.MovementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockInventoryServiceMockRecorder) Transfer(ctx, actorID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockInventoryService)(nil).Transfer), ctx, actorID, req)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/loyalty/loyalty.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	loyalty "bookstore-framework/internal/loyalty"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoyaltyRepository is a mock of LoyaltyRepository interface.
+type MockLoyaltyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyRepositoryMockRecorder
+}
+
+// MockLoyaltyRepositoryMockRecorder is the mock recorder for MockLoyaltyRepository.
+type MockLoyaltyRepositoryMockRecorder struct {
+	mock *MockLoyaltyRepository
+}
+
+// NewMockLoyaltyRepository creates a new mock instance.
+func NewMockLoyaltyRepository(ctrl *gomock.Controller) *MockLoyaltyRepository {
+	mock := &MockLoyaltyRepository{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyRepository) EXPECT() *MockLoyaltyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Expire mocks base method.
+func (m *MockLoyaltyRepository) Expire(ctx context.Context, now time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, now, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockLoyaltyRepositoryMockRecorder) Expire(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockLoyaltyRepository)(nil).Expire), ctx, now, limit)
+}
+
+// FindAccount mocks base method.
+func (m *MockLoyaltyRepository) FindAccount(ctx context.Context, userID uint) (*loyalty.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccount", ctx, userID)
+	ret0, _ := ret[0].(*loyalty.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccount indicates an expected call of FindAccount.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccount", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindAccount), ctx, userID)
+}
+
+// FindEntries mocks base method.
+func (m *MockLoyaltyRepository) FindEntries(ctx context.Context, userID uint, offset, limit int) ([]loyalty.Entry, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntries", ctx, userID, offset, limit)
+	ret0, _ := ret[0].([]loyalty.Entry)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindEntries indicates an expected call of FindEntries.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindEntries(ctx, userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntries", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindEntries), ctx, userID, offset, limit)
+}
+
+// FindEntry mocks base method.
+func (m *MockLoyaltyRepository) FindEntry(ctx context.Context, userID uint, entryType, reference string) (*loyalty.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntry", ctx, userID, entryType, reference)
+	ret0, _ := ret[0].(*loyalty.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntry indicates an expected call of FindEntry.
+func (mr *MockLoyaltyRepositoryMockRecorder) FindEntry(ctx, userID, entryType, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntry", reflect.TypeOf((*MockLoyaltyRepository)(nil).FindEntry), ctx, userID, entryType, reference)
+}
+
+// Record mocks base method.
+func (m *MockLoyaltyRepository) Record(ctx context.Context, entry *loyalty.Entry, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, entry, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockLoyaltyRepositoryMockRecorder) Record(ctx, entry, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockLoyaltyRepository)(nil).Record), ctx, entry, now)
+}
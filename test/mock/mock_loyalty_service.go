@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/loyalty/loyalty.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/loyalty/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoyaltyService is a mock of LoyaltyService interface.
+type MockLoyaltyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyServiceMockRecorder
+}
+
+// MockLoyaltyServiceMockRecorder is the mock recorder for MockLoyaltyService.
+type MockLoyaltyServiceMockRecorder struct {
+	mock *MockLoyaltyService
+}
+
+// NewMockLoyaltyService creates a new mock instance.
+func NewMockLoyaltyService(ctrl *gomock.Controller) *MockLoyaltyService {
+	mock := &MockLoyaltyService{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyService) EXPECT() *MockLoyaltyServiceMockRecorder {
+	return m.recorder
+}
+
+// AwardPurchase mocks base method.
+func (m *MockLoyaltyService) AwardPurchase(ctx context.Context, userID, orderID uint, total int64, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwardPurchase", ctx, userID, orderID, total, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AwardPurchase indicates an expected call of AwardPurchase.
+func (mr *MockLoyaltyServiceMockRecorder) AwardPurchase(ctx, userID, orderID, total, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwardPurchase", reflect.TypeOf((*MockLoyaltyService)(nil).AwardPurchase), ctx, userID, orderID, total, currency)
+}
+
+// AwardReview mocks base method.
+func (m *MockLoyaltyService) AwardReview(ctx context.Context, userID, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwardReview", ctx, userID, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AwardReview indicates an expected call of AwardReview.
+func (mr *MockLoyaltyServiceMockRecorder) AwardReview(ctx, userID, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwardReview", reflect.TypeOf((*MockLoyaltyService)(nil).AwardReview), ctx, userID, bookID)
+}
+
+// ExpirePoints mocks base method.
+func (m *MockLoyaltyService) ExpirePoints(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePoints", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpirePoints indicates an expected call of ExpirePoints.
+func (mr *MockLoyaltyServiceMockRecorder) ExpirePoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePoints", reflect.TypeOf((*MockLoyaltyService)(nil).ExpirePoints), ctx)
+}
+
+// GetAccount mocks base method.
+func (m *MockLoyaltyService) GetAccount(ctx context.Context, userID uint) (*dto.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, userID)
+	ret0, _ := ret[0].(*dto.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockLoyaltyServiceMockRecorder) GetAccount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockLoyaltyService)(nil).GetAccount), ctx, userID)
+}
+
+// GetEntries mocks base method.
+func (m *MockLoyaltyService) GetEntries(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.EntryListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", ctx, userID, query)
+	ret0, _ := ret[0].(*dto.EntryListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockLoyaltyServiceMockRecorder) GetEntries(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockLoyaltyService)(nil).GetEntries), ctx, userID, query)
+}
+
+// Redeem mocks base method.
+func (m *MockLoyaltyService) Redeem(ctx context.Context, userID, orderID uint, points int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, userID, orderID, points)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockLoyaltyServiceMockRecorder) Redeem(ctx, userID, orderID, points interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockLoyaltyService)(nil).Redeem), ctx, userID, orderID, points)
+}
+
+// ReverseOrder mocks base method.
+func (m *MockLoyaltyService) ReverseOrder(ctx context.Context, userID, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseOrder", ctx, userID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReverseOrder indicates an expected call of ReverseOrder.
+func (mr *MockLoyaltyServiceMockRecorder) ReverseOrder(ctx, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseOrder", reflect.TypeOf((*MockLoyaltyService)(nil).ReverseOrder), ctx, userID, orderID)
+}
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/orders/order.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	orders "bookstore-framework/internal/orders"
+	dto "bookstore-framework/internal/orders/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderService is a mock of OrderService interface.
+type MockOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderServiceMockRecorder
+}
+
+// MockOrderServiceMockRecorder is the mock recorder for MockOrderService.
+type MockOrderServiceMockRecorder struct {
+	mock *MockOrderService
+}
+
+// NewMockOrderService creates a new mock instance.
+func NewMockOrderService(ctrl *gomock.Controller) *MockOrderService {
+	mock := &MockOrderService{ctrl: ctrl}
+	mock.recorder = &MockOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// AllocateBackorders mocks base method.
+func (m *MockOrderService) AllocateBackorders(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateBackorders", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllocateBackorders indicates an expected call of AllocateBackorders.
+func (mr *MockOrderServiceMockRecorder) AllocateBackorders(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateBackorders", reflect.TypeOf((*MockOrderService)(nil).AllocateBackorders), ctx)
+}
+
+// Cancel mocks base method.
+func (m *MockOrderService) Cancel(ctx context.Context, actor orders.Actor, id uint, req dto.CancelOrderRequest) (*dto.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, actor, id, req)
+	ret0, _ := ret[0].(*dto.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderServiceMockRecorder) Cancel(ctx, actor, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderService)(nil).Cancel), ctx, actor, id, req)
+}
+
+// Checkout mocks base method.
+func (m *MockOrderService) Checkout(ctx context.Context, userID uint, req dto.CheckoutRequest) (*dto.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockOrderServiceMockRecorder) Checkout(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockOrderService)(nil).Checkout), ctx, userID, req)
+}
+
+// GetNotifications mocks base method.
+func (m *MockOrderService) GetNotifications(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.OrderNotificationListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, query)
+	ret0, _ := ret[0].(*dto.OrderNotificationListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockOrderServiceMockRecorder) GetNotifications(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockOrderService)(nil).GetNotifications), ctx, userID, query)
+}
+
+// GetOrder mocks base method.
+func (m *MockOrderService) GetOrder(ctx context.Context, actor orders.Actor, id uint) (*dto.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, actor, id)
+	ret0, _ := ret[0].(*dto.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockOrderServiceMockRecorder) GetOrder(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockOrderService)(nil).GetOrder), ctx, actor, id)
+}
+
+// GetOrders mocks base method.
+func (m *MockOrderService) GetOrders(ctx context.Context, actor orders.Actor, query dto.OrderListQuery) (*dto.OrderListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", ctx, actor, query)
+	ret0, _ := ret[0].(*dto.OrderListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders.
+func (mr *MockOrderServiceMockRecorder) GetOrders(ctx, actor, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderService)(nil).GetOrders), ctx, actor, query)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockOrderService) MarkNotificationsRead(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockOrderServiceMockRecorder) MarkNotificationsRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockOrderService)(nil).MarkNotificationsRead), ctx, userID)
+}
+
+// Transition mocks base method.
+func (m *MockOrderService) Transition(ctx context.Context, actorID *uint, id uint, req dto.TransitionRequest) (*dto.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockOrderServiceMockRecorder) Transition(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockOrderService)(nil).Transition), ctx, actorID, id, req)
+}
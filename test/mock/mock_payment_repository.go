@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payments/payment.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	payments "bookstore-framework/internal/payments"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentRepository is a mock of PaymentRepository interface.
+type MockPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryMockRecorder
+}
+
+// MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
+type MockPaymentRepositoryMockRecorder struct {
+	mock *MockPaymentRepository
+}
+
+// NewMockPaymentRepository creates a new mock instance.
+func NewMockPaymentRepository(ctrl *gomock.Controller) *MockPaymentRepository {
+	mock := &MockPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddRefund mocks base method.
+func (m *MockPaymentRepository) AddRefund(ctx context.Context, refund *payments.PaymentRefund) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRefund", ctx, refund)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRefund indicates an expected call of AddRefund.
+func (mr *MockPaymentRepositoryMockRecorder) AddRefund(ctx, refund interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRefund", reflect.TypeOf((*MockPaymentRepository)(nil).AddRefund), ctx, refund)
+}
+
+// Create mocks base method.
+func (m *MockPaymentRepository) Create(ctx context.Context, payment *payments.Payment) (*payments.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, payment)
+	ret0, _ := ret[0].(*payments.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentRepositoryMockRecorder) Create(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentRepository)(nil).Create), ctx, payment)
+}
+
+// FindByID mocks base method.
+func (m *MockPaymentRepository) FindByID(ctx context.Context, id uint) (*payments.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*payments.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPaymentRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIntentIDForUpdate mocks base method.
+func (m *MockPaymentRepository) FindByIntentIDForUpdate(ctx context.Context, provider, intentID string) (*payments.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIntentIDForUpdate", ctx, provider, intentID)
+	ret0, _ := ret[0].(*payments.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIntentIDForUpdate indicates an expected call of FindByIntentIDForUpdate.
+func (mr *MockPaymentRepositoryMockRecorder) FindByIntentIDForUpdate(ctx, provider, intentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIntentIDForUpdate", reflect.TypeOf((*MockPaymentRepository)(nil).FindByIntentIDForUpdate), ctx, provider, intentID)
+}
+
+// FindByOrderID mocks base method.
+func (m *MockPaymentRepository) FindByOrderID(ctx context.Context, orderID uint) ([]payments.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]payments.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrderID indicates an expected call of FindByOrderID.
+func (mr *MockPaymentRepositoryMockRecorder) FindByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).FindByOrderID), ctx, orderID)
+}
+
+// FindStale mocks base method.
+func (m *MockPaymentRepository) FindStale(ctx context.Context, before time.Time, limit int) ([]payments.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStale", ctx, before, limit)
+	ret0, _ := ret[0].([]payments.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStale indicates an expected call of FindStale.
+func (mr *MockPaymentRepositoryMockRecorder) FindStale(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStale", reflect.TypeOf((*MockPaymentRepository)(nil).FindStale), ctx, before, limit)
+}
+
+// RecordEvent mocks base method.
+func (m *MockPaymentRepository) RecordEvent(ctx context.Context, event *payments.PaymentEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEvent", ctx, event)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordEvent indicates an expected call of RecordEvent.
+func (mr *MockPaymentRepositoryMockRecorder) RecordEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEvent", reflect.TypeOf((*MockPaymentRepository)(nil).RecordEvent), ctx, event)
+}
+
+// Update mocks base method.
+func (m *MockPaymentRepository) Update(ctx context.Context, payment *payments.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPaymentRepositoryMockRecorder) Update(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPaymentRepository)(nil).Update), ctx, payment)
+}
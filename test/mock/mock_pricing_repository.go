@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pricing/pricing.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	pricing "bookstore-framework/internal/pricing"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPricingRepository is a mock of PricingRepository interface.
+type MockPricingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingRepositoryMockRecorder
+}
+
+// MockPricingRepositoryMockRecorder is the mock recorder for MockPricingRepository.
+type MockPricingRepositoryMockRecorder struct {
+	mock *MockPricingRepository
+}
+
+// NewMockPricingRepository creates a new mock instance.
+func NewMockPricingRepository(ctrl *gomock.Controller) *MockPricingRepository {
+	mock := &MockPricingRepository{ctrl: ctrl}
+	mock.recorder = &MockPricingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricingRepository) EXPECT() *MockPricingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeletePrice mocks base method.
+func (m *MockPricingRepository) DeletePrice(ctx context.Context, currency string, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrice", ctx, currency, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrice indicates an expected call of DeletePrice.
+func (mr *MockPricingRepositoryMockRecorder) DeletePrice(ctx, currency, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrice", reflect.TypeOf((*MockPricingRepository)(nil).DeletePrice), ctx, currency, bookID)
+}
+
+// DeleteRate mocks base method.
+func (m *MockPricingRepository) DeleteRate(ctx context.Context, base, quote string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, base, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate.
+func (mr *MockPricingRepositoryMockRecorder) DeleteRate(ctx, base, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockPricingRepository)(nil).DeleteRate), ctx, base, quote)
+}
+
+// FindAllRates mocks base method.
+func (m *MockPricingRepository) FindAllRates(ctx context.Context) ([]pricing.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllRates", ctx)
+	ret0, _ := ret[0].([]pricing.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllRates indicates an expected call of FindAllRates.
+func (mr *MockPricingRepositoryMockRecorder) FindAllRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllRates", reflect.TypeOf((*MockPricingRepository)(nil).FindAllRates), ctx)
+}
+
+// FindPriceList mocks base method.
+func (m *MockPricingRepository) FindPriceList(ctx context.Context, filter pricing.PriceListFilter) ([]pricing.BookPrice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPriceList", ctx, filter)
+	ret0, _ := ret[0].([]pricing.BookPrice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPriceList indicates an expected call of FindPriceList.
+func (mr *MockPricingRepositoryMockRecorder) FindPriceList(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPriceList", reflect.TypeOf((*MockPricingRepository)(nil).FindPriceList), ctx, filter)
+}
+
+// FindPrices mocks base method.
+func (m *MockPricingRepository) FindPrices(ctx context.Context, currency string, bookIDs []uint) ([]pricing.BookPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrices", ctx, currency, bookIDs)
+	ret0, _ := ret[0].([]pricing.BookPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrices indicates an expected call of FindPrices.
+func (mr *MockPricingRepositoryMockRecorder) FindPrices(ctx, currency, bookIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrices", reflect.TypeOf((*MockPricingRepository)(nil).FindPrices), ctx, currency, bookIDs)
+}
+
+// FindRates mocks base method.
+func (m *MockPricingRepository) FindRates(ctx context.Context, currency string, others []string) ([]pricing.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRates", ctx, currency, others)
+	ret0, _ := ret[0].([]pricing.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRates indicates an expected call of FindRates.
+func (mr *MockPricingRepositoryMockRecorder) FindRates(ctx, currency, others interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRates", reflect.TypeOf((*MockPricingRepository)(nil).FindRates), ctx, currency, others)
+}
+
+// MissingBooks mocks base method.
+func (m *MockPricingRepository) MissingBooks(ctx context.Context, bookIDs []uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MissingBooks", ctx, bookIDs)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MissingBooks indicates an expected call of MissingBooks.
+func (mr *MockPricingRepositoryMockRecorder) MissingBooks(ctx, bookIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MissingBooks", reflect.TypeOf((*MockPricingRepository)(nil).MissingBooks), ctx, bookIDs)
+}
+
+// SavePrices mocks base method.
+func (m *MockPricingRepository) SavePrices(ctx context.Context, prices []pricing.BookPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrices", ctx, prices)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrices indicates an expected call of SavePrices.
+func (mr *MockPricingRepositoryMockRecorder) SavePrices(ctx, prices interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrices", reflect.TypeOf((*MockPricingRepository)(nil).SavePrices), ctx, prices)
+}
+
+// SaveRate mocks base method.
+func (m *MockPricingRepository) SaveRate(ctx context.Context, rate *pricing.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRate indicates an expected call of SaveRate.
+func (mr *MockPricingRepositoryMockRecorder) SaveRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRate", reflect.TypeOf((*MockPricingRepository)(nil).SaveRate), ctx, rate)
+}
@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pricing/pricing.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	pricing "bookstore-framework/internal/pricing"
+	dto "bookstore-framework/internal/pricing/api/dto"
+	pkg "bookstore-framework/pkg"
+	money "bookstore-framework/pkg/money"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPricingService is a mock of PricingService interface.
+type MockPricingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingServiceMockRecorder
+}
+
+// MockPricingServiceMockRecorder is the mock recorder for MockPricingService.
+type MockPricingServiceMockRecorder struct {
+	mock *MockPricingService
+}
+
+// NewMockPricingService creates a new mock instance.
+func NewMockPricingService(ctrl *gomock.Controller) *MockPricingService {
+	mock := &MockPricingService{ctrl: ctrl}
+	mock.recorder = &MockPricingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricingService) EXPECT() *MockPricingServiceMockRecorder {
+	return m.recorder
+}
+
+// Convert mocks base method.
+func (m *MockPricingService) Convert(ctx context.Context, amount money.Money, currency string) (money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Convert", ctx, amount, currency)
+	ret0, _ := ret[0].(money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Convert indicates an expected call of Convert.
+func (mr *MockPricingServiceMockRecorder) Convert(ctx, amount, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockPricingService)(nil).Convert), ctx, amount, currency)
+}
+
+// DeleteExchangeRate mocks base method.
+func (m *MockPricingService) DeleteExchangeRate(ctx context.Context, base, quote string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExchangeRate", ctx, base, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExchangeRate indicates an expected call of DeleteExchangeRate.
+func (mr *MockPricingServiceMockRecorder) DeleteExchangeRate(ctx, base, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExchangeRate", reflect.TypeOf((*MockPricingService)(nil).DeleteExchangeRate), ctx, base, quote)
+}
+
+// DeletePrice mocks base method.
+func (m *MockPricingService) DeletePrice(ctx context.Context, currency string, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrice", ctx, currency, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrice indicates an expected call of DeletePrice.
+func (mr *MockPricingServiceMockRecorder) DeletePrice(ctx, currency, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrice", reflect.TypeOf((*MockPricingService)(nil).DeletePrice), ctx, currency, bookID)
+}
+
+// GetCurrencies mocks base method.
+func (m *MockPricingService) GetCurrencies(ctx context.Context) []dto.CurrencyResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrencies", ctx)
+	ret0, _ := ret[0].([]dto.CurrencyResponse)
+	return ret0
+}
+
+// GetCurrencies indicates an expected call of GetCurrencies.
+func (mr *MockPricingServiceMockRecorder) GetCurrencies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockPricingService)(nil).GetCurrencies), ctx)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockPricingService) GetExchangeRates(ctx context.Context) ([]dto.ExchangeRateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx)
+	ret0, _ := ret[0].([]dto.ExchangeRateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockPricingServiceMockRecorder) GetExchangeRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockPricingService)(nil).GetExchangeRates), ctx)
+}
+
+// GetPriceList mocks base method.
+func (m *MockPricingService) GetPriceList(ctx context.Context, currency string, query pkg.PaginationQuery) (*dto.PriceListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceList", ctx, currency, query)
+	ret0, _ := ret[0].(*dto.PriceListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceList indicates an expected call of GetPriceList.
+func (mr *MockPricingServiceMockRecorder) GetPriceList(ctx, currency, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceList", reflect.TypeOf((*MockPricingService)(nil).GetPriceList), ctx, currency, query)
+}
+
+// Quote mocks base method.
+func (m *MockPricingService) Quote(ctx context.Context, currency string, items []pricing.Item) (map[uint]pricing.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, currency, items)
+	ret0, _ := ret[0].(map[uint]pricing.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockPricingServiceMockRecorder) Quote(ctx, currency, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockPricingService)(nil).Quote), ctx, currency, items)
+}
+
+// SaveExchangeRate mocks base method.
+func (m *MockPricingService) SaveExchangeRate(ctx context.Context, req dto.ExchangeRateRequest) (*dto.ExchangeRateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveExchangeRate", ctx, req)
+	ret0, _ := ret[0].(*dto.ExchangeRateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveExchangeRate indicates an expected call of SaveExchangeRate.
+func (mr *MockPricingServiceMockRecorder) SaveExchangeRate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveExchangeRate", reflect.TypeOf((*MockPricingService)(nil).SaveExchangeRate), ctx, req)
+}
+
+// SavePriceList mocks base method.
+func (m *MockPricingService) SavePriceList(ctx context.Context, currency string, req dto.PriceListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePriceList", ctx, currency, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePriceList indicates an expected call of SavePriceList.
+func (mr *MockPricingServiceMockRecorder) SavePriceList(ctx, currency, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePriceList", reflect.TypeOf((*MockPricingService)(nil).SavePriceList), ctx, currency, req)
+}
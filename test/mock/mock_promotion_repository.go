@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotions/promotion.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	promotions "bookstore-framework/internal/promotions"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromotionRepository is a mock of PromotionRepository interface.
+type MockPromotionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionRepositoryMockRecorder
+}
+
+// MockPromotionRepositoryMockRecorder is the mock recorder for MockPromotionRepository.
+type MockPromotionRepositoryMockRecorder struct {
+	mock *MockPromotionRepository
+}
+
+// NewMockPromotionRepository creates a new mock instance.
+func NewMockPromotionRepository(ctrl *gomock.Controller) *MockPromotionRepository {
+	mock := &MockPromotionRepository{ctrl: ctrl}
+	mock.recorder = &MockPromotionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionRepository) EXPECT() *MockPromotionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountRedemptions mocks base method.
+func (m *MockPromotionRepository) CountRedemptions(ctx context.Context, userID uint, promotionIDs []uint) (map[uint]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRedemptions", ctx, userID, promotionIDs)
+	ret0, _ := ret[0].(map[uint]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRedemptions indicates an expected call of CountRedemptions.
+func (mr *MockPromotionRepositoryMockRecorder) CountRedemptions(ctx, userID, promotionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRedemptions", reflect.TypeOf((*MockPromotionRepository)(nil).CountRedemptions), ctx, userID, promotionIDs)
+}
+
+// Create mocks base method.
+func (m *MockPromotionRepository) Create(ctx context.Context, promotion *promotions.Promotion) (*promotions.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, promotion)
+	ret0, _ := ret[0].(*promotions.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionRepositoryMockRecorder) Create(ctx, promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionRepository)(nil).Create), ctx, promotion)
+}
+
+// FindAll mocks base method.
+func (m *MockPromotionRepository) FindAll(ctx context.Context, filter promotions.PromotionFilter) ([]promotions.Promotion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]promotions.Promotion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPromotionRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPromotionRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindApplicable mocks base method.
+func (m *MockPromotionRepository) FindApplicable(ctx context.Context, code string, now time.Time) ([]promotions.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindApplicable", ctx, code, now)
+	ret0, _ := ret[0].([]promotions.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApplicable indicates an expected call of FindApplicable.
+func (mr *MockPromotionRepositoryMockRecorder) FindApplicable(ctx, code, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApplicable", reflect.TypeOf((*MockPromotionRepository)(nil).FindApplicable), ctx, code, now)
+}
+
+// FindBookScopes mocks base method.
+func (m *MockPromotionRepository) FindBookScopes(ctx context.Context, bookIDs []uint) (map[uint]promotions.BookScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBookScopes", ctx, bookIDs)
+	ret0, _ := ret[0].(map[uint]promotions.BookScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBookScopes indicates an expected call of FindBookScopes.
+func (mr *MockPromotionRepositoryMockRecorder) FindBookScopes(ctx, bookIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBookScopes", reflect.TypeOf((*MockPromotionRepository)(nil).FindBookScopes), ctx, bookIDs)
+}
+
+// FindByCode mocks base method.
+func (m *MockPromotionRepository) FindByCode(ctx context.Context, code string) (*promotions.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(*promotions.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockPromotionRepositoryMockRecorder) FindByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockPromotionRepository)(nil).FindByCode), ctx, code)
+}
+
+// FindByID mocks base method.
+func (m *MockPromotionRepository) FindByID(ctx context.Context, id uint) (*promotions.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*promotions.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPromotionRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPromotionRepository)(nil).FindByID), ctx, id)
+}
+
+// Redeem mocks base method.
+func (m *MockPromotionRepository) Redeem(ctx context.Context, redemption *promotions.PromotionRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockPromotionRepositoryMockRecorder) Redeem(ctx, redemption interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockPromotionRepository)(nil).Redeem), ctx, redemption)
+}
+
+// ReleaseRedemptions mocks base method.
+func (m *MockPromotionRepository) ReleaseRedemptions(ctx context.Context, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRedemptions", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRedemptions indicates an expected call of ReleaseRedemptions.
+func (mr *MockPromotionRepositoryMockRecorder) ReleaseRedemptions(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRedemptions", reflect.TypeOf((*MockPromotionRepository)(nil).ReleaseRedemptions), ctx, orderID)
+}
+
+// Update mocks base method.
+func (m *MockPromotionRepository) Update(ctx context.Context, promotion *promotions.Promotion) (*promotions.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, promotion)
+	ret0, _ := ret[0].(*promotions.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionRepositoryMockRecorder) Update(ctx, promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionRepository)(nil).Update), ctx, promotion)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotions/promotion.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	promotions "bookstore-framework/internal/promotions"
+	dto "bookstore-framework/internal/promotions/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromotionService is a mock of PromotionService interface.
+type MockPromotionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionServiceMockRecorder
+}
+
+// MockPromotionServiceMockRecorder is the mock recorder for MockPromotionService.
+type MockPromotionServiceMockRecorder struct {
+	mock *MockPromotionService
+}
+
+// NewMockPromotionService creates a new mock instance.
+func NewMockPromotionService(ctrl *gomock.Controller) *MockPromotionService {
+	mock := &MockPromotionService{ctrl: ctrl}
+	mock.recorder = &MockPromotionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionService) EXPECT() *MockPromotionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePromotion mocks base method.
+func (m *MockPromotionService) CreatePromotion(ctx context.Context, req dto.PromotionRequest) (*dto.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, req)
+	ret0, _ := ret[0].(*dto.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockPromotionServiceMockRecorder) CreatePromotion(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockPromotionService)(nil).CreatePromotion), ctx, req)
+}
+
+// Evaluate mocks base method.
+func (m *MockPromotionService) Evaluate(ctx context.Context, basket promotions.Basket) (*promotions.Evaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, basket)
+	ret0, _ := ret[0].(*promotions.Evaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockPromotionServiceMockRecorder) Evaluate(ctx, basket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockPromotionService)(nil).Evaluate), ctx, basket)
+}
+
+// FindCoupon mocks base method.
+func (m *MockPromotionService) FindCoupon(ctx context.Context, code string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCoupon", ctx, code)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCoupon indicates an expected call of FindCoupon.
+func (mr *MockPromotionServiceMockRecorder) FindCoupon(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCoupon", reflect.TypeOf((*MockPromotionService)(nil).FindCoupon), ctx, code)
+}
+
+// GetPromotion mocks base method.
+func (m *MockPromotionService) GetPromotion(ctx context.Context, id uint) (*dto.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotion", ctx, id)
+	ret0, _ := ret[0].(*dto.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotion indicates an expected call of GetPromotion.
+func (mr *MockPromotionServiceMockRecorder) GetPromotion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotion", reflect.TypeOf((*MockPromotionService)(nil).GetPromotion), ctx, id)
+}
+
+// GetPromotions mocks base method.
+func (m *MockPromotionService) GetPromotions(ctx context.Context, query dto.PromotionListQuery) (*dto.PromotionListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotions", ctx, query)
+	ret0, _ := ret[0].(*dto.PromotionListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotions indicates an expected call of GetPromotions.
+func (mr *MockPromotionServiceMockRecorder) GetPromotions(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotions", reflect.TypeOf((*MockPromotionService)(nil).GetPromotions), ctx, query)
+}
+
+// Redeem mocks base method.
+func (m *MockPromotionService) Redeem(ctx context.Context, evaluation *promotions.Evaluation, userID, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, evaluation, userID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockPromotionServiceMockRecorder) Redeem(ctx, evaluation, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockPromotionService)(nil).Redeem), ctx, evaluation, userID, orderID)
+}
+
+// Release mocks base method.
+func (m *MockPromotionService) Release(ctx context.Context, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockPromotionServiceMockRecorder) Release(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockPromotionService)(nil).Release), ctx, orderID)
+}
+
+// UpdatePromotion mocks base method.
+func (m *MockPromotionService) UpdatePromotion(ctx context.Context, id uint, req dto.PromotionRequest) (*dto.PromotionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromotion", ctx, id, req)
+	ret0, _ := ret[0].(*dto.PromotionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePromotion indicates an expected call of UpdatePromotion.
+func (mr *MockPromotionServiceMockRecorder) UpdatePromotion(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockPromotionService)(nil).UpdatePromotion), ctx, id, req)
+}
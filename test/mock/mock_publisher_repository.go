@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/publisher.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	books "bookstore-framework/internal/books"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPublisherRepository is a mock of PublisherRepository interface.
+type MockPublisherRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherRepositoryMockRecorder
+}
+
+// MockPublisherRepositoryMockRecorder is the mock recorder for MockPublisherRepository.
+type MockPublisherRepositoryMockRecorder struct {
+	mock *MockPublisherRepository
+}
+
+// NewMockPublisherRepository creates a new mock instance.
+func NewMockPublisherRepository(ctrl *gomock.Controller) *MockPublisherRepository {
+	mock := &MockPublisherRepository{ctrl: ctrl}
+	mock.recorder = &MockPublisherRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisherRepository) EXPECT() *MockPublisherRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountBooks mocks base method.
+func (m *MockPublisherRepository) CountBooks(ctx context.Context, id uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBooks", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBooks indicates an expected call of CountBooks.
+func (mr *MockPublisherRepositoryMockRecorder) CountBooks(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBooks", reflect.TypeOf((*MockPublisherRepository)(nil).CountBooks), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockPublisherRepository) Create(ctx context.Context, publisher *books.Publisher) (*books.Publisher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, publisher)
+	ret0, _ := ret[0].(*books.Publisher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPublisherRepositoryMockRecorder) Create(ctx, publisher interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPublisherRepository)(nil).Create), ctx, publisher)
+}
+
+// Delete mocks base method.
+func (m *MockPublisherRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPublisherRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPublisherRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPublisherRepository) FindAll(ctx context.Context, name string, offset, limit int) ([]books.Publisher, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, name, offset, limit)
+	ret0, _ := ret[0].([]books.Publisher)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPublisherRepositoryMockRecorder) FindAll(ctx, name, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPublisherRepository)(nil).FindAll), ctx, name, offset, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockPublisherRepository) FindByID(ctx context.Context, id uint) (*books.Publisher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*books.Publisher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPublisherRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPublisherRepository)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockPublisherRepository) Update(ctx context.Context, publisher *books.Publisher) (*books.Publisher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, publisher)
+	ret0, _ := ret[0].(*books.Publisher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPublisherRepositoryMockRecorder) Update(ctx, publisher interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPublisherRepository)(nil).Update), ctx, publisher)
+}
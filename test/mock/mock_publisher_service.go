@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/books/publisher.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/books/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPublisherService is a mock of PublisherService interface.
+type MockPublisherService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherServiceMockRecorder
+}
+
+// MockPublisherServiceMockRecorder is the mock recorder for MockPublisherService.
+type MockPublisherServiceMockRecorder struct {
+	mock *MockPublisherService
+}
+
+// NewMockPublisherService creates a new mock instance.
+func NewMockPublisherService(ctrl *gomock.Controller) *MockPublisherService {
+	mock := &MockPublisherService{ctrl: ctrl}
+	mock.recorder = &MockPublisherServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisherService) EXPECT() *MockPublisherServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePublisher mocks base method.
+func (m *MockPublisherService) CreatePublisher(ctx context.Context, req dto.PublisherRequest) (*dto.PublisherResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePublisher", ctx, req)
+	ret0, _ := ret[0].(*dto.PublisherResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePublisher indicates an expected call of CreatePublisher.
+func (mr *MockPublisherServiceMockRecorder) CreatePublisher(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePublisher", reflect.TypeOf((*MockPublisherService)(nil).CreatePublisher), ctx, req)
+}
+
+// DeletePublisher mocks base method.
+func (m *MockPublisherService) DeletePublisher(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublisher", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePublisher indicates an expected call of DeletePublisher.
+func (mr *MockPublisherServiceMockRecorder) DeletePublisher(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublisher", reflect.TypeOf((*MockPublisherService)(nil).DeletePublisher), ctx, id)
+}
+
+// GetPublisher mocks base method.
+func (m *MockPublisherService) GetPublisher(ctx context.Context, id uint) (*dto.PublisherResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublisher", ctx, id)
+	ret0, _ := ret[0].(*dto.PublisherResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublisher indicates an expected call of GetPublisher.
+func (mr *MockPublisherServiceMockRecorder) GetPublisher(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublisher", reflect.TypeOf((*MockPublisherService)(nil).GetPublisher), ctx, id)
+}
+
+// GetPublisherBooks mocks base method.
+func (m *MockPublisherService) GetPublisherBooks(ctx context.Context, id uint, query pkg.PaginationQuery) (*dto.PublisherBooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublisherBooks", ctx, id, query)
+	ret0, _ := ret[0].(*dto.PublisherBooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublisherBooks indicates an expected call of GetPublisherBooks.
+func (mr *MockPublisherServiceMockRecorder) GetPublisherBooks(ctx, id, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublisherBooks", reflect.TypeOf((*MockPublisherService)(nil).GetPublisherBooks), ctx, id, query)
+}
+
+// GetPublishers mocks base method.
+func (m *MockPublisherService) GetPublishers(ctx context.Context, query dto.NameListQuery) (*dto.PublisherListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishers", ctx, query)
+	ret0, _ := ret[0].(*dto.PublisherListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishers indicates an expected call of GetPublishers.
+func (mr *MockPublisherServiceMockRecorder) GetPublishers(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishers", reflect.TypeOf((*MockPublisherService)(nil).GetPublishers), ctx, query)
+}
+
+// UpdatePublisher mocks base method.
+func (m *MockPublisherService) UpdatePublisher(ctx context.Context, id uint, req dto.PublisherRequest) (*dto.PublisherResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePublisher", ctx, id, req)
+	ret0, _ := ret[0].(*dto.PublisherResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePublisher indicates an expected call of UpdatePublisher.
+func (mr *MockPublisherServiceMockRecorder) UpdatePublisher(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePublisher", reflect.TypeOf((*MockPublisherService)(nil).UpdatePublisher), ctx, id, req)
+}
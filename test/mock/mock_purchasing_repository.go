@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/purchasing/purchase.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	purchasing "bookstore-framework/internal/purchasing"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurchasingRepository is a mock of PurchasingRepository interface.
+type MockPurchasingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchasingRepositoryMockRecorder
+}
+
+// MockPurchasingRepositoryMockRecorder is the mock recorder for MockPurchasingRepository.
+type MockPurchasingRepositoryMockRecorder struct {
+	mock *MockPurchasingRepository
+}
+
+// NewMockPurchasingRepository creates a new mock instance.
+func NewMockPurchasingRepository(ctrl *gomock.Controller) *MockPurchasingRepository {
+	mock := &MockPurchasingRepository{ctrl: ctrl}
+	mock.recorder = &MockPurchasingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchasingRepository) EXPECT() *MockPurchasingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePurchaseOrder mocks base method.
+func (m *MockPurchasingRepository) CreatePurchaseOrder(ctx context.Context, order *purchasing.PurchaseOrder) (*purchasing.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePurchaseOrder", ctx, order)
+	ret0, _ := ret[0].(*purchasing.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePurchaseOrder indicates an expected call of CreatePurchaseOrder.
+func (mr *MockPurchasingRepositoryMockRecorder) CreatePurchaseOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePurchaseOrder", reflect.TypeOf((*MockPurchasingRepository)(nil).CreatePurchaseOrder), ctx, order)
+}
+
+// CreateSupplier mocks base method.
+func (m *MockPurchasingRepository) CreateSupplier(ctx context.Context, supplier *purchasing.Supplier) (*purchasing.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSupplier", ctx, supplier)
+	ret0, _ := ret[0].(*purchasing.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSupplier indicates an expected call of CreateSupplier.
+func (mr *MockPurchasingRepositoryMockRecorder) CreateSupplier(ctx, supplier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSupplier", reflect.TypeOf((*MockPurchasingRepository)(nil).CreateSupplier), ctx, supplier)
+}
+
+// DeleteSupplierItem mocks base method.
+func (m *MockPurchasingRepository) DeleteSupplierItem(ctx context.Context, supplierID, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSupplierItem", ctx, supplierID, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSupplierItem indicates an expected call of DeleteSupplierItem.
+func (mr *MockPurchasingRepositoryMockRecorder) DeleteSupplierItem(ctx, supplierID, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSupplierItem", reflect.TypeOf((*MockPurchasingRepository)(nil).DeleteSupplierItem), ctx, supplierID, bookID)
+}
+
+// FindPurchaseOrderByID mocks base method.
+func (m *MockPurchasingRepository) FindPurchaseOrderByID(ctx context.Context, id uint) (*purchasing.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPurchaseOrderByID", ctx, id)
+	ret0, _ := ret[0].(*purchasing.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPurchaseOrderByID indicates an expected call of FindPurchaseOrderByID.
+func (mr *MockPurchasingRepositoryMockRecorder) FindPurchaseOrderByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPurchaseOrderByID", reflect.TypeOf((*MockPurchasingRepository)(nil).FindPurchaseOrderByID), ctx, id)
+}
+
+// FindPurchaseOrderForUpdate mocks base method.
+func (m *MockPurchasingRepository) FindPurchaseOrderForUpdate(ctx context.Context, id uint) (*purchasing.PurchaseOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPurchaseOrderForUpdate", ctx, id)
+	ret0, _ := ret[0].(*purchasing.PurchaseOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPurchaseOrderForUpdate indicates an expected call of FindPurchaseOrderForUpdate.
+func (mr *MockPurchasingRepositoryMockRecorder) FindPurchaseOrderForUpdate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPurchaseOrderForUpdate", reflect.TypeOf((*MockPurchasingRepository)(nil).FindPurchaseOrderForUpdate), ctx, id)
+}
+
+// FindPurchaseOrders mocks base method.
+func (m *MockPurchasingRepository) FindPurchaseOrders(ctx context.Context, filter purchasing.PurchaseOrderFilter) ([]purchasing.PurchaseOrder, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPurchaseOrders", ctx, filter)
+	ret0, _ := ret[0].([]purchasing.PurchaseOrder)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPurchaseOrders indicates an expected call of FindPurchaseOrders.
+func (mr *MockPurchasingRepositoryMockRecorder) FindPurchaseOrders(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPurchaseOrders", reflect.TypeOf((*MockPurchasingRepository)(nil).FindPurchaseOrders), ctx, filter)
+}
+
+// FindSupplierByID mocks base method.
+func (m *MockPurchasingRepository) FindSupplierByID(ctx context.Context, id uint) (*purchasing.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSupplierByID", ctx, id)
+	ret0, _ := ret[0].(*purchasing.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSupplierByID indicates an expected call of FindSupplierByID.
+func (mr *MockPurchasingRepositoryMockRecorder) FindSupplierByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSupplierByID", reflect.TypeOf((*MockPurchasingRepository)(nil).FindSupplierByID), ctx, id)
+}
+
+// FindSupplierItems mocks base method.
+func (m *MockPurchasingRepository) FindSupplierItems(ctx context.Context, supplierID uint) ([]purchasing.SupplierItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSupplierItems", ctx, supplierID)
+	ret0, _ := ret[0].([]purchasing.SupplierItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSupplierItems indicates an expected call of FindSupplierItems.
+func (mr *MockPurchasingRepositoryMockRecorder) FindSupplierItems(ctx, supplierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSupplierItems", reflect.TypeOf((*MockPurchasingRepository)(nil).FindSupplierItems), ctx, supplierID)
+}
+
+// FindSuppliers mocks base method.
+func (m *MockPurchasingRepository) FindSuppliers(ctx context.Context, filter purchasing.SupplierFilter) ([]purchasing.Supplier, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSuppliers", ctx, filter)
+	ret0, _ := ret[0].([]purchasing.Supplier)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindSuppliers indicates an expected call of FindSuppliers.
+func (mr *MockPurchasingRepositoryMockRecorder) FindSuppliers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSuppliers", reflect.TypeOf((*MockPurchasingRepository)(nil).FindSuppliers), ctx, filter)
+}
+
+// ReplacePurchaseOrderItems mocks base method.
+func (m *MockPurchasingRepository) ReplacePurchaseOrderItems(ctx context.Context, order *purchasing.PurchaseOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePurchaseOrderItems", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePurchaseOrderItems indicates an expected call of ReplacePurchaseOrderItems.
+func (mr *MockPurchasingRepositoryMockRecorder) ReplacePurchaseOrderItems(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePurchaseOrderItems", reflect.TypeOf((*MockPurchasingRepository)(nil).ReplacePurchaseOrderItems), ctx, order)
+}
+
+// SaveSupplierItems mocks base method.
+func (m *MockPurchasingRepository) SaveSupplierItems(ctx context.Context, items []purchasing.SupplierItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSupplierItems", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSupplierItems indicates an expected call of SaveSupplierItems.
+func (mr *MockPurchasingRepositoryMockRecorder) SaveSupplierItems(ctx, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSupplierItems", reflect.TypeOf((*MockPurchasingRepository)(nil).SaveSupplierItems), ctx, items)
+}
+
+// UpdatePurchaseOrder mocks base method.
+func (m *MockPurchasingRepository) UpdatePurchaseOrder(ctx context.Context, order *purchasing.PurchaseOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePurchaseOrder", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePurchaseOrder indicates an expected call of UpdatePurchaseOrder.
+func (mr *MockPurchasingRepositoryMockRecorder) UpdatePurchaseOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePurchaseOrder", reflect.TypeOf((*MockPurchasingRepository)(nil).UpdatePurchaseOrder), ctx, order)
+}
+
+// UpdateSupplier mocks base method.
+func (m *MockPurchasingRepository) UpdateSupplier(ctx context.Context, supplier *purchasing.Supplier) (*purchasing.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupplier", ctx, supplier)
+	ret0, _ := ret[0].(*purchasing.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSupplier indicates an expected call of UpdateSupplier.
+func (mr *MockPurchasingRepositoryMockRecorder) UpdateSupplier(ctx, supplier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupplier", reflect.TypeOf((*MockPurchasingRepository)(nil).UpdateSupplier), ctx, supplier)
+}
@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/purchasing/purchase.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/purchasing/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurchasingService is a mock of PurchasingService interface.
+type MockPurchasingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchasingServiceMockRecorder
+}
+
+// MockPurchasingServiceMockRecorder is the mock recorder for MockPurchasingService.
+type MockPurchasingServiceMockRecorder struct {
+	mock *MockPurchasingService
+}
+
+// NewMockPurchasingService creates a new mock instance.
+func NewMockPurchasingService(ctrl *gomock.Controller) *MockPurchasingService {
+	mock := &MockPurchasingService{ctrl: ctrl}
+	mock.recorder = &MockPurchasingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchasingService) EXPECT() *MockPurchasingServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockPurchasingService) Cancel(ctx context.Context, actorID, id uint) (*dto.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, actorID, id)
+	ret0, _ := ret[0].(*dto.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPurchasingServiceMockRecorder) Cancel(ctx, actorID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPurchasingService)(nil).Cancel), ctx, actorID, id)
+}
+
+// CreatePurchaseOrder mocks base method.
+func (m *MockPurchasingService) CreatePurchaseOrder(ctx context.Context, actorID uint, req dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePurchaseOrder", ctx, actorID, req)
+	ret0, _ := ret[0].(*dto.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePurchaseOrder indicates an expected call of CreatePurchaseOrder.
+func (mr *MockPurchasingServiceMockRecorder) CreatePurchaseOrder(ctx, actorID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePurchaseOrder", reflect.TypeOf((*MockPurchasingService)(nil).CreatePurchaseOrder), ctx, actorID, req)
+}
+
+// CreateSupplier mocks base method.
+func (m *MockPurchasingService) CreateSupplier(ctx context.Context, req dto.SupplierRequest) (*dto.SupplierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSupplier", ctx, req)
+	ret0, _ := ret[0].(*dto.SupplierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSupplier indicates an expected call of CreateSupplier.
+func (mr *MockPurchasingServiceMockRecorder) CreateSupplier(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSupplier", reflect.TypeOf((*MockPurchasingService)(nil).CreateSupplier), ctx, req)
+}
+
+// DeleteCatalogItem mocks base method.
+func (m *MockPurchasingService) DeleteCatalogItem(ctx context.Context, supplierID, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCatalogItem", ctx, supplierID, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCatalogItem indicates an expected call of DeleteCatalogItem.
+func (mr *MockPurchasingServiceMockRecorder) DeleteCatalogItem(ctx, supplierID, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCatalogItem", reflect.TypeOf((*MockPurchasingService)(nil).DeleteCatalogItem), ctx, supplierID, bookID)
+}
+
+// GetCatalog mocks base method.
+func (m *MockPurchasingService) GetCatalog(ctx context.Context, supplierID uint) (*dto.SupplierCatalogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCatalog", ctx, supplierID)
+	ret0, _ := ret[0].(*dto.SupplierCatalogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCatalog indicates an expected call of GetCatalog.
+func (mr *MockPurchasingServiceMockRecorder) GetCatalog(ctx, supplierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCatalog", reflect.TypeOf((*MockPurchasingService)(nil).GetCatalog), ctx, supplierID)
+}
+
+// GetPurchaseOrder mocks base method.
+func (m *MockPurchasingService) GetPurchaseOrder(ctx context.Context, id uint) (*dto.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseOrder", ctx, id)
+	ret0, _ := ret[0].(*dto.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseOrder indicates an expected call of GetPurchaseOrder.
+func (mr *MockPurchasingServiceMockRecorder) GetPurchaseOrder(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseOrder", reflect.TypeOf((*MockPurchasingService)(nil).GetPurchaseOrder), ctx, id)
+}
+
+// GetPurchaseOrders mocks base method.
+func (m *MockPurchasingService) GetPurchaseOrders(ctx context.Context, query dto.PurchaseOrderListQuery) (*dto.PurchaseOrderListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseOrders", ctx, query)
+	ret0, _ := ret[0].(*dto.PurchaseOrderListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseOrders indicates an expected call of GetPurchaseOrders.
+func (mr *MockPurchasingServiceMockRecorder) GetPurchaseOrders(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseOrders", reflect.TypeOf((*MockPurchasingService)(nil).GetPurchaseOrders), ctx, query)
+}
+
+// GetSupplier mocks base method.
+func (m *MockPurchasingService) GetSupplier(ctx context.Context, id uint) (*dto.SupplierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplier", ctx, id)
+	ret0, _ := ret[0].(*dto.SupplierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplier indicates an expected call of GetSupplier.
+func (mr *MockPurchasingServiceMockRecorder) GetSupplier(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplier", reflect.TypeOf((*MockPurchasingService)(nil).GetSupplier), ctx, id)
+}
+
+// GetSuppliers mocks base method.
+func (m *MockPurchasingService) GetSuppliers(ctx context.Context, query dto.SupplierListQuery) (*dto.SupplierListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuppliers", ctx, query)
+	ret0, _ := ret[0].(*dto.SupplierListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuppliers indicates an expected call of GetSuppliers.
+func (mr *MockPurchasingServiceMockRecorder) GetSuppliers(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuppliers", reflect.TypeOf((*MockPurchasingService)(nil).GetSuppliers), ctx, query)
+}
+
+// Receive mocks base method.
+func (m *MockPurchasingService) Receive(ctx context.Context, actorID, id uint, req dto.ReceivePurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockPurchasingServiceMockRecorder) Receive(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockPurchasingService)(nil).Receive), ctx, actorID, id, req)
+}
+
+// SaveCatalogItems mocks base method.
+func (m *MockPurchasingService) SaveCatalogItems(ctx context.Context, supplierID uint, req dto.SupplierItemsRequest) (*dto.SupplierCatalogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCatalogItems", ctx, supplierID, req)
+	ret0, _ := ret[0].(*dto.SupplierCatalogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCatalogItems indicates an expected call of SaveCatalogItems.
+func (mr *MockPurchasingServiceMockRecorder) SaveCatalogItems(ctx, supplierID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCatalogItems", reflect.TypeOf((*MockPurchasingService)(nil).SaveCatalogItems), ctx, supplierID, req)
+}
+
+// Send mocks base method.
+func (m *MockPurchasingService) Send(ctx context.Context, actorID, id uint) (*dto.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, actorID, id)
+	ret0, _ := ret[0].(*dto.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPurchasingServiceMockRecorder) Send(ctx, actorID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPurchasingService)(nil).Send), ctx, actorID, id)
+}
+
+// UpdatePurchaseOrder mocks base method.
+func (m *MockPurchasingService) UpdatePurchaseOrder(ctx context.Context, actorID, id uint, req dto.PurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePurchaseOrder", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.PurchaseOrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePurchaseOrder indicates an expected call of UpdatePurchaseOrder.
+func (mr *MockPurchasingServiceMockRecorder) UpdatePurchaseOrder(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePurchaseOrder", reflect.TypeOf((*MockPurchasingService)(nil).UpdatePurchaseOrder), ctx, actorID, id, req)
+}
+
+// UpdateSupplier mocks base method.
+func (m *MockPurchasingService) UpdateSupplier(ctx context.Context, id uint, req dto.SupplierRequest) (*dto.SupplierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupplier", ctx, id, req)
+	ret0, _ := ret[0].(*dto.SupplierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSupplier indicates an expected call of UpdateSupplier.
+func (mr *MockPurchasingServiceMockRecorder) UpdateSupplier(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupplier", reflect.TypeOf((*MockPurchasingService)(nil).UpdateSupplier), ctx, id, req)
+}
@@ -0,0 +1,200 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/recommendations/recommendation.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	recommendations "bookstore-framework/internal/recommendations"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRecommendationRepository is a mock of RecommendationRepository interface.
+type MockRecommendationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecommendationRepositoryMockRecorder
+}
+
+// MockRecommendationRepositoryMockRecorder is the mock recorder for MockRecommendationRepository.
+type MockRecommendationRepositoryMockRecorder struct {
+	mock *MockRecommendationRepository
+}
+
+// NewMockRecommendationRepository creates a new mock instance.
+func NewMockRecommendationRepository(ctrl *gomock.Controller) *MockRecommendationRepository {
+	mock := &MockRecommendationRepository{ctrl: ctrl}
+	mock.recorder = &MockRecommendationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecommendationRepository) EXPECT() *MockRecommendationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteViewsBefore mocks base method.
+func (m *MockRecommendationRepository) DeleteViewsBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteViewsBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteViewsBefore indicates an expected call of DeleteViewsBefore.
+func (mr *MockRecommendationRepositoryMockRecorder) DeleteViewsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteViewsBefore", reflect.TypeOf((*MockRecommendationRepository)(nil).DeleteViewsBefore), ctx, before)
+}
+
+// FindNeighbours mocks base method.
+func (m *MockRecommendationRepository) FindNeighbours(ctx context.Context, bookID uint, limit int) ([]recommendations.BookNeighbour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNeighbours", ctx, bookID, limit)
+	ret0, _ := ret[0].([]recommendations.BookNeighbour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNeighbours indicates an expected call of FindNeighbours.
+func (mr *MockRecommendationRepositoryMockRecorder) FindNeighbours(ctx, bookID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNeighbours", reflect.TypeOf((*MockRecommendationRepository)(nil).FindNeighbours), ctx, bookID, limit)
+}
+
+// FindNeighboursOf mocks base method.
+func (m *MockRecommendationRepository) FindNeighboursOf(ctx context.Context, seeds []uint, limit int) ([]recommendations.BookNeighbour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNeighboursOf", ctx, seeds, limit)
+	ret0, _ := ret[0].([]recommendations.BookNeighbour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNeighboursOf indicates an expected call of FindNeighboursOf.
+func (mr *MockRecommendationRepositoryMockRecorder) FindNeighboursOf(ctx, seeds, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNeighboursOf", reflect.TypeOf((*MockRecommendationRepository)(nil).FindNeighboursOf), ctx, seeds, limit)
+}
+
+// FindPurchased mocks base method.
+func (m *MockRecommendationRepository) FindPurchased(ctx context.Context, userID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPurchased", ctx, userID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPurchased indicates an expected call of FindPurchased.
+func (mr *MockRecommendationRepositoryMockRecorder) FindPurchased(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPurchased", reflect.TypeOf((*MockRecommendationRepository)(nil).FindPurchased), ctx, userID)
+}
+
+// FindViewed mocks base method.
+func (m *MockRecommendationRepository) FindViewed(ctx context.Context, userID uint, since time.Time, limit int) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindViewed", ctx, userID, since, limit)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindViewed indicates an expected call of FindViewed.
+func (mr *MockRecommendationRepositoryMockRecorder) FindViewed(ctx, userID, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindViewed", reflect.TypeOf((*MockRecommendationRepository)(nil).FindViewed), ctx, userID, since, limit)
+}
+
+// PurchaseCounts mocks base method.
+func (m *MockRecommendationRepository) PurchaseCounts(ctx context.Context) ([]recommendations.Popularity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurchaseCounts", ctx)
+	ret0, _ := ret[0].([]recommendations.Popularity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurchaseCounts indicates an expected call of PurchaseCounts.
+func (mr *MockRecommendationRepositoryMockRecorder) PurchaseCounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurchaseCounts", reflect.TypeOf((*MockRecommendationRepository)(nil).PurchaseCounts), ctx)
+}
+
+// PurchasePairs mocks base method.
+func (m *MockRecommendationRepository) PurchasePairs(ctx context.Context, minCount int) ([]recommendations.Pair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurchasePairs", ctx, minCount)
+	ret0, _ := ret[0].([]recommendations.Pair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurchasePairs indicates an expected call of PurchasePairs.
+func (mr *MockRecommendationRepositoryMockRecorder) PurchasePairs(ctx, minCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurchasePairs", reflect.TypeOf((*MockRecommendationRepository)(nil).PurchasePairs), ctx, minCount)
+}
+
+// RecordView mocks base method.
+func (m *MockRecommendationRepository) RecordView(ctx context.Context, view *recommendations.BookView) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordView", ctx, view)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordView indicates an expected call of RecordView.
+func (mr *MockRecommendationRepositoryMockRecorder) RecordView(ctx, view interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordView", reflect.TypeOf((*MockRecommendationRepository)(nil).RecordView), ctx, view)
+}
+
+// ReplaceNeighbours mocks base method.
+func (m *MockRecommendationRepository) ReplaceNeighbours(ctx context.Context, neighbours []recommendations.BookNeighbour) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNeighbours", ctx, neighbours)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceNeighbours indicates an expected call of ReplaceNeighbours.
+func (mr *MockRecommendationRepositoryMockRecorder) ReplaceNeighbours(ctx, neighbours interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNeighbours", reflect.TypeOf((*MockRecommendationRepository)(nil).ReplaceNeighbours), ctx, neighbours)
+}
+
+// ViewCounts mocks base method.
+func (m *MockRecommendationRepository) ViewCounts(ctx context.Context, since time.Time) ([]recommendations.Popularity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewCounts", ctx, since)
+	ret0, _ := ret[0].([]recommendations.Popularity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewCounts indicates an expected call of ViewCounts.
+func (mr *MockRecommendationRepositoryMockRecorder) ViewCounts(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewCounts", reflect.TypeOf((*MockRecommendationRepository)(nil).ViewCounts), ctx, since)
+}
+
+// ViewPairs mocks base method.
+func (m *MockRecommendationRepository) ViewPairs(ctx context.Context, since time.Time, minCount int) ([]recommendations.Pair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewPairs", ctx, since, minCount)
+	ret0, _ := ret[0].([]recommendations.Pair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewPairs indicates an expected call of ViewPairs.
+func (mr *MockRecommendationRepositoryMockRecorder) ViewPairs(ctx, since, minCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewPairs", reflect.TypeOf((*MockRecommendationRepository)(nil).ViewPairs), ctx, since, minCount)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/recommendations/recommendation.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/recommendations/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRecommendationService is a mock of RecommendationService interface.
+type MockRecommendationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecommendationServiceMockRecorder
+}
+
+// MockRecommendationServiceMockRecorder is the mock recorder for MockRecommendationService.
+type MockRecommendationServiceMockRecorder struct {
+	mock *MockRecommendationService
+}
+
+// NewMockRecommendationService creates a new mock instance.
+func NewMockRecommendationService(ctrl *gomock.Controller) *MockRecommendationService {
+	mock := &MockRecommendationService{ctrl: ctrl}
+	mock.recorder = &MockRecommendationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecommendationService) EXPECT() *MockRecommendationServiceMockRecorder {
+	return m.recorder
+}
+
+// ComputeNeighbours mocks base method.
+func (m *MockRecommendationService) ComputeNeighbours(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeNeighbours", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComputeNeighbours indicates an expected call of ComputeNeighbours.
+func (mr *MockRecommendationServiceMockRecorder) ComputeNeighbours(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeNeighbours", reflect.TypeOf((*MockRecommendationService)(nil).ComputeNeighbours), ctx)
+}
+
+// GetBookRecommendations mocks base method.
+func (m *MockRecommendationService) GetBookRecommendations(ctx context.Context, bookID uint, query dto.RecommendationQuery) ([]dto.RecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookRecommendations", ctx, bookID, query)
+	ret0, _ := ret[0].([]dto.RecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookRecommendations indicates an expected call of GetBookRecommendations.
+func (mr *MockRecommendationServiceMockRecorder) GetBookRecommendations(ctx, bookID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookRecommendations", reflect.TypeOf((*MockRecommendationService)(nil).GetBookRecommendations), ctx, bookID, query)
+}
+
+// GetUserRecommendations mocks base method.
+func (m *MockRecommendationService) GetUserRecommendations(ctx context.Context, userID uint, query dto.RecommendationQuery) ([]dto.RecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRecommendations", ctx, userID, query)
+	ret0, _ := ret[0].([]dto.RecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRecommendations indicates an expected call of GetUserRecommendations.
+func (mr *MockRecommendationServiceMockRecorder) GetUserRecommendations(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecommendations", reflect.TypeOf((*MockRecommendationService)(nil).GetUserRecommendations), ctx, userID, query)
+}
+
+// RecordView mocks base method.
+func (m *MockRecommendationService) RecordView(ctx context.Context, userID, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordView", ctx, userID, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordView indicates an expected call of RecordView.
+func (mr *MockRecommendationServiceMockRecorder) RecordView(ctx, userID, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordView", reflect.TypeOf((*MockRecommendationService)(nil).RecordView), ctx, userID, bookID)
+}
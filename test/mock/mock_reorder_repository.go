@@ -0,0 +1,231 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/purchasing/reorder.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	purchasing "bookstore-framework/internal/purchasing"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReorderRepository is a mock of ReorderRepository interface.
+type MockReorderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderRepositoryMockRecorder
+}
+
+// MockReorderRepositoryMockRecorder is the mock recorder for MockReorderRepository.
+type MockReorderRepositoryMockRecorder struct {
+	mock *MockReorderRepository
+}
+
+// NewMockReorderRepository creates a new mock instance.
+func NewMockReorderRepository(ctrl *gomock.Controller) *MockReorderRepository {
+	mock := &MockReorderRepository{ctrl: ctrl}
+	mock.recorder = &MockReorderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderRepository) EXPECT() *MockReorderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAlerts mocks base method.
+func (m *MockReorderRepository) CreateAlerts(ctx context.Context, alerts []purchasing.LowStockAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlerts", ctx, alerts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAlerts indicates an expected call of CreateAlerts.
+func (mr *MockReorderRepositoryMockRecorder) CreateAlerts(ctx, alerts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlerts", reflect.TypeOf((*MockReorderRepository)(nil).CreateAlerts), ctx, alerts)
+}
+
+// DeleteRule mocks base method.
+func (m *MockReorderRepository) DeleteRule(ctx context.Context, sku string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, sku)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockReorderRepositoryMockRecorder) DeleteRule(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockReorderRepository)(nil).DeleteRule), ctx, sku)
+}
+
+// FindAlerts mocks base method.
+func (m *MockReorderRepository) FindAlerts(ctx context.Context, filter purchasing.AlertFilter) ([]purchasing.LowStockAlert, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAlerts", ctx, filter)
+	ret0, _ := ret[0].([]purchasing.LowStockAlert)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAlerts indicates an expected call of FindAlerts.
+func (mr *MockReorderRepositoryMockRecorder) FindAlerts(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAlerts", reflect.TypeOf((*MockReorderRepository)(nil).FindAlerts), ctx, filter)
+}
+
+// FindNotifications mocks base method.
+func (m *MockReorderRepository) FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]purchasing.StockNotification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotifications", ctx, userID, offset, limit)
+	ret0, _ := ret[0].([]purchasing.StockNotification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindNotifications indicates an expected call of FindNotifications.
+func (mr *MockReorderRepositoryMockRecorder) FindNotifications(ctx, userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotifications", reflect.TypeOf((*MockReorderRepository)(nil).FindNotifications), ctx, userID, offset, limit)
+}
+
+// FindOffers mocks base method.
+func (m *MockReorderRepository) FindOffers(ctx context.Context, bookIDs []uint) ([]purchasing.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOffers", ctx, bookIDs)
+	ret0, _ := ret[0].([]purchasing.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOffers indicates an expected call of FindOffers.
+func (mr *MockReorderRepositoryMockRecorder) FindOffers(ctx, bookIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOffers", reflect.TypeOf((*MockReorderRepository)(nil).FindOffers), ctx, bookIDs)
+}
+
+// FindOpenAlerts mocks base method.
+func (m *MockReorderRepository) FindOpenAlerts(ctx context.Context, skus []string) ([]purchasing.LowStockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenAlerts", ctx, skus)
+	ret0, _ := ret[0].([]purchasing.LowStockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenAlerts indicates an expected call of FindOpenAlerts.
+func (mr *MockReorderRepositoryMockRecorder) FindOpenAlerts(ctx, skus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenAlerts", reflect.TypeOf((*MockReorderRepository)(nil).FindOpenAlerts), ctx, skus)
+}
+
+// FindRules mocks base method.
+func (m *MockReorderRepository) FindRules(ctx context.Context, offset, limit int) ([]purchasing.ReorderRule, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRules", ctx, offset, limit)
+	ret0, _ := ret[0].([]purchasing.ReorderRule)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindRules indicates an expected call of FindRules.
+func (mr *MockReorderRepositoryMockRecorder) FindRules(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRules", reflect.TypeOf((*MockReorderRepository)(nil).FindRules), ctx, offset, limit)
+}
+
+// FindRulesAfter mocks base method.
+func (m *MockReorderRepository) FindRulesAfter(ctx context.Context, afterID uint, limit int) ([]purchasing.ReorderRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRulesAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]purchasing.ReorderRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRulesAfter indicates an expected call of FindRulesAfter.
+func (mr *MockReorderRepositoryMockRecorder) FindRulesAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRulesAfter", reflect.TypeOf((*MockReorderRepository)(nil).FindRulesAfter), ctx, afterID, limit)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockReorderRepository) MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockReorderRepositoryMockRecorder) MarkNotificationsRead(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockReorderRepository)(nil).MarkNotificationsRead), ctx, userID, now)
+}
+
+// NotifyStaff mocks base method.
+func (m *MockReorderRepository) NotifyStaff(ctx context.Context, notificationType, message string, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyStaff", ctx, notificationType, message, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyStaff indicates an expected call of NotifyStaff.
+func (mr *MockReorderRepositoryMockRecorder) NotifyStaff(ctx, notificationType, message, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyStaff", reflect.TypeOf((*MockReorderRepository)(nil).NotifyStaff), ctx, notificationType, message, now)
+}
+
+// OnOrderBySKU mocks base method.
+func (m *MockReorderRepository) OnOrderBySKU(ctx context.Context, skus []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnOrderBySKU", ctx, skus)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OnOrderBySKU indicates an expected call of OnOrderBySKU.
+func (mr *MockReorderRepositoryMockRecorder) OnOrderBySKU(ctx, skus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnOrderBySKU", reflect.TypeOf((*MockReorderRepository)(nil).OnOrderBySKU), ctx, skus)
+}
+
+// ResolveAlerts mocks base method.
+func (m *MockReorderRepository) ResolveAlerts(ctx context.Context, ids []uint, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveAlerts", ctx, ids, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveAlerts indicates an expected call of ResolveAlerts.
+func (mr *MockReorderRepositoryMockRecorder) ResolveAlerts(ctx, ids, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAlerts", reflect.TypeOf((*MockReorderRepository)(nil).ResolveAlerts), ctx, ids, now)
+}
+
+// SaveRule mocks base method.
+func (m *MockReorderRepository) SaveRule(ctx context.Context, rule *purchasing.ReorderRule) (*purchasing.ReorderRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRule", ctx, rule)
+	ret0, _ := ret[0].(*purchasing.ReorderRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveRule indicates an expected call of SaveRule.
+func (mr *MockReorderRepositoryMockRecorder) SaveRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRule", reflect.TypeOf((*MockReorderRepository)(nil).SaveRule), ctx, rule)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/purchasing/reorder.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/purchasing/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReorderService is a mock of ReorderService interface.
+type MockReorderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderServiceMockRecorder
+}
+
+// MockReorderServiceMockRecorder is the mock recorder for MockReorderService.
+type MockReorderServiceMockRecorder struct {
+	mock *MockReorderService
+}
+
+// NewMockReorderService creates a new mock instance.
+func NewMockReorderService(ctrl *gomock.Controller) *MockReorderService {
+	mock := &MockReorderService{ctrl: ctrl}
+	mock.recorder = &MockReorderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderService) EXPECT() *MockReorderServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckStock mocks base method.
+func (m *MockReorderService) CheckStock(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStock", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckStock indicates an expected call of CheckStock.
+func (mr *MockReorderServiceMockRecorder) CheckStock(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStock", reflect.TypeOf((*MockReorderService)(nil).CheckStock), ctx)
+}
+
+// DeleteRule mocks base method.
+func (m *MockReorderService) DeleteRule(ctx context.Context, sku string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, sku)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockReorderServiceMockRecorder) DeleteRule(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockReorderService)(nil).DeleteRule), ctx, sku)
+}
+
+// GetAlerts mocks base method.
+func (m *MockReorderService) GetAlerts(ctx context.Context, query dto.LowStockAlertListQuery) (*dto.LowStockAlertListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", ctx, query)
+	ret0, _ := ret[0].(*dto.LowStockAlertListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts.
+func (mr *MockReorderServiceMockRecorder) GetAlerts(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockReorderService)(nil).GetAlerts), ctx, query)
+}
+
+// GetNotifications mocks base method.
+func (m *MockReorderService) GetNotifications(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.StockNotificationListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, query)
+	ret0, _ := ret[0].(*dto.StockNotificationListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockReorderServiceMockRecorder) GetNotifications(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockReorderService)(nil).GetNotifications), ctx, userID, query)
+}
+
+// GetRules mocks base method.
+func (m *MockReorderService) GetRules(ctx context.Context, query pkg.PaginationQuery) (*dto.ReorderRuleListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRules", ctx, query)
+	ret0, _ := ret[0].(*dto.ReorderRuleListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRules indicates an expected call of GetRules.
+func (mr *MockReorderServiceMockRecorder) GetRules(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRules", reflect.TypeOf((*MockReorderService)(nil).GetRules), ctx, query)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockReorderService) MarkNotificationsRead(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockReorderServiceMockRecorder) MarkNotificationsRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockReorderService)(nil).MarkNotificationsRead), ctx, userID)
+}
+
+// SaveRule mocks base method.
+func (m *MockReorderService) SaveRule(ctx context.Context, sku string, req dto.ReorderRuleRequest) (*dto.ReorderRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRule", ctx, sku, req)
+	ret0, _ := ret[0].(*dto.ReorderRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveRule indicates an expected call of SaveRule.
+func (mr *MockReorderServiceMockRecorder) SaveRule(ctx, sku, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRule", reflect.TypeOf((*MockReorderService)(nil).SaveRule), ctx, sku, req)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reports/report.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reports "bookstore-framework/internal/reports"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SalesByPeriod mocks base method.
+func (m *MockReportRepository) SalesByPeriod(ctx context.Context, interval string, filter reports.SalesFilter) ([]reports.SalesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesByPeriod", ctx, interval, filter)
+	ret0, _ := ret[0].([]reports.SalesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesByPeriod indicates an expected call of SalesByPeriod.
+func (mr *MockReportRepositoryMockRecorder) SalesByPeriod(ctx, interval, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesByPeriod", reflect.TypeOf((*MockReportRepository)(nil).SalesByPeriod), ctx, interval, filter)
+}
+
+// TopSellers mocks base method.
+func (m *MockReportRepository) TopSellers(ctx context.Context, dimension string, filter reports.SalesFilter, limit int) ([]reports.SellerRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopSellers", ctx, dimension, filter, limit)
+	ret0, _ := ret[0].([]reports.SellerRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopSellers indicates an expected call of TopSellers.
+func (mr *MockReportRepositoryMockRecorder) TopSellers(ctx, dimension, filter, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopSellers", reflect.TypeOf((*MockReportRepository)(nil).TopSellers), ctx, dimension, filter, limit)
+}
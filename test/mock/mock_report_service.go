@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reports/report.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/reports/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportService is a mock of ReportService interface.
+type MockReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceMockRecorder
+}
+
+// MockReportServiceMockRecorder is the mock recorder for MockReportService.
+type MockReportServiceMockRecorder struct {
+	mock *MockReportService
+}
+
+// NewMockReportService creates a new mock instance.
+func NewMockReportService(ctrl *gomock.Controller) *MockReportService {
+	mock := &MockReportService{ctrl: ctrl}
+	mock.recorder = &MockReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSales mocks base method.
+func (m *MockReportService) GetSales(ctx context.Context, query dto.SalesReportQuery) (*dto.SalesReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSales", ctx, query)
+	ret0, _ := ret[0].(*dto.SalesReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSales indicates an expected call of GetSales.
+func (mr *MockReportServiceMockRecorder) GetSales(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSales", reflect.TypeOf((*MockReportService)(nil).GetSales), ctx, query)
+}
+
+// GetTopSellers mocks base method.
+func (m *MockReportService) GetTopSellers(ctx context.Context, dimension string, query dto.TopSellersQuery) (*dto.TopSellersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopSellers", ctx, dimension, query)
+	ret0, _ := ret[0].(*dto.TopSellersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopSellers indicates an expected call of GetTopSellers.
+func (mr *MockReportServiceMockRecorder) GetTopSellers(ctx, dimension, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopSellers", reflect.TypeOf((*MockReportService)(nil).GetTopSellers), ctx, dimension, query)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/return.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	returns "bookstore-framework/internal/returns"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReturnRepository is a mock of ReturnRepository interface.
+type MockReturnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnRepositoryMockRecorder
+}
+
+// MockReturnRepositoryMockRecorder is the mock recorder for MockReturnRepository.
+type MockReturnRepositoryMockRecorder struct {
+	mock *MockReturnRepository
+}
+
+// NewMockReturnRepository creates a new mock instance.
+func NewMockReturnRepository(ctrl *gomock.Controller) *MockReturnRepository {
+	mock := &MockReturnRepository{ctrl: ctrl}
+	mock.recorder = &MockReturnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturnRepository) EXPECT() *MockReturnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReturnRepository) Create(ctx context.Context, ret *returns.Return) (*returns.Return, error) {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "Create", ctx, ret)
+	ret0, _ := ret_2[0].(*returns.Return)
+	ret1, _ := ret_2[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReturnRepositoryMockRecorder) Create(ctx, ret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReturnRepository)(nil).Create), ctx, ret)
+}
+
+// FindAll mocks base method.
+func (m *MockReturnRepository) FindAll(ctx context.Context, filter returns.ReturnFilter) ([]returns.Return, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]returns.Return)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReturnRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReturnRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindByID mocks base method.
+func (m *MockReturnRepository) FindByID(ctx context.Context, id uint) (*returns.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*returns.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockReturnRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReturnRepository)(nil).FindByID), ctx, id)
+}
+
+// ReturnedQuantities mocks base method.
+func (m *MockReturnRepository) ReturnedQuantities(ctx context.Context, orderID uint) (map[uint]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnedQuantities", ctx, orderID)
+	ret0, _ := ret[0].(map[uint]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnedQuantities indicates an expected call of ReturnedQuantities.
+func (mr *MockReturnRepositoryMockRecorder) ReturnedQuantities(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnedQuantities", reflect.TypeOf((*MockReturnRepository)(nil).ReturnedQuantities), ctx, orderID)
+}
+
+// Update mocks base method.
+func (m *MockReturnRepository) Update(ctx context.Context, ret *returns.Return, from string) error {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "Update", ctx, ret, from)
+	ret0, _ := ret_2[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReturnRepositoryMockRecorder) Update(ctx, ret, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReturnRepository)(nil).Update), ctx, ret, from)
+}
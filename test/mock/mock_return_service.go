@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/return.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	orders "bookstore-framework/internal/orders"
+	dto "bookstore-framework/internal/returns/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReturnService is a mock of ReturnService interface.
+type MockReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnServiceMockRecorder
+}
+
+// MockReturnServiceMockRecorder is the mock recorder for MockReturnService.
+type MockReturnServiceMockRecorder struct {
+	mock *MockReturnService
+}
+
+// NewMockReturnService creates a new mock instance.
+func NewMockReturnService(ctrl *gomock.Controller) *MockReturnService {
+	mock := &MockReturnService{ctrl: ctrl}
+	mock.recorder = &MockReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturnService) EXPECT() *MockReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockReturnService) Approve(ctx context.Context, actorID, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.ReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockReturnServiceMockRecorder) Approve(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockReturnService)(nil).Approve), ctx, actorID, id, req)
+}
+
+// GetReturn mocks base method.
+func (m *MockReturnService) GetReturn(ctx context.Context, actor orders.Actor, id uint) (*dto.ReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturn", ctx, actor, id)
+	ret0, _ := ret[0].(*dto.ReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturn indicates an expected call of GetReturn.
+func (mr *MockReturnServiceMockRecorder) GetReturn(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturn", reflect.TypeOf((*MockReturnService)(nil).GetReturn), ctx, actor, id)
+}
+
+// GetReturns mocks base method.
+func (m *MockReturnService) GetReturns(ctx context.Context, actor orders.Actor, query dto.ReturnListQuery) (*dto.ReturnListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturns", ctx, actor, query)
+	ret0, _ := ret[0].(*dto.ReturnListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturns indicates an expected call of GetReturns.
+func (mr *MockReturnServiceMockRecorder) GetReturns(ctx, actor, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturns", reflect.TypeOf((*MockReturnService)(nil).GetReturns), ctx, actor, query)
+}
+
+// Receive mocks base method.
+func (m *MockReturnService) Receive(ctx context.Context, actorID, id uint, req dto.ReceiveReturnRequest) (*dto.ReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.ReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockReturnServiceMockRecorder) Receive(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockReturnService)(nil).Receive), ctx, actorID, id, req)
+}
+
+// Refund mocks base method.
+func (m *MockReturnService) Refund(ctx context.Context, actorID, id uint, req dto.RefundReturnRequest) (*dto.ReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.ReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockReturnServiceMockRecorder) Refund(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockReturnService)(nil).Refund), ctx, actorID, id, req)
+}
+
+// Reject mocks base method.
+func (m *MockReturnService) Reject(ctx context.Context, actorID, id uint, req dto.ReviewReturnRequest) (*dto.ReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, actorID, id, req)
+	ret0, _ := ret[0].(*dto.ReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockReturnServiceMockRecorder) Reject(ctx, actorID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockReturnService)(nil).Reject), ctx, actorID, id, req)
+}
+
+// RequestReturn mocks base method.
+func (m *MockReturnService) RequestReturn(ctx context.Context, userID uint, req dto.CreateReturnRequest) (*dto.ReturnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReturn", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.ReturnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestReturn indicates an expected call of RequestReturn.
+func (mr *MockReturnServiceMockRecorder) RequestReturn(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReturn", reflect.TypeOf((*MockReturnService)(nil).RequestReturn), ctx, userID, req)
+}
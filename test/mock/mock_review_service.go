@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reviews/review.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reviews "bookstore-framework/internal/reviews"
+	dto "bookstore-framework/internal/reviews/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReviewService is a mock of ReviewService interface.
+type MockReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewServiceMockRecorder
+}
+
+// MockReviewServiceMockRecorder is the mock recorder for MockReviewService.
+type MockReviewServiceMockRecorder struct {
+	mock *MockReviewService
+}
+
+// NewMockReviewService creates a new mock instance.
+func NewMockReviewService(ctrl *gomock.Controller) *MockReviewService {
+	mock := &MockReviewService{ctrl: ctrl}
+	mock.recorder = &MockReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewService) EXPECT() *MockReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewService) CreateReview(ctx context.Context, userID, bookID uint, req dto.ReviewRequest) (*dto.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, userID, bookID, req)
+	ret0, _ := ret[0].(*dto.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewServiceMockRecorder) CreateReview(ctx, userID, bookID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewService)(nil).CreateReview), ctx, userID, bookID, req)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewService) DeleteReview(ctx context.Context, actor reviews.Actor, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, actor, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewServiceMockRecorder) DeleteReview(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewService)(nil).DeleteReview), ctx, actor, id)
+}
+
+// GetReviews mocks base method.
+func (m *MockReviewService) GetReviews(ctx context.Context, bookID uint, query dto.ReviewListQuery) (*dto.ReviewListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", ctx, bookID, query)
+	ret0, _ := ret[0].(*dto.ReviewListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockReviewServiceMockRecorder) GetReviews(ctx, bookID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockReviewService)(nil).GetReviews), ctx, bookID, query)
+}
+
+// Unvote mocks base method.
+func (m *MockReviewService) Unvote(ctx context.Context, userID, id uint) (*dto.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unvote", ctx, userID, id)
+	ret0, _ := ret[0].(*dto.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unvote indicates an expected call of Unvote.
+func (mr *MockReviewServiceMockRecorder) Unvote(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unvote", reflect.TypeOf((*MockReviewService)(nil).Unvote), ctx, userID, id)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewService) UpdateReview(ctx context.Context, actor reviews.Actor, id uint, req dto.ReviewRequest) (*dto.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, actor, id, req)
+	ret0, _ := ret[0].(*dto.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewServiceMockRecorder) UpdateReview(ctx, actor, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewService)(nil).UpdateReview), ctx, actor, id, req)
+}
+
+// Vote mocks base method.
+func (m *MockReviewService) Vote(ctx context.Context, userID, id uint) (*dto.ReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", ctx, userID, id)
+	ret0, _ := ret[0].(*dto.ReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockReviewServiceMockRecorder) Vote(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockReviewService)(nil).Vote), ctx, userID, id)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: D:\WORKOUT\Golang\go-gin\simple-project-go\internal\users\user.service.go
+// Source: internal/users/user.service.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -79,3 +79,40 @@ func (mr *MockUserServiceMockRecorder) Register(ctx, req interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUserService)(nil).Register), ctx, req)
 }
+
+// MockCartMerger is a mock of CartMerger interface.
+type MockCartMerger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartMergerMockRecorder
+}
+
+// MockCartMergerMockRecorder is the mock recorder for MockCartMerger.
+type MockCartMergerMockRecorder struct {
+	mock *MockCartMerger
+}
+
+// NewMockCartMerger creates a new mock instance.
+func NewMockCartMerger(ctrl *gomock.Controller) *MockCartMerger {
+	mock := &MockCartMerger{ctrl: ctrl}
+	mock.recorder = &MockCartMergerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartMerger) EXPECT() *MockCartMergerMockRecorder {
+	return m.recorder
+}
+
+// MergeGuestCart mocks base method.
+func (m *MockCartMerger) MergeGuestCart(ctx context.Context, guestToken string, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuestCart", ctx, guestToken, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuestCart indicates an expected call of MergeGuestCart.
+func (mr *MockCartMergerMockRecorder) MergeGuestCart(ctx, guestToken, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuestCart", reflect.TypeOf((*MockCartMerger)(nil).MergeGuestCart), ctx, guestToken, userID)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/taxes/tax.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	taxes "bookstore-framework/internal/taxes"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaxRepository is a mock of TaxRepository interface.
+type MockTaxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRepositoryMockRecorder
+}
+
+// MockTaxRepositoryMockRecorder is the mock recorder for MockTaxRepository.
+type MockTaxRepositoryMockRecorder struct {
+	mock *MockTaxRepository
+}
+
+// NewMockTaxRepository creates a new mock instance.
+func NewMockTaxRepository(ctrl *gomock.Controller) *MockTaxRepository {
+	mock := &MockTaxRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRepository) EXPECT() *MockTaxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTaxRepository) Create(ctx context.Context, region *taxes.TaxRegion) (*taxes.TaxRegion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, region)
+	ret0, _ := ret[0].(*taxes.TaxRegion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaxRepositoryMockRecorder) Create(ctx, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaxRepository)(nil).Create), ctx, region)
+}
+
+// Delete mocks base method.
+func (m *MockTaxRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockTaxRepository) FindAll(ctx context.Context, filter taxes.TaxRegionFilter) ([]taxes.TaxRegion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]taxes.TaxRegion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTaxRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTaxRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindByID mocks base method.
+func (m *MockTaxRepository) FindByID(ctx context.Context, id uint) (*taxes.TaxRegion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*taxes.TaxRegion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTaxRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTaxRepository)(nil).FindByID), ctx, id)
+}
+
+// FindForDestination mocks base method.
+func (m *MockTaxRepository) FindForDestination(ctx context.Context, country, region string) (*taxes.TaxRegion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForDestination", ctx, country, region)
+	ret0, _ := ret[0].(*taxes.TaxRegion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForDestination indicates an expected call of FindForDestination.
+func (mr *MockTaxRepositoryMockRecorder) FindForDestination(ctx, country, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForDestination", reflect.TypeOf((*MockTaxRepository)(nil).FindForDestination), ctx, country, region)
+}
+
+// Update mocks base method.
+func (m *MockTaxRepository) Update(ctx context.Context, region *taxes.TaxRegion) (*taxes.TaxRegion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, region)
+	ret0, _ := ret[0].(*taxes.TaxRegion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaxRepositoryMockRecorder) Update(ctx, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaxRepository)(nil).Update), ctx, region)
+}
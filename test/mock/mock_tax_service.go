@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/taxes/tax.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	taxes "bookstore-framework/internal/taxes"
+	dto "bookstore-framework/internal/taxes/api/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaxService is a mock of TaxService interface.
+type MockTaxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxServiceMockRecorder
+}
+
+// MockTaxServiceMockRecorder is the mock recorder for MockTaxService.
+type MockTaxServiceMockRecorder struct {
+	mock *MockTaxService
+}
+
+// NewMockTaxService creates a new mock instance.
+func NewMockTaxService(ctrl *gomock.Controller) *MockTaxService {
+	mock := &MockTaxService{ctrl: ctrl}
+	mock.recorder = &MockTaxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxService) EXPECT() *MockTaxServiceMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockTaxService) Calculate(ctx context.Context, destination taxes.Destination, lines []taxes.Line) (*taxes.Calculation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, destination, lines)
+	ret0, _ := ret[0].(*taxes.Calculation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockTaxServiceMockRecorder) Calculate(ctx, destination, lines interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockTaxService)(nil).Calculate), ctx, destination, lines)
+}
+
+// CreateRegion mocks base method.
+func (m *MockTaxService) CreateRegion(ctx context.Context, req dto.TaxRegionRequest) (*dto.TaxRegionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegion", ctx, req)
+	ret0, _ := ret[0].(*dto.TaxRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRegion indicates an expected call of CreateRegion.
+func (mr *MockTaxServiceMockRecorder) CreateRegion(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegion", reflect.TypeOf((*MockTaxService)(nil).CreateRegion), ctx, req)
+}
+
+// DeleteRegion mocks base method.
+func (m *MockTaxService) DeleteRegion(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRegion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRegion indicates an expected call of DeleteRegion.
+func (mr *MockTaxServiceMockRecorder) DeleteRegion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegion", reflect.TypeOf((*MockTaxService)(nil).DeleteRegion), ctx, id)
+}
+
+// GetRegion mocks base method.
+func (m *MockTaxService) GetRegion(ctx context.Context, id uint) (*dto.TaxRegionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegion", ctx, id)
+	ret0, _ := ret[0].(*dto.TaxRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegion indicates an expected call of GetRegion.
+func (mr *MockTaxServiceMockRecorder) GetRegion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegion", reflect.TypeOf((*MockTaxService)(nil).GetRegion), ctx, id)
+}
+
+// GetRegions mocks base method.
+func (m *MockTaxService) GetRegions(ctx context.Context, query dto.TaxRegionListQuery) (*dto.TaxRegionListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegions", ctx, query)
+	ret0, _ := ret[0].(*dto.TaxRegionListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegions indicates an expected call of GetRegions.
+func (mr *MockTaxServiceMockRecorder) GetRegions(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegions", reflect.TypeOf((*MockTaxService)(nil).GetRegions), ctx, query)
+}
+
+// UpdateRegion mocks base method.
+func (m *MockTaxService) UpdateRegion(ctx context.Context, id uint, req dto.TaxRegionRequest) (*dto.TaxRegionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegion", ctx, id, req)
+	ret0, _ := ret[0].(*dto.TaxRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRegion indicates an expected call of UpdateRegion.
+func (mr *MockTaxServiceMockRecorder) UpdateRegion(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegion", reflect.TypeOf((*MockTaxService)(nil).UpdateRegion), ctx, id, req)
+}
@@ -34,16 +34,16 @@ func (m *MockJWTGenerator) EXPECT() *MockJWTGeneratorMockRecorder {
 }
 
 // GenerateToken mocks base method.
-func (m *MockJWTGenerator) GenerateToken(userId uint, username, email string) (string, error) {
+func (m *MockJWTGenerator) GenerateToken(userId uint, username, email, role string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateToken", userId, username, email)
+	ret := m.ctrl.Call(m, "GenerateToken", userId, username, email, role)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateToken indicates an expected call of GenerateToken.
-func (mr *MockJWTGeneratorMockRecorder) GenerateToken(userId, username, email interface{}) *gomock.Call {
+func (mr *MockJWTGeneratorMockRecorder) GenerateToken(userId, username, email, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTGenerator)(nil).GenerateToken), userId, username, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTGenerator)(nil).GenerateToken), userId, username, email, role)
 }
@@ -0,0 +1,203 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/wallet/wallet.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	wallet "bookstore-framework/internal/wallet"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWalletRepository is a mock of WalletRepository interface.
+type MockWalletRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletRepositoryMockRecorder
+}
+
+// MockWalletRepositoryMockRecorder is the mock recorder for MockWalletRepository.
+type MockWalletRepositoryMockRecorder struct {
+	mock *MockWalletRepository
+}
+
+// NewMockWalletRepository creates a new mock instance.
+func NewMockWalletRepository(ctrl *gomock.Controller) *MockWalletRepository {
+	mock := &MockWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletRepository) EXPECT() *MockWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateGiftCard mocks base method.
+func (m *MockWalletRepository) CreateGiftCard(ctx context.Context, card *wallet.GiftCard) (*wallet.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGiftCard", ctx, card)
+	ret0, _ := ret[0].(*wallet.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGiftCard indicates an expected call of CreateGiftCard.
+func (mr *MockWalletRepositoryMockRecorder) CreateGiftCard(ctx, card interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGiftCard", reflect.TypeOf((*MockWalletRepository)(nil).CreateGiftCard), ctx, card)
+}
+
+// FindAccounts mocks base method.
+func (m *MockWalletRepository) FindAccounts(ctx context.Context, keys []string) ([]wallet.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccounts", ctx, keys)
+	ret0, _ := ret[0].([]wallet.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccounts indicates an expected call of FindAccounts.
+func (mr *MockWalletRepositoryMockRecorder) FindAccounts(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccounts", reflect.TypeOf((*MockWalletRepository)(nil).FindAccounts), ctx, keys)
+}
+
+// FindExpiredGiftCards mocks base method.
+func (m *MockWalletRepository) FindExpiredGiftCards(ctx context.Context, now time.Time, limit int) ([]wallet.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredGiftCards", ctx, now, limit)
+	ret0, _ := ret[0].([]wallet.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredGiftCards indicates an expected call of FindExpiredGiftCards.
+func (mr *MockWalletRepositoryMockRecorder) FindExpiredGiftCards(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredGiftCards", reflect.TypeOf((*MockWalletRepository)(nil).FindExpiredGiftCards), ctx, now, limit)
+}
+
+// FindGiftCardByCodeHash mocks base method.
+func (m *MockWalletRepository) FindGiftCardByCodeHash(ctx context.Context, codeHash string) (*wallet.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGiftCardByCodeHash", ctx, codeHash)
+	ret0, _ := ret[0].(*wallet.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGiftCardByCodeHash indicates an expected call of FindGiftCardByCodeHash.
+func (mr *MockWalletRepositoryMockRecorder) FindGiftCardByCodeHash(ctx, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGiftCardByCodeHash", reflect.TypeOf((*MockWalletRepository)(nil).FindGiftCardByCodeHash), ctx, codeHash)
+}
+
+// FindGiftCardByID mocks base method.
+func (m *MockWalletRepository) FindGiftCardByID(ctx context.Context, id uint) (*wallet.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGiftCardByID", ctx, id)
+	ret0, _ := ret[0].(*wallet.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGiftCardByID indicates an expected call of FindGiftCardByID.
+func (mr *MockWalletRepositoryMockRecorder) FindGiftCardByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGiftCardByID", reflect.TypeOf((*MockWalletRepository)(nil).FindGiftCardByID), ctx, id)
+}
+
+// FindGiftCards mocks base method.
+func (m *MockWalletRepository) FindGiftCards(ctx context.Context, offset, limit int) ([]wallet.GiftCard, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGiftCards", ctx, offset, limit)
+	ret0, _ := ret[0].([]wallet.GiftCard)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindGiftCards indicates an expected call of FindGiftCards.
+func (mr *MockWalletRepositoryMockRecorder) FindGiftCards(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGiftCards", reflect.TypeOf((*MockWalletRepository)(nil).FindGiftCards), ctx, offset, limit)
+}
+
+// FindMovements mocks base method.
+func (m *MockWalletRepository) FindMovements(ctx context.Context, userID uint, offset, limit int) ([]wallet.Movement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMovements", ctx, userID, offset, limit)
+	ret0, _ := ret[0].([]wallet.Movement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindMovements indicates an expected call of FindMovements.
+func (mr *MockWalletRepositoryMockRecorder) FindMovements(ctx, userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMovements", reflect.TypeOf((*MockWalletRepository)(nil).FindMovements), ctx, userID, offset, limit)
+}
+
+// FindTransaction mocks base method.
+func (m *MockWalletRepository) FindTransaction(ctx context.Context, txType, reference string) (*wallet.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransaction", ctx, txType, reference)
+	ret0, _ := ret[0].(*wallet.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransaction indicates an expected call of FindTransaction.
+func (mr *MockWalletRepositoryMockRecorder) FindTransaction(ctx, txType, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransaction", reflect.TypeOf((*MockWalletRepository)(nil).FindTransaction), ctx, txType, reference)
+}
+
+// FindTransactionsByPrefix mocks base method.
+func (m *MockWalletRepository) FindTransactionsByPrefix(ctx context.Context, txType, prefix string) ([]wallet.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactionsByPrefix", ctx, txType, prefix)
+	ret0, _ := ret[0].([]wallet.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactionsByPrefix indicates an expected call of FindTransactionsByPrefix.
+func (mr *MockWalletRepositoryMockRecorder) FindTransactionsByPrefix(ctx, txType, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactionsByPrefix", reflect.TypeOf((*MockWalletRepository)(nil).FindTransactionsByPrefix), ctx, txType, prefix)
+}
+
+// FindWallets mocks base method.
+func (m *MockWalletRepository) FindWallets(ctx context.Context, userID uint) ([]wallet.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWallets", ctx, userID)
+	ret0, _ := ret[0].([]wallet.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWallets indicates an expected call of FindWallets.
+func (mr *MockWalletRepositoryMockRecorder) FindWallets(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWallets", reflect.TypeOf((*MockWalletRepository)(nil).FindWallets), ctx, userID)
+}
+
+// Post mocks base method.
+func (m *MockWalletRepository) Post(ctx context.Context, transaction *wallet.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockWalletRepositoryMockRecorder) Post(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockWalletRepository)(nil).Post), ctx, transaction)
+}
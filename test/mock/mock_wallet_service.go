@@ -0,0 +1,228 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/wallet/wallet.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	wallet "bookstore-framework/internal/wallet"
+	dto "bookstore-framework/internal/wallet/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWalletService is a mock of WalletService interface.
+type MockWalletService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletServiceMockRecorder
+}
+
+// MockWalletServiceMockRecorder is the mock recorder for MockWalletService.
+type MockWalletServiceMockRecorder struct {
+	mock *MockWalletService
+}
+
+// NewMockWalletService creates a new mock instance.
+func NewMockWalletService(ctrl *gomock.Controller) *MockWalletService {
+	mock := &MockWalletService{ctrl: ctrl}
+	mock.recorder = &MockWalletServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletService) EXPECT() *MockWalletServiceMockRecorder {
+	return m.recorder
+}
+
+// Charge mocks base method.
+func (m *MockWalletService) Charge(ctx context.Context, userID, orderID uint, funds wallet.Funds) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Charge", ctx, userID, orderID, funds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Charge indicates an expected call of Charge.
+func (mr *MockWalletServiceMockRecorder) Charge(ctx, userID, orderID, funds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charge", reflect.TypeOf((*MockWalletService)(nil).Charge), ctx, userID, orderID, funds)
+}
+
+// CheckGiftCard mocks base method.
+func (m *MockWalletService) CheckGiftCard(ctx context.Context, req dto.GiftCardCodeRequest) (*dto.GiftCardBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckGiftCard", ctx, req)
+	ret0, _ := ret[0].(*dto.GiftCardBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckGiftCard indicates an expected call of CheckGiftCard.
+func (mr *MockWalletServiceMockRecorder) CheckGiftCard(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckGiftCard", reflect.TypeOf((*MockWalletService)(nil).CheckGiftCard), ctx, req)
+}
+
+// CreditRefund mocks base method.
+func (m *MockWalletService) CreditRefund(ctx context.Context, userID uint, currency string, amount int64, reference string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreditRefund", ctx, userID, currency, amount, reference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreditRefund indicates an expected call of CreditRefund.
+func (mr *MockWalletServiceMockRecorder) CreditRefund(ctx, userID, currency, amount, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditRefund", reflect.TypeOf((*MockWalletService)(nil).CreditRefund), ctx, userID, currency, amount, reference)
+}
+
+// ExpireGiftCards mocks base method.
+func (m *MockWalletService) ExpireGiftCards(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireGiftCards", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireGiftCards indicates an expected call of ExpireGiftCards.
+func (mr *MockWalletServiceMockRecorder) ExpireGiftCards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireGiftCards", reflect.TypeOf((*MockWalletService)(nil).ExpireGiftCards), ctx)
+}
+
+// Funds mocks base method.
+func (m *MockWalletService) Funds(ctx context.Context, userID uint, req wallet.FundsRequest) (*wallet.Funds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Funds", ctx, userID, req)
+	ret0, _ := ret[0].(*wallet.Funds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Funds indicates an expected call of Funds.
+func (mr *MockWalletServiceMockRecorder) Funds(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Funds", reflect.TypeOf((*MockWalletService)(nil).Funds), ctx, userID, req)
+}
+
+// GetGiftCard mocks base method.
+func (m *MockWalletService) GetGiftCard(ctx context.Context, id uint) (*dto.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftCard", ctx, id)
+	ret0, _ := ret[0].(*dto.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCard indicates an expected call of GetGiftCard.
+func (mr *MockWalletServiceMockRecorder) GetGiftCard(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCard", reflect.TypeOf((*MockWalletService)(nil).GetGiftCard), ctx, id)
+}
+
+// GetGiftCards mocks base method.
+func (m *MockWalletService) GetGiftCards(ctx context.Context, query pkg.PaginationQuery) (*dto.GiftCardListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftCards", ctx, query)
+	ret0, _ := ret[0].(*dto.GiftCardListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCards indicates an expected call of GetGiftCards.
+func (mr *MockWalletServiceMockRecorder) GetGiftCards(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCards", reflect.TypeOf((*MockWalletService)(nil).GetGiftCards), ctx, query)
+}
+
+// GetMovements mocks base method.
+func (m *MockWalletService) GetMovements(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto.MovementListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovements", ctx, userID, query)
+	ret0, _ := ret[0].(*dto.MovementListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockWalletServiceMockRecorder) GetMovements(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockWalletService)(nil).GetMovements), ctx, userID, query)
+}
+
+// GetWallet mocks base method.
+func (m *MockWalletService) GetWallet(ctx context.Context, userID uint) (*dto.WalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, userID)
+	ret0, _ := ret[0].(*dto.WalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockWalletServiceMockRecorder) GetWallet(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockWalletService)(nil).GetWallet), ctx, userID)
+}
+
+// IssueGiftCard mocks base method.
+func (m *MockWalletService) IssueGiftCard(ctx context.Context, actorID uint, req dto.IssueGiftCardRequest) (*dto.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueGiftCard", ctx, actorID, req)
+	ret0, _ := ret[0].(*dto.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueGiftCard indicates an expected call of IssueGiftCard.
+func (mr *MockWalletServiceMockRecorder) IssueGiftCard(ctx, actorID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueGiftCard", reflect.TypeOf((*MockWalletService)(nil).IssueGiftCard), ctx, actorID, req)
+}
+
+// RedeemGiftCard mocks base method.
+func (m *MockWalletService) RedeemGiftCard(ctx context.Context, userID uint, req dto.GiftCardCodeRequest) (*dto.WalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemGiftCard", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.WalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGiftCard indicates an expected call of RedeemGiftCard.
+func (mr *MockWalletServiceMockRecorder) RedeemGiftCard(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGiftCard", reflect.TypeOf((*MockWalletService)(nil).RedeemGiftCard), ctx, userID, req)
+}
+
+// RefundOrder mocks base method.
+func (m *MockWalletService) RefundOrder(ctx context.Context, userID, orderID uint, amount int64, reference string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, userID, orderID, amount, reference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockWalletServiceMockRecorder) RefundOrder(ctx, userID, orderID, amount, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockWalletService)(nil).RefundOrder), ctx, userID, orderID, amount, reference)
+}
+
+// ReverseOrder mocks base method.
+func (m *MockWalletService) ReverseOrder(ctx context.Context, userID, orderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseOrder", ctx, userID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReverseOrder indicates an expected call of ReverseOrder.
+func (mr *MockWalletServiceMockRecorder) ReverseOrder(ctx, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseOrder", reflect.TypeOf((*MockWalletService)(nil).ReverseOrder), ctx, userID, orderID)
+}
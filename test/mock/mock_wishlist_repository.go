@@ -0,0 +1,228 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/wishlists/wishlist.repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	wishlists "bookstore-framework/internal/wishlists"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWishlistRepository is a mock of WishlistRepository interface.
+type MockWishlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistRepositoryMockRecorder
+}
+
+// MockWishlistRepositoryMockRecorder is the mock recorder for MockWishlistRepository.
+type MockWishlistRepositoryMockRecorder struct {
+	mock *MockWishlistRepository
+}
+
+// NewMockWishlistRepository creates a new mock instance.
+func NewMockWishlistRepository(ctrl *gomock.Controller) *MockWishlistRepository {
+	mock := &MockWishlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWishlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistRepository) EXPECT() *MockWishlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWishlistRepository) Create(ctx context.Context, wishlist *wishlists.Wishlist) (*wishlists.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, wishlist)
+	ret0, _ := ret[0].(*wishlists.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWishlistRepositoryMockRecorder) Create(ctx, wishlist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWishlistRepository)(nil).Create), ctx, wishlist)
+}
+
+// Delete mocks base method.
+func (m *MockWishlistRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWishlistRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWishlistRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteItem mocks base method.
+func (m *MockWishlistRepository) DeleteItem(ctx context.Context, wishlistID, bookID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, wishlistID, bookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockWishlistRepositoryMockRecorder) DeleteItem(ctx, wishlistID, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockWishlistRepository)(nil).DeleteItem), ctx, wishlistID, bookID)
+}
+
+// FindByID mocks base method.
+func (m *MockWishlistRepository) FindByID(ctx context.Context, id uint) (*wishlists.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*wishlists.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWishlistRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWishlistRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByShareToken mocks base method.
+func (m *MockWishlistRepository) FindByShareToken(ctx context.Context, token string) (*wishlists.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByShareToken", ctx, token)
+	ret0, _ := ret[0].(*wishlists.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByShareToken indicates an expected call of FindByShareToken.
+func (mr *MockWishlistRepositoryMockRecorder) FindByShareToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByShareToken", reflect.TypeOf((*MockWishlistRepository)(nil).FindByShareToken), ctx, token)
+}
+
+// FindByUserID mocks base method.
+func (m *MockWishlistRepository) FindByUserID(ctx context.Context, userID uint) ([]wishlists.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]wishlists.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockWishlistRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockWishlistRepository)(nil).FindByUserID), ctx, userID)
+}
+
+// FindNotifications mocks base method.
+func (m *MockWishlistRepository) FindNotifications(ctx context.Context, userID uint, offset, limit int) ([]wishlists.PriceDropNotification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNotifications", ctx, userID, offset, limit)
+	ret0, _ := ret[0].([]wishlists.PriceDropNotification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindNotifications indicates an expected call of FindNotifications.
+func (mr *MockWishlistRepositoryMockRecorder) FindNotifications(ctx, userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotifications", reflect.TypeOf((*MockWishlistRepository)(nil).FindNotifications), ctx, userID, offset, limit)
+}
+
+// FindPublic mocks base method.
+func (m *MockWishlistRepository) FindPublic(ctx context.Context, userID uint, offset, limit int) ([]wishlists.Wishlist, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublic", ctx, userID, offset, limit)
+	ret0, _ := ret[0].([]wishlists.Wishlist)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPublic indicates an expected call of FindPublic.
+func (mr *MockWishlistRepositoryMockRecorder) FindPublic(ctx, userID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublic", reflect.TypeOf((*MockWishlistRepository)(nil).FindPublic), ctx, userID, offset, limit)
+}
+
+// FindRepriced mocks base method.
+func (m *MockWishlistRepository) FindRepriced(ctx context.Context, afterID uint, limit int) ([]wishlists.RepricedItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRepriced", ctx, afterID, limit)
+	ret0, _ := ret[0].([]wishlists.RepricedItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRepriced indicates an expected call of FindRepriced.
+func (mr *MockWishlistRepositoryMockRecorder) FindRepriced(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepriced", reflect.TypeOf((*MockWishlistRepository)(nil).FindRepriced), ctx, afterID, limit)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockWishlistRepository) MarkNotificationsRead(ctx context.Context, userID uint, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockWishlistRepositoryMockRecorder) MarkNotificationsRead(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockWishlistRepository)(nil).MarkNotificationsRead), ctx, userID, now)
+}
+
+// Reprice mocks base method.
+func (m *MockWishlistRepository) Reprice(ctx context.Context, item wishlists.RepricedItem, notification *wishlists.PriceDropNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reprice", ctx, item, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reprice indicates an expected call of Reprice.
+func (mr *MockWishlistRepositoryMockRecorder) Reprice(ctx, item, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reprice", reflect.TypeOf((*MockWishlistRepository)(nil).Reprice), ctx, item, notification)
+}
+
+// SaveItem mocks base method.
+func (m *MockWishlistRepository) SaveItem(ctx context.Context, item *wishlists.WishlistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveItem indicates an expected call of SaveItem.
+func (mr *MockWishlistRepositoryMockRecorder) SaveItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveItem", reflect.TypeOf((*MockWishlistRepository)(nil).SaveItem), ctx, item)
+}
+
+// Update mocks base method.
+func (m *MockWishlistRepository) Update(ctx context.Context, wishlist *wishlists.Wishlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, wishlist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWishlistRepositoryMockRecorder) Update(ctx, wishlist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWishlistRepository)(nil).Update), ctx, wishlist)
+}
@@ -0,0 +1,230 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/wishlists/wishlist.service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	dto "bookstore-framework/internal/carts/api/dto"
+	dto0 "bookstore-framework/internal/wishlists/api/dto"
+	pkg "bookstore-framework/pkg"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWishlistService is a mock of WishlistService interface.
+type MockWishlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistServiceMockRecorder
+}
+
+// MockWishlistServiceMockRecorder is the mock recorder for MockWishlistService.
+type MockWishlistServiceMockRecorder struct {
+	mock *MockWishlistService
+}
+
+// NewMockWishlistService creates a new mock instance.
+func NewMockWishlistService(ctrl *gomock.Controller) *MockWishlistService {
+	mock := &MockWishlistService{ctrl: ctrl}
+	mock.recorder = &MockWishlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistService) EXPECT() *MockWishlistServiceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockWishlistService) AddItem(ctx context.Context, userID, id uint, req dto0.AddWishlistItemRequest) (*dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, userID, id, req)
+	ret0, _ := ret[0].(*dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockWishlistServiceMockRecorder) AddItem(ctx, userID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockWishlistService)(nil).AddItem), ctx, userID, id, req)
+}
+
+// CreateWishlist mocks base method.
+func (m *MockWishlistService) CreateWishlist(ctx context.Context, userID uint, req dto0.WishlistRequest) (*dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWishlist", ctx, userID, req)
+	ret0, _ := ret[0].(*dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWishlist indicates an expected call of CreateWishlist.
+func (mr *MockWishlistServiceMockRecorder) CreateWishlist(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWishlist", reflect.TypeOf((*MockWishlistService)(nil).CreateWishlist), ctx, userID, req)
+}
+
+// DeleteWishlist mocks base method.
+func (m *MockWishlistService) DeleteWishlist(ctx context.Context, userID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWishlist", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWishlist indicates an expected call of DeleteWishlist.
+func (mr *MockWishlistServiceMockRecorder) DeleteWishlist(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWishlist", reflect.TypeOf((*MockWishlistService)(nil).DeleteWishlist), ctx, userID, id)
+}
+
+// GetPriceDrops mocks base method.
+func (m *MockWishlistService) GetPriceDrops(ctx context.Context, userID uint, query pkg.PaginationQuery) (*dto0.PriceDropListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceDrops", ctx, userID, query)
+	ret0, _ := ret[0].(*dto0.PriceDropListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceDrops indicates an expected call of GetPriceDrops.
+func (mr *MockWishlistServiceMockRecorder) GetPriceDrops(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceDrops", reflect.TypeOf((*MockWishlistService)(nil).GetPriceDrops), ctx, userID, query)
+}
+
+// GetPublicWishlists mocks base method.
+func (m *MockWishlistService) GetPublicWishlists(ctx context.Context, query dto0.PublicWishlistQuery) (*dto0.WishlistListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicWishlists", ctx, query)
+	ret0, _ := ret[0].(*dto0.WishlistListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicWishlists indicates an expected call of GetPublicWishlists.
+func (mr *MockWishlistServiceMockRecorder) GetPublicWishlists(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicWishlists", reflect.TypeOf((*MockWishlistService)(nil).GetPublicWishlists), ctx, query)
+}
+
+// GetSharedWishlist mocks base method.
+func (m *MockWishlistService) GetSharedWishlist(ctx context.Context, token string) (*dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedWishlist", ctx, token)
+	ret0, _ := ret[0].(*dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedWishlist indicates an expected call of GetSharedWishlist.
+func (mr *MockWishlistServiceMockRecorder) GetSharedWishlist(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedWishlist", reflect.TypeOf((*MockWishlistService)(nil).GetSharedWishlist), ctx, token)
+}
+
+// GetWishlist mocks base method.
+func (m *MockWishlistService) GetWishlist(ctx context.Context, viewer *uint, id uint) (*dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlist", ctx, viewer, id)
+	ret0, _ := ret[0].(*dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlist indicates an expected call of GetWishlist.
+func (mr *MockWishlistServiceMockRecorder) GetWishlist(ctx, viewer, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlist", reflect.TypeOf((*MockWishlistService)(nil).GetWishlist), ctx, viewer, id)
+}
+
+// GetWishlists mocks base method.
+func (m *MockWishlistService) GetWishlists(ctx context.Context, userID uint) ([]dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlists", ctx, userID)
+	ret0, _ := ret[0].([]dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlists indicates an expected call of GetWishlists.
+func (mr *MockWishlistServiceMockRecorder) GetWishlists(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlists", reflect.TypeOf((*MockWishlistService)(nil).GetWishlists), ctx, userID)
+}
+
+// MarkPriceDropsRead mocks base method.
+func (m *MockWishlistService) MarkPriceDropsRead(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPriceDropsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPriceDropsRead indicates an expected call of MarkPriceDropsRead.
+func (mr *MockWishlistServiceMockRecorder) MarkPriceDropsRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPriceDropsRead", reflect.TypeOf((*MockWishlistService)(nil).MarkPriceDropsRead), ctx, userID)
+}
+
+// MoveToCart mocks base method.
+func (m *MockWishlistService) MoveToCart(ctx context.Context, userID, id, bookID uint, req dto0.MoveToCartRequest) (*dto.CartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToCart", ctx, userID, id, bookID, req)
+	ret0, _ := ret[0].(*dto.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveToCart indicates an expected call of MoveToCart.
+func (mr *MockWishlistServiceMockRecorder) MoveToCart(ctx, userID, id, bookID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToCart", reflect.TypeOf((*MockWishlistService)(nil).MoveToCart), ctx, userID, id, bookID, req)
+}
+
+// NotifyPriceDrops mocks base method.
+func (m *MockWishlistService) NotifyPriceDrops(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyPriceDrops", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyPriceDrops indicates an expected call of NotifyPriceDrops.
+func (mr *MockWishlistServiceMockRecorder) NotifyPriceDrops(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPriceDrops", reflect.TypeOf((*MockWishlistService)(nil).NotifyPriceDrops), ctx)
+}
+
+// RemoveItem mocks base method.
+func (m *MockWishlistService) RemoveItem(ctx context.Context, userID, id, bookID uint) (*dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, userID, id, bookID)
+	ret0, _ := ret[0].(*dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockWishlistServiceMockRecorder) RemoveItem(ctx, userID, id, bookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockWishlistService)(nil).RemoveItem), ctx, userID, id, bookID)
+}
+
+// UpdateWishlist mocks base method.
+func (m *MockWishlistService) UpdateWishlist(ctx context.Context, userID, id uint, req dto0.WishlistRequest) (*dto0.WishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWishlist", ctx, userID, id, req)
+	ret0, _ := ret[0].(*dto0.WishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWishlist indicates an expected call of UpdateWishlist.
+func (mr *MockWishlistServiceMockRecorder) UpdateWishlist(ctx, userID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWishlist", reflect.TypeOf((*MockWishlistService)(nil).UpdateWishlist), ctx, userID, id, req)
+}
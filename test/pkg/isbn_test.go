@@ -0,0 +1,107 @@
+package pkg_test
+
+import (
+	"bookstore-framework/pkg/isbn"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISBN_Success(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		assert.True(t, isbn.Valid("978-0-306-40615-7"))
+		assert.True(t, isbn.Valid("0-306-40615-2"))
+		assert.True(t, isbn.Valid("0-547-92822-x"))
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		normalized, err := isbn.Normalize("0-306-40615-2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "9780306406157", normalized)
+	})
+
+	t.Run("ToISBN10", func(t *testing.T) {
+		isbn10, err := isbn.ToISBN10("978-0-547-92822-7")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "054792822X", isbn10)
+	})
+
+	t.Run("FromBarcode_WithAddOn", func(t *testing.T) {
+		normalized, err := isbn.FromBarcode("978030640615712")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "9780306406157", normalized)
+	})
+
+	t.Run("Hyphenate", func(t *testing.T) {
+		cases := map[string]string{
+			"9780306406157": "978-0-306-40615-7",
+			"0306406152":    "0-306-40615-2",
+			"9783161484100": "978-3-16-148410-0",
+			"9791090636071": "979-10-90636-07-1",
+			// Boundaries of the registrant ranges of the English language groups.
+			"9780227999998": "978-0-227-99999-8",
+			"9780228000006": "978-0-2280-0000-6",
+			"9780648000006": "978-0-6480000-0-6",
+			"9780649000005": "978-0-649-00000-5",
+			"9781731999993": "978-1-73199-999-3",
+			"9781732000001": "978-1-7320000-0-1",
+			"9781739999995": "978-1-7399999-9-5",
+			"9781740000000": "978-1-74000-000-0",
+			"9781973000006": "978-1-9730-0000-6",
+			"9781987799996": "978-1-9877-9999-6",
+			"9781987800005": "978-1-987800-00-5",
+		}
+		for input, expected := range cases {
+			hyphenated, err := isbn.Hyphenate(input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, hyphenated)
+		}
+	})
+}
+
+func TestISBN_Error(t *testing.T) {
+	t.Run("Valid_WrongCheckDigit", func(t *testing.T) {
+		assert.False(t, isbn.Valid("978-0-306-40615-8"))
+		assert.False(t, isbn.Valid("0-306-40615-3"))
+	})
+
+	t.Run("Valid_NotBookland", func(t *testing.T) {
+		assert.False(t, isbn.Valid("9770306406158"))
+	})
+
+	t.Run("Normalize_Garbage", func(t *testing.T) {
+		_, err := isbn.Normalize("97803064O6157")
+
+		assert.ErrorIs(t, err, isbn.ErrInvalid)
+	})
+
+	t.Run("ToISBN10_979Prefix", func(t *testing.T) {
+		_, err := isbn.ToISBN10("9791090636071")
+
+		assert.ErrorIs(t, err, isbn.ErrNotConvertible)
+	})
+
+	t.Run("Hyphenate_UnknownGroup", func(t *testing.T) {
+		_, err := isbn.Hyphenate("9788960000001")
+
+		assert.ErrorIs(t, err, isbn.ErrUnknownRange)
+	})
+
+	t.Run("Hyphenate_UncheckedRange", func(t *testing.T) {
+		_, err := isbn.Hyphenate("9781775000006")
+
+		assert.ErrorIs(t, err, isbn.ErrUnknownRange)
+	})
+
+	t.Run("Hyphenate_FrenchLeftOut", func(t *testing.T) {
+		// 978-2-490000 has a six digit registrant that a coarse range
+		// would split after three.
+		_, err := isbn.Hyphenate("9782490000005")
+
+		assert.ErrorIs(t, err, isbn.ErrUnknownRange)
+	})
+}
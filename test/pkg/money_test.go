@@ -0,0 +1,104 @@
+package pkg_test
+
+import (
+	"bookstore-framework/pkg/money"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_Success(t *testing.T) {
+	t.Run("Convert_RoundsHalfUp", func(t *testing.T) {
+		// 10.99 USD * 0.9215 = 10.127285 EUR
+		converted, err := money.New(1099, "USD").Convert("EUR", big.NewRat(9215, 10000))
+
+		require.NoError(t, err)
+		assert.Equal(t, money.New(1013, "EUR"), converted)
+	})
+
+	t.Run("Convert_ToCurrencyWithoutDecimals", func(t *testing.T) {
+		// 10.99 USD * 150.25 = 1651.2475 JPY
+		converted, err := money.New(1099, "USD").Convert("JPY", big.NewRat(15025, 100))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1651), converted.Amount)
+		assert.Equal(t, "1651 JPY", converted.String())
+	})
+
+	t.Run("Convert_ToCashIncrement", func(t *testing.T) {
+		// 10.99 USD * 0.8812 = 9.684388 CHF, rounded to 5 centimes
+		converted, err := money.New(1099, "USD").Convert("CHF", big.NewRat(8812, 10000))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(970), converted.Amount)
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		assert.Equal(t, "10.99 USD", money.Format(1099, "USD"))
+		assert.Equal(t, "1.500 KWD", money.Format(1500, "KWD"))
+		assert.Equal(t, "-0.05 EUR", money.Format(-5, "EUR"))
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		cases := map[[2]string]int64{
+			{"12.99", "USD"}:   1299,
+			{"12.5", "USD"}:    1250,
+			{"12", "USD"}:      1200,
+			{"1500", "JPY"}:    1500,
+			{"1500.00", "JPY"}: 1500,
+			{"1.250", "KWD"}:   1250,
+		}
+		for input, expected := range cases {
+			amount, err := money.Parse(input[0], input[1])
+
+			require.NoError(t, err, input)
+			assert.Equal(t, expected, amount, input)
+		}
+	})
+
+	t.Run("ParseRate", func(t *testing.T) {
+		rate, err := money.ParseRate(" 0.9215 ")
+
+		require.NoError(t, err)
+		assert.Equal(t, big.NewRat(9215, 10000), rate)
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		currency, ok := money.Lookup("JPY")
+
+		assert.True(t, ok)
+		assert.Zero(t, currency.Exponent)
+	})
+}
+
+func TestMoney_Error(t *testing.T) {
+	t.Run("Parse_Invalid", func(t *testing.T) {
+		for _, input := range [][2]string{{"ten", "USD"}, {"-1.00", "USD"}, {"12.999", "USD"}, {"1500.5", "JPY"}} {
+			_, err := money.Parse(input[0], input[1])
+
+			assert.ErrorIs(t, err, money.ErrInvalidAmount, input)
+		}
+	})
+
+	t.Run("Parse_UnknownCurrency", func(t *testing.T) {
+		_, err := money.Parse("12.99", "XXX")
+
+		assert.ErrorIs(t, err, money.ErrUnknownCurrency)
+	})
+
+	t.Run("ParseRate_Invalid", func(t *testing.T) {
+		for _, rate := range []string{"", "0", "-1.2", "1e3", "1.12345678901", "abc"} {
+			_, err := money.ParseRate(rate)
+
+			assert.ErrorIs(t, err, money.ErrInvalidRate, rate)
+		}
+	})
+
+	t.Run("Convert_UnknownCurrency", func(t *testing.T) {
+		_, err := money.New(1099, "USD").Convert("XYZ", big.NewRat(1, 1))
+
+		assert.ErrorIs(t, err, money.ErrUnknownCurrency)
+	})
+}
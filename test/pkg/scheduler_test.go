@@ -0,0 +1,36 @@
+package pkg_test
+
+import (
+	"bookstore-framework/pkg/scheduler"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_Every(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var runs atomic.Int32
+	done := make(chan struct{})
+
+	go func() {
+		scheduler.Every(ctx, "test", 5*time.Millisecond, func(context.Context) error {
+			// A failing run must not stop the schedule.
+			if runs.Add(1) >= 3 {
+				cancel()
+			}
+			return errors.New("boom")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after the context was cancelled")
+	}
+	assert.GreaterOrEqual(t, runs.Load(), int32(3))
+}
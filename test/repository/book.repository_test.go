@@ -0,0 +1,175 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/books"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestBookRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := books.NewBookRepository(gormDB)
+
+	t.Run("Create", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "books"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"currency", "id"}).AddRow("USD", 1))
+
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "book_authors" ("book_id","author_id","role","position") VALUES ($1,$2,$3,$4)`)).
+			WithArgs(1, 7, "author", 0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := repo.Create(context.Background(), &books.Book{
+			Title:   "The Hobbit",
+			ISBN:    "9780547928227",
+			Authors: []books.BookAuthor{{AuthorID: 7, Role: "author"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), result.ID)
+		assert.Equal(t, uint(1), result.Authors[0].BookID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindAll", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "books" WHERE language = $1 AND "books"."deleted_at" IS NULL`)).
+			WithArgs("en").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "books" WHERE language = $1 AND "books"."deleted_at" IS NULL ORDER BY id LIMIT $2`)).
+			WithArgs("en", 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "isbn"}).AddRow(1, "The Hobbit", "9780547928227"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "book_authors" WHERE "book_authors"."book_id" = $1 ORDER BY position`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "author_id", "role", "position"}).AddRow(1, 7, "author", 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "authors" WHERE "authors"."id" = $1 AND "authors"."deleted_at" IS NULL`)).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "J. R. R. Tolkien"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "book_categories" WHERE "book_categories"."book_id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "category_id"}).AddRow(1, 4))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1`)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "slug", "path"}).AddRow(4, "Fantasy", "fantasy", "1/4/"))
+
+		result, total, err := repo.FindAll(context.Background(), books.BookFilter{Language: "en", Limit: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "J. R. R. Tolkien", result[0].Authors[0].Author.Name)
+		assert.Equal(t, "fantasy", result[0].Categories[0].Slug)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindAll_ByAuthor", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "books" WHERE id IN (SELECT "book_id" FROM "book_authors" WHERE author_id = $1) AND "books"."deleted_at" IS NULL`)).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "books" WHERE id IN (SELECT "book_id" FROM "book_authors" WHERE author_id = $1)`)).
+			WithArgs(7, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		result, total, err := repo.FindAll(context.Background(), books.BookFilter{AuthorID: 7, Limit: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindAll_ByCategorySubtree", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "books" WHERE id IN (SELECT book_categories.book_id FROM "book_categories" JOIN categories ON categories.id = book_categories.category_id WHERE categories.path LIKE $1)`)).
+			WithArgs("1/4/%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "books" WHERE id IN (SELECT book_categories.book_id`)).
+			WithArgs("1/4/%", 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, total, err := repo.FindAll(context.Background(), books.BookFilter{CategoryPath: "1/4/", Limit: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM books, (SELECT config, to_tsquery(config, $1) AS query FROM (SELECT catalog_search_config($2) AS config) c) q WHERE books.search_vector @@ q.query AND books.deleted_at IS NULL AND books.language = $3`)).
+			WithArgs("hobbit:* & tolk:*", "en", "en").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectQuery(`SELECT books.id,\s+ts_rank\(books.search_vector, q.query\) AS rank,.+ORDER BY rank DESC, books.id LIMIT \$4`).
+			WithArgs("hobbit:* & tolk:*", "en", "en", 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "rank", "title_highlight", "snippet"}).
+				AddRow(2, 0.9, "The <mark>Hobbit</mark>", "").
+				AddRow(1, 0.3, "The Lord of the Rings", "Sequel to The <mark>Hobbit</mark>"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "books" WHERE "books"."id" IN ($1,$2) AND "books"."deleted_at" IS NULL`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "The Lord of the Rings").AddRow(2, "The Hobbit"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "book_authors"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "author_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "book_categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "category_id"}))
+
+		result, total, err := repo.Search(context.Background(), books.BookSearchFilter{
+			Terms:          []string{"hobbit", "tolk"},
+			SearchLanguage: "en",
+			Language:       "en",
+			Limit:          20,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "The Hobbit", result[0].Book.Title)
+		assert.Equal(t, "The <mark>Hobbit</mark>", result[0].TitleHighlight)
+		assert.Equal(t, "The Lord of the Rings", result[1].Book.Title)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "books" SET "deleted_at"=$1 WHERE "books"."id" = $2 AND "books"."deleted_at" IS NULL`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SetCover", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "books" SET "cover_height"=$1,"cover_key"=$2,"cover_width"=$3 WHERE id = $4 AND deleted_at IS NULL`)).
+			WithArgs(600, "covers/1/abc/original.png", 400, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.SetCover(context.Background(), 1, "covers/1/abc/original.png", 400, 600)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBookRepository_Error(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := books.NewBookRepository(gormDB)
+
+	t.Run("Delete_NotFound", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "books" SET "deleted_at"=$1`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.Delete(context.Background(), 42)
+
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/carts"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := carts.NewCartRepository(gormDB)
+	expiresAt := time.Now().Add(carts.UserCartTTL)
+
+	t.Run("Merge_IntoUserCart", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "carts" WHERE token = $1 AND user_id IS NULL AND expires_at > $2 ORDER BY "carts"."id" LIMIT $3 FOR UPDATE`)).
+			WithArgs("guest-token", sqlmock.AnyArg(), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "token", "expires_at"}).AddRow(3, "guest-token", expiresAt))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "carts" WHERE user_id = $1 ORDER BY "carts"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(7, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "expires_at"}).AddRow(4, 7, expiresAt))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO cart_items (cart_id, book_id, quantity, unit_price, created_at, modified_at)`)).
+			WithArgs(4, 3, carts.MaxItemQuantity).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "carts" WHERE "carts"."id" = $1`)).
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "carts" SET "expires_at"=$1,"modified_at"=$2 WHERE "id" = $3`)).
+			WithArgs(expiresAt, sqlmock.AnyArg(), 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Merge(context.Background(), "guest-token", 7, expiresAt, carts.MaxItemQuantity)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Merge_TakesOverGuestCart", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "carts" WHERE token = $1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "token", "expires_at"}).AddRow(3, "guest-token", expiresAt))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "carts" WHERE user_id = $1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "carts" SET "expires_at"=$1,"user_id"=$2,"modified_at"=$3 WHERE "id" = $4`)).
+			WithArgs(expiresAt, 7, sqlmock.AnyArg(), 3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Merge(context.Background(), "guest-token", 7, expiresAt, carts.MaxItemQuantity)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteExpired", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "carts" WHERE expires_at <= $1`)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		purged, err := repo.DeleteExpired(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
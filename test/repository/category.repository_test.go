@@ -0,0 +1,86 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/books"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := books.NewCategoryRepository(gormDB)
+	columns := []string{"id", "parent_id", "name", "slug", "path", "depth"}
+
+	t.Run("Create", func(t *testing.T) {
+		parentID := uint(1)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1 ORDER BY "categories"."id" LIMIT $2 FOR SHARE`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, nil, "Fiction", "fiction", "1/", 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "categories" SET "path"=$1,"modified_at"=$2 WHERE "id" = $3`)).
+			WithArgs("1/2/", sqlmock.AnyArg(), 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := repo.Create(context.Background(), &books.Category{ParentID: &parentID, Name: "Fantasy", Slug: "fantasy"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1/2/", result.Path)
+		assert.Equal(t, 1, result.Depth)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1 ORDER BY "categories"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 1, "Fantasy", "fantasy", "1/2/", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1 ORDER BY "categories"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(4, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(4, 5, "Genre", "genre", "5/4/", 1))
+		mock.ExpectExec(`UPDATE categories\s+SET path = \$1 \|\| substr\(path, \$2\), depth = depth \+ \$3`).
+			WithArgs("5/4/2/", 5, 1, "1/2/%").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "categories" SET "parent_id"=$1`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		category := &books.Category{ID: 2, Path: "1/2/", Depth: 1}
+		result, err := repo.Move(context.Background(), category, &books.Category{ID: 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "5/4/2/", result.Path)
+		assert.Equal(t, 2, result.Depth)
+		assert.Equal(t, uint(4), *result.ParentID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepository_Error(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := books.NewCategoryRepository(gormDB)
+	columns := []string{"id", "parent_id", "name", "slug", "path", "depth"}
+
+	t.Run("Move_BelowDescendant", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 1, "Fantasy", "fantasy", "1/2/", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WithArgs(3, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(3, 2, "Epic", "epic", "1/2/3/", 2))
+		mock.ExpectRollback()
+
+		result, err := repo.Move(context.Background(), &books.Category{ID: 2}, &books.Category{ID: 3})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrCategoryCycle)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,49 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/exports"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := exports.NewExportRepository(gormDB)
+
+	t.Run("StreamBooks_UpdatedSince", func(t *testing.T) {
+		since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		deletedAt := since.Add(time.Hour)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "books" WHERE (deleted_at IS NULL AND modified_at >= $1) OR deleted_at >= $2 ORDER BY "books"."id" LIMIT $3`)).
+			WithArgs(since, since, 500).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "isbn", "sku", "title", "deleted_at"}).
+				AddRow(1, "9780547928227", "9780547928227", "The Hobbit", nil).
+				AddRow(2, "9780306406157", "9780306406157", "Withdrawn", deletedAt))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "book_authors" WHERE "book_authors"."book_id" IN ($1,$2) ORDER BY position`)).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "author_id", "role", "position"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "book_categories" WHERE "book_categories"."book_id" IN ($1,$2)`)).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "category_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT sku, SUM(on_hand) AS on_hand FROM "stock_levels" WHERE sku IN ($1,$2) GROUP BY "sku"`)).
+			WithArgs("9780547928227", "9780306406157").
+			WillReturnRows(sqlmock.NewRows([]string{"sku", "on_hand"}).AddRow("9780547928227", 7))
+
+		var rows []exports.ExportRow
+		err := repo.StreamBooks(context.Background(), exports.ExportFilter{UpdatedSince: &since}, func(batch []exports.ExportRow) error {
+			rows = append(rows, batch...)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, 7, rows[0].OnHand)
+		assert.False(t, rows[0].Deleted)
+		assert.Equal(t, 0, rows[1].OnHand)
+		assert.True(t, rows[1].Deleted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/imports"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := imports.NewImportRepository(gormDB)
+
+	t.Run("UpsertBatch", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "publishers" ("name","website","country","created_at","modified_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("name") DO UPDATE SET "deleted_at"=$7 RETURNING "id"`)).
+			WithArgs("Houghton Mifflin", "", "", sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "authors" WHERE name IN ($1) AND "authors"."deleted_at" IS NULL ORDER BY id`)).
+			WithArgs("J. R. R. Tolkien").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "J. R. R. Tolkien"))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "books"`) + `.+` +
+			regexp.QuoteMeta(`ON CONFLICT ("isbn") DO UPDATE SET "title"="excluded"."title"`) + `.+` +
+			regexp.QuoteMeta(`"deleted_at"=$`)).
+			WillReturnRows(sqlmock.NewRows([]string{"currency", "id"}).AddRow("USD", 12))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "book_authors" WHERE book_id IN ($1)`)).
+			WithArgs(12).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "book_authors" ("book_id","author_id","role","position") VALUES ($1,$2,$3,$4)`)).
+			WithArgs(12, 7, "author", 0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.UpsertBatch(context.Background(), []imports.Record{{
+			Row:          2,
+			ISBN:         "9780547928227",
+			Title:        "The Hobbit",
+			Price:        1099,
+			Currency:     "USD",
+			Publisher:    "Houghton Mifflin",
+			Contributors: []imports.Contributor{{Name: "J. R. R. Tolkien", Role: "author"}},
+		}})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,237 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/inventory"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := inventory.NewInventoryRepository(gormDB)
+
+	t.Run("RecordMovements_Receipt", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO stock_levels (sku, location_id, book_id, on_hand, updated_at)`)).
+			WithArgs("9780547928227", 1, 1, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"on_hand"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		result, err := repo.RecordMovements(context.Background(), []inventory.StockMovement{{
+			SKU: "9780547928227", LocationID: 1, BookID: 1, Type: inventory.MovementReceipt, Quantity: 5,
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, result[0].BalanceAfter)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordMovements_Sale", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE stock_levels SET on_hand = on_hand + $1, updated_at = now()`)).
+			WithArgs(-1, "9780547928227", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"on_hand"}).AddRow(4))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectCommit()
+
+		result, err := repo.RecordMovements(context.Background(), []inventory.StockMovement{{
+			SKU: "9780547928227", LocationID: 1, BookID: 1, Type: inventory.MovementSale, Quantity: -1,
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result[0].BalanceAfter)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reserve", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT on_hand FROM stock_levels WHERE sku = $1 FOR UPDATE`)).
+			WithArgs("9780547928227").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(quantity), 0) FROM "stock_reservations" WHERE sku = $1 AND status IN ($2,$3)`)).
+			WithArgs("9780547928227", inventory.ReservationActive, inventory.ReservationWaiting).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(3))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_reservations"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		result, err := repo.Reserve(context.Background(), []inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 2, Reference: "order:42",
+		}})
+
+		assert.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, inventory.ReservationActive, result[0].Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reserve_BackorderWaitsForTheRest", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT on_hand FROM stock_levels WHERE sku = $1 FOR UPDATE`)).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(quantity), 0) FROM "stock_reservations"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(4))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_reservations" ("sku","book_id","quantity","status","reference","created_at","modified_at")`)).
+			WithArgs("9780547928227", 1, 1, inventory.ReservationActive, "order:42", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_reservations"`)).
+			WithArgs("9780547928227", 1, 2, inventory.ReservationWaiting, "order:42", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectCommit()
+
+		result, err := repo.Reserve(context.Background(), []inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 3, Reference: "order:42", Backorder: true,
+		}})
+
+		assert.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, inventory.ReservationWaiting, result[1].Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Allocate_OldestFirst", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT on_hand FROM stock_levels WHERE sku = $1 FOR UPDATE`)).
+			WithArgs("9780547928227").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(4))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(quantity), 0) FROM "stock_reservations" WHERE sku = $1 AND status IN ($2)`)).
+			WithArgs("9780547928227", inventory.ReservationActive).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "stock_reservations" WHERE sku = $1 AND status = $2 ORDER BY id FOR UPDATE`)).
+			WithArgs("9780547928227", inventory.ReservationWaiting).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "book_id", "quantity", "status", "reference"}).
+				AddRow(2, "9780547928227", 1, 2, inventory.ReservationWaiting, "order:42").
+				AddRow(3, "9780547928227", 1, 2, inventory.ReservationWaiting, "order:43"))
+		// The oldest backorder gets all it waits for, the next what is left.
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "stock_reservations" SET "status"=$1,"modified_at"=$2 WHERE "id" = $3`)).
+			WithArgs(inventory.ReservationActive, sqlmock.AnyArg(), 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "stock_reservations" SET "quantity"=$1,"modified_at"=$2 WHERE "id" = $3`)).
+			WithArgs(1, sqlmock.AnyArg(), 3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_reservations"`)).
+			WithArgs("9780547928227", 1, 1, inventory.ReservationActive, "order:43", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT "reference" FROM "stock_reservations" WHERE reference IN ($1,$2) AND status = $3`)).
+			WithArgs("order:42", "order:43", inventory.ReservationWaiting).
+			WillReturnRows(sqlmock.NewRows([]string{"reference"}).AddRow("order:43"))
+		mock.ExpectCommit()
+
+		filled, err := repo.Allocate(context.Background(), "9780547928227")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"order:42"}, filled)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindAllocatableSKUs", func(t *testing.T) {
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT stock_reservations.sku FROM "stock_reservations" JOIN books ON books.id = stock_reservations.book_id WHERE stock_reservations.status = $1 AND (books.release_date IS NULL OR books.release_date <= $2) ORDER BY stock_reservations.sku`)).
+			WithArgs(inventory.ReservationWaiting, now).
+			WillReturnRows(sqlmock.NewRows([]string{"sku"}).AddRow("9780547928227"))
+
+		skus, err := repo.FindAllocatableSKUs(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"9780547928227"}, skus)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CommitReservations", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "stock_reservations" WHERE reference = $1 AND status = $2 ORDER BY sku FOR UPDATE`)).
+			WithArgs("order:42", inventory.ReservationActive).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "book_id", "quantity", "status", "reference"}).
+				AddRow(1, "9780547928227", 1, 3, inventory.ReservationActive, "order:42"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "stock_levels" WHERE sku = $1 AND on_hand > 0 ORDER BY on_hand DESC, location_id FOR UPDATE`)).
+			WithArgs("9780547928227").
+			WillReturnRows(sqlmock.NewRows([]string{"sku", "location_id", "book_id", "on_hand"}).
+				AddRow("9780547928227", 2, 1, 2).
+				AddRow("9780547928227", 1, 1, 1))
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE stock_levels SET on_hand = on_hand + $1`)).
+			WithArgs(-2, "9780547928227", 2, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"on_hand"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE stock_levels SET on_hand = on_hand + $1`)).
+			WithArgs(-1, "9780547928227", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"on_hand"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "stock_reservations" SET "status"=$1,"modified_at"=$2 WHERE reference = $3 AND status = $4`)).
+			WithArgs(inventory.ReservationCommitted, sqlmock.AnyArg(), "order:42", inventory.ReservationActive).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.CommitReservations(context.Background(), "order:42", nil)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestInventoryRepository_Error(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := inventory.NewInventoryRepository(gormDB)
+
+	t.Run("RecordMovements_LastCopyAlreadySold", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE stock_levels SET on_hand = on_hand + $1`)).
+			WithArgs(-1, "9780547928227", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"on_hand"}))
+		mock.ExpectRollback()
+
+		result, err := repo.RecordMovements(context.Background(), []inventory.StockMovement{{
+			SKU: "9780547928227", LocationID: 1, BookID: 1, Type: inventory.MovementSale, Quantity: -1,
+		}})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordMovements_TransferRollsBackBothLegs", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE stock_levels SET on_hand = on_hand + $1`)).
+			WithArgs(-3, "9780547928227", 1, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"on_hand"}))
+		mock.ExpectRollback()
+
+		result, err := repo.RecordMovements(context.Background(), []inventory.StockMovement{
+			{SKU: "9780547928227", LocationID: 1, BookID: 1, Type: inventory.MovementTransferOut, Quantity: -3},
+			{SKU: "9780547928227", LocationID: 2, BookID: 1, Type: inventory.MovementTransferIn, Quantity: 3},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reserve_HeldByOtherOrders", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT on_hand FROM stock_levels WHERE sku = $1 FOR UPDATE`)).
+			WithArgs("9780547928227").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(quantity), 0) FROM "stock_reservations"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(4))
+		mock.ExpectRollback()
+
+		result, err := repo.Reserve(context.Background(), []inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 2, Reference: "order:43",
+		}})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
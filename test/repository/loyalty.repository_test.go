@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/loyalty"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoyaltyRepository(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := loyalty.NewLoyaltyRepository(gormDB)
+	now := time.Now()
+
+	t.Run("Record_RedeemMoreThanBalance", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loyalty_accounts" ("balance","earned","modified_at","user_id") VALUES ($1,$2,$3,$4) ON CONFLICT DO NOTHING`)).
+			WithArgs(0, 0, sqlmock.AnyArg(), 7).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loyalty_accounts" WHERE user_id = $1 ORDER BY "loyalty_accounts"."user_id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(7, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "balance", "earned"}).AddRow(7, 300, 300))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loyalty_entries" WHERE user_id = $1 AND remaining > 0 AND expires_at <= $2 ORDER BY id FOR UPDATE`)).
+			WithArgs(7, now).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		err := repo.Record(context.Background(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryRedeem, Reference: "order:42", Points: -500,
+		}, now)
+
+		assert.ErrorIs(t, err, loyalty.ErrInsufficientPoints)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Record_CreditExpiresAfterLifetime", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loyalty_accounts"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "loyalty_accounts" WHERE user_id = $1`)).
+			WithArgs(7, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "balance", "earned"}).AddRow(7, 300, 300))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "loyalty_entries" ("user_id","type","reference","points","remaining","expires_at","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+			WithArgs(7, loyalty.EntryPurchase, "order:42", 25, 25, now.Add(loyalty.PointsLifetime), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "loyalty_accounts" SET "balance"=$1,"earned"=$2,"modified_at"=$3 WHERE "user_id" = $4`)).
+			WithArgs(325, 325, sqlmock.AnyArg(), 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Record(context.Background(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryPurchase, Reference: "order:42", Points: 25,
+		}, now)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
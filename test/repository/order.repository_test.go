@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/pkg"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := orders.NewOrderRepository(gormDB)
+	transactor := pkg.NewTransactor(gormDB)
+
+	t.Run("UpdateStatus_JoinsOuterTransaction", func(t *testing.T) {
+		actorID := uint(2)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "orders" WHERE "orders"."id" = $1 ORDER BY "orders"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(42, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "status"}).AddRow(42, 7, orders.StatusPending))
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "status"=$1,"modified_at"=$2 WHERE "id" = $3`)).
+			WithArgs(orders.StatusPaid, sqlmock.AnyArg(), 42).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "order_transitions" ("order_id","from_status","to_status","actor_id","note","created_at")`)).
+			WithArgs(42, orders.StatusPending, orders.StatusPaid, actorID, "", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		err := transactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+			order, err := repo.FindByIDForUpdate(ctx, 42)
+			if err != nil {
+				return err
+			}
+			order.Status = orders.StatusPaid
+			return repo.UpdateStatus(ctx, order, &orders.OrderTransition{
+				OrderID: 42, FromStatus: orders.StatusPending, ToStatus: orders.StatusPaid, ActorID: &actorID,
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("SetBackordered_OnlyWhenChanged", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "backordered"=$1,"modified_at"=$2 WHERE id = $3 AND backordered = $4`)).
+			WithArgs(false, sqlmock.AnyArg(), 42, true).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		changed, err := repo.SetBackordered(context.Background(), 42, false)
+
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/payments"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := payments.NewPaymentRepository(gormDB)
+
+	t.Run("RecordEvent", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "payment_events" ("provider","event_id","type","intent_id","created_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT DO NOTHING RETURNING "id"`)).
+			WithArgs("fake", "evt_1", payments.EventPaymentSucceeded, "pi_1", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		fresh, err := repo.RecordEvent(context.Background(), &payments.PaymentEvent{
+			Provider: "fake", EventID: "evt_1", Type: payments.EventPaymentSucceeded, IntentID: "pi_1",
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, fresh)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecordEvent_Replayed", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "payment_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		fresh, err := repo.RecordEvent(context.Background(), &payments.PaymentEvent{
+			Provider: "fake", EventID: "evt_1", Type: payments.EventPaymentSucceeded, IntentID: "pi_1",
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, fresh)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindByIntentIDForUpdate", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE provider = $1 AND intent_id = $2 ORDER BY "payments"."id" LIMIT $3 FOR UPDATE`)).
+			WithArgs("fake", "pi_1", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "intent_id", "status"}).
+				AddRow(1, 42, "pi_1", payments.PaymentPending))
+
+		payment, err := repo.FindByIntentIDForUpdate(context.Background(), "fake", "pi_1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(42), payment.OrderID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindStale", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE status IN ($1,$2) AND modified_at < $3 ORDER BY modified_at LIMIT $4`)).
+			WithArgs(payments.PaymentPending, payments.PaymentAuthorized, sqlmock.AnyArg(), 100).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "intent_id", "status"}).
+				AddRow(1, "pi_1", payments.PaymentAuthorized))
+
+		result, err := repo.FindStale(context.Background(), time.Now(), 100)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
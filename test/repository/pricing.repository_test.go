@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/pricing"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPricingRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := pricing.NewPricingRepository(gormDB)
+
+	t.Run("FindRates_BothDirections", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exchange_rates" WHERE (base = $1 AND quote IN ($2,$3)) OR (quote = $4 AND base IN ($5,$6))`)).
+			WithArgs("EUR", "USD", "GBP", "EUR", "USD", "GBP").
+			WillReturnRows(sqlmock.NewRows([]string{"base", "quote", "rate"}).
+				AddRow("USD", "EUR", "0.9200000000").
+				AddRow("EUR", "GBP", "0.8500000000"))
+
+		rates, err := repo.FindRates(context.Background(), "EUR", []string{"USD", "GBP"})
+
+		assert.NoError(t, err)
+		assert.Len(t, rates, 2)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MissingBooks", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "books" WHERE id IN ($1,$2,$3) AND deleted_at IS NULL`)).
+			WithArgs(1, 2, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3))
+
+		missing, err := repo.MissingBooks(context.Background(), []uint{1, 2, 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{2}, missing)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPricingRepository_Error(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := pricing.NewPricingRepository(gormDB)
+
+	t.Run("DeletePrice_NotFound", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "book_prices" WHERE currency = $1 AND book_id = $2`)).
+			WithArgs("GBP", 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.DeletePrice(context.Background(), "GBP", 9)
+
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/promotions"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromotionRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := promotions.NewPromotionRepository(gormDB)
+
+	t.Run("Redeem", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotions" WHERE "promotions"."id" = $1 ORDER BY "promotions"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "usage_limit", "usage_count", "per_user_limit"}).AddRow(2, 100, 99, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "promotion_redemptions" WHERE promotion_id = $1 AND user_id = $2`)).
+			WithArgs(2, 7).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "promotions" SET "usage_count"=usage_count + 1 WHERE "id" = $1`)).
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "promotion_redemptions"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		err := repo.Redeem(context.Background(), &promotions.PromotionRedemption{PromotionID: 2, OrderID: 42, UserID: 7})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReleaseRedemptions", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM "promotion_redemptions" WHERE order_id = $1 RETURNING "promotion_id"`)).
+			WithArgs(42).
+			WillReturnRows(sqlmock.NewRows([]string{"promotion_id"}).AddRow(2).AddRow(5))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "promotions" SET "usage_count"=usage_count - 1 WHERE id IN ($1,$2) AND usage_count > 0`)).
+			WithArgs(2, 5).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.ReleaseRedemptions(context.Background(), 42)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPromotionRepository_Error(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := promotions.NewPromotionRepository(gormDB)
+
+	t.Run("Redeem_LastRedemptionTaken", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "promotions" WHERE "promotions"."id" = $1 ORDER BY "promotions"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "usage_limit", "usage_count"}).AddRow(2, 100, 100))
+		mock.ExpectRollback()
+
+		err := repo.Redeem(context.Background(), &promotions.PromotionRedemption{PromotionID: 2, OrderID: 43, UserID: 8})
+
+		assert.ErrorIs(t, err, promotions.ErrUsageLimitReached)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
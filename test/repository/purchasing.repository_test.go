@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/purchasing"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPurchasingRepository(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := purchasing.NewPurchasingRepository(gormDB)
+
+	t.Run("DeleteSupplierItem_NotInCatalog", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "supplier_items" WHERE supplier_id = $1 AND book_id = $2`)).
+			WithArgs(2, 8).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.DeleteSupplierItem(context.Background(), 2, 8)
+
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindPurchaseOrderForUpdate_LocksOnlyTheOrder", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM "purchase_orders" LEFT JOIN "suppliers" "Supplier" ON "purchase_orders"."supplier_id" = "Supplier"."id" WHERE purchase_orders.id = \$1 ORDER BY "purchase_orders"."id" LIMIT \$2 FOR UPDATE OF "purchase_orders"`).
+			WithArgs(9, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "supplier_id", "status", "Supplier__id", "Supplier__email"}).
+				AddRow(9, 2, purchasing.StatusSent, 2, "orders@northernbooks.example"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "purchase_order_items" WHERE "purchase_order_items"."purchase_order_id" = $1 ORDER BY purchase_order_items.id`)).
+			WithArgs(9).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "purchase_order_id", "book_id", "quantity"}).AddRow(1, 9, 1, 20))
+
+		order, err := repo.FindPurchaseOrderForUpdate(context.Background(), 9)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "orders@northernbooks.example", order.Supplier.Email)
+		assert.Len(t, order.Items, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/recommendations"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecommendationRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := recommendations.NewRecommendationRepository(gormDB)
+
+	t.Run("PurchasePairs", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`WITH events AS (SELECT DISTINCT orders.user_id, order_items.book_id FROM order_items
+JOIN orders ON orders.id = order_items.order_id WHERE orders.status IN ($1,$2,$3,$4))
+SELECT a.book_id, b.book_id AS neighbour_id, COUNT(*) AS count
+FROM events a JOIN events b ON a.user_id = b.user_id AND a.book_id <> b.book_id
+GROUP BY a.book_id, b.book_id HAVING COUNT(*) >= $5`)).
+			WithArgs("paid", "fulfilling", "shipped", "delivered", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "neighbour_id", "count"}).AddRow(1, 2, 3).AddRow(2, 1, 3))
+
+		pairs, err := repo.PurchasePairs(context.Background(), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []recommendations.Pair{{BookID: 1, NeighbourID: 2, Count: 3}, {BookID: 2, NeighbourID: 1, Count: 3}}, pairs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReplaceNeighbours", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "book_neighbours"`)).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "book_neighbours" ("book_id","neighbour_id","score","co_purchases","co_views","computed_at") VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,$12)`)).
+			WithArgs(1, 2, 0.7, 3, 0, now, 2, 1, 0.7, 3, 0, now).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.ReplaceNeighbours(context.Background(), []recommendations.BookNeighbour{
+			{BookID: 1, NeighbourID: 2, Score: 0.7, CoPurchases: 3, ComputedAt: now},
+			{BookID: 2, NeighbourID: 1, Score: 0.7, CoPurchases: 3, ComputedAt: now},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindNeighboursOf", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT neighbour_id, SUM(score) AS score, SUM(co_purchases) AS co_purchases, SUM(co_views) AS co_views FROM "book_neighbours" WHERE (book_id IN ($1,$2) AND neighbour_id NOT IN ($3,$4)) AND (EXISTS (SELECT 1 FROM books WHERE books.id = book_neighbours.neighbour_id AND books.deleted_at IS NULL)) GROUP BY "neighbour_id" ORDER BY score DESC, neighbour_id LIMIT $5`)).
+			WithArgs(1, 2, 1, 2, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"neighbour_id", "score", "co_purchases", "co_views"}).AddRow(3, 1.2, 5, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "books" WHERE id IN ($1) AND "books"."deleted_at" IS NULL`)).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "price", "currency"}).AddRow(3, "The Silmarillion", 1499, "USD"))
+
+		neighbours, err := repo.FindNeighboursOf(context.Background(), []uint{1, 2}, 10)
+
+		assert.NoError(t, err)
+		if assert.Len(t, neighbours, 1) {
+			assert.Equal(t, uint(3), neighbours[0].NeighbourID)
+			assert.Equal(t, 1.2, neighbours[0].Score)
+			assert.Equal(t, "The Silmarillion", neighbours[0].Neighbour.Title)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
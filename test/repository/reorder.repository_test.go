@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/purchasing"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReorderRepository(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := purchasing.NewReorderRepository(gormDB)
+
+	t.Run("OnOrderBySKU_CountsOutstandingCopies", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT purchase_order_items.sku, SUM(purchase_order_items.quantity - purchase_order_items.received_quantity) AS on_order FROM "purchase_order_items" JOIN purchase_orders ON purchase_orders.id = purchase_order_items.purchase_order_id WHERE purchase_order_items.sku IN ($1,$2) AND purchase_orders.status IN ($3,$4,$5) GROUP BY "purchase_order_items"."sku"`)).
+			WithArgs("SKU-1", "SKU-2", purchasing.StatusDraft, purchasing.StatusSent, purchasing.StatusPartiallyReceived).
+			WillReturnRows(sqlmock.NewRows([]string{"sku", "on_order"}).AddRow("SKU-1", 12))
+
+		onOrder, err := repo.OnOrderBySKU(context.Background(), []string{"SKU-1", "SKU-2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"SKU-1": 12}, onOrder)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindAlerts_Open", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "low_stock_alerts" WHERE resolved_at IS NULL`)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "low_stock_alerts" WHERE resolved_at IS NULL ORDER BY created_at DESC, id DESC LIMIT $1`)).
+			WithArgs(20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "suggested_quantity"}).AddRow(4, "SKU-1", 75))
+
+		alerts, total, err := repo.FindAlerts(context.Background(), purchasing.AlertFilter{Status: purchasing.AlertOpen, Limit: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, alerts, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotifyStaff", func(t *testing.T) {
+		now := time.Now()
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO stock_notifications (user_id, type, message, created_at)
+		SELECT id, $1, $2, $3 FROM users WHERE role = $4 AND deleted_at IS NULL`)).
+			WithArgs(purchasing.NotificationLowStock, "A SKU fell to its reorder point.", now, "staff").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		sent, err := repo.NotifyStaff(context.Background(), purchasing.NotificationLowStock, "A SKU fell to its reorder point.", now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
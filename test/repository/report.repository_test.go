@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/reports"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportRepository(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := reports.NewReportRepository(gormDB)
+	filter := reports.SalesFilter{
+		Start:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		Timezone: "UTC",
+		Currency: "GBP",
+	}
+
+	t.Run("SalesByPeriod", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT period, currency, COUNT\(\*\) AS orders, .* FROM \(SELECT date_trunc\(\$1, orders.created_at AT TIME ZONE \$2\) AS period, .* ledger_transactions.type = \$3 AND ledger_transactions.reference = 'order:' \|\| orders.id\s+AND ledger_postings.amount > 0\) AS refunded FROM "orders" WHERE \(orders.status IN \(\$4,\$5,\$6,\$7,\$8\) AND orders.created_at >= \$9 AND orders.created_at < \$10\) AND orders.currency = \$11\) AS sales GROUP BY period, currency ORDER BY period, currency`).
+			WithArgs("week", "UTC", "checkout_reversal", "paid", "fulfilling", "shipped", "delivered", "refunded", filter.Start, filter.End, "GBP").
+			WillReturnRows(sqlmock.NewRows([]string{"period", "currency", "orders", "revenue", "tax", "discount", "refunded_orders", "refunded_amount"}).
+				AddRow(time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC), "GBP", 12, 25188, 0, 1200, 1, 1299))
+
+		rows, err := repo.SalesByPeriod(context.Background(), reports.IntervalWeek, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.Equal(t, int64(1299), rows[0].RefundedAmount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SalesByPeriod_RefundedWalletFundedOrder", func(t *testing.T) {
+		// A refunded order of 2500 paid 1000 by card and 1500 from a gift
+		// card, which the reversal gave back to the wallet.
+		mock.ExpectQuery(`\(SELECT COALESCE\(SUM\(payments.refunded_amount\), 0\) FROM payments WHERE payments.order_id = orders.id\) \+\s+\(SELECT COALESCE\(SUM\(returns.store_credit\), 0\) FROM returns WHERE returns.order_id = orders.id\) \+\s+\(SELECT COALESCE\(SUM\(ledger_postings.amount\), 0\) FROM ledger_transactions\s+JOIN ledger_postings ON ledger_postings.transaction_id = ledger_transactions.id`).
+			WithArgs("day", "UTC", "checkout_reversal", "paid", "fulfilling", "shipped", "delivered", "refunded", filter.Start, filter.End, "GBP").
+			WillReturnRows(sqlmock.NewRows([]string{"period", "currency", "orders", "revenue", "tax", "discount", "refunded_orders", "refunded_amount"}).
+				AddRow(time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), "GBP", 1, 2500, 0, 0, 1, 2500))
+
+		rows, err := repo.SalesByPeriod(context.Background(), reports.IntervalDay, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.Equal(t, rows[0].Revenue, rows[0].RefundedAmount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TopSellers_Author", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT authors.id, authors.name, SUM(order_items.quantity) AS quantity, COUNT(DISTINCT orders.id) AS orders, SUM(order_items.line_total - order_items.discount) AS revenue FROM "order_items" JOIN orders ON orders.id = order_items.order_id JOIN book_authors ON book_authors.book_id = order_items.book_id AND book_authors.role = $1 JOIN authors ON authors.id = book_authors.author_id WHERE (orders.status IN ($2,$3,$4,$5,$6) AND orders.created_at >= $7 AND orders.created_at < $8) AND orders.currency = $9 GROUP BY authors.id, authors.name ORDER BY quantity DESC, revenue DESC, id LIMIT $10`)).
+			WithArgs("author", "paid", "fulfilling", "shipped", "delivered", "refunded", filter.Start, filter.End, "GBP", 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "quantity", "orders", "revenue"}).AddRow(4, "Ursula K. Le Guin", 12, 9, 15588))
+
+		rows, err := repo.TopSellers(context.Background(), reports.DimensionAuthor, filter, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []reports.SellerRow{{ID: 4, Name: "Ursula K. Le Guin", Quantity: 12, Orders: 9, Revenue: 15588}}, rows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/returns"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestReturnRepository(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := returns.NewReturnRepository(gormDB)
+
+	t.Run("ReturnedQuantities_SkipsRejected", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT return_items.book_id, SUM(return_items.quantity) AS quantity FROM "return_items" JOIN returns ON returns.id = return_items.return_id WHERE returns.order_id = $1 AND returns.status <> $2 GROUP BY "return_items"."book_id"`)).
+			WithArgs(42, returns.StatusRejected).
+			WillReturnRows(sqlmock.NewRows([]string{"book_id", "quantity"}).AddRow(1, 2).AddRow(3, 1))
+
+		quantities, err := repo.ReturnedQuantities(context.Background(), 42)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint]int{1: 2, 3: 1}, quantities)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Update_ChangedStatus", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "returns" SET "actor_id"=$1,"note"=$2,"refund_method"=$3,"refunded_amount"=$4,"status"=$5,"modified_at"=$6 WHERE id = $7 AND status = $8`)).
+			WithArgs(sqlmock.AnyArg(), "", "", 0, returns.StatusApproved, sqlmock.AnyArg(), 5, returns.StatusRequested).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		actorID := uint(1)
+		err := repo.Update(context.Background(), &returns.Return{ID: 5, Status: returns.StatusApproved, ActorID: &actorID}, returns.StatusRequested)
+
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/reviews"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := reviews.NewReviewRepository(gormDB)
+
+	t.Run("Create_CountsRating", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "reviews" ("book_id","user_id","rating","title","body","verified_purchase","helpful_count","created_at","modified_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "books" SET "rating_4_count"=rating_4_count + $1,"rating_count"=rating_count + $2,"rating_sum"=rating_sum + $3 WHERE id = $4`)).
+			WithArgs(1, 1, 4, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		review, err := repo.Create(context.Background(), &reviews.Review{BookID: 1, UserID: 7, Rating: 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), review.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Update_MovesRating", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","book_id","rating" FROM "reviews" WHERE "reviews"."id" = $1 ORDER BY "reviews"."id" LIMIT $2 FOR UPDATE`)).
+			WithArgs(3, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "book_id", "rating"}).AddRow(3, 1, 4))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "reviews" SET "rating"=$1,"title"=$2,"body"=$3,"verified_purchase"=$4,"modified_at"=$5 WHERE "id" = $6`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "books" SET "rating_4_count"=rating_4_count + $1,"rating_count"=rating_count + $2,"rating_sum"=rating_sum + $3 WHERE id = $4`)).
+			WithArgs(-1, -1, -4, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "books" SET "rating_2_count"=rating_2_count + $1,"rating_count"=rating_count + $2,"rating_sum"=rating_sum + $3 WHERE id = $4`)).
+			WithArgs(1, 1, 2, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := repo.Update(context.Background(), &reviews.Review{ID: 3, BookID: 1, UserID: 7, Rating: 2})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Vote_CountsOnce", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "review_votes" ("review_id","user_id","created_at") VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`)).
+			WithArgs(3, 8, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		voted, err := repo.Vote(context.Background(), 3, 8)
+
+		assert.NoError(t, err)
+		assert.False(t, voted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Vote", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "review_votes" ("review_id","user_id","created_at") VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`)).
+			WithArgs(3, 9, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "reviews" SET "helpful_count"=helpful_count + 1 WHERE id = $1`)).
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		voted, err := repo.Vote(context.Background(), 3, 9)
+
+		assert.NoError(t, err)
+		assert.True(t, voted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/taxes"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := taxes.NewTaxRepository(gormDB)
+
+	t.Run("FindForDestination_RegionBeforeCountry", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tax_regions" WHERE country = $1 AND region IN ($2,$3) ORDER BY region DESC,"tax_regions"."id" LIMIT $4`)).
+			WithArgs("US", "CA", "", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "country", "region", "prices_include_tax"}).AddRow(3, "US", "CA", false))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tax_rates" WHERE "tax_rates"."region_id" = $1`)).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "region_id", "tax_class", "name", "rate"}).AddRow(1, 3, "book", "Sales tax", 725))
+
+		region, err := repo.FindForDestination(context.Background(), "US", "CA")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CA", region.Region)
+		assert.Equal(t, 725, region.RateFor("book").Rate)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Update_ReplacesRates", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tax_regions" SET "country"=$1,"region"=$2,"name"=$3,"prices_include_tax"=$4,"created_at"=$5,"modified_at"=$6 WHERE "id" = $7`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "tax_rates" WHERE region_id = $1`)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "tax_rates" ("region_id","tax_class","name","rate") VALUES ($1,$2,$3,$4)`)).
+			WithArgs(1, "book", "TVA", 550).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		mock.ExpectCommit()
+
+		region, err := repo.Update(context.Background(), &taxes.TaxRegion{
+			ID: 1, Country: "FR", Name: "France", PricesIncludeTax: true,
+			Rates: []taxes.TaxRate{{ID: 1, RegionID: 1, TaxClass: "book", Name: "TVA", Rate: 550}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(7), region.Rates[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
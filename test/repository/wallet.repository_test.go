@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/wallet"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletRepository(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := wallet.NewWalletRepository(gormDB)
+
+	t.Run("Post_Unbalanced", func(t *testing.T) {
+		err := repo.Post(context.Background(), &wallet.Transaction{
+			Type:      wallet.TransactionRefund,
+			Reference: "return:5",
+			Postings:  []wallet.Posting{{Account: wallet.WalletAccount(7, "USD"), Amount: 990}},
+		})
+
+		assert.ErrorIs(t, err, wallet.ErrUnbalancedTransaction)
+	})
+
+	t.Run("Post_WalletOverdrawn", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ledger_accounts" ("key","type","user_id","currency","balance","created_at","modified_at") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT ("key") DO NOTHING RETURNING "id"`)).
+			WithArgs("system:orders:USD", wallet.AccountSystem, nil, "USD", 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "ledger_accounts"`)).
+			WithArgs("wallet:7:USD", wallet.AccountWallet, 7, "USD", 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "ledger_accounts" WHERE key IN ($1,$2) ORDER BY id FOR UPDATE`)).
+			WithArgs("system:orders:USD", "wallet:7:USD").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "key", "type", "user_id", "currency", "balance"}).
+				AddRow(1, "system:orders:USD", wallet.AccountSystem, nil, "USD", -5000).
+				AddRow(2, "wallet:7:USD", wallet.AccountWallet, 7, "USD", 500))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "ledger_accounts" SET "balance"=$1,"modified_at"=$2 WHERE id = $3`)).
+			WithArgs(-4010, sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		err := repo.Post(context.Background(), &wallet.Transaction{
+			Type:      wallet.TransactionCheckout,
+			Reference: "order:42",
+			Postings:  wallet.Transfer(wallet.WalletAccount(7, "USD"), wallet.SystemAccount(wallet.SystemOrders, "USD"), 990),
+		})
+
+		assert.ErrorIs(t, err, wallet.ErrInsufficientFunds)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
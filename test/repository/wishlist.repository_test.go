@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"bookstore-framework/internal/wishlists"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestWishlistRepository_Success(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := wishlists.NewWishlistRepository(gormDB)
+
+	t.Run("SaveItem_UpdatesNote", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "wishlist_items" ("wishlist_id","book_id","note","price","currency","created_at") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("wishlist_id","book_id") DO UPDATE SET "note"="excluded"."note" RETURNING "id"`)).
+			WithArgs(2, 1, "For the beach", 1299, "USD", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		mock.ExpectCommit()
+
+		err := repo.SaveItem(context.Background(), &wishlists.WishlistItem{WishlistID: 2, BookID: 1, Note: "For the beach", Price: 1299, Currency: "USD"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FindRepriced", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT wishlist_items.id, wishlist_items.wishlist_id, wishlists.user_id, wishlist_items.book_id, wishlist_items.price, wishlist_items.currency, books.price AS new_price, books.currency AS new_currency FROM "wishlist_items" JOIN wishlists ON wishlists.id = wishlist_items.wishlist_id JOIN books ON books.id = wishlist_items.book_id AND books.deleted_at IS NULL WHERE wishlist_items.id > $1 AND (books.price <> wishlist_items.price OR books.currency <> wishlist_items.currency) ORDER BY wishlist_items.id LIMIT $2`)).
+			WithArgs(0, 500).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "wishlist_id", "user_id", "book_id", "price", "currency", "new_price", "new_currency"}).
+				AddRow(5, 2, 7, 1, 1299, "USD", 999, "USD"))
+
+		items, err := repo.FindRepriced(context.Background(), 0, 500)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []wishlists.RepricedItem{{ID: 5, WishlistID: 2, UserID: 7, BookID: 1, Price: 1299, Currency: "USD", NewPrice: 999, NewCurrency: "USD"}}, items)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reprice_WithNotification", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "wishlist_items" SET "currency"=$1,"price"=$2 WHERE id = $3`)).
+			WithArgs("USD", 999, 5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "price_drop_notifications" ("user_id","wishlist_id","book_id","old_price","new_price","currency","read_at","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs(7, 2, 1, 1299, 999, "USD", nil, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		item := wishlists.RepricedItem{ID: 5, WishlistID: 2, UserID: 7, BookID: 1, Price: 1299, Currency: "USD", NewPrice: 999, NewCurrency: "USD"}
+		err := repo.Reprice(context.Background(), item, &wishlists.PriceDropNotification{
+			UserID: 7, WishlistID: 2, BookID: 1, OldPrice: 1299, NewPrice: 999, Currency: "USD",
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWishlistRepository_Error(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := wishlists.NewWishlistRepository(gormDB)
+
+	t.Run("DeleteItem_NotOnList", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "wishlist_items" WHERE wishlist_id = $1 AND book_id = $2`)).
+			WithArgs(2, 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.DeleteItem(context.Background(), 2, 9)
+
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/pkg"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAuthorService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := books.NewAuthorService(mockAuthorRepo, mockBookRepo)
+
+	t.Run("CreateAuthor", func(t *testing.T) {
+		req := dto.AuthorRequest{Name: "Ursula K. Le Guin"}
+		mockAuthorRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, author *books.Author) (*books.Author, error) {
+				author.ID = 1
+				return author, nil
+			})
+
+		result, err := service.CreateAuthor(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), result.ID)
+		assert.Equal(t, req.Name, result.Name)
+	})
+
+	t.Run("GetBibliography", func(t *testing.T) {
+		query := pkg.PaginationQuery{Page: 1, Limit: 20}
+		mockAuthorRepo.EXPECT().FindByID(gomock.Any(), uint(1)).
+			Return(&books.Author{ID: 1, Name: "Ursula K. Le Guin"}, nil)
+		mockBookRepo.EXPECT().FindAll(gomock.Any(), books.BookFilter{AuthorID: 1, Limit: 20}).
+			Return([]books.Book{
+				{ID: 10, Title: "A Wizard of Earthsea", Authors: []books.BookAuthor{{AuthorID: 1, Role: books.RoleAuthor, Author: books.Author{ID: 1, Name: "Ursula K. Le Guin"}}}},
+				{ID: 11, Title: "Tao Te Ching", Authors: []books.BookAuthor{{AuthorID: 1, Role: books.RoleTranslator, Author: books.Author{ID: 1, Name: "Ursula K. Le Guin"}}}},
+			}, int64(2), nil)
+
+		result, err := service.GetBibliography(context.Background(), 1, query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Ursula K. Le Guin", result.Author.Name)
+		assert.Len(t, result.Books, 2)
+		assert.Equal(t, books.RoleTranslator, result.Books[1].Authors[0].Role)
+		assert.Equal(t, int64(2), result.Pagination.Total)
+	})
+
+	t.Run("DeleteAuthor", func(t *testing.T) {
+		mockAuthorRepo.EXPECT().CountBooks(gomock.Any(), uint(1)).Return(int64(0), nil)
+		mockAuthorRepo.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
+
+		err := service.DeleteAuthor(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuthorService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := books.NewAuthorService(mockAuthorRepo, mockBookRepo)
+
+	t.Run("GetBibliography_NotFound", func(t *testing.T) {
+		mockAuthorRepo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.GetBibliography(context.Background(), 9, pkg.PaginationQuery{Page: 1, Limit: 20})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrAuthorNotFound)
+	})
+
+	t.Run("DeleteAuthor_HasBooks", func(t *testing.T) {
+		mockAuthorRepo.EXPECT().CountBooks(gomock.Any(), uint(1)).Return(int64(3), nil)
+
+		err := service.DeleteAuthor(context.Background(), 1)
+
+		assert.ErrorIs(t, err, books.ErrAuthorHasBooks)
+	})
+}
+
+func TestPublisherService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPublisherRepo := mocks.NewMockPublisherRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := books.NewPublisherService(mockPublisherRepo, mockBookRepo)
+
+	t.Run("CreatePublisher_Duplicate", func(t *testing.T) {
+		mockPublisherRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreatePublisher(context.Background(), dto.PublisherRequest{Name: "Tor"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrDuplicatePublisher)
+	})
+
+	t.Run("DeletePublisher_HasBooks", func(t *testing.T) {
+		mockPublisherRepo.EXPECT().CountBooks(gomock.Any(), uint(2)).Return(int64(1), nil)
+
+		err := service.DeletePublisher(context.Background(), 2)
+
+		assert.ErrorIs(t, err, books.ErrPublisherHasBooks)
+	})
+}
@@ -0,0 +1,338 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestBookService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookRepository(ctrl)
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	mockPublisherRepo := mocks.NewMockPublisherRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	service := books.NewBookService(mockRepo, mockAuthorRepo, mockPublisherRepo, mockCategoryRepo, mockPricingService)
+
+	publisherID := uint(3)
+	req := dto.BookRequest{
+		Title:           "The Hobbit",
+		ISBN:            "9780547928227",
+		Price:           1099,
+		Language:        "en",
+		PageCount:       300,
+		PublicationDate: "1937-09-21",
+		PublisherID:     &publisherID,
+		Authors: []dto.BookAuthorRequest{
+			{AuthorID: 7},
+			{AuthorID: 8, Role: books.RoleIllustrator},
+		},
+		CategoryIDs: []uint{4, 4, 9},
+	}
+
+	t.Run("CreateBook", func(t *testing.T) {
+		var created *books.Book
+		mockPublisherRepo.EXPECT().FindByID(gomock.Any(), publisherID).Return(&books.Publisher{ID: publisherID}, nil)
+		mockCategoryRepo.EXPECT().CountByIDs(gomock.Any(), []uint{4, 9}).Return(int64(2), nil)
+		mockAuthorRepo.EXPECT().CountByIDs(gomock.Any(), []uint{7, 8}).Return(int64(2), nil)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, book *books.Book) (*books.Book, error) {
+				book.ID = 1
+				created = book
+				return book, nil
+			})
+		mockRepo.EXPECT().FindByID(gomock.Any(), uint(1)).
+			DoAndReturn(func(_ context.Context, _ uint) (*books.Book, error) {
+				created.Publisher = &books.Publisher{ID: publisherID, Name: "Houghton Mifflin"}
+				created.Authors[0].Author = books.Author{ID: 7, Name: "J. R. R. Tolkien"}
+				created.Authors[1].Author = books.Author{ID: 8, Name: "Alan Lee"}
+				created.Categories = []books.Category{{ID: 4, Name: "Fantasy", Slug: "fantasy"}, {ID: 9, Name: "Classics", Slug: "classics"}}
+				return created, nil
+			})
+
+		result, err := service.CreateBook(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), result.ID)
+		assert.Equal(t, "USD", result.Currency)
+		assert.Equal(t, "1937-09-21", result.PublicationDate)
+		assert.Equal(t, "Houghton Mifflin", result.Publisher.Name)
+		assert.Equal(t, []dto.BookAuthorResponse{
+			{ID: 7, Name: "J. R. R. Tolkien", Role: books.RoleAuthor, Position: 0},
+			{ID: 8, Name: "Alan Lee", Role: books.RoleIllustrator, Position: 1},
+		}, result.Authors)
+		assert.Len(t, result.Categories, 2)
+	})
+
+	t.Run("CreateBook_NormalizesISBN10", func(t *testing.T) {
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, book *books.Book) (*books.Book, error) {
+				assert.Equal(t, "9780547928227", book.ISBN)
+				assert.Equal(t, "9780547928227", book.SKU)
+				book.ID = 2
+				return book, nil
+			})
+		mockRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&books.Book{ID: 2, ISBN: "9780547928227"}, nil)
+
+		result, err := service.CreateBook(context.Background(), dto.BookRequest{Title: "The Hobbit", ISBN: "0-547-92822-X"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "9780547928227", result.ISBN)
+		assert.Equal(t, "054792822X", result.ISBN10)
+		assert.Equal(t, "978-0-547-92822-7", result.ISBNDisplay)
+	})
+
+	t.Run("GetBookByBarcode", func(t *testing.T) {
+		mockRepo.EXPECT().FindByISBN(gomock.Any(), "9780547928227").Return(&books.Book{ID: 1, ISBN: "9780547928227"}, nil)
+
+		// EAN-13 followed by a 5 digit price add-on, as read by the counter scanner.
+		result, err := service.GetBookByBarcode(context.Background(), "978054792822751099")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), result.ID)
+	})
+
+	t.Run("GetBooks_ByCategory", func(t *testing.T) {
+		query := dto.BookListQuery{
+			PaginationQuery: pkg.PaginationQuery{Page: 1, Limit: 20},
+			Category:        "fantasy",
+		}
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "fantasy").Return(&books.Category{ID: 4, Path: "1/4/"}, nil)
+		mockRepo.EXPECT().FindAll(gomock.Any(), books.BookFilter{CategoryPath: "1/4/", Limit: 20}).
+			Return([]books.Book{}, int64(0), nil)
+
+		result, err := service.GetBooks(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Books)
+	})
+
+	t.Run("GetBooks", func(t *testing.T) {
+		query := dto.BookListQuery{
+			PaginationQuery: pkg.PaginationQuery{Page: 2, Limit: 1},
+			Language:        "en",
+		}
+		mockRepo.EXPECT().FindAll(gomock.Any(), books.BookFilter{Language: "en", Offset: 1, Limit: 1}).
+			Return([]books.Book{{ID: 2, Title: "The Hobbit"}}, int64(3), nil)
+
+		result, err := service.GetBooks(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Books, 1)
+		assert.Equal(t, int64(3), result.Pagination.Total)
+		assert.Equal(t, 3, result.Pagination.TotalPages)
+	})
+
+	t.Run("GetBooks_InCurrency", func(t *testing.T) {
+		query := dto.BookListQuery{
+			PaginationQuery: pkg.PaginationQuery{Page: 1, Limit: 20},
+			Currency:        "EUR",
+		}
+		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any()).
+			Return([]books.Book{{ID: 2, Price: 1099, Currency: "USD"}, {ID: 5, Price: 899, Currency: "EUR"}}, int64(2), nil)
+		mockPricingService.EXPECT().Quote(gomock.Any(), "EUR", []pricing.Item{
+			{BookID: 2, Price: money.New(1099, "USD")},
+			{BookID: 5, Price: money.New(899, "EUR")},
+		}).Return(map[uint]pricing.Price{
+			2: {Money: money.New(1012, "EUR"), Converted: true},
+			5: {Money: money.New(899, "EUR")},
+		}, nil)
+
+		result, err := service.GetBooks(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1012), result.Books[0].Price)
+		assert.Equal(t, "EUR", result.Books[0].Currency)
+		assert.True(t, result.Books[0].PriceConverted)
+		assert.Equal(t, int64(899), result.Books[1].Price)
+		assert.False(t, result.Books[1].PriceConverted)
+	})
+
+	t.Run("SearchBooks", func(t *testing.T) {
+		query := dto.BookSearchQuery{
+			PaginationQuery: pkg.PaginationQuery{Page: 1, Limit: 20},
+			Q:               "Tolkien's hobbit 978-0-547-92822-7",
+			Category:        "fantasy",
+		}
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "fantasy").Return(&books.Category{ID: 4, Path: "1/4/"}, nil)
+		mockRepo.EXPECT().Search(gomock.Any(), books.BookSearchFilter{
+			Terms:          []string{"tolkien", "s", "hobbit", "9780547928227"},
+			SearchLanguage: "en",
+			CategoryPath:   "1/4/",
+			Limit:          20,
+		}).Return([]books.BookSearchHit{{
+			Book:           books.Book{ID: 1, Title: "The Hobbit"},
+			Rank:           0.75,
+			TitleHighlight: "The <mark>Hobbit</mark>",
+		}}, int64(1), nil)
+
+		result, err := service.SearchBooks(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Results, 1)
+		assert.Equal(t, "The Hobbit", result.Results[0].Book.Title)
+		assert.Equal(t, "The <mark>Hobbit</mark>", result.Results[0].TitleHighlight)
+		assert.Equal(t, int64(1), result.Pagination.Total)
+	})
+
+	t.Run("UpdateBook", func(t *testing.T) {
+		existing := &books.Book{ID: 1, Title: "Old title", ISBN: req.ISBN}
+		update := dto.BookRequest{Title: "The Hobbit, or There and Back Again", ISBN: req.ISBN}
+		mockRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(existing, nil).Times(2)
+		mockRepo.EXPECT().Update(gomock.Any(), existing).Return(existing, nil)
+
+		result, err := service.UpdateBook(context.Background(), 1, update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, update.Title, result.Title)
+		assert.Empty(t, result.Authors)
+	})
+
+	t.Run("DeleteBook", func(t *testing.T) {
+		mockRepo.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
+
+		err := service.DeleteBook(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestBookService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookRepository(ctrl)
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	mockPublisherRepo := mocks.NewMockPublisherRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	service := books.NewBookService(mockRepo, mockAuthorRepo, mockPublisherRepo, mockCategoryRepo, mockPricingService)
+
+	t.Run("CreateBook_DuplicateISBN", func(t *testing.T) {
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreateBook(context.Background(), dto.BookRequest{Title: "x", ISBN: "0-547-92822-X"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrDuplicateISBN)
+	})
+
+	t.Run("CreateBook_InvalidISBN", func(t *testing.T) {
+		result, err := service.CreateBook(context.Background(), dto.BookRequest{Title: "x", ISBN: "978-0-547-92822-8"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrInvalidISBN)
+	})
+
+	t.Run("GetBookByBarcode_InvalidCheckDigit", func(t *testing.T) {
+		result, err := service.GetBookByBarcode(context.Background(), "9780547928228")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrInvalidISBN)
+	})
+
+	t.Run("GetBookByBarcode_NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().FindByISBN(gomock.Any(), "9780547928227").Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.GetBookByBarcode(context.Background(), "9780547928227")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrBookNotFound)
+	})
+
+	t.Run("CreateBook_UnknownAuthor", func(t *testing.T) {
+		req := dto.BookRequest{Title: "x", ISBN: "1", Authors: []dto.BookAuthorRequest{{AuthorID: 7}, {AuthorID: 9}}}
+		mockAuthorRepo.EXPECT().CountByIDs(gomock.Any(), []uint{7, 9}).Return(int64(1), nil)
+
+		result, err := service.CreateBook(context.Background(), req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrAuthorNotFound)
+	})
+
+	t.Run("CreateBook_DuplicateContributor", func(t *testing.T) {
+		req := dto.BookRequest{Title: "x", ISBN: "1", Authors: []dto.BookAuthorRequest{{AuthorID: 7}, {AuthorID: 7, Role: books.RoleAuthor}}}
+
+		result, err := service.CreateBook(context.Background(), req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrDuplicateContributor)
+	})
+
+	t.Run("CreateBook_UnknownCategory", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().CountByIDs(gomock.Any(), []uint{42}).Return(int64(0), nil)
+
+		result, err := service.CreateBook(context.Background(), dto.BookRequest{Title: "x", ISBN: "1", CategoryIDs: []uint{42}})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrCategoryNotFound)
+	})
+
+	t.Run("CreateBook_UnknownPublisher", func(t *testing.T) {
+		publisherID := uint(5)
+		mockPublisherRepo.EXPECT().FindByID(gomock.Any(), publisherID).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.CreateBook(context.Background(), dto.BookRequest{Title: "x", ISBN: "1", PublisherID: &publisherID})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrPublisherNotFound)
+	})
+
+	t.Run("SearchBooks_NoTerms", func(t *testing.T) {
+		result, err := service.SearchBooks(context.Background(), dto.BookSearchQuery{Q: "!!! --"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrEmptySearch)
+	})
+
+	t.Run("GetBook_NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().FindByID(gomock.Any(), uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.GetBook(context.Background(), 99, "")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrBookNotFound)
+	})
+
+	t.Run("GetBook_NoExchangeRate", func(t *testing.T) {
+		mockRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&books.Book{ID: 2, Price: 1099, Currency: "USD"}, nil)
+		mockPricingService.EXPECT().Quote(gomock.Any(), "JPY", gomock.Any()).Return(map[uint]pricing.Price{}, nil)
+
+		result, err := service.GetBook(context.Background(), 2, "JPY")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrCurrencyNotAvailable)
+	})
+
+	t.Run("GetBook_UnsupportedCurrency", func(t *testing.T) {
+		mockRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&books.Book{ID: 2, Price: 1099, Currency: "USD"}, nil)
+		mockPricingService.EXPECT().Quote(gomock.Any(), "XAU", gomock.Any()).Return(nil, pricing.ErrUnsupportedCurrency)
+
+		result, err := service.GetBook(context.Background(), 2, "XAU")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrCurrencyNotAvailable)
+	})
+
+	t.Run("GetBooks_RepositoryError", func(t *testing.T) {
+		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, int64(0), errors.New("Error database"))
+
+		result, err := service.GetBooks(context.Background(), dto.BookListQuery{PaginationQuery: pkg.PaginationQuery{Page: 1, Limit: 20}})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "Error database")
+	})
+}
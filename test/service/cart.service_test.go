@@ -0,0 +1,292 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func cartBook(id uint, price int64) books.Book {
+	return books.Book{ID: id, ISBN: "9780547928227", SKU: "9780547928227", Title: "The Hobbit", Price: price, Currency: "USD"}
+}
+
+func TestCartService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCartRepo := mocks.NewMockCartRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockPromotionService := mocks.NewMockPromotionService(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	service := carts.NewCartService(mockCartRepo, mockBookRepo, mockInventoryRepo, mockPromotionService, mockPricingService)
+	guest := carts.Owner{Token: "guest-token"}
+	runningPromotions(mockPromotionService, promotions.Promotion{
+		ID: 1, Name: "Hobbit week", Type: promotions.TypePercentage, Value: 10, Scope: promotions.ScopeAll, Active: true,
+	})
+	quotingAt(mockPricingService, pricing.ExchangeRate{Base: "USD", Quote: "EUR", Rate: "0.92"})
+
+	t.Run("AddItem_CreatesGuestCart", func(t *testing.T) {
+		book := cartBook(1, 1099)
+		cart := &carts.Cart{ID: 5, Token: "guest-token", Currency: "USD", ExpiresAt: time.Now().Add(time.Hour)}
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&book, nil)
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(nil, gorm.ErrRecordNotFound)
+		mockCartRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *carts.Cart) (*carts.Cart, error) {
+				assert.Nil(t, c.UserID)
+				assert.Equal(t, "guest-token", c.Token)
+				assert.WithinDuration(t, time.Now().Add(carts.GuestCartTTL), c.ExpiresAt, time.Minute)
+				return cart, nil
+			})
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{book.SKU}).Return(map[string]int{book.SKU: 3}, nil).Times(2)
+		mockCartRepo.EXPECT().SaveItem(gomock.Any(), &carts.CartItem{CartID: 5, BookID: 1, Quantity: 2, UnitPrice: 1099}).Return(nil)
+		mockCartRepo.EXPECT().Save(gomock.Any(), cart).Return(nil)
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(&carts.Cart{
+			ID: 5, Currency: "USD", ExpiresAt: cart.ExpiresAt,
+			Items: []carts.CartItem{{ID: 1, BookID: 1, Quantity: 2, UnitPrice: 1099, Book: book}},
+		}, nil)
+
+		result, err := service.AddItem(context.Background(), guest, "", dto.AddCartItemRequest{BookID: 1, Quantity: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2198), result.Subtotal)
+		assert.Equal(t, int64(220), result.Discount)
+		assert.Equal(t, int64(1978), result.Total)
+		assert.Equal(t, 2, result.ItemCount)
+		assert.Empty(t, result.Items[0].Issues)
+		require.Len(t, result.Items[0].Promotions, 1)
+		assert.Equal(t, "10% off", result.Items[0].Promotions[0].Description)
+	})
+
+	t.Run("AddItem_PreorderBeyondStock", func(t *testing.T) {
+		book := cartBook(1, 1099)
+		release := time.Now().AddDate(0, 1, 0)
+		book.ReleaseDate = &release
+		cart := &carts.Cart{ID: 5, Token: "guest-token", Currency: "USD", ExpiresAt: time.Now().Add(time.Hour)}
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&book, nil)
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(cart, nil)
+		// Pre-orders are taken without checking stock.
+		mockCartRepo.EXPECT().SaveItem(gomock.Any(), &carts.CartItem{CartID: 5, BookID: 1, Quantity: 3, UnitPrice: 1099}).Return(nil)
+		mockCartRepo.EXPECT().Save(gomock.Any(), cart).Return(nil)
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(&carts.Cart{
+			ID: 5, Currency: "USD", ExpiresAt: cart.ExpiresAt,
+			Items: []carts.CartItem{{ID: 1, BookID: 1, Quantity: 3, UnitPrice: 1099, Book: book}},
+		}, nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{book.SKU}).Return(map[string]int{}, nil)
+
+		result, err := service.AddItem(context.Background(), guest, "", dto.AddCartItemRequest{BookID: 1, Quantity: 3})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items[0].Issues)
+		assert.True(t, result.Items[0].Preorder)
+		assert.True(t, result.Items[0].Backorder)
+		assert.Equal(t, 3, result.ItemCount)
+	})
+
+	t.Run("ApplyCoupon", func(t *testing.T) {
+		book := cartBook(1, 1099)
+		cart := &carts.Cart{
+			ID: 5, Token: "guest-token", Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+			Items: []carts.CartItem{{ID: 1, BookID: 1, Quantity: 1, UnitPrice: 1099, Book: book}},
+		}
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(cart, nil)
+		mockPromotionService.EXPECT().FindCoupon(gomock.Any(), "welcome5").Return("WELCOME5", nil)
+		mockCartRepo.EXPECT().Save(gomock.Any(), cart).Return(nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{book.SKU}).Return(map[string]int{book.SKU: 3}, nil)
+
+		result, err := service.ApplyCoupon(context.Background(), guest, dto.ApplyCouponRequest{Code: "welcome5"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "WELCOME5", cart.CouponCode)
+		require.NotNil(t, result.Coupon)
+		assert.False(t, result.Coupon.Applied)
+		assert.Equal(t, "coupon code not found", result.Coupon.Reason)
+	})
+
+	t.Run("GetCart_Revalidates", func(t *testing.T) {
+		repriced := cartBook(1, 1299)
+		withdrawn := cartBook(2, 500)
+		withdrawn.SKU = "9780306406157"
+		withdrawn.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(&carts.Cart{
+			ID: 5, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+			Items: []carts.CartItem{
+				{ID: 1, BookID: 1, Quantity: 4, UnitPrice: 1099, Book: repriced},
+				{ID: 2, BookID: 2, Quantity: 1, UnitPrice: 500, Book: withdrawn},
+			},
+		}, nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{repriced.SKU, withdrawn.SKU}).
+			Return(map[string]int{repriced.SKU: 3}, nil)
+		mockCartRepo.EXPECT().UpdatePrices(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, items []carts.CartItem) error {
+				require.Len(t, items, 1)
+				assert.Equal(t, int64(1299), items[0].UnitPrice)
+				return nil
+			})
+
+		result, err := service.GetCart(context.Background(), guest, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{carts.IssuePriceChanged, carts.IssueInsufficientStock}, result.Items[0].Issues)
+		assert.Equal(t, int64(1099), *result.Items[0].PreviousPrice)
+		assert.Equal(t, []string{carts.IssueUnavailable}, result.Items[1].Issues)
+		assert.Equal(t, int64(0), result.Subtotal)
+	})
+
+	t.Run("GetCart_SwitchesCurrency", func(t *testing.T) {
+		book := cartBook(1, 1099)
+		cart := &carts.Cart{
+			ID: 5, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+			Items: []carts.CartItem{{ID: 1, BookID: 1, Quantity: 1, UnitPrice: 1099, Book: book}},
+		}
+		mockCartRepo.EXPECT().FindByToken(gomock.Any(), "guest-token").Return(cart, nil)
+		mockCartRepo.EXPECT().UpdatePrices(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, items []carts.CartItem) error {
+				require.Len(t, items, 1)
+				assert.Equal(t, int64(1011), items[0].UnitPrice)
+				return nil
+			})
+		mockCartRepo.EXPECT().Save(gomock.Any(), cart).Return(nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{book.SKU}).Return(map[string]int{book.SKU: 3}, nil)
+
+		result, err := service.GetCart(context.Background(), guest, "eur")
+
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.Equal(t, int64(1011), result.Items[0].UnitPrice)
+		assert.True(t, result.Items[0].PriceConverted)
+		assert.Empty(t, result.Items[0].Issues)
+		assert.Equal(t, int64(1011), result.Subtotal)
+	})
+
+	t.Run("GetCart_ExpiredCartIsDeleted", func(t *testing.T) {
+		userID := uint(7)
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), userID).
+			Return(&carts.Cart{ID: 9, ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(9)).Return(nil)
+
+		result, err := service.GetCart(context.Background(), carts.Owner{UserID: &userID}, "")
+
+		require.NoError(t, err)
+		assert.Zero(t, result.ID)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("MergeGuestCart", func(t *testing.T) {
+		mockCartRepo.EXPECT().Merge(gomock.Any(), "guest-token", uint(7), gomock.Any(), carts.MaxItemQuantity).Return(nil)
+
+		err := service.MergeGuestCart(context.Background(), "guest-token", 7)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("PurgeExpired", func(t *testing.T) {
+		mockCartRepo.EXPECT().DeleteExpired(gomock.Any(), gomock.Any()).Return(int64(3), nil)
+
+		assert.NoError(t, service.PurgeExpired(context.Background()))
+	})
+}
+
+func TestCartService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCartRepo := mocks.NewMockCartRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockPromotionService := mocks.NewMockPromotionService(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	service := carts.NewCartService(mockCartRepo, mockBookRepo, mockInventoryRepo, mockPromotionService, mockPricingService)
+	userID := uint(7)
+	owner := carts.Owner{UserID: &userID}
+	quotingAt(mockPricingService)
+
+	t.Run("AddItem_BookNotFound", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.AddItem(context.Background(), owner, "", dto.AddCartItemRequest{BookID: 99, Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrBookNotFound)
+	})
+
+	t.Run("AddItem_InsufficientStock", func(t *testing.T) {
+		book := cartBook(1, 1099)
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&book, nil)
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(&carts.Cart{
+			ID: 5, UserID: &userID, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+			Items: []carts.CartItem{{BookID: 1, Quantity: 2, UnitPrice: 1099, Book: book}},
+		}, nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{book.SKU}).Return(map[string]int{book.SKU: 2}, nil)
+
+		result, err := service.AddItem(context.Background(), owner, "", dto.AddCartItemRequest{BookID: 1, Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrInsufficientStock)
+	})
+
+	t.Run("AddItem_NoPriceInCartCurrency", func(t *testing.T) {
+		book := cartBook(2, 899)
+		book.Currency = "EUR"
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&book, nil)
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(&carts.Cart{
+			ID: 5, UserID: &userID, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+			Items: []carts.CartItem{{BookID: 1, Quantity: 1, UnitPrice: 1099, Book: cartBook(1, 1099)}},
+		}, nil)
+
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{book.SKU}).Return(map[string]int{book.SKU: 2}, nil)
+
+		result, err := service.AddItem(context.Background(), owner, "", dto.AddCartItemRequest{BookID: 2, Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrPriceNotAvailable)
+	})
+
+	t.Run("GetCart_UnsupportedCurrency", func(t *testing.T) {
+		result, err := service.GetCart(context.Background(), owner, "XAU")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrCurrencyNotAvailable)
+	})
+
+	t.Run("UpdateItem_NotInCart", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(&carts.Cart{
+			ID: 5, UserID: &userID, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+
+		result, err := service.UpdateItem(context.Background(), owner, 1, dto.UpdateCartItemRequest{Quantity: 2})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrItemNotFound)
+	})
+
+	t.Run("ApplyCoupon_UnknownCode", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), userID).Return(&carts.Cart{
+			ID: 5, UserID: &userID, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		mockPromotionService.EXPECT().FindCoupon(gomock.Any(), "NOPE").Return("", promotions.ErrCouponNotFound)
+
+		result, err := service.ApplyCoupon(context.Background(), owner, dto.ApplyCouponRequest{Code: "NOPE"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrCouponNotFound)
+	})
+
+	t.Run("RemoveItem_NoCart", func(t *testing.T) {
+		result, err := service.RemoveItem(context.Background(), carts.Owner{}, 1)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrItemNotFound)
+	})
+}
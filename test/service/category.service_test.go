@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/books/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCategoryService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := books.NewCategoryService(mockCategoryRepo, mockBookRepo)
+
+	fiction := books.Category{ID: 1, Name: "Fiction", Slug: "fiction", Path: "1/"}
+	fantasy := books.Category{ID: 2, ParentID: uintPtr(1), Name: "Fantasy", Slug: "fantasy", Path: "1/2/", Depth: 1}
+	epic := books.Category{ID: 3, ParentID: uintPtr(2), Name: "Epic", Slug: "epic", Path: "1/2/3/", Depth: 2}
+	poetry := books.Category{ID: 4, Name: "Poetry", Slug: "poetry", Path: "4/"}
+
+	t.Run("CreateCategory_DerivesSlug", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, category *books.Category) (*books.Category, error) {
+				assert.Equal(t, "science-fiction-fantasy", category.Slug)
+				category.ID = 5
+				return category, nil
+			})
+
+		result, err := service.CreateCategory(context.Background(), dto.CategoryRequest{Name: "Science Fiction & Fantasy"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), result.ID)
+	})
+
+	t.Run("GetCategoryTree", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindAll(gomock.Any()).
+			Return([]books.Category{fiction, poetry, fantasy, epic}, nil)
+
+		result, err := service.GetCategoryTree(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "fiction", result[0].Slug)
+		assert.Equal(t, "fantasy", result[0].Children[0].Slug)
+		assert.Equal(t, "epic", result[0].Children[0].Children[0].Slug)
+		assert.Empty(t, result[1].Children)
+	})
+
+	t.Run("GetCategory", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "fantasy").Return(&fantasy, nil)
+		mockCategoryRepo.EXPECT().FindByIDs(gomock.Any(), []uint{1}).Return([]books.Category{fiction}, nil)
+		mockCategoryRepo.EXPECT().FindSubtree(gomock.Any(), &fantasy).Return([]books.Category{fantasy, epic}, nil)
+
+		result, err := service.GetCategory(context.Background(), "fantasy")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "fiction", result.Ancestors[0].Slug)
+		assert.Len(t, result.Children, 1)
+		assert.Equal(t, "epic", result.Children[0].Slug)
+	})
+
+	t.Run("GetCategoryBooks", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "fantasy").Return(&fantasy, nil)
+		mockBookRepo.EXPECT().FindAll(gomock.Any(), books.BookFilter{CategoryPath: "1/2/", Limit: 10, Offset: 10}).
+			Return([]books.Book{{ID: 1, Title: "The Hobbit"}}, int64(11), nil)
+
+		result, err := service.GetCategoryBooks(context.Background(), "fantasy", paginate(2, 10))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Books, 1)
+		assert.Equal(t, 2, result.Pagination.TotalPages)
+	})
+
+	t.Run("MoveCategory", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&fantasy, nil)
+		mockCategoryRepo.EXPECT().FindByID(gomock.Any(), uint(4)).Return(&poetry, nil)
+		mockCategoryRepo.EXPECT().Move(gomock.Any(), &fantasy, &poetry).
+			Return(&books.Category{ID: 2, ParentID: uintPtr(4), Name: "Fantasy", Slug: "fantasy", Path: "4/2/", Depth: 1}, nil)
+
+		result, err := service.MoveCategory(context.Background(), 2, dto.MoveCategoryRequest{ParentID: uintPtr(4)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(4), *result.ParentID)
+	})
+}
+
+func TestCategoryService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := books.NewCategoryService(mockCategoryRepo, mockBookRepo)
+
+	fantasy := books.Category{ID: 2, ParentID: uintPtr(1), Name: "Fantasy", Slug: "fantasy", Path: "1/2/", Depth: 1}
+	epic := books.Category{ID: 3, ParentID: uintPtr(2), Name: "Epic", Slug: "epic", Path: "1/2/3/", Depth: 2}
+
+	t.Run("CreateCategory_InvalidSlug", func(t *testing.T) {
+		result, err := service.CreateCategory(context.Background(), dto.CategoryRequest{Name: "???"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrInvalidSlug)
+	})
+
+	t.Run("CreateCategory_DuplicateSlug", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreateCategory(context.Background(), dto.CategoryRequest{Name: "Fantasy"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrDuplicateSlug)
+	})
+
+	t.Run("MoveCategory_BelowDescendant", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&fantasy, nil)
+		mockCategoryRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&epic, nil)
+
+		result, err := service.MoveCategory(context.Background(), 2, dto.MoveCategoryRequest{ParentID: uintPtr(3)})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrCategoryCycle)
+	})
+
+	t.Run("DeleteCategory_HasChildren", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().CountChildren(gomock.Any(), uint(2)).Return(int64(1), nil)
+
+		err := service.DeleteCategory(context.Background(), 2)
+
+		assert.ErrorIs(t, err, books.ErrCategoryHasChildren)
+	})
+
+	t.Run("GetCategoryBooks_NotFound", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.GetCategoryBooks(context.Background(), "missing", paginate(1, 20))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, books.ErrCategoryNotFound)
+	})
+}
@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/pkg/blob"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 200, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestCoverService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	store := blob.NewLocalStore(t.TempDir())
+	service := books.NewCoverService(mockBookRepo, store)
+	ctx := context.Background()
+
+	t.Run("UploadCover_StoresThumbnails", func(t *testing.T) {
+		var key string
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1}, nil)
+		mockBookRepo.EXPECT().SetCover(gomock.Any(), uint(1), gomock.Any(), 400, 600).
+			DoAndReturn(func(_ context.Context, _ uint, k string, _, _ int) error {
+				key = k
+				return nil
+			})
+
+		result, err := service.UploadCover(ctx, 1, pngImage(t, 400, 600))
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(key, "covers/1/"))
+		assert.True(t, strings.HasSuffix(key, "/original.png"))
+		assert.Equal(t, books.CoverURLPrefix+strings.TrimPrefix(key, "covers/"), result.Original)
+
+		// Thumbnails keep the aspect ratio and are never enlarged.
+		for url, width := range map[string]int{result.Small: 160, result.Medium: 320, result.Large: 400} {
+			object, err := service.GetImage(ctx, strings.TrimPrefix(url, books.CoverURLPrefix))
+			require.NoError(t, err, url)
+			config, err := jpeg.DecodeConfig(object.Body)
+			object.Body.Close()
+			require.NoError(t, err, url)
+			assert.Equal(t, width, config.Width, url)
+			assert.Equal(t, width*3/2, config.Height, url)
+		}
+	})
+
+	t.Run("UploadCover_ReplacesOldCover", func(t *testing.T) {
+		old := "covers/2/0123456789abcdef/original.png"
+		require.NoError(t, store.Put(ctx, old, []byte("old"), "image/png"))
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&books.Book{ID: 2, CoverKey: old}, nil)
+		mockBookRepo.EXPECT().SetCover(gomock.Any(), uint(2), gomock.Any(), 100, 100).Return(nil)
+
+		_, err := service.UploadCover(ctx, 2, pngImage(t, 100, 100))
+
+		require.NoError(t, err)
+		_, err = store.Get(ctx, old)
+		assert.ErrorIs(t, err, blob.ErrNotFound)
+	})
+
+	t.Run("DeleteCover", func(t *testing.T) {
+		key := "covers/3/0123456789abcdef/original.png"
+		require.NoError(t, store.Put(ctx, key, []byte("png"), "image/png"))
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&books.Book{ID: 3, CoverKey: key}, nil)
+		mockBookRepo.EXPECT().SetCover(gomock.Any(), uint(3), "", 0, 0).Return(nil)
+
+		require.NoError(t, service.DeleteCover(ctx, 3))
+		_, err := store.Get(ctx, key)
+		assert.ErrorIs(t, err, blob.ErrNotFound)
+	})
+}
+
+func TestCoverService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockStore := mocks.NewMockBlobStore(ctrl)
+	service := books.NewCoverService(mockBookRepo, mockStore)
+	ctx := context.Background()
+
+	t.Run("UploadCover_TooLarge", func(t *testing.T) {
+		_, err := service.UploadCover(ctx, 1, make([]byte, books.MaxCoverSize+1))
+		assert.ErrorIs(t, err, books.ErrCoverTooLarge)
+	})
+
+	t.Run("UploadCover_SniffsContent", func(t *testing.T) {
+		// A GIF is rejected whatever the client claimed it to be.
+		_, err := service.UploadCover(ctx, 1, []byte("GIF89a\x01\x00\x01\x00"))
+		assert.ErrorIs(t, err, books.ErrCoverUnsupported)
+	})
+
+	t.Run("UploadCover_Corrupt", func(t *testing.T) {
+		_, err := service.UploadCover(ctx, 1, pngImage(t, 10, 10)[:40])
+		assert.ErrorIs(t, err, books.ErrCoverCorrupt)
+	})
+
+	t.Run("UploadCover_TooManyPixels", func(t *testing.T) {
+		_, err := service.UploadCover(ctx, 1, pngImage(t, books.MaxCoverDimension+1, 1))
+		assert.ErrorIs(t, err, books.ErrCoverDimensions)
+	})
+
+	t.Run("UploadCover_BookNotFound", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := service.UploadCover(ctx, 9, pngImage(t, 10, 10))
+		assert.ErrorIs(t, err, books.ErrBookNotFound)
+	})
+
+	t.Run("UploadCover_RemovesBlobsWhenNotSaved", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1}, nil)
+		mockStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		mockBookRepo.EXPECT().SetCover(gomock.Any(), uint(1), gomock.Any(), 10, 10).Return(errors.New("db down"))
+		mockStore.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+		_, err := service.UploadCover(ctx, 1, pngImage(t, 10, 10))
+		assert.EqualError(t, err, "db down")
+	})
+
+	t.Run("DeleteCover_NoCover", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1}, nil)
+
+		assert.ErrorIs(t, service.DeleteCover(ctx, 1), books.ErrBookHasNoCover)
+	})
+
+	t.Run("GetImage_NotFound", func(t *testing.T) {
+		mockStore.EXPECT().Get(gomock.Any(), "covers/1/x/small.jpg").Return(nil, blob.ErrNotFound)
+
+		_, err := service.GetImage(ctx, "/1/x/small.jpg")
+		assert.ErrorIs(t, err, books.ErrCoverNotFound)
+	})
+}
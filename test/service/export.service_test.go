@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/exports"
+	"bookstore-framework/internal/exports/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func exportRows() []exports.ExportRow {
+	published := time.Date(1937, 9, 21, 0, 0, 0, 0, time.UTC)
+	return []exports.ExportRow{
+		{
+			Book: books.Book{
+				ID:              1,
+				ISBN:            "9780547928227",
+				SKU:             "9780547928227",
+				Title:           "The Hobbit",
+				Price:           1099,
+				Currency:        "USD",
+				Language:        "en",
+				PageCount:       300,
+				PublicationDate: &published,
+				Publisher:       &books.Publisher{Name: "Houghton Mifflin"},
+				Authors: []books.BookAuthor{
+					{Role: books.RoleAuthor, Author: books.Author{Name: "J. R. R. Tolkien"}},
+					{Role: books.RoleIllustrator, Author: books.Author{Name: "Alan Lee"}},
+				},
+				Categories: []books.Category{{Slug: "fantasy"}},
+				ModifiedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			OnHand: 4,
+		},
+		{
+			Book:    books.Book{ID: 2, ISBN: "9780306406157", SKU: "9780306406157", Title: "Withdrawn", Price: 500, Currency: "USD"},
+			Deleted: true,
+		},
+	}
+}
+
+func streamRows(rows []exports.ExportRow) func(context.Context, exports.ExportFilter, func([]exports.ExportRow) error) error {
+	return func(_ context.Context, _ exports.ExportFilter, fn func([]exports.ExportRow) error) error {
+		return fn(rows)
+	}
+}
+
+func TestExportService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockExportRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	service := exports.NewExportService(mockRepo, mockCategoryRepo)
+
+	t.Run("ResolveFilter", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "fantasy").
+			Return(&books.Category{ID: 3, Slug: "fantasy", Path: "1/3/"}, nil)
+
+		filter, err := service.ResolveFilter(context.Background(), dto.ExportQuery{
+			Format:       exports.FormatCSV,
+			Category:     "fantasy",
+			UpdatedSince: "2026-01-01T00:00:00Z",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "1/3/", filter.CategoryPath)
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), filter.UpdatedSince.UTC())
+	})
+
+	t.Run("Export_CSV", func(t *testing.T) {
+		mockRepo.EXPECT().StreamBooks(gomock.Any(), exports.ExportFilter{}, gomock.Any()).
+			DoAndReturn(streamRows(exportRows()))
+
+		var out bytes.Buffer
+		err := service.Export(context.Background(), exports.FormatCSV, exports.ExportFilter{}, &out)
+
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.True(t, strings.HasPrefix(lines[0], "isbn,sku,title,"))
+		assert.Equal(t, "9780547928227,9780547928227,The Hobbit,,,10.99,USD,en,300,1937-09-21,Houghton Mifflin,"+
+			"J. R. R. Tolkien; Alan Lee (illustrator),fantasy,4,active,2026-01-02T03:04:05Z", lines[1])
+		assert.Contains(t, lines[2], ",deleted,")
+	})
+
+	t.Run("Export_CSV_CurrencyDecimals", func(t *testing.T) {
+		rows := []exports.ExportRow{
+			{Book: books.Book{ID: 1, ISBN: "9780547928227", SKU: "9780547928227", Title: "The Hobbit", Price: 1500, Currency: "JPY"}},
+			{Book: books.Book{ID: 2, ISBN: "9780306406157", SKU: "9780306406157", Title: "Gödel Escher Bach", Price: 1250, Currency: "KWD"}},
+		}
+		mockRepo.EXPECT().StreamBooks(gomock.Any(), exports.ExportFilter{}, gomock.Any()).
+			DoAndReturn(streamRows(rows))
+
+		var out bytes.Buffer
+		err := service.Export(context.Background(), exports.FormatCSV, exports.ExportFilter{}, &out)
+
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Contains(t, lines[1], ",The Hobbit,,,1500,JPY,")
+		assert.Contains(t, lines[2], ",Gödel Escher Bach,,,1.250,KWD,")
+	})
+
+	t.Run("Export_JSONL", func(t *testing.T) {
+		mockRepo.EXPECT().StreamBooks(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(streamRows(exportRows()))
+
+		var out bytes.Buffer
+		err := service.Export(context.Background(), exports.FormatJSONL, exports.ExportFilter{}, &out)
+
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 2)
+		var first dto.ExportBookResponse
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+		assert.Equal(t, int64(1099), first.Price)
+		assert.Equal(t, 4, first.Stock)
+		assert.Equal(t, []string{"fantasy"}, first.Categories)
+		assert.Equal(t, "illustrator", first.Contributors[1].Role)
+	})
+
+	t.Run("Export_ONIX", func(t *testing.T) {
+		mockRepo.EXPECT().StreamBooks(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(streamRows(exportRows()))
+
+		var out bytes.Buffer
+		err := service.Export(context.Background(), exports.FormatONIX, exports.ExportFilter{}, &out)
+
+		require.NoError(t, err)
+		var message struct {
+			Release  string `xml:"release,attr"`
+			Products []struct {
+				NotificationType string   `xml:"NotificationType"`
+				Language         string   `xml:"DescriptiveDetail>Language>LanguageCode"`
+				Roles            []string `xml:"DescriptiveDetail>Contributor>ContributorRole"`
+				Availability     string   `xml:"ProductSupply>SupplyDetail>ProductAvailability"`
+				PriceAmount      string   `xml:"ProductSupply>SupplyDetail>Price>PriceAmount"`
+			} `xml:"Product"`
+		}
+		require.NoError(t, xml.Unmarshal(out.Bytes(), &message))
+		assert.Equal(t, "3.0", message.Release)
+		require.Len(t, message.Products, 2)
+		assert.Equal(t, "03", message.Products[0].NotificationType)
+		assert.Equal(t, "eng", message.Products[0].Language)
+		assert.Equal(t, []string{"A01", "A12"}, message.Products[0].Roles)
+		assert.Equal(t, "21", message.Products[0].Availability)
+		assert.Equal(t, "10.99", message.Products[0].PriceAmount)
+		assert.Equal(t, "05", message.Products[1].NotificationType)
+		assert.Equal(t, "40", message.Products[1].Availability)
+	})
+}
+
+func TestExportService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockExportRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	service := exports.NewExportService(mockRepo, mockCategoryRepo)
+
+	t.Run("ResolveFilter_CategoryNotFound", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindBySlug(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		filter, err := service.ResolveFilter(context.Background(), dto.ExportQuery{Format: exports.FormatCSV, Category: "missing"})
+
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, exports.ErrCategoryNotFound)
+	})
+
+	t.Run("Export_UnsupportedFormat", func(t *testing.T) {
+		err := service.Export(context.Background(), "xlsx", exports.ExportFilter{}, &bytes.Buffer{})
+
+		assert.ErrorIs(t, err, exports.ErrUnsupportedFormat)
+	})
+
+	t.Run("Export_StreamError", func(t *testing.T) {
+		mockRepo.EXPECT().StreamBooks(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection reset"))
+
+		err := service.Export(context.Background(), exports.FormatJSONL, exports.ExportFilter{}, &bytes.Buffer{})
+
+		assert.EqualError(t, err, "connection reset")
+	})
+}
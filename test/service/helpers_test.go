@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/pkg"
+	"bookstore-framework/pkg/money"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func paginate(page, limit int) pkg.PaginationQuery {
+	return pkg.PaginationQuery{Page: page, Limit: limit}
+}
+
+// runningPromotions makes the mocked promotion service evaluate baskets
+// against the given promotions.
+func runningPromotions(service *mocks.MockPromotionService, running ...promotions.Promotion) {
+	service.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, basket promotions.Basket) (*promotions.Evaluation, error) {
+			return promotions.Evaluate(running, basket, nil, time.Now()), nil
+		}).AnyTimes()
+}
+
+// taxingIn makes the mocked tax service tax every destination at the rates of
+// the given region, or not at all when it is nil.
+func taxingIn(service *mocks.MockTaxService, region *taxes.TaxRegion) {
+	service.EXPECT().Calculate(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ taxes.Destination, lines []taxes.Line) (*taxes.Calculation, error) {
+			return taxes.Calculate(region, lines), nil
+		}).AnyTimes()
+}
+
+// quotingAt makes the mocked pricing service price books at their catalog
+// price, converted with the given rates, and convert amounts with the rates
+// or their inverse. Books without a rate to the currency are not priced.
+func quotingAt(service *mocks.MockPricingService, rates ...pricing.ExchangeRate) {
+	service.EXPECT().Convert(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, amount money.Money, currency string) (money.Money, error) {
+			if amount.Currency == currency {
+				return amount, nil
+			}
+			for _, rate := range rates {
+				value, _ := money.ParseRate(rate.Rate)
+				switch {
+				case rate.Base == amount.Currency && rate.Quote == currency:
+					return amount.Convert(currency, value)
+				case rate.Base == currency && rate.Quote == amount.Currency:
+					return amount.Convert(currency, value.Inv(value))
+				}
+			}
+			return money.Money{}, pricing.ErrExchangeRateNotFound
+		}).AnyTimes()
+	service.EXPECT().Quote(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, currency string, items []pricing.Item) (map[uint]pricing.Price, error) {
+			quotes := make(map[uint]pricing.Price, len(items))
+			for _, item := range items {
+				if item.Price.Currency == currency {
+					quotes[item.BookID] = pricing.Price{Money: item.Price}
+					continue
+				}
+				for _, rate := range rates {
+					if rate.Base != item.Price.Currency || rate.Quote != currency {
+						continue
+					}
+					value, _ := money.ParseRate(rate.Rate)
+					converted, _ := item.Price.Convert(currency, value)
+					quotes[item.BookID] = pricing.Price{Money: converted, Converted: true}
+				}
+			}
+			return quotes, nil
+		}).AnyTimes()
+}
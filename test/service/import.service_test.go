@@ -0,0 +1,305 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/imports"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const importCSV = `isbn,title,price,currency,language,publisher,authors,publication_date
+978-0-547-92822-7,The Hobbit,10.99,usd,EN,Houghton Mifflin,J. R. R. Tolkien; Alan Lee (illustrator),1937-09-21
+0-306-40615-2,Bad Price,ten,,,,,
+9780306406158,Bad Check Digit,9.99,,,,,
+0-547-92822-X,The Hobbit (Anniversary),12.5,,,,J. R. R. Tolkien,
+`
+
+const importONIX = `<?xml version="1.0" encoding="UTF-8"?>
+<ONIXMessage release="3.0">
+  <Header><Sender><SenderName>Houghton Mifflin</SenderName></Sender></Header>
+  <Product>
+    <RecordReference>hm-1</RecordReference>
+    <ProductIdentifier><ProductIDType>15</ProductIDType><IDValue>9780547928227</IDValue></ProductIdentifier>
+    <DescriptiveDetail>
+      <Contributor>
+        <SequenceNumber>2</SequenceNumber><ContributorRole>A12</ContributorRole>
+        <NamesBeforeKey>Alan</NamesBeforeKey><KeyNames>Lee</KeyNames>
+      </Contributor>
+      <Contributor>
+        <SequenceNumber>1</SequenceNumber><ContributorRole>A01</ContributorRole>
+        <PersonName>J. R. R. Tolkien</PersonName>
+      </Contributor>
+      <TitleDetail>
+        <TitleType>01</TitleType>
+        <TitleElement>
+          <TitleElementLevel>01</TitleElementLevel>
+          <TitlePrefix>The</TitlePrefix><TitleWithoutPrefix>Hobbit</TitleWithoutPrefix>
+          <Subtitle>There and Back Again</Subtitle>
+        </TitleElement>
+      </TitleDetail>
+      <Language><LanguageRole>01</LanguageRole><LanguageCode>eng</LanguageCode></Language>
+      <Extent><ExtentType>00</ExtentType><ExtentValue>300</ExtentValue><ExtentUnit>03</ExtentUnit></Extent>
+    </DescriptiveDetail>
+    <CollateralDetail><TextContent><TextType>03</TextType><Text>Bilbo Baggins is a hobbit.</Text></TextContent></CollateralDetail>
+    <PublishingDetail>
+      <Publisher><PublishingRole>01</PublishingRole><PublisherName>Houghton Mifflin</PublisherName></Publisher>
+      <PublishingDate><PublishingDateRole>01</PublishingDateRole><Date>19370921</Date></PublishingDate>
+    </PublishingDetail>
+    <ProductSupply><SupplyDetail><Price><PriceType>02</PriceType><PriceAmount>10.99</PriceAmount><CurrencyCode>USD</CurrencyCode></Price></SupplyDetail></ProductSupply>
+  </Product>
+  <Product>
+    <ProductIdentifier><ProductIDType>15</ProductIDType><IDValue>9780306406157</IDValue></ProductIdentifier>
+  </Product>
+</ONIXMessage>`
+
+func TestImportService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockImportRepository(ctrl)
+	service := imports.NewImportService(mockRepo)
+
+	t.Run("Import_CSV", func(t *testing.T) {
+		var batches [][]imports.Record
+		var rowErrors []imports.ImportError
+		mockRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *imports.ImportJob) (*imports.ImportJob, error) {
+				assert.Equal(t, imports.FormatCSV, job.Format)
+				assert.Equal(t, uint(9), *job.ActorID)
+				job.ID = 1
+				return job, nil
+			})
+		mockRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, records []imports.Record) error {
+				batches = append(batches, append([]imports.Record(nil), records...))
+				return nil
+			}).Times(2)
+		mockRepo.EXPECT().AddErrors(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, errs []imports.ImportError) error {
+				rowErrors = append(rowErrors, errs...)
+				return nil
+			}).AnyTimes()
+
+		result, err := service.Import(context.Background(), 9, "", "backlist.csv", strings.NewReader(importCSV))
+
+		require.NoError(t, err)
+		assert.Equal(t, imports.StatusCompleted, result.Status)
+		assert.Equal(t, 4, result.TotalRows)
+		assert.Equal(t, 2, result.ImportedRows)
+		assert.Equal(t, 2, result.FailedRows)
+
+		// The ISBN-10 on line 5 is the same book as line 2, so it starts a
+		// new batch and is applied after it.
+		require.Len(t, batches, 2)
+		first := batches[0][0]
+		assert.Equal(t, "9780547928227", first.ISBN)
+		assert.Equal(t, int64(1099), first.Price)
+		assert.Equal(t, "USD", first.Currency)
+		assert.Equal(t, "en", first.Language)
+		assert.Equal(t, []imports.Contributor{
+			{Name: "J. R. R. Tolkien", Role: books.RoleAuthor},
+			{Name: "Alan Lee", Role: books.RoleIllustrator},
+		}, first.Contributors)
+		assert.Equal(t, "9780547928227", batches[1][0].ISBN)
+		assert.Equal(t, int64(1250), batches[1][0].Price)
+
+		require.Len(t, rowErrors, 2)
+		assert.Equal(t, 3, rowErrors[0].Row)
+		assert.Contains(t, rowErrors[0].Message, "invalid price")
+		assert.Equal(t, 4, rowErrors[1].Row)
+		assert.Contains(t, rowErrors[1].Message, "invalid ISBN")
+	})
+
+	t.Run("Import_ONIX", func(t *testing.T) {
+		// A fresh mock keeps the AnyTimes expectations of other cases from
+		// capturing this import's calls.
+		mockRepo := mocks.NewMockImportRepository(ctrl)
+		service := imports.NewImportService(mockRepo)
+
+		var imported []imports.Record
+		var rowErrors []imports.ImportError
+		mockRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *imports.ImportJob) (*imports.ImportJob, error) {
+				job.ID = 2
+				return job, nil
+			})
+		mockRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, records []imports.Record) error {
+				imported = append(imported, records...)
+				return nil
+			})
+		mockRepo.EXPECT().AddErrors(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, errs []imports.ImportError) error {
+				rowErrors = append(rowErrors, errs...)
+				return nil
+			}).AnyTimes()
+
+		result, err := service.Import(context.Background(), 0, "", "feed.xml", strings.NewReader(importONIX))
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.TotalRows)
+		assert.Equal(t, 1, result.ImportedRows)
+		require.Len(t, imported, 1)
+		book := imported[0]
+		assert.Equal(t, "The Hobbit", book.Title)
+		assert.Equal(t, "There and Back Again", book.Subtitle)
+		assert.Equal(t, "en", book.Language)
+		assert.Equal(t, 300, book.PageCount)
+		assert.Equal(t, "Houghton Mifflin", book.Publisher)
+		assert.Equal(t, "1937-09-21", book.PublicationDate.Format("2006-01-02"))
+		assert.Equal(t, []imports.Contributor{
+			{Name: "J. R. R. Tolkien", Role: books.RoleAuthor},
+			{Name: "Alan Lee", Role: books.RoleIllustrator},
+		}, book.Contributors)
+
+		require.Len(t, rowErrors, 1)
+		assert.Equal(t, 2, rowErrors[0].Row)
+		assert.Equal(t, "price is required", rowErrors[0].Message)
+	})
+
+	t.Run("Import_CSV_CurrencyDecimals", func(t *testing.T) {
+		mockRepo := mocks.NewMockImportRepository(ctrl)
+		service := imports.NewImportService(mockRepo)
+
+		csv := "isbn,title,price,currency\n" +
+			"9780547928227,The Hobbit,1500,JPY\n" +
+			"9780306406157,Gödel Escher Bach,1.250,kwd\n" +
+			"9780140449136,Crime and Punishment,1500.5,JPY\n" +
+			"9780141439518,Pride and Prejudice,9.99,XXX\n"
+		var imported []imports.Record
+		var rowErrors []imports.ImportError
+		mockRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *imports.ImportJob) (*imports.ImportJob, error) {
+				job.ID = 4
+				return job, nil
+			})
+		mockRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, records []imports.Record) error {
+				imported = append(imported, records...)
+				return nil
+			})
+		mockRepo.EXPECT().AddErrors(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, errs []imports.ImportError) error {
+				rowErrors = append(rowErrors, errs...)
+				return nil
+			}).AnyTimes()
+
+		_, err := service.Import(context.Background(), 0, "", "prices.csv", strings.NewReader(csv))
+
+		require.NoError(t, err)
+		require.Len(t, imported, 2)
+		assert.Equal(t, int64(1500), imported[0].Price)
+		assert.Equal(t, "JPY", imported[0].Currency)
+		assert.Equal(t, int64(1250), imported[1].Price)
+		assert.Equal(t, "KWD", imported[1].Currency)
+
+		require.Len(t, rowErrors, 2)
+		assert.Contains(t, rowErrors[0].Message, "invalid price")
+		assert.Contains(t, rowErrors[1].Message, "unsupported currency")
+	})
+
+	t.Run("Import_RetriesFailedBatchPerRow", func(t *testing.T) {
+		mockRepo := mocks.NewMockImportRepository(ctrl)
+		service := imports.NewImportService(mockRepo)
+
+		csv := "isbn,title,price\n9780547928227,The Hobbit,10.99\n9780306406157,Taken SKU,5\n"
+		var rowErrors []imports.ImportError
+		mockRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *imports.ImportJob) (*imports.ImportJob, error) {
+				job.ID = 3
+				return job, nil
+			})
+		mockRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		gomock.InOrder(
+			mockRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Len(2)).Return(gorm.ErrDuplicatedKey),
+			mockRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Len(1)).Return(nil),
+			mockRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Len(1)).Return(gorm.ErrDuplicatedKey),
+		)
+		mockRepo.EXPECT().AddErrors(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, errs []imports.ImportError) error {
+				rowErrors = append(rowErrors, errs...)
+				return nil
+			}).AnyTimes()
+
+		result, err := service.Import(context.Background(), 0, imports.FormatCSV, "upload", strings.NewReader(csv))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.ImportedRows)
+		assert.Equal(t, 1, result.FailedRows)
+		require.Len(t, rowErrors, 1)
+		assert.Equal(t, 3, rowErrors[0].Row)
+		assert.Equal(t, "9780306406157", rowErrors[0].ISBN)
+	})
+
+	t.Run("GetJobErrors", func(t *testing.T) {
+		mockRepo.EXPECT().FindJobByID(gomock.Any(), uint(1)).Return(&imports.ImportJob{ID: 1}, nil)
+		mockRepo.EXPECT().FindErrors(gomock.Any(), uint(1), 0, 20).
+			Return([]imports.ImportError{{JobID: 1, Row: 3, Message: "invalid price \"ten\""}}, int64(1), nil)
+
+		result, err := service.GetJobErrors(context.Background(), 1, paginate(1, 20))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Errors, 1)
+		assert.Equal(t, 3, result.Errors[0].Row)
+	})
+}
+
+func TestImportService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockImportRepository(ctrl)
+	service := imports.NewImportService(mockRepo)
+
+	t.Run("Import_UnsupportedFormat", func(t *testing.T) {
+		result, err := service.Import(context.Background(), 0, "", "backlist.xlsx", strings.NewReader(""))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, imports.ErrUnsupportedFormat)
+	})
+
+	t.Run("Import_MissingColumn", func(t *testing.T) {
+		mockRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *imports.ImportJob) (*imports.ImportJob, error) {
+				job.ID = 4
+				return job, nil
+			})
+		mockRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockRepo.EXPECT().AddErrors(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		result, err := service.Import(context.Background(), 0, "", "backlist.csv", strings.NewReader("isbn,name\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, imports.StatusFailed, result.Status)
+		assert.Contains(t, result.Error, `"title"`)
+	})
+
+	t.Run("Import_RepositoryError", func(t *testing.T) {
+		mockRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).Return(nil, errors.New("Error database"))
+
+		result, err := service.Import(context.Background(), 0, "", "backlist.csv", strings.NewReader(importCSV))
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "Error database")
+	})
+
+	t.Run("GetJob_NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().FindJobByID(gomock.Any(), uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.GetJob(context.Background(), 99)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, imports.ErrJobNotFound)
+	})
+}
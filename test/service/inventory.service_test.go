@@ -0,0 +1,156 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/inventory/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestInventoryService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := inventory.NewInventoryService(mockInventoryRepo, mockBookRepo)
+
+	book := &books.Book{ID: 1, SKU: "9780547928227"}
+	main := &inventory.Location{ID: 1, Code: "main", Active: true}
+	store := &inventory.Location{ID: 2, Code: "store", Active: true}
+
+	t.Run("RecordMovement_Sale", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), book.SKU).Return(book, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(1)).Return(main, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, movements []inventory.StockMovement) ([]inventory.StockMovement, error) {
+				assert.Len(t, movements, 1)
+				assert.Equal(t, -2, movements[0].Quantity)
+				assert.Equal(t, uint(9), *movements[0].ActorID)
+				movements[0].ID = 10
+				movements[0].BalanceAfter = 3
+				return movements, nil
+			})
+
+		result, err := service.RecordMovement(context.Background(), 9, dto.MovementRequest{
+			SKU:        book.SKU,
+			LocationID: 1,
+			Type:       inventory.MovementSale,
+			Quantity:   2,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, -2, result.Quantity)
+		assert.Equal(t, 3, result.BalanceAfter)
+	})
+
+	t.Run("Transfer", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), book.SKU).Return(book, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(1)).Return(main, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(2)).Return(store, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, movements []inventory.StockMovement) ([]inventory.StockMovement, error) {
+				return movements, nil
+			})
+
+		result, err := service.Transfer(context.Background(), 9, dto.TransferRequest{
+			SKU:            book.SKU,
+			FromLocationID: 1,
+			ToLocationID:   2,
+			Quantity:       4,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, inventory.MovementTransferOut, result[0].Type)
+		assert.Equal(t, -4, result[0].Quantity)
+		assert.Equal(t, inventory.MovementTransferIn, result[1].Type)
+		assert.Equal(t, 4, result[1].Quantity)
+		assert.Equal(t, result[0].Reference, result[1].Reference)
+	})
+
+	t.Run("GetStock", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), book.SKU).Return(book, nil)
+		mockInventoryRepo.EXPECT().FindStockLevels(gomock.Any(), book.SKU).Return([]inventory.StockLevel{
+			{SKU: book.SKU, LocationID: 1, OnHand: 3, Location: *main},
+			{SKU: book.SKU, LocationID: 2, OnHand: 4, Location: *store},
+		}, nil)
+
+		result, err := service.GetStock(context.Background(), book.SKU)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, result.OnHand)
+		assert.Len(t, result.Levels, 2)
+	})
+}
+
+func TestInventoryService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := inventory.NewInventoryService(mockInventoryRepo, mockBookRepo)
+
+	book := &books.Book{ID: 1, SKU: "9780547928227"}
+
+	t.Run("RecordMovement_AdjustmentWithoutReason", func(t *testing.T) {
+		result, err := service.RecordMovement(context.Background(), 9, dto.MovementRequest{
+			SKU: book.SKU, LocationID: 1, Type: inventory.MovementAdjustment, Quantity: -1,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrReasonRequired)
+	})
+
+	t.Run("RecordMovement_NegativeReceipt", func(t *testing.T) {
+		result, err := service.RecordMovement(context.Background(), 9, dto.MovementRequest{
+			SKU: book.SKU, LocationID: 1, Type: inventory.MovementReceipt, Quantity: -1,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrInvalidQuantity)
+	})
+
+	t.Run("RecordMovement_UnknownSKU", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.RecordMovement(context.Background(), 9, dto.MovementRequest{
+			SKU: "missing", LocationID: 1, Type: inventory.MovementReceipt, Quantity: 1,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrUnknownSKU)
+	})
+
+	t.Run("RecordMovement_InactiveLocation", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), book.SKU).Return(book, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3}, nil)
+
+		result, err := service.RecordMovement(context.Background(), 9, dto.MovementRequest{
+			SKU: book.SKU, LocationID: 3, Type: inventory.MovementReceipt, Quantity: 1,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrLocationInactive)
+	})
+
+	t.Run("RecordMovement_InsufficientStock", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), book.SKU).Return(book, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(1)).Return(&inventory.Location{ID: 1, Active: true}, nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any(), gomock.Any()).Return(nil, inventory.ErrInsufficientStock)
+
+		result, err := service.RecordMovement(context.Background(), 9, dto.MovementRequest{
+			SKU: book.SKU, LocationID: 1, Type: inventory.MovementSale, Quantity: 1,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, inventory.ErrInsufficientStock)
+	})
+}
@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/pkg/money"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestLoyaltyService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLoyaltyRepo := mocks.NewMockLoyaltyRepository(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	service := loyalty.NewLoyaltyService(mockLoyaltyRepo, mockPricingService)
+	quotingAt(mockPricingService, pricing.ExchangeRate{Base: "USD", Quote: "JPY", Rate: "149.57"})
+
+	t.Run("GetAccount_ShowsNextTier", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().FindAccount(gomock.Any(), uint(7)).Return(&loyalty.Account{UserID: 7, Balance: 300, Earned: 1200}, nil)
+
+		result, err := service.GetAccount(context.Background(), 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, "silver", result.Tier)
+		assert.Equal(t, 125, result.Multiplier)
+		assert.Equal(t, "gold", result.NextTier)
+		assert.Equal(t, 3800, result.PointsToNextTier)
+	})
+
+	t.Run("AwardPurchase_AtTierMultiplier", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().FindAccount(gomock.Any(), uint(7)).Return(&loyalty.Account{UserID: 7, Earned: 1200}, nil)
+		mockLoyaltyRepo.EXPECT().Record(gomock.Any(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryPurchase, Reference: "order:42", Points: 25,
+		}, gomock.Any()).Return(nil)
+
+		err := service.AwardPurchase(context.Background(), 7, 42, 2099, "USD")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AwardPurchase_ConvertedToBaseCurrency", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().FindAccount(gomock.Any(), uint(7)).Return(&loyalty.Account{UserID: 7}, nil)
+		// 2540 JPY is 16.98 USD.
+		mockLoyaltyRepo.EXPECT().Record(gomock.Any(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryPurchase, Reference: "order:43", Points: 16,
+		}, gomock.Any()).Return(nil)
+
+		err := service.AwardPurchase(context.Background(), 7, 43, 2540, "JPY")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("AwardReview_OncePerBook", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).Return(gorm.ErrDuplicatedKey)
+
+		err := service.AwardReview(context.Background(), 7, 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Redeem_NotEnoughPoints", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().Record(gomock.Any(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryRedeem, Reference: "order:42", Points: -500,
+		}, gomock.Any()).Return(loyalty.ErrInsufficientPoints)
+
+		err := service.Redeem(context.Background(), 7, 42, 500)
+
+		assert.ErrorIs(t, err, loyalty.ErrInsufficientPoints)
+	})
+
+	t.Run("ReverseOrder_TakesBackEarnedAndRestoresRedeemed", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().FindEntry(gomock.Any(), uint(7), loyalty.EntryPurchase, "order:42").
+			Return(&loyalty.Entry{ID: 1, UserID: 7, Type: loyalty.EntryPurchase, Reference: "order:42", Points: 25}, nil)
+		mockLoyaltyRepo.EXPECT().Record(gomock.Any(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryReversal, Reference: "order:42", Points: -25,
+		}, gomock.Any()).Return(nil)
+		mockLoyaltyRepo.EXPECT().FindEntry(gomock.Any(), uint(7), loyalty.EntryRedeem, "order:42").
+			Return(&loyalty.Entry{ID: 2, UserID: 7, Type: loyalty.EntryRedeem, Reference: "order:42", Points: -500}, nil)
+		mockLoyaltyRepo.EXPECT().Record(gomock.Any(), &loyalty.Entry{
+			UserID: 7, Type: loyalty.EntryRestore, Reference: "order:42", Points: 500,
+		}, gomock.Any()).Return(nil)
+
+		err := service.ReverseOrder(context.Background(), 7, 42)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ReverseOrder_NothingEarned", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().FindEntry(gomock.Any(), uint(7), loyalty.EntryPurchase, "order:43").Return(nil, gorm.ErrRecordNotFound)
+		mockLoyaltyRepo.EXPECT().FindEntry(gomock.Any(), uint(7), loyalty.EntryRedeem, "order:43").Return(nil, gorm.ErrRecordNotFound)
+
+		err := service.ReverseOrder(context.Background(), 7, 43)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExpirePoints_InBatches", func(t *testing.T) {
+		mockLoyaltyRepo.EXPECT().Expire(gomock.Any(), gomock.Any(), 500).Return(500, nil)
+		mockLoyaltyRepo.EXPECT().Expire(gomock.Any(), gomock.Any(), 500).Return(3, nil)
+
+		err := service.ExpirePoints(context.Background())
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTierFor(t *testing.T) {
+	tier, next := loyalty.TierFor(0)
+	assert.Equal(t, "bronze", tier.Name)
+	assert.Equal(t, "silver", next.Name)
+
+	tier, next = loyalty.TierFor(5000)
+	assert.Equal(t, "gold", tier.Name)
+	assert.Nil(t, next)
+
+	assert.Equal(t, money.New(500, "USD"), loyalty.PointsValue(500))
+}
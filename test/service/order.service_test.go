@@ -0,0 +1,514 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/loyalty"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/orders/api/dto"
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/wallet"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func checkoutRequest() dto.CheckoutRequest {
+	return dto.CheckoutRequest{ShippingAddress: dto.AddressRequest{
+		Name: "Bilbo Baggins", Line1: "Bag End", City: "Hobbiton", PostalCode: "SH1 1BE", Country: "GB",
+	}}
+}
+
+func checkoutCart(userID uint, price int64) *carts.Cart {
+	book := books.Book{ID: 1, ISBN: "9780547928227", SKU: "9780547928227", Title: "The Hobbit", Price: 1099, Currency: "USD", TaxClass: books.TaxClassBook}
+	return &carts.Cart{
+		ID: 5, UserID: &userID, Currency: "USD", ExpiresAt: time.Now().Add(time.Hour),
+		Items: []carts.CartItem{{ID: 1, BookID: 1, Quantity: 2, UnitPrice: price, Book: book}},
+	}
+}
+
+// runInTransaction makes the mocked transactor call the work directly.
+func runInTransaction(transactor *mocks.MockTransactor) {
+	transactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func TestOrderService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderRepo := mocks.NewMockOrderRepository(ctrl)
+	mockCartRepo := mocks.NewMockCartRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockPromotionService := mocks.NewMockPromotionService(ctrl)
+	mockTaxService := mocks.NewMockTaxService(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	mockLoyaltyService := mocks.NewMockLoyaltyService(ctrl)
+	mockWalletService := mocks.NewMockWalletService(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := orders.NewOrderService(mockOrderRepo, mockCartRepo, mockInventoryRepo, mockPromotionService, mockTaxService, mockPricingService, mockLoyaltyService, mockWalletService, mockTransactor)
+	staffID := uint(2)
+	quotingAt(mockPricingService, pricing.ExchangeRate{Base: "USD", Quote: "JPY", Rate: "149.57"})
+	taxingIn(mockTaxService, &taxes.TaxRegion{ID: 1, Country: "GB", PricesIncludeTax: true, Rates: []taxes.TaxRate{
+		{TaxClass: books.TaxClassBook, Name: "VAT", Rate: 500},
+		{TaxClass: taxes.ClassDefault, Name: "VAT", Rate: 2000},
+	}})
+	code := "HOBBIT"
+	runningPromotions(mockPromotionService, promotions.Promotion{
+		ID: 3, Name: "Hobbit coupon", Code: &code, Type: promotions.TypePercentage, Value: 10, Scope: promotions.ScopeAll, Active: true,
+	})
+
+	t.Run("Checkout", func(t *testing.T) {
+		cart := checkoutCart(7, 1099)
+		cart.CouponCode = "HOBBIT"
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(cart, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				assert.Equal(t, orders.StatusPending, order.Status)
+				assert.Equal(t, int64(2198), order.Subtotal)
+				assert.Equal(t, int64(220), order.Discount)
+				// Prices include 5% VAT, so the tax is part of what is left.
+				assert.Equal(t, int64(94), order.Tax)
+				assert.Equal(t, int64(1978), order.Total)
+				assert.Equal(t, "VAT", order.Items[0].TaxName)
+				assert.Equal(t, 500, order.Items[0].TaxRate)
+				assert.Equal(t, "HOBBIT", order.CouponCode)
+				require.Len(t, order.Discounts, 1)
+				assert.Equal(t, uint(3), order.Discounts[0].PromotionID)
+				assert.Equal(t, "Hobbiton", order.Shipping.City)
+				require.Len(t, order.Transitions, 1)
+				assert.Equal(t, uint(7), *order.Transitions[0].ActorID)
+				order.ID = 42
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), []inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 2, Reference: "order:42",
+		}}).Return([]inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 2, Reference: "order:42", Status: inventory.ReservationActive,
+		}}, nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), uint(42)).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(42), result.ID)
+		assert.Equal(t, int64(1099), result.Items[0].UnitPrice)
+		assert.Equal(t, int64(2198), result.Items[0].LineTotal)
+		assert.Equal(t, int64(220), result.Items[0].Discount)
+		require.Len(t, result.Promotions, 1)
+		assert.Equal(t, "Hobbit coupon", result.Promotions[0].Name)
+		require.Len(t, result.Taxes, 1)
+		assert.Equal(t, int64(94), result.Taxes[0].Amount)
+	})
+
+	t.Run("Checkout_PreorderIsBackordered", func(t *testing.T) {
+		cart := checkoutCart(7, 1099)
+		release := time.Now().AddDate(0, 2, 0)
+		cart.Items[0].Book.ReleaseDate = &release
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(cart, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				order.ID = 45
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), []inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 2, Reference: "order:45", Backorder: true,
+		}}).Return([]inventory.StockReservation{{
+			SKU: "9780547928227", BookID: 1, Quantity: 2, Reference: "order:45", Status: inventory.ReservationWaiting,
+		}}, nil)
+		mockOrderRepo.EXPECT().SetBackordered(gomock.Any(), uint(45), true).Return(true, nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), uint(45)).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		require.NoError(t, err)
+		assert.True(t, result.Backordered)
+	})
+
+	t.Run("Checkout_RedeemsPoints", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				assert.Equal(t, int64(500), order.PointsDiscount)
+				assert.Equal(t, int64(1698), order.Total)
+				order.ID = 47
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLoyaltyService.EXPECT().Redeem(gomock.Any(), uint(7), uint(47), 500).Return(nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), uint(47)).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+
+		req := checkoutRequest()
+		req.RedeemPoints = 500
+		result, err := service.Checkout(context.Background(), 7, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 500, result.PointsRedeemed)
+		assert.Equal(t, int64(1698), result.Total)
+	})
+
+	t.Run("Checkout_RedeemsPointsInYen", func(t *testing.T) {
+		// 10.99 USD is 1644 JPY at 149.57.
+		cart := checkoutCart(7, 1644)
+		cart.Currency = "JPY"
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(cart, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				assert.Equal(t, "JPY", order.Currency)
+				// 500 points are worth 5 USD, 747.85 JPY rounded to the yen.
+				assert.Equal(t, int64(748), order.PointsDiscount)
+				assert.Equal(t, int64(2540), order.Total)
+				order.ID = 50
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLoyaltyService.EXPECT().Redeem(gomock.Any(), uint(7), uint(50), 500).Return(nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), uint(50)).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+
+		req := checkoutRequest()
+		req.RedeemPoints = 500
+		result, err := service.Checkout(context.Background(), 7, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(748), result.PointsDiscount)
+		assert.Equal(t, int64(2540), result.Total)
+	})
+
+	t.Run("Checkout_GiftCardPaysPart", func(t *testing.T) {
+		cardID := uint(3)
+		funds := &wallet.Funds{Currency: "USD", GiftCardID: &cardID, GiftCard: 500}
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		mockWalletService.EXPECT().Funds(gomock.Any(), uint(7), wallet.FundsRequest{
+			Currency: "USD", Amount: 2198, Code: "7KQM-X2PD-H9RT-4WLB",
+		}).Return(funds, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				assert.Equal(t, &cardID, order.GiftCardID)
+				assert.Equal(t, int64(500), order.GiftCardAmount)
+				order.ID = 48
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockWalletService.EXPECT().Charge(gomock.Any(), uint(7), uint(48), *funds).Return(nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), uint(48)).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+
+		req := checkoutRequest()
+		req.GiftCardCode = "7KQM-X2PD-H9RT-4WLB"
+		result, err := service.Checkout(context.Background(), 7, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, orders.StatusPending, result.Status)
+		assert.Equal(t, int64(2198), result.Total)
+		assert.Equal(t, int64(1698), result.AmountDue)
+	})
+
+	t.Run("Checkout_PaidInFullWithStoreCredit", func(t *testing.T) {
+		funds := &wallet.Funds{Currency: "USD", StoreCredit: 2198}
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		mockWalletService.EXPECT().Funds(gomock.Any(), uint(7), gomock.Any()).Return(funds, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				order.ID = 49
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockWalletService.EXPECT().Charge(gomock.Any(), uint(7), uint(49), *funds).Return(nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), uint(49)).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+		// Nothing is left to pay, so the order is paid right away.
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(49)).
+			Return(&orders.Order{ID: 49, UserID: 7, Status: orders.StatusPending, Currency: "USD", Total: 2198, StoreCredit: 2198}, nil)
+		mockLoyaltyService.EXPECT().AwardPurchase(gomock.Any(), uint(7), uint(49), int64(2198), "USD").Return(nil)
+		mockOrderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(49)).
+			Return(&orders.Order{ID: 49, UserID: 7, Status: orders.StatusPaid, Total: 2198, StoreCredit: 2198}, nil)
+
+		req := checkoutRequest()
+		req.UseStoreCredit = true
+		result, err := service.Checkout(context.Background(), 7, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, orders.StatusPaid, result.Status)
+		assert.Equal(t, int64(0), result.AmountDue)
+	})
+
+	t.Run("Transition_PaidEarnsPoints", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(47)).
+			Return(&orders.Order{ID: 47, UserID: 7, Status: orders.StatusPending, Currency: "USD", Total: 1698}, nil)
+		mockLoyaltyService.EXPECT().AwardPurchase(gomock.Any(), uint(7), uint(47), int64(1698), "USD").Return(nil)
+		mockOrderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(47)).
+			Return(&orders.Order{ID: 47, UserID: 7, Status: orders.StatusPaid}, nil)
+
+		result, err := service.Transition(context.Background(), nil, 47, dto.TransitionRequest{Status: orders.StatusPaid})
+
+		require.NoError(t, err)
+		assert.Equal(t, orders.StatusPaid, result.Status)
+	})
+
+	t.Run("AllocateBackorders_NotifiesFilledOrders", func(t *testing.T) {
+		mockInventoryRepo.EXPECT().FindAllocatableSKUs(gomock.Any(), gomock.Any()).Return([]string{"9780547928227"}, nil)
+		runInTransaction(mockTransactor)
+		mockInventoryRepo.EXPECT().Allocate(gomock.Any(), "9780547928227").Return([]string{"order:45", "order:46"}, nil)
+		mockOrderRepo.EXPECT().SetBackordered(gomock.Any(), uint(45), false).Return(true, nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(45)).Return(&orders.Order{ID: 45, UserID: 7}, nil)
+		mockOrderRepo.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *orders.OrderNotification) error {
+				assert.Equal(t, uint(7), notification.UserID)
+				assert.Equal(t, uint(45), notification.OrderID)
+				assert.Equal(t, orders.NotificationFulfillable, notification.Type)
+				return nil
+			})
+		// Order 46 was not backordered, only part of its copies waited.
+		mockOrderRepo.EXPECT().SetBackordered(gomock.Any(), uint(46), false).Return(false, nil)
+
+		assert.NoError(t, service.AllocateBackorders(context.Background()))
+	})
+
+	t.Run("Transition_ShippedCommitsReservations", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).
+			Return(&orders.Order{ID: 42, UserID: 7, Status: orders.StatusFulfilling}, nil)
+		mockInventoryRepo.EXPECT().CommitReservations(gomock.Any(), "order:42", &staffID).Return(nil)
+		mockOrderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), &orders.OrderTransition{
+			OrderID: 42, FromStatus: orders.StatusFulfilling, ToStatus: orders.StatusShipped, ActorID: &staffID, Note: "DHL 123",
+		}).Return(nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).
+			Return(&orders.Order{ID: 42, UserID: 7, Status: orders.StatusShipped}, nil)
+
+		result, err := service.Transition(context.Background(), &staffID, 42, dto.TransitionRequest{Status: orders.StatusShipped, Note: "DHL 123"})
+
+		require.NoError(t, err)
+		assert.Equal(t, orders.StatusShipped, result.Status)
+	})
+
+	t.Run("Cancel_OwnPendingOrder", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).
+			Return(&orders.Order{ID: 42, UserID: 7, Status: orders.StatusPending}, nil)
+		mockInventoryRepo.EXPECT().ReleaseReservations(gomock.Any(), "order:42").Return(nil)
+		mockLoyaltyService.EXPECT().ReverseOrder(gomock.Any(), uint(7), uint(42)).Return(nil)
+		mockWalletService.EXPECT().ReverseOrder(gomock.Any(), uint(7), uint(42)).Return(nil)
+		mockPromotionService.EXPECT().Release(gomock.Any(), uint(42)).Return(nil)
+		mockOrderRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).
+			Return(&orders.Order{ID: 42, UserID: 7, Status: orders.StatusCancelled}, nil)
+
+		result, err := service.Cancel(context.Background(), orders.Actor{UserID: 7}, 42, dto.CancelOrderRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, orders.StatusCancelled, result.Status)
+	})
+
+	t.Run("GetOrders_CustomerSeesOwnOrders", func(t *testing.T) {
+		mockOrderRepo.EXPECT().FindAll(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter orders.OrderFilter) ([]orders.Order, int64, error) {
+				assert.Equal(t, uint(7), *filter.UserID)
+				return []orders.Order{{ID: 42, UserID: 7}}, 1, nil
+			})
+
+		result, err := service.GetOrders(context.Background(), orders.Actor{UserID: 7}, dto.OrderListQuery{PaginationQuery: paginate(1, 20)})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Orders, 1)
+		assert.Equal(t, int64(1), result.Pagination.Total)
+	})
+}
+
+func TestOrderService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderRepo := mocks.NewMockOrderRepository(ctrl)
+	mockCartRepo := mocks.NewMockCartRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockPromotionService := mocks.NewMockPromotionService(ctrl)
+	mockTaxService := mocks.NewMockTaxService(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	mockLoyaltyService := mocks.NewMockLoyaltyService(ctrl)
+	mockWalletService := mocks.NewMockWalletService(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := orders.NewOrderService(mockOrderRepo, mockCartRepo, mockInventoryRepo, mockPromotionService, mockTaxService, mockPricingService, mockLoyaltyService, mockWalletService, mockTransactor)
+	taxingIn(mockTaxService, nil)
+	quotingAt(mockPricingService)
+	code := "SPENT"
+	runningPromotions(mockPromotionService, promotions.Promotion{
+		ID: 4, Name: "Launch coupon", Code: &code, Type: promotions.TypeFixedAmount, Value: 500, Currency: "USD",
+		Scope: promotions.ScopeAll, Active: true, UsageLimit: &[]int{100}[0], UsageCount: 100,
+	})
+
+	t.Run("Checkout_EmptyCart", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrEmptyCart)
+	})
+
+	t.Run("Checkout_PriceChanged", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 999), nil)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrCartChanged)
+	})
+
+	t.Run("Checkout_InsufficientStock", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *orders.Order) (*orders.Order, error) {
+				order.ID = 43
+				return order, nil
+			})
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, inventory.ErrInsufficientStock)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrInsufficientStock)
+	})
+
+	t.Run("Transition_BackorderedCannotShip", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(45)).
+			Return(&orders.Order{ID: 45, UserID: 7, Status: orders.StatusPaid, Backordered: true}, nil)
+
+		result, err := service.Transition(context.Background(), nil, 45, dto.TransitionRequest{Status: orders.StatusFulfilling})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrInvalidTransition)
+	})
+
+	t.Run("Checkout_PointsWorthMoreThanOrder", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+
+		req := checkoutRequest()
+		req.RedeemPoints = 5000
+		result, err := service.Checkout(context.Background(), 7, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, loyalty.ErrRedemptionTooLarge)
+	})
+
+	t.Run("Checkout_NotEnoughPoints", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&orders.Order{ID: 48}, nil)
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLoyaltyService.EXPECT().Redeem(gomock.Any(), uint(7), gomock.Any(), 500).Return(loyalty.ErrInsufficientPoints)
+
+		req := checkoutRequest()
+		req.RedeemPoints = 500
+		result, err := service.Checkout(context.Background(), 7, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, loyalty.ErrInsufficientPoints)
+	})
+
+	t.Run("Checkout_CartAlreadyCheckedOut", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&orders.Order{ID: 44}, nil)
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), gomock.Any()).Return(nil)
+		mockCartRepo.EXPECT().Delete(gomock.Any(), uint(5)).Return(gorm.ErrRecordNotFound)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrEmptyCart)
+	})
+
+	t.Run("Checkout_CouponFullyRedeemed", func(t *testing.T) {
+		cart := checkoutCart(7, 1099)
+		cart.CouponCode = "SPENT"
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(cart, nil)
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrCouponNotApplicable)
+		assert.Contains(t, err.Error(), "fully redeemed")
+	})
+
+	t.Run("Checkout_LastRedemptionTakenConcurrently", func(t *testing.T) {
+		mockCartRepo.EXPECT().FindByUserID(gomock.Any(), uint(7)).Return(checkoutCart(7, 1099), nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&orders.Order{ID: 45}, nil)
+		mockInventoryRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockPromotionService.EXPECT().Redeem(gomock.Any(), gomock.Any(), uint(7), gomock.Any()).
+			Return(fmt.Errorf("Launch coupon: %w", promotions.ErrUsageLimitReached))
+
+		result, err := service.Checkout(context.Background(), 7, checkoutRequest())
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrPromotionUnavailable)
+	})
+
+	t.Run("Transition_NotAllowed", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).
+			Return(&orders.Order{ID: 42, Status: orders.StatusPending}, nil)
+
+		result, err := service.Transition(context.Background(), nil, 42, dto.TransitionRequest{Status: orders.StatusShipped})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrInvalidTransition)
+	})
+
+	t.Run("Cancel_PaidOrderByCustomer", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).
+			Return(&orders.Order{ID: 42, UserID: 7, Status: orders.StatusPaid}, nil)
+
+		result, err := service.Cancel(context.Background(), orders.Actor{UserID: 7}, 42, dto.CancelOrderRequest{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrInvalidTransition)
+	})
+
+	t.Run("GetOrder_OtherCustomer", func(t *testing.T) {
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(&orders.Order{ID: 42, UserID: 7}, nil)
+
+		result, err := service.GetOrder(context.Background(), orders.Actor{UserID: 8}, 42)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, orders.ErrOrderNotFound)
+	})
+}
+
+func TestOrderStateMachine(t *testing.T) {
+	assert.True(t, orders.CanTransition(orders.StatusPending, orders.StatusPaid))
+	assert.True(t, orders.CanTransition(orders.StatusDelivered, orders.StatusRefunded))
+	assert.False(t, orders.CanTransition(orders.StatusShipped, orders.StatusCancelled))
+	assert.False(t, orders.CanTransition(orders.StatusCancelled, orders.StatusPending))
+	assert.False(t, orders.CanTransition(orders.StatusRefunded, orders.StatusPaid))
+}
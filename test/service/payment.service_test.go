@@ -0,0 +1,234 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/orders"
+	ordersDto "bookstore-framework/internal/orders/api/dto"
+	"bookstore-framework/internal/payments"
+	"bookstore-framework/internal/payments/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingOrder() *ordersDto.OrderResponse {
+	return &ordersDto.OrderResponse{ID: 42, UserID: 7, Status: orders.StatusPending, Currency: "USD", Total: 2198, AmountDue: 2198}
+}
+
+func TestPaymentService_FakeProvider(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	mockOrderService := mocks.NewMockOrderService(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	provider := payments.NewFakeProvider("webhook-secret")
+	service := payments.NewPaymentService(mockPaymentRepo, provider, mockOrderService, mockTransactor)
+	customer := orders.Actor{UserID: 7}
+
+	var payment *payments.Payment
+	t.Run("CreatePayment", func(t *testing.T) {
+		mockOrderService.EXPECT().GetOrder(gomock.Any(), customer, uint(42)).Return(pendingOrder(), nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return(nil, nil)
+		mockPaymentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *payments.Payment) (*payments.Payment, error) {
+				p.ID = 1
+				payment = p
+				return p, nil
+			})
+
+		result, err := service.CreatePayment(context.Background(), customer, dto.CreatePaymentRequest{OrderID: 42})
+
+		require.NoError(t, err)
+		assert.Equal(t, payments.PaymentPending, result.Status)
+		assert.Equal(t, int64(2198), result.Amount)
+		assert.Equal(t, payments.ProviderFake, result.Provider)
+		assert.NotEmpty(t, result.ClientSecret)
+	})
+
+	t.Run("CreatePayment_ReturnsOpenPayment", func(t *testing.T) {
+		mockOrderService.EXPECT().GetOrder(gomock.Any(), customer, uint(42)).Return(pendingOrder(), nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return([]payments.Payment{*payment}, nil)
+
+		result, err := service.CreatePayment(context.Background(), customer, dto.CreatePaymentRequest{OrderID: 42})
+
+		require.NoError(t, err)
+		assert.Equal(t, payment.IntentID, result.IntentID)
+		assert.NotEmpty(t, result.ClientSecret)
+	})
+
+	t.Run("HandleWebhook_AuthorizedIsCapturedAndOrderPaid", func(t *testing.T) {
+		payload, header, err := provider.Complete(payment.IntentID, true)
+		require.NoError(t, err)
+
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().RecordEvent(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockPaymentRepo.EXPECT().FindByIntentIDForUpdate(gomock.Any(), payments.ProviderFake, payment.IntentID).Return(payment, nil)
+		mockPaymentRepo.EXPECT().Update(gomock.Any(), payment).Return(nil)
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().FindByIntentIDForUpdate(gomock.Any(), payments.ProviderFake, payment.IntentID).Return(payment, nil)
+		mockPaymentRepo.EXPECT().Update(gomock.Any(), payment).Return(nil)
+		mockOrderService.EXPECT().Transition(gomock.Any(), nil, uint(42), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *uint, _ uint, req ordersDto.TransitionRequest) (*ordersDto.OrderResponse, error) {
+				assert.Equal(t, orders.StatusPaid, req.Status)
+				return &ordersDto.OrderResponse{ID: 42, Status: orders.StatusPaid}, nil
+			})
+
+		err = service.HandleWebhook(context.Background(), payload, header)
+
+		require.NoError(t, err)
+		assert.Equal(t, payments.PaymentCaptured, payment.Status)
+	})
+
+	t.Run("HandleWebhook_ReplayedEventIsAcknowledged", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_1","type":"payment.succeeded","intent_id":"` + payment.IntentID + `"}`)
+
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().RecordEvent(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := service.HandleWebhook(context.Background(), payload, provider.Header(payload))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Refund_Full", func(t *testing.T) {
+		staffID := uint(2)
+		mockPaymentRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(payment, nil)
+		mockOrderService.EXPECT().GetOrder(gomock.Any(), orders.Actor{Staff: true}, uint(42)).
+			Return(&ordersDto.OrderResponse{ID: 42, Status: orders.StatusPaid}, nil)
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().FindByIntentIDForUpdate(gomock.Any(), payments.ProviderFake, payment.IntentID).Return(payment, nil)
+		mockPaymentRepo.EXPECT().AddRefund(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, refund *payments.PaymentRefund) error {
+				assert.Equal(t, int64(2198), refund.Amount)
+				assert.Equal(t, &staffID, refund.ActorID)
+				return nil
+			})
+		mockPaymentRepo.EXPECT().Update(gomock.Any(), payment).Return(nil)
+		mockOrderService.EXPECT().Transition(gomock.Any(), &staffID, uint(42), ordersDto.TransitionRequest{
+			Status: orders.StatusRefunded, Note: "Damaged in transit",
+		}).Return(&ordersDto.OrderResponse{ID: 42, Status: orders.StatusRefunded}, nil)
+		mockPaymentRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(payment, nil)
+
+		result, err := service.Refund(context.Background(), staffID, 1, dto.RefundRequest{Reason: "Damaged in transit"})
+
+		require.NoError(t, err)
+		assert.Equal(t, payments.PaymentRefunded, result.Status)
+		assert.Equal(t, int64(2198), result.RefundedAmount)
+	})
+}
+
+func TestPaymentService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	mockProvider := mocks.NewMockPaymentProvider(ctrl)
+	mockOrderService := mocks.NewMockOrderService(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := payments.NewPaymentService(mockPaymentRepo, mockProvider, mockOrderService, mockTransactor)
+	mockProvider.EXPECT().Name().Return("stub").AnyTimes()
+
+	t.Run("Reconcile", func(t *testing.T) {
+		lost := payments.Payment{ID: 1, OrderID: 42, Provider: "stub", IntentID: "pi_lost", Status: payments.PaymentPending}
+		abandoned := payments.Payment{ID: 2, OrderID: 43, Provider: "stub", IntentID: "pi_abandoned", Status: payments.PaymentPending}
+		mockPaymentRepo.EXPECT().FindStale(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]payments.Payment{lost, abandoned}, nil)
+
+		// The webhook for the first payment never arrived.
+		mockProvider.EXPECT().GetIntent(gomock.Any(), "pi_lost").
+			Return(&payments.Intent{ID: "pi_lost", Status: payments.IntentAuthorized}, nil)
+		mockProvider.EXPECT().Capture(gomock.Any(), "pi_lost").
+			Return(&payments.Intent{ID: "pi_lost", Status: payments.IntentSucceeded}, nil)
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().FindByIntentIDForUpdate(gomock.Any(), "stub", "pi_lost").Return(&lost, nil)
+		mockPaymentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockOrderService.EXPECT().Transition(gomock.Any(), nil, uint(42), gomock.Any()).
+			Return(&ordersDto.OrderResponse{ID: 42, Status: orders.StatusPaid}, nil)
+
+		mockProvider.EXPECT().GetIntent(gomock.Any(), "pi_abandoned").
+			Return(&payments.Intent{ID: "pi_abandoned", Status: payments.IntentCanceled}, nil)
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().FindByIntentIDForUpdate(gomock.Any(), "stub", "pi_abandoned").Return(&abandoned, nil)
+		mockPaymentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := service.Reconcile(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, payments.PaymentCaptured, lost.Status)
+		assert.Equal(t, payments.PaymentFailed, abandoned.Status)
+	})
+
+	t.Run("HandleWebhook_CapturedAfterOrderCancelled", func(t *testing.T) {
+		payment := &payments.Payment{ID: 3, OrderID: 44, Provider: "stub", IntentID: "pi_late", Status: payments.PaymentAuthorized}
+		mockProvider.EXPECT().VerifyWebhook(gomock.Any(), gomock.Any()).
+			Return(&payments.WebhookEvent{ID: "evt_2", Type: payments.EventPaymentSucceeded, IntentID: "pi_late"}, nil)
+		runInTransaction(mockTransactor)
+		mockPaymentRepo.EXPECT().RecordEvent(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockPaymentRepo.EXPECT().FindByIntentIDForUpdate(gomock.Any(), "stub", "pi_late").Return(payment, nil)
+		mockPaymentRepo.EXPECT().Update(gomock.Any(), payment).Return(nil)
+		mockOrderService.EXPECT().Transition(gomock.Any(), nil, uint(44), gomock.Any()).Return(nil, orders.ErrInvalidTransition)
+
+		err := service.HandleWebhook(context.Background(), nil, http.Header{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, payments.PaymentCaptured, payment.Status)
+	})
+}
+
+func TestPaymentService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	mockOrderService := mocks.NewMockOrderService(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	provider := payments.NewFakeProvider("webhook-secret")
+	service := payments.NewPaymentService(mockPaymentRepo, provider, mockOrderService, mockTransactor)
+
+	t.Run("CreatePayment_OrderAlreadyPaid", func(t *testing.T) {
+		order := pendingOrder()
+		order.Status = orders.StatusPaid
+		mockOrderService.EXPECT().GetOrder(gomock.Any(), gomock.Any(), uint(42)).Return(order, nil)
+
+		result, err := service.CreatePayment(context.Background(), orders.Actor{UserID: 7}, dto.CreatePaymentRequest{OrderID: 42})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, payments.ErrOrderNotPayable)
+	})
+
+	t.Run("HandleWebhook_InvalidSignature", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_3","type":"payment.succeeded","intent_id":"pi_1"}`)
+		header := payments.NewFakeProvider("another-secret").Header(payload)
+
+		err := service.HandleWebhook(context.Background(), payload, header)
+
+		assert.ErrorIs(t, err, payments.ErrInvalidSignature)
+	})
+
+	t.Run("Refund_MoreThanCaptured", func(t *testing.T) {
+		mockPaymentRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&payments.Payment{
+			ID: 1, Status: payments.PaymentCaptured, Amount: 2198, RefundedAmount: 1000,
+		}, nil)
+
+		result, err := service.Refund(context.Background(), 2, 1, dto.RefundRequest{Amount: 1500})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, payments.ErrInvalidRefundAmount)
+	})
+
+	t.Run("Refund_PendingPayment", func(t *testing.T) {
+		mockPaymentRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&payments.Payment{
+			ID: 1, Status: payments.PaymentPending, Amount: 2198,
+		}, nil)
+
+		result, err := service.Refund(context.Background(), 2, 1, dto.RefundRequest{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, payments.ErrNotRefundable)
+	})
+}
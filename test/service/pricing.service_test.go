@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/pricing/api/dto"
+	"bookstore-framework/pkg/money"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestPricingService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPricingRepo := mocks.NewMockPricingRepository(ctrl)
+	service := pricing.NewPricingService(mockPricingRepo)
+
+	t.Run("Quote", func(t *testing.T) {
+		items := []pricing.Item{
+			{BookID: 1, Price: money.New(1099, "USD")},
+			{BookID: 2, Price: money.New(899, "EUR")},
+			{BookID: 3, Price: money.New(1250, "USD")},
+			{BookID: 4, Price: money.New(1500, "GBP")},
+			{BookID: 5, Price: money.New(2000, "JPY")},
+		}
+		mockPricingRepo.EXPECT().FindPrices(gomock.Any(), "EUR", []uint{1, 2, 3, 4, 5}).
+			Return([]pricing.BookPrice{{BookID: 1, Currency: "EUR", Amount: 999}}, nil)
+		mockPricingRepo.EXPECT().FindRates(gomock.Any(), "EUR", []string{"USD", "GBP", "JPY"}).
+			Return([]pricing.ExchangeRate{
+				{Base: "USD", Quote: "EUR", Rate: "0.9200000000"},
+				{Base: "EUR", Quote: "GBP", Rate: "0.8"},
+			}, nil)
+
+		quotes, err := service.Quote(context.Background(), "eur", items)
+
+		require.NoError(t, err)
+		// The price list wins over conversion.
+		assert.Equal(t, pricing.Price{Money: money.New(999, "EUR")}, quotes[1])
+		// The catalog price is used as is in its own currency.
+		assert.Equal(t, pricing.Price{Money: money.New(899, "EUR")}, quotes[2])
+		assert.Equal(t, pricing.Price{Money: money.New(1150, "EUR"), Converted: true}, quotes[3])
+		// Only EUR to GBP is set, so GBP to EUR uses its inverse.
+		assert.Equal(t, pricing.Price{Money: money.New(1875, "EUR"), Converted: true}, quotes[4])
+		// Without a rate the book cannot be priced.
+		assert.NotContains(t, quotes, uint(5))
+	})
+
+	t.Run("Convert_ReverseRate", func(t *testing.T) {
+		mockPricingRepo.EXPECT().FindRates(gomock.Any(), "USD", []string{"KWD"}).
+			Return([]pricing.ExchangeRate{{Base: "USD", Quote: "KWD", Rate: "0.3075"}}, nil)
+
+		converted, err := service.Convert(context.Background(), money.New(12345, "KWD"), "USD")
+
+		require.NoError(t, err)
+		// 12.345 KWD / 0.3075 is 40.146... USD.
+		assert.Equal(t, money.New(4015, "USD"), converted)
+	})
+
+	t.Run("SaveExchangeRate", func(t *testing.T) {
+		mockPricingRepo.EXPECT().SaveRate(gomock.Any(), &pricing.ExchangeRate{Base: "USD", Quote: "JPY", Rate: "150.25"}).Return(nil)
+
+		result, err := service.SaveExchangeRate(context.Background(), dto.ExchangeRateRequest{Base: "usd", Quote: "jpy", Rate: "150.25"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "USD", result.Base)
+		assert.Equal(t, "150.25", result.Rate)
+	})
+
+	t.Run("SavePriceList", func(t *testing.T) {
+		mockPricingRepo.EXPECT().MissingBooks(gomock.Any(), []uint{1, 2}).Return(nil, nil)
+		mockPricingRepo.EXPECT().SavePrices(gomock.Any(), []pricing.BookPrice{
+			{BookID: 1, Currency: "GBP", Amount: 899},
+			{BookID: 2, Currency: "GBP", Amount: 1299},
+		}).Return(nil)
+
+		err := service.SavePriceList(context.Background(), "gbp", dto.PriceListRequest{Prices: []dto.BookPriceRequest{
+			{BookID: 1, Amount: 899}, {BookID: 2, Amount: 1299}, {BookID: 1, Amount: 999},
+		}})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestPricingService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPricingRepo := mocks.NewMockPricingRepository(ctrl)
+	service := pricing.NewPricingService(mockPricingRepo)
+
+	t.Run("Quote_UnsupportedCurrency", func(t *testing.T) {
+		quotes, err := service.Quote(context.Background(), "XAU", []pricing.Item{{BookID: 1, Price: money.New(1099, "USD")}})
+
+		assert.Nil(t, quotes)
+		assert.ErrorIs(t, err, pricing.ErrUnsupportedCurrency)
+	})
+
+	t.Run("Convert_NoRate", func(t *testing.T) {
+		mockPricingRepo.EXPECT().FindRates(gomock.Any(), "JPY", []string{"USD"}).Return(nil, nil)
+
+		_, err := service.Convert(context.Background(), money.New(500, "USD"), "JPY")
+
+		assert.ErrorIs(t, err, pricing.ErrExchangeRateNotFound)
+	})
+
+	t.Run("SaveExchangeRate_InvalidRate", func(t *testing.T) {
+		result, err := service.SaveExchangeRate(context.Background(), dto.ExchangeRateRequest{Base: "USD", Quote: "EUR", Rate: "0.92.1"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, pricing.ErrInvalidExchangeRate)
+	})
+
+	t.Run("SaveExchangeRate_SameCurrency", func(t *testing.T) {
+		result, err := service.SaveExchangeRate(context.Background(), dto.ExchangeRateRequest{Base: "usd", Quote: "USD", Rate: "1.01"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, pricing.ErrSameCurrency)
+	})
+
+	t.Run("SavePriceList_UnknownBook", func(t *testing.T) {
+		mockPricingRepo.EXPECT().MissingBooks(gomock.Any(), []uint{1, 42}).Return([]uint{42}, nil)
+
+		err := service.SavePriceList(context.Background(), "GBP", dto.PriceListRequest{Prices: []dto.BookPriceRequest{
+			{BookID: 1, Amount: 899}, {BookID: 42, Amount: 1299},
+		}})
+
+		assert.ErrorIs(t, err, pricing.ErrBookNotFound)
+	})
+
+	t.Run("DeleteExchangeRate_NotFound", func(t *testing.T) {
+		mockPricingRepo.EXPECT().DeleteRate(gomock.Any(), "USD", "EUR").Return(gorm.ErrRecordNotFound)
+
+		err := service.DeleteExchangeRate(context.Background(), "usd", "eur")
+
+		assert.ErrorIs(t, err, pricing.ErrExchangeRateNotFound)
+	})
+}
@@ -0,0 +1,241 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/promotions"
+	"bookstore-framework/internal/promotions/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func couponCode(code string) *string {
+	return &code
+}
+
+func fantasyBasket() promotions.Basket {
+	return promotions.Basket{
+		Currency: "USD",
+		UserID:   uintPtr(7),
+		Lines: []promotions.Line{
+			{BookID: 1, Quantity: 2, UnitPrice: 1000, CategoryIDs: []uint{3, 1}, AuthorIDs: []uint{5}},
+			{BookID: 2, Quantity: 1, UnitPrice: 2000, CategoryIDs: []uint{4}, AuthorIDs: []uint{6}},
+		},
+	}
+}
+
+func TestPromotionEngine(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Stacking", func(t *testing.T) {
+		running := []promotions.Promotion{
+			{ID: 1, Name: "Fantasy week", Type: promotions.TypePercentage, Value: 10, Scope: promotions.ScopeCategory, ScopeID: uintPtr(3), Stackable: true, Active: true},
+			{ID: 2, Name: "Five off", Type: promotions.TypeFixedAmount, Value: 500, Currency: "USD", Scope: promotions.ScopeAll, Stackable: true, Priority: 10, Active: true},
+		}
+
+		result := promotions.Evaluate(running, fantasyBasket(), nil, now)
+
+		// The fixed amount goes first and is spread 250/250 over the lines,
+		// then 10% comes off what is left of the fantasy line.
+		require.Len(t, result.Applied, 2)
+		assert.Equal(t, uint(2), result.Applied[0].PromotionID)
+		assert.Equal(t, int64(500), result.Applied[0].Amount)
+		assert.Equal(t, int64(175), result.Applied[1].Amount)
+		assert.Equal(t, int64(425), result.Lines[0].Discount)
+		assert.Equal(t, int64(250), result.Lines[1].Discount)
+		assert.Equal(t, int64(675), result.Discount)
+	})
+
+	t.Run("NonStackableBlocksLaterPromotions", func(t *testing.T) {
+		running := []promotions.Promotion{
+			{ID: 1, Name: "Half price", Type: promotions.TypePercentage, Value: 50, Scope: promotions.ScopeAll, Priority: 10, Active: true},
+			{ID: 2, Name: "Free shipping", Type: promotions.TypeFreeShipping, Scope: promotions.ScopeAll, Stackable: true, Active: true},
+		}
+
+		result := promotions.Evaluate(running, fantasyBasket(), nil, now)
+
+		require.Len(t, result.Applied, 1)
+		assert.Equal(t, int64(2000), result.Discount)
+		assert.False(t, result.FreeShipping)
+	})
+
+	t.Run("BuyXGetY_CheapestCopiesFree", func(t *testing.T) {
+		running := []promotions.Promotion{
+			{ID: 1, Name: "Three for two", Type: promotions.TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1, Scope: promotions.ScopeAll, Active: true},
+		}
+
+		result := promotions.Evaluate(running, fantasyBasket(), nil, now)
+
+		assert.Equal(t, int64(1000), result.Lines[0].Discount)
+		assert.Equal(t, int64(0), result.Lines[1].Discount)
+		assert.Equal(t, "buy 2, get 1 free", result.Applied[0].Description)
+	})
+
+	t.Run("FixedAmountNeverExceedsEligibleLines", func(t *testing.T) {
+		running := []promotions.Promotion{
+			{ID: 1, Name: "Tolkien credit", Type: promotions.TypeFixedAmount, Value: 5000, Currency: "USD", Scope: promotions.ScopeAuthor, ScopeID: uintPtr(5), Active: true},
+		}
+
+		result := promotions.Evaluate(running, fantasyBasket(), nil, now)
+
+		assert.Equal(t, int64(2000), result.Lines[0].Discount)
+		assert.Equal(t, int64(0), result.Lines[1].Discount)
+	})
+
+	t.Run("CouponReasons", func(t *testing.T) {
+		limit := 1
+		ended := now.Add(-time.Hour)
+		cases := []struct {
+			name      string
+			promotion promotions.Promotion
+			reason    string
+		}{
+			{"Ended", promotions.Promotion{EndsAt: &ended}, "promotion has ended"},
+			{"PerUserLimit", promotions.Promotion{PerUserLimit: &limit}, "you have already used this promotion as many times as allowed"},
+			{"Currency", promotions.Promotion{Currency: "EUR"}, "promotion only applies to carts in EUR"},
+			{"MinSubtotal", promotions.Promotion{Currency: "USD", MinSubtotal: 10000}, "requires a subtotal of at least 100.00 USD"},
+			{"NoEligibleBooks", promotions.Promotion{Scope: promotions.ScopeCategory, ScopeID: uintPtr(9)}, "no eligible books in the cart"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				promotion := tc.promotion
+				promotion.ID, promotion.Name, promotion.Code = 1, "Coupon", couponCode("SAVE10")
+				promotion.Type, promotion.Value, promotion.Active = promotions.TypePercentage, 10, true
+				basket := fantasyBasket()
+				basket.Code = "SAVE10"
+
+				result := promotions.Evaluate([]promotions.Promotion{promotion}, basket, map[uint]int64{1: 1}, now)
+
+				require.NotNil(t, result.Coupon)
+				assert.False(t, result.Coupon.Applied)
+				assert.Equal(t, tc.reason, result.Coupon.Reason)
+				assert.Zero(t, result.Discount)
+			})
+		}
+	})
+}
+
+func TestPromotionService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPromotionRepo := mocks.NewMockPromotionRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	service := promotions.NewPromotionService(mockPromotionRepo, mockCategoryRepo, mockAuthorRepo)
+
+	t.Run("CreatePromotion", func(t *testing.T) {
+		mockCategoryRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&books.Category{ID: 3}, nil)
+		mockPromotionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *promotions.Promotion) (*promotions.Promotion, error) {
+				assert.Equal(t, "SUMMER10", *p.Code)
+				assert.True(t, p.Active)
+				p.ID = 1
+				return p, nil
+			})
+
+		result, err := service.CreatePromotion(context.Background(), dto.PromotionRequest{
+			Name: "Summer fantasy sale", Code: " summer10 ", Type: promotions.TypePercentage, Value: 10,
+			Scope: promotions.ScopeCategory, ScopeID: uintPtr(3),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "SUMMER10", result.Code)
+		assert.Equal(t, "10% off", result.Description)
+	})
+
+	t.Run("Evaluate_LoadsScopesAndUsage", func(t *testing.T) {
+		limit := 1
+		mockPromotionRepo.EXPECT().FindApplicable(gomock.Any(), "WELCOME", gomock.Any()).Return([]promotions.Promotion{
+			{ID: 2, Name: "Welcome", Code: couponCode("WELCOME"), Type: promotions.TypePercentage, Value: 10, Scope: promotions.ScopeAuthor, ScopeID: uintPtr(5), PerUserLimit: &limit, Active: true},
+		}, nil)
+		mockPromotionRepo.EXPECT().FindBookScopes(gomock.Any(), []uint{1}).
+			Return(map[uint]promotions.BookScope{1: {AuthorIDs: []uint{5}}}, nil)
+		mockPromotionRepo.EXPECT().CountRedemptions(gomock.Any(), uint(7), []uint{2}).Return(map[uint]int64{}, nil)
+
+		result, err := service.Evaluate(context.Background(), promotions.Basket{
+			Currency: "USD", UserID: uintPtr(7), Code: "welcome",
+			Lines: []promotions.Line{{BookID: 1, Quantity: 1, UnitPrice: 1099}},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Coupon.Applied)
+		assert.Equal(t, int64(110), result.Discount)
+	})
+
+	t.Run("Redeem", func(t *testing.T) {
+		mockPromotionRepo.EXPECT().Redeem(gomock.Any(), &promotions.PromotionRedemption{PromotionID: 2, OrderID: 42, UserID: 7}).Return(nil)
+
+		err := service.Redeem(context.Background(), &promotions.Evaluation{
+			Applied: []promotions.Adjustment{{PromotionID: 2, Name: "Welcome", Amount: 110}},
+		}, 7, 42)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestPromotionService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPromotionRepo := mocks.NewMockPromotionRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	service := promotions.NewPromotionService(mockPromotionRepo, mockCategoryRepo, mockAuthorRepo)
+
+	t.Run("CreatePromotion_PercentageOutOfRange", func(t *testing.T) {
+		result, err := service.CreatePromotion(context.Background(), dto.PromotionRequest{
+			Name: "Too generous", Type: promotions.TypePercentage, Value: 150,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, promotions.ErrInvalidPromotion)
+	})
+
+	t.Run("CreatePromotion_UnknownAuthor", func(t *testing.T) {
+		mockAuthorRepo.EXPECT().FindByID(gomock.Any(), uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.CreatePromotion(context.Background(), dto.PromotionRequest{
+			Name: "Author week", Type: promotions.TypePercentage, Value: 10, Scope: promotions.ScopeAuthor, ScopeID: uintPtr(99),
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, promotions.ErrScopeNotFound)
+	})
+
+	t.Run("CreatePromotion_DuplicateCode", func(t *testing.T) {
+		mockPromotionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreatePromotion(context.Background(), dto.PromotionRequest{
+			Name: "Again", Code: "SUMMER10", Type: promotions.TypeFreeShipping,
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, promotions.ErrDuplicateCode)
+	})
+
+	t.Run("FindCoupon_NotFound", func(t *testing.T) {
+		mockPromotionRepo.EXPECT().FindByCode(gomock.Any(), "NOPE").Return(nil, gorm.ErrRecordNotFound)
+
+		code, err := service.FindCoupon(context.Background(), "nope")
+
+		assert.Empty(t, code)
+		assert.ErrorIs(t, err, promotions.ErrCouponNotFound)
+	})
+
+	t.Run("Redeem_UsageLimitReached", func(t *testing.T) {
+		mockPromotionRepo.EXPECT().Redeem(gomock.Any(), gomock.Any()).Return(promotions.ErrUsageLimitReached)
+
+		err := service.Redeem(context.Background(), &promotions.Evaluation{
+			Applied: []promotions.Adjustment{{PromotionID: 2, Name: "Welcome"}},
+		}, 7, 42)
+
+		assert.ErrorIs(t, err, promotions.ErrUsageLimitReached)
+	})
+}
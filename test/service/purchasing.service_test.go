@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/purchasing/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func purchaseOrderOf(status string, received int) *purchasing.PurchaseOrder {
+	return &purchasing.PurchaseOrder{
+		ID:         9,
+		SupplierID: 2,
+		LocationID: 3,
+		Status:     status,
+		Currency:   "GBP",
+		Total:      13980,
+		Supplier:   purchasing.Supplier{ID: 2, Name: "Northern Books", Active: true},
+		Items: []purchasing.PurchaseOrderItem{
+			{ID: 1, BookID: 1, SKU: "SKU-1", Quantity: 20, ReceivedQuantity: received, UnitCost: 699, LineTotal: 13980},
+		},
+	}
+}
+
+func TestPurchasingService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPurchasingRepo := mocks.NewMockPurchasingRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := purchasing.NewPurchasingService(mockPurchasingRepo, mockBookRepo, mockInventoryRepo, mockTransactor)
+	supplier := &purchasing.Supplier{ID: 2, Name: "Northern Books", Email: "orders@northernbooks.example", Currency: "GBP", Active: true}
+	catalog := []purchasing.SupplierItem{{SupplierID: 2, BookID: 1, SupplierSKU: "NBD-1", Cost: 699, MinQuantity: 5}}
+
+	t.Run("CreateSupplier_Duplicate", func(t *testing.T) {
+		mockPurchasingRepo.EXPECT().CreateSupplier(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		_, err := service.CreateSupplier(context.Background(), dto.SupplierRequest{Name: "Northern Books", Email: "orders@northernbooks.example", Currency: "GBP"})
+
+		assert.ErrorIs(t, err, purchasing.ErrDuplicateSupplier)
+	})
+
+	t.Run("SaveCatalogItems_DefaultsMinimumQuantity", func(t *testing.T) {
+		mockPurchasingRepo.EXPECT().FindSupplierByID(gomock.Any(), uint(2)).Return(supplier, nil)
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1, SKU: "SKU-1"}, nil)
+		mockPurchasingRepo.EXPECT().SaveSupplierItems(gomock.Any(), []purchasing.SupplierItem{
+			{SupplierID: 2, BookID: 1, Cost: 699, MinQuantity: 1},
+		}).Return(nil)
+		mockPurchasingRepo.EXPECT().FindSupplierItems(gomock.Any(), uint(2)).Return(catalog, nil)
+
+		result, err := service.SaveCatalogItems(context.Background(), 2, dto.SupplierItemsRequest{
+			Items: []dto.SupplierItemRequest{{BookID: 1, Cost: 699}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "GBP", result.Currency)
+		assert.Len(t, result.Items, 1)
+	})
+
+	t.Run("CreatePurchaseOrder_PricesFromCatalog", func(t *testing.T) {
+		mockPurchasingRepo.EXPECT().FindSupplierByID(gomock.Any(), uint(2)).Return(supplier, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+		mockPurchasingRepo.EXPECT().FindSupplierItems(gomock.Any(), uint(2)).Return(catalog, nil)
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1, SKU: "SKU-1", Title: "The Hobbit"}, nil)
+		mockPurchasingRepo.EXPECT().CreatePurchaseOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *purchasing.PurchaseOrder) (*purchasing.PurchaseOrder, error) {
+				order.ID = 9
+				return order, nil
+			})
+
+		result, err := service.CreatePurchaseOrder(context.Background(), 1, dto.PurchaseOrderRequest{
+			SupplierID: 2,
+			LocationID: 3,
+			Items:      []dto.PurchaseOrderItemRequest{{BookID: 1, Quantity: 20}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, purchasing.StatusDraft, result.Status)
+		assert.Equal(t, "GBP", result.Currency)
+		assert.Equal(t, int64(13980), result.Total)
+		assert.Equal(t, "NBD-1", result.Items[0].SupplierSKU)
+		assert.Equal(t, "orders@northernbooks.example", result.SupplierEmail)
+	})
+
+	t.Run("CreatePurchaseOrder_BelowMinimumQuantity", func(t *testing.T) {
+		mockPurchasingRepo.EXPECT().FindSupplierByID(gomock.Any(), uint(2)).Return(supplier, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+		mockPurchasingRepo.EXPECT().FindSupplierItems(gomock.Any(), uint(2)).Return(catalog, nil)
+
+		_, err := service.CreatePurchaseOrder(context.Background(), 1, dto.PurchaseOrderRequest{
+			SupplierID: 2,
+			LocationID: 3,
+			Items:      []dto.PurchaseOrderItemRequest{{BookID: 1, Quantity: 4}},
+		})
+
+		assert.ErrorIs(t, err, purchasing.ErrBelowMinimumQuantity)
+	})
+
+	t.Run("CreatePurchaseOrder_BookNotInCatalog", func(t *testing.T) {
+		mockPurchasingRepo.EXPECT().FindSupplierByID(gomock.Any(), uint(2)).Return(supplier, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+		mockPurchasingRepo.EXPECT().FindSupplierItems(gomock.Any(), uint(2)).Return(catalog, nil)
+
+		_, err := service.CreatePurchaseOrder(context.Background(), 1, dto.PurchaseOrderRequest{
+			SupplierID: 2,
+			LocationID: 3,
+			Items:      []dto.PurchaseOrderItemRequest{{BookID: 8, Quantity: 10}},
+		})
+
+		assert.ErrorIs(t, err, purchasing.ErrInvalidItems)
+	})
+
+	t.Run("UpdatePurchaseOrder_NotDraft", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusSent, 0), nil)
+
+		_, err := service.UpdatePurchaseOrder(context.Background(), 1, 9, dto.PurchaseOrderRequest{SupplierID: 2, LocationID: 3})
+
+		assert.ErrorIs(t, err, purchasing.ErrNotEditable)
+	})
+
+	t.Run("Send", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusDraft, 0), nil)
+		mockPurchasingRepo.EXPECT().UpdatePurchaseOrder(gomock.Any(), gomock.Any()).Return(nil)
+
+		result, err := service.Send(context.Background(), 1, 9)
+
+		require.NoError(t, err)
+		assert.Equal(t, purchasing.StatusSent, result.Status)
+		assert.NotNil(t, result.SentAt)
+	})
+
+	t.Run("Cancel_PartiallyReceived", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusPartiallyReceived, 5), nil)
+
+		_, err := service.Cancel(context.Background(), 1, 9)
+
+		assert.ErrorIs(t, err, purchasing.ErrInvalidTransition)
+	})
+
+	t.Run("Receive_PartialPostsReceipts", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusSent, 0), nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+		mockPurchasingRepo.EXPECT().UpdatePurchaseOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *purchasing.PurchaseOrder) error {
+				assert.Equal(t, 12, order.Items[0].ReceivedQuantity)
+				return nil
+			})
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, movements []inventory.StockMovement) ([]inventory.StockMovement, error) {
+				require.Len(t, movements, 1)
+				assert.Equal(t, inventory.MovementReceipt, movements[0].Type)
+				assert.Equal(t, "SKU-1", movements[0].SKU)
+				assert.Equal(t, 12, movements[0].Quantity)
+				assert.Equal(t, uint(3), movements[0].LocationID)
+				assert.Equal(t, "purchase_order:9", movements[0].Reference)
+				return movements, nil
+			})
+
+		result, err := service.Receive(context.Background(), 1, 9, dto.ReceivePurchaseOrderRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Quantity: 12}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, purchasing.StatusPartiallyReceived, result.Status)
+		assert.Nil(t, result.ReceivedAt)
+	})
+
+	t.Run("Receive_Rest", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusPartiallyReceived, 12), nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+		mockPurchasingRepo.EXPECT().UpdatePurchaseOrder(gomock.Any(), gomock.Any()).Return(nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		result, err := service.Receive(context.Background(), 1, 9, dto.ReceivePurchaseOrderRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Quantity: 8}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, purchasing.StatusReceived, result.Status)
+		assert.Equal(t, 20, result.Items[0].ReceivedQuantity)
+		assert.NotNil(t, result.ReceivedAt)
+	})
+
+	t.Run("Receive_MoreThanOutstanding", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusPartiallyReceived, 12), nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+
+		_, err := service.Receive(context.Background(), 1, 9, dto.ReceivePurchaseOrderRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Quantity: 9}},
+		})
+
+		assert.ErrorIs(t, err, purchasing.ErrOverReceipt)
+	})
+
+	t.Run("Receive_Draft", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockPurchasingRepo.EXPECT().FindPurchaseOrderForUpdate(gomock.Any(), uint(9)).Return(purchaseOrderOf(purchasing.StatusDraft, 0), nil)
+
+		_, err := service.Receive(context.Background(), 1, 9, dto.ReceivePurchaseOrderRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Quantity: 1}},
+		})
+
+		assert.ErrorIs(t, err, purchasing.ErrInvalidTransition)
+	})
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/recommendations"
+	"bookstore-framework/internal/recommendations/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRecommendationService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := mocks.NewMockRecommendationRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := recommendations.NewRecommendationService(mockRecommendationRepo, mockBookRepo)
+
+	t.Run("ComputeNeighbours", func(t *testing.T) {
+		mockRecommendationRepo.EXPECT().PurchasePairs(gomock.Any(), 2).
+			Return([]recommendations.Pair{{BookID: 1, NeighbourID: 2, Count: 2}}, nil)
+		mockRecommendationRepo.EXPECT().PurchaseCounts(gomock.Any()).
+			Return([]recommendations.Popularity{{BookID: 1, Count: 4}, {BookID: 2, Count: 4}}, nil)
+		mockRecommendationRepo.EXPECT().ViewPairs(gomock.Any(), gomock.Any(), 3).
+			Return([]recommendations.Pair{{BookID: 1, NeighbourID: 2, Count: 4}, {BookID: 1, NeighbourID: 3, Count: 3}}, nil)
+		mockRecommendationRepo.EXPECT().ViewCounts(gomock.Any(), gomock.Any()).
+			Return([]recommendations.Popularity{{BookID: 1, Count: 9}, {BookID: 2, Count: 4}, {BookID: 3, Count: 4}}, nil)
+		mockRecommendationRepo.EXPECT().ReplaceNeighbours(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, neighbours []recommendations.BookNeighbour) error {
+				require.Len(t, neighbours, 2)
+				// 0.7 * 2/sqrt(4*4) + 0.3 * 4/sqrt(9*4)
+				assert.Equal(t, uint(2), neighbours[0].NeighbourID)
+				assert.InDelta(t, 0.55, neighbours[0].Score, 1e-9)
+				assert.Equal(t, 2, neighbours[0].CoPurchases)
+				assert.Equal(t, 4, neighbours[0].CoViews)
+				// 0.3 * 3/sqrt(9*4)
+				assert.Equal(t, uint(3), neighbours[1].NeighbourID)
+				assert.InDelta(t, 0.15, neighbours[1].Score, 1e-9)
+				assert.Equal(t, 0, neighbours[1].CoPurchases)
+				return nil
+			})
+		mockRecommendationRepo.EXPECT().DeleteViewsBefore(gomock.Any(), gomock.Any()).Return(int64(12), nil)
+
+		err := service.ComputeNeighbours(context.Background())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetBookRecommendations", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1}, nil)
+		mockRecommendationRepo.EXPECT().FindNeighbours(gomock.Any(), uint(1), 10).Return([]recommendations.BookNeighbour{
+			{BookID: 1, NeighbourID: 2, Score: 0.55, CoPurchases: 2, CoViews: 4, Neighbour: books.Book{ID: 2, Title: "The Lord of the Rings", Price: 2999, Currency: "USD"}},
+		}, nil)
+
+		result, err := service.GetBookRecommendations(context.Background(), 1, dto.RecommendationQuery{Limit: 10})
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, dto.RecommendationResponse{
+			BookID: 2, Title: "The Lord of the Rings", Price: 2999, Currency: "USD", Score: 0.55, CoPurchases: 2, CoViews: 4,
+		}, result[0])
+	})
+
+	t.Run("GetUserRecommendations_FromHistory", func(t *testing.T) {
+		mockRecommendationRepo.EXPECT().FindPurchased(gomock.Any(), uint(7)).Return([]uint{1}, nil)
+		mockRecommendationRepo.EXPECT().FindViewed(gomock.Any(), uint(7), gomock.Any(), 50).Return([]uint{4}, nil)
+		mockRecommendationRepo.EXPECT().FindNeighboursOf(gomock.Any(), []uint{1, 4}, 5).Return([]recommendations.BookNeighbour{
+			{NeighbourID: 2, Score: 0.9, Neighbour: books.Book{ID: 2, Title: "The Lord of the Rings"}},
+		}, nil)
+
+		result, err := service.GetUserRecommendations(context.Background(), 7, dto.RecommendationQuery{Limit: 5})
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, uint(2), result[0].BookID)
+	})
+
+	t.Run("GetUserRecommendations_NoHistory", func(t *testing.T) {
+		mockRecommendationRepo.EXPECT().FindPurchased(gomock.Any(), uint(8)).Return(nil, nil)
+		mockRecommendationRepo.EXPECT().FindViewed(gomock.Any(), uint(8), gomock.Any(), 50).Return(nil, nil)
+
+		result, err := service.GetUserRecommendations(context.Background(), 8, dto.RecommendationQuery{Limit: 5})
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestRecommendationService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := mocks.NewMockRecommendationRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	service := recommendations.NewRecommendationService(mockRecommendationRepo, mockBookRepo)
+
+	t.Run("GetBookRecommendations_BookNotFound", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.GetBookRecommendations(context.Background(), 99, dto.RecommendationQuery{Limit: 10})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, recommendations.ErrBookNotFound)
+	})
+
+	t.Run("ComputeNeighbours_KeepsNeighboursOnFailure", func(t *testing.T) {
+		mockRecommendationRepo.EXPECT().PurchasePairs(gomock.Any(), 2).Return(nil, gorm.ErrInvalidDB)
+
+		err := service.ComputeNeighbours(context.Background())
+
+		assert.ErrorIs(t, err, gorm.ErrInvalidDB)
+	})
+}
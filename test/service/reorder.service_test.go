@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/purchasing"
+	"bookstore-framework/internal/purchasing/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestSuggestQuantity(t *testing.T) {
+	// 2 a day over 5 days of lead time and 30 days of cover, up to one past
+	// the reorder point of 10 from a position of 6.
+	assert.Equal(t, 75, purchasing.SuggestQuantity(2, 5, 10, 6, 5))
+	// The supplier's minimum wins over a smaller need.
+	assert.Equal(t, 12, purchasing.SuggestQuantity(0, 5, 2, 0, 12))
+	// At least one copy, even with no sales and no minimum.
+	assert.Equal(t, 1, purchasing.SuggestQuantity(0, 0, 0, 0, 0))
+}
+
+func TestReorderService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReorderRepo := mocks.NewMockReorderRepository(ctrl)
+	mockPurchasingRepo := mocks.NewMockPurchasingRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := purchasing.NewReorderService(mockReorderRepo, mockPurchasingRepo, mockBookRepo, mockInventoryRepo, mockTransactor)
+
+	t.Run("SaveRule_UnknownSKU", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), "SKU-404").Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := service.SaveRule(context.Background(), "SKU-404", dto.ReorderRuleRequest{ReorderPoint: 5, LocationID: 3})
+
+		assert.ErrorIs(t, err, books.ErrBookNotFound)
+	})
+
+	t.Run("SaveRule_InactiveLocation", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindBySKU(gomock.Any(), "SKU-1").Return(&books.Book{ID: 1, SKU: "SKU-1"}, nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3}, nil)
+
+		_, err := service.SaveRule(context.Background(), "SKU-1", dto.ReorderRuleRequest{ReorderPoint: 5, LocationID: 3})
+
+		assert.ErrorIs(t, err, inventory.ErrLocationInactive)
+	})
+
+	t.Run("DeleteRule_NotFound", func(t *testing.T) {
+		mockReorderRepo.EXPECT().DeleteRule(gomock.Any(), "SKU-404").Return(gorm.ErrRecordNotFound)
+
+		err := service.DeleteRule(context.Background(), "SKU-404")
+
+		assert.ErrorIs(t, err, purchasing.ErrReorderRuleNotFound)
+	})
+
+	t.Run("CheckStock_RaisesAlertsAndDraftsPurchaseOrder", func(t *testing.T) {
+		rules := []purchasing.ReorderRule{
+			{ID: 1, SKU: "SKU-1", BookID: 1, ReorderPoint: 10, LocationID: 3},
+			{ID: 2, SKU: "SKU-2", BookID: 2, ReorderPoint: 5, LocationID: 3},
+			{ID: 3, SKU: "SKU-3", BookID: 3, ReorderPoint: 5, LocationID: 3},
+		}
+		skus := []string{"SKU-1", "SKU-2", "SKU-3"}
+		mockReorderRepo.EXPECT().FindRulesAfter(gomock.Any(), uint(0), 100).Return(rules, nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), skus).Return(map[string]int{"SKU-1": 4, "SKU-2": 8}, nil)
+		mockReorderRepo.EXPECT().OnOrderBySKU(gomock.Any(), skus).Return(map[string]int{"SKU-1": 2}, nil)
+		mockReorderRepo.EXPECT().FindOpenAlerts(gomock.Any(), skus).Return([]purchasing.LowStockAlert{{ID: 4, SKU: "SKU-2"}}, nil)
+		mockReorderRepo.EXPECT().ResolveAlerts(gomock.Any(), []uint{4}, gomock.Any()).Return(nil)
+		mockInventoryRepo.EXPECT().SoldBySKU(gomock.Any(), []string{"SKU-1", "SKU-3"}, gomock.Any()).Return(map[string]int{"SKU-1": 60}, nil)
+		mockReorderRepo.EXPECT().FindOffers(gomock.Any(), []uint{1, 3}).Return([]purchasing.Offer{
+			{SupplierID: 2, BookID: 1, SupplierSKU: "NBD-1", Cost: 699, MinQuantity: 5, Currency: "GBP", LeadTimeDays: 5},
+		}, nil)
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1, SKU: "SKU-1", Title: "The Hobbit"}, nil)
+		runInTransaction(mockTransactor)
+		runInTransaction(mockTransactor)
+
+		var order *purchasing.PurchaseOrder
+		mockPurchasingRepo.EXPECT().CreatePurchaseOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, created *purchasing.PurchaseOrder) (*purchasing.PurchaseOrder, error) {
+				created.ID = 9
+				order = created
+				return created, nil
+			})
+		var raised []purchasing.LowStockAlert
+		mockReorderRepo.EXPECT().CreateAlerts(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, alerts []purchasing.LowStockAlert) error {
+				raised = append(raised, alerts...)
+				return nil
+			}).Times(2)
+		mockReorderRepo.EXPECT().NotifyStaff(gomock.Any(), purchasing.NotificationLowStock, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, message string, _ time.Time) (int64, error) {
+				assert.Contains(t, message, "2 SKUs fell to their reorder point.")
+				return 3, nil
+			})
+
+		err := service.CheckStock(context.Background())
+
+		require.NoError(t, err)
+		require.NotNil(t, order)
+		assert.Equal(t, purchasing.StatusDraft, order.Status)
+		assert.Equal(t, uint(2), order.SupplierID)
+		assert.Equal(t, uint(3), order.LocationID)
+		require.Len(t, order.Items, 1)
+		assert.Equal(t, 75, order.Items[0].Quantity)
+		assert.Equal(t, int64(75*699), order.Total)
+
+		require.Len(t, raised, 2)
+		assert.Equal(t, "SKU-1", raised[0].SKU)
+		assert.Equal(t, 2.0, raised[0].DailySales)
+		require.NotNil(t, raised[0].PurchaseOrderID)
+		assert.Equal(t, uint(9), *raised[0].PurchaseOrderID)
+		// No supplier carries SKU-3, so it is only alerted.
+		assert.Equal(t, "SKU-3", raised[1].SKU)
+		assert.Nil(t, raised[1].PurchaseOrderID)
+		assert.Equal(t, 6, raised[1].SuggestedQuantity)
+	})
+
+	t.Run("CheckStock_OnOrderKeepsAlertFromBeingRaised", func(t *testing.T) {
+		rules := []purchasing.ReorderRule{{ID: 1, SKU: "SKU-1", BookID: 1, ReorderPoint: 10, LocationID: 3}}
+		mockReorderRepo.EXPECT().FindRulesAfter(gomock.Any(), uint(0), 100).Return(rules, nil)
+		mockInventoryRepo.EXPECT().AvailableBySKU(gomock.Any(), []string{"SKU-1"}).Return(map[string]int{"SKU-1": 4}, nil)
+		mockReorderRepo.EXPECT().OnOrderBySKU(gomock.Any(), []string{"SKU-1"}).Return(map[string]int{"SKU-1": 75}, nil)
+		mockReorderRepo.EXPECT().FindOpenAlerts(gomock.Any(), []string{"SKU-1"}).Return(nil, nil)
+
+		err := service.CheckStock(context.Background())
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/pricing"
+	"bookstore-framework/internal/reports"
+	"bookstore-framework/internal/reports/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReportRepo := mocks.NewMockReportRepository(ctrl)
+	service := reports.NewReportService(mockReportRepo)
+
+	t.Run("GetSales_LocalDaysAndTotals", func(t *testing.T) {
+		// The clocks go forward on the 29th, so the range ends at 23:00 UTC.
+		mockReportRepo.EXPECT().SalesByPeriod(gomock.Any(), reports.IntervalDay, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, filter reports.SalesFilter) ([]reports.SalesRow, error) {
+				assert.True(t, filter.Start.Equal(time.Date(2026, 3, 29, 0, 0, 0, 0, time.UTC)))
+				assert.True(t, filter.End.Equal(time.Date(2026, 3, 30, 23, 0, 0, 0, time.UTC)))
+				assert.Equal(t, "Europe/London", filter.Timezone)
+				return []reports.SalesRow{
+					{Period: time.Date(2026, 3, 29, 0, 0, 0, 0, time.UTC), Currency: "GBP", Orders: 3, Revenue: 5000, RefundedOrders: 1, RefundedAmount: 1000},
+					{Period: time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC), Currency: "EUR", Orders: 1, Revenue: 2499},
+					{Period: time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC), Currency: "GBP", Orders: 1, Revenue: 1000},
+				}, nil
+			})
+
+		result, err := service.GetSales(context.Background(), dto.SalesReportQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2026-03-29", To: "2026-03-30", Timezone: "Europe/London"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, reports.IntervalDay, result.Interval)
+		require.Len(t, result.Periods, 3)
+		assert.Equal(t, "2026-03-29", result.Periods[0].Period)
+		assert.Equal(t, int64(1667), result.Periods[0].AverageOrderValue)
+		assert.Equal(t, 0.2, result.Periods[0].RefundRate)
+		assert.Equal(t, 0.3333, result.Periods[0].OrderRefundRate)
+
+		require.Len(t, result.Totals, 2)
+		assert.Equal(t, "EUR", result.Totals[0].Currency)
+		gbp := result.Totals[1]
+		assert.Equal(t, int64(4), gbp.Orders)
+		assert.Equal(t, int64(6000), gbp.Revenue)
+		assert.Equal(t, int64(1500), gbp.AverageOrderValue)
+		assert.Equal(t, 0.1667, gbp.RefundRate)
+		assert.Equal(t, 0.25, gbp.OrderRefundRate)
+
+		var buf bytes.Buffer
+		require.NoError(t, reports.WriteSalesCSV(&buf, result))
+		assert.Contains(t, buf.String(), "2026-03-29,GBP,3,50.00,0.00,0.00,16.67,1,10.00,0.2,0.3333\n")
+		assert.Contains(t, buf.String(), "total,GBP,4,60.00,0.00,0.00,15.00,1,10.00,0.1667,0.25\n")
+	})
+
+	t.Run("GetSales_ToBeforeFrom", func(t *testing.T) {
+		_, err := service.GetSales(context.Background(), dto.SalesReportQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2026-03-29", To: "2026-03-28"},
+		})
+
+		assert.ErrorIs(t, err, reports.ErrInvalidRange)
+	})
+
+	t.Run("GetSales_RangeTooLong", func(t *testing.T) {
+		_, err := service.GetSales(context.Background(), dto.SalesReportQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2020-01-01", To: "2026-03-28"},
+		})
+
+		assert.ErrorIs(t, err, reports.ErrRangeTooLong)
+	})
+
+	t.Run("GetSales_UnknownTimezone", func(t *testing.T) {
+		_, err := service.GetSales(context.Background(), dto.SalesReportQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2026-03-29", To: "2026-03-30", Timezone: "Mars/Olympus_Mons"},
+		})
+
+		assert.ErrorIs(t, err, reports.ErrUnknownTimezone)
+	})
+
+	t.Run("GetTopSellers_RanksInCurrency", func(t *testing.T) {
+		mockReportRepo.EXPECT().TopSellers(gomock.Any(), reports.DimensionAuthor, gomock.Any(), 10).
+			DoAndReturn(func(_ context.Context, _ string, filter reports.SalesFilter, _ int) ([]reports.SellerRow, error) {
+				assert.Equal(t, "EUR", filter.Currency)
+				assert.Equal(t, "UTC", filter.Timezone)
+				return []reports.SellerRow{
+					{ID: 4, Name: "Ursula K. Le Guin", Quantity: 12, Orders: 9, Revenue: 15588},
+					{ID: 2, Name: "J.R.R. Tolkien", Quantity: 7, Orders: 7, Revenue: 9093},
+				}, nil
+			})
+
+		result, err := service.GetTopSellers(context.Background(), reports.DimensionAuthor, dto.TopSellersQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2026-10-01", To: "2026-10-31"},
+			Currency:         "eur",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Sellers, 2)
+		assert.Equal(t, 1, result.Sellers[0].Rank)
+		assert.Equal(t, 2, result.Sellers[1].Rank)
+		assert.Equal(t, "EUR", result.Currency)
+	})
+
+	t.Run("GetTopSellers_UnsupportedCurrency", func(t *testing.T) {
+		_, err := service.GetTopSellers(context.Background(), reports.DimensionBook, dto.TopSellersQuery{
+			ReportRangeQuery: dto.ReportRangeQuery{From: "2026-10-01", To: "2026-10-31"},
+			Currency:         "XXX",
+		})
+
+		assert.ErrorIs(t, err, pricing.ErrUnsupportedCurrency)
+	})
+}
@@ -0,0 +1,325 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/inventory"
+	"bookstore-framework/internal/orders"
+	"bookstore-framework/internal/payments"
+	paymentsDto "bookstore-framework/internal/payments/api/dto"
+	"bookstore-framework/internal/returns"
+	"bookstore-framework/internal/returns/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deliveredOrder(deliveredAt time.Time) *orders.Order {
+	return &orders.Order{
+		ID:       42,
+		UserID:   7,
+		Status:   orders.StatusDelivered,
+		Currency: "USD",
+		Total:    1980,
+		Items: []orders.OrderItem{
+			{BookID: 1, SKU: "SKU-1", Title: "The Hobbit", Quantity: 2, UnitPrice: 1000, LineTotal: 2000, Discount: 200, Tax: 180},
+		},
+		Transitions: []orders.OrderTransition{
+			{ToStatus: orders.StatusPending},
+			{FromStatus: orders.StatusShipped, ToStatus: orders.StatusDelivered, CreatedAt: deliveredAt},
+		},
+	}
+}
+
+func returnOf(status string) *returns.Return {
+	return &returns.Return{
+		ID:       5,
+		OrderID:  42,
+		UserID:   7,
+		Status:   status,
+		Currency: "USD",
+		Amount:   990,
+		Items:    []returns.ReturnItem{{ID: 1, BookID: 1, SKU: "SKU-1", Quantity: 1, Amount: 990}},
+	}
+}
+
+func TestReturnService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReturnRepo := mocks.NewMockReturnRepository(ctrl)
+	mockOrderRepo := mocks.NewMockOrderRepository(ctrl)
+	mockInventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	mockPaymentService := mocks.NewMockPaymentService(ctrl)
+	mockWalletService := mocks.NewMockWalletService(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := returns.NewReturnService(mockReturnRepo, mockOrderRepo, mockInventoryRepo, mockPaymentRepo, mockPaymentService, mockWalletService, mockTransactor)
+	request := dto.CreateReturnRequest{
+		OrderID: 42,
+		Reason:  "Pages missing",
+		Items:   []dto.ReturnItemRequest{{BookID: 1, Quantity: 1}},
+	}
+
+	t.Run("RequestReturn", func(t *testing.T) {
+		order := deliveredOrder(time.Now().Add(-48 * time.Hour))
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).Return(order, nil)
+		mockReturnRepo.EXPECT().ReturnedQuantities(gomock.Any(), uint(42)).Return(map[uint]int{}, nil)
+		mockReturnRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ret *returns.Return) (*returns.Return, error) {
+				ret.ID = 5
+				return ret, nil
+			})
+
+		result, err := service.RequestReturn(context.Background(), 7, request)
+
+		require.NoError(t, err)
+		assert.Equal(t, returns.StatusRequested, result.Status)
+		// Half of the line, after its discount and with its tax.
+		assert.Equal(t, int64(990), result.Amount)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "SKU-1", result.Items[0].SKU)
+	})
+
+	t.Run("RequestReturn_PointsSpreadOverLines", func(t *testing.T) {
+		order := deliveredOrder(time.Now().Add(-48 * time.Hour))
+		order.Items = append(order.Items, orders.OrderItem{BookID: 2, SKU: "SKU-2", Title: "Dune", Quantity: 1, UnitPrice: 1000, LineTotal: 1000, Tax: 100})
+		order.PointsDiscount = 616
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).Return(order, nil)
+		mockReturnRepo.EXPECT().ReturnedQuantities(gomock.Any(), uint(42)).Return(map[uint]int{}, nil)
+		mockReturnRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ret *returns.Return) (*returns.Return, error) {
+				return ret, nil
+			})
+
+		result, err := service.RequestReturn(context.Background(), 7, request)
+
+		require.NoError(t, err)
+		// The line cost 1980 of 3080, so 396 of the points came off it.
+		assert.Equal(t, int64(792), result.Amount)
+		assert.Equal(t, int64(792), result.Items[0].Amount)
+	})
+
+	t.Run("RequestReturn_WindowClosed", func(t *testing.T) {
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).
+			Return(deliveredOrder(time.Now().Add(-returns.ReturnWindow-time.Hour)), nil)
+
+		_, err := service.RequestReturn(context.Background(), 7, request)
+
+		assert.ErrorIs(t, err, returns.ErrReturnWindowClosed)
+	})
+
+	t.Run("RequestReturn_AlreadyReturned", func(t *testing.T) {
+		order := deliveredOrder(time.Now())
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		runInTransaction(mockTransactor)
+		mockOrderRepo.EXPECT().FindByIDForUpdate(gomock.Any(), uint(42)).Return(order, nil)
+		mockReturnRepo.EXPECT().ReturnedQuantities(gomock.Any(), uint(42)).Return(map[uint]int{1: 2}, nil)
+
+		_, err := service.RequestReturn(context.Background(), 7, request)
+
+		assert.ErrorIs(t, err, returns.ErrInvalidReturnItems)
+	})
+
+	t.Run("RequestReturn_OtherCustomersOrder", func(t *testing.T) {
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(deliveredOrder(time.Now()), nil)
+
+		_, err := service.RequestReturn(context.Background(), 8, request)
+
+		assert.ErrorIs(t, err, orders.ErrOrderNotFound)
+	})
+
+	t.Run("Approve_OnlyRequested", func(t *testing.T) {
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+
+		_, err := service.Approve(context.Background(), 1, 5, dto.ReviewReturnRequest{})
+
+		assert.ErrorIs(t, err, returns.ErrInvalidTransition)
+	})
+
+	t.Run("Receive_RestocksIntoLedger", func(t *testing.T) {
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusApproved), nil)
+		mockInventoryRepo.EXPECT().FindLocationByID(gomock.Any(), uint(3)).Return(&inventory.Location{ID: 3, Active: true}, nil)
+		runInTransaction(mockTransactor)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusApproved).Return(nil)
+		mockInventoryRepo.EXPECT().RecordMovements(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, movements []inventory.StockMovement) ([]inventory.StockMovement, error) {
+				require.Len(t, movements, 1)
+				assert.Equal(t, inventory.MovementReturn, movements[0].Type)
+				assert.Equal(t, 1, movements[0].Quantity)
+				assert.Equal(t, uint(3), movements[0].LocationID)
+				assert.Equal(t, "return:5", movements[0].Reference)
+				return movements, nil
+			})
+
+		result, err := service.Receive(context.Background(), 1, 5, dto.ReceiveReturnRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Disposition: returns.DispositionRestock, LocationID: 3}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, returns.StatusReceived, result.Status)
+		assert.Equal(t, returns.DispositionRestock, result.Items[0].Disposition)
+	})
+
+	t.Run("Receive_WriteOffStaysOutOfStock", func(t *testing.T) {
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusApproved), nil)
+		runInTransaction(mockTransactor)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusApproved).Return(nil)
+
+		result, err := service.Receive(context.Background(), 1, 5, dto.ReceiveReturnRequest{
+			Items: []dto.ReceiveItemRequest{{BookID: 1, Disposition: returns.DispositionWriteOff}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, returns.DispositionWriteOff, result.Items[0].Disposition)
+	})
+
+	t.Run("Refund_Partial", func(t *testing.T) {
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(deliveredOrder(time.Now()), nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return([]payments.Payment{
+			{ID: 2, Status: payments.PaymentFailed},
+			{ID: 3, Status: payments.PaymentCaptured},
+		}, nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		mockPaymentService.EXPECT().Refund(gomock.Any(), uint(1), uint(3), paymentsDto.RefundRequest{Amount: 500, Reason: "Return 5"}).
+			Return(&paymentsDto.PaymentResponse{ID: 3}, nil)
+
+		result, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{Amount: 500})
+
+		require.NoError(t, err)
+		assert.Equal(t, returns.StatusRefunded, result.Status)
+		assert.Equal(t, int64(500), result.RefundedAmount)
+	})
+
+	t.Run("Refund_StoreCredit", func(t *testing.T) {
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(deliveredOrder(time.Now()), nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		mockWalletService.EXPECT().CreditRefund(gomock.Any(), uint(7), "USD", int64(990), "return:5").Return(nil)
+
+		result, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{StoreCredit: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, returns.RefundStoreCredit, result.RefundMethod)
+		assert.Equal(t, int64(990), result.RefundedAmount)
+	})
+
+	t.Run("Refund_SplitsGiftCardAndStoreCredit", func(t *testing.T) {
+		order := deliveredOrder(time.Now())
+		order.GiftCardAmount = 500
+		order.StoreCredit = 490
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return([]payments.Payment{{ID: 3, Status: payments.PaymentCaptured}}, nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		// Half of the order was paid from the gift card and the wallet.
+		mockWalletService.EXPECT().RefundOrder(gomock.Any(), uint(7), uint(42), int64(495), "return:5").Return(nil)
+		mockPaymentService.EXPECT().Refund(gomock.Any(), uint(1), uint(3), paymentsDto.RefundRequest{Amount: 495, Reason: "Return 5"}).
+			Return(&paymentsDto.PaymentResponse{ID: 3}, nil)
+
+		result, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, returns.RefundPayment, result.RefundMethod)
+		assert.Equal(t, int64(990), result.RefundedAmount)
+		assert.Equal(t, int64(495), result.StoreCredit)
+	})
+
+	t.Run("Refund_PaidFromGiftCardOnly", func(t *testing.T) {
+		order := deliveredOrder(time.Now())
+		order.GiftCardAmount = 1980
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		mockWalletService.EXPECT().RefundOrder(gomock.Any(), uint(7), uint(42), int64(990), "return:5").Return(nil)
+
+		result, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(990), result.StoreCredit)
+	})
+
+	t.Run("Refund_MoreThanReturned", func(t *testing.T) {
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+
+		_, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{Amount: 991})
+
+		assert.ErrorIs(t, err, returns.ErrRefundExceedsReturn)
+	})
+
+	t.Run("Refund_ProviderFailureReopens", func(t *testing.T) {
+		failure := errors.New("provider unavailable")
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(deliveredOrder(time.Now()), nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return([]payments.Payment{{ID: 3, Status: payments.PaymentCaptured}}, nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		mockPaymentService.EXPECT().Refund(gomock.Any(), uint(1), uint(3), gomock.Any()).Return(nil, failure)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusRefunded).
+			DoAndReturn(func(_ context.Context, ret *returns.Return, _ string) error {
+				assert.Equal(t, returns.StatusReceived, ret.Status)
+				assert.Zero(t, ret.RefundedAmount)
+				return nil
+			})
+
+		_, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{})
+
+		assert.ErrorIs(t, err, failure)
+	})
+
+	t.Run("Refund_RetryAfterProviderFailure", func(t *testing.T) {
+		failure := errors.New("provider unavailable")
+		order := deliveredOrder(time.Now())
+		order.GiftCardAmount = 990
+		captured := []payments.Payment{{ID: 3, Status: payments.PaymentCaptured}}
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(returnOf(returns.StatusReceived), nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return(captured, nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		mockWalletService.EXPECT().RefundOrder(gomock.Any(), uint(7), uint(42), int64(495), "return:5").Return(nil)
+		mockPaymentService.EXPECT().Refund(gomock.Any(), uint(1), uint(3), gomock.Any()).Return(nil, failure)
+		var reopened returns.Return
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusRefunded).
+			DoAndReturn(func(_ context.Context, ret *returns.Return, _ string) error {
+				reopened = *ret
+				return nil
+			})
+
+		_, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{})
+
+		assert.ErrorIs(t, err, failure)
+		// The wallet keeps its share, and so does the return.
+		assert.Equal(t, returns.StatusReceived, reopened.Status)
+		assert.Equal(t, int64(495), reopened.StoreCredit)
+		assert.Equal(t, int64(495), reopened.RefundedAmount)
+
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(&reopened, nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+
+		_, err = service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{Amount: 500})
+
+		assert.ErrorIs(t, err, returns.ErrRefundRetryMismatch)
+
+		mockReturnRepo.EXPECT().FindByID(gomock.Any(), uint(5)).Return(&reopened, nil)
+		mockOrderRepo.EXPECT().FindByID(gomock.Any(), uint(42)).Return(order, nil)
+		mockPaymentRepo.EXPECT().FindByOrderID(gomock.Any(), uint(42)).Return(captured, nil)
+		mockReturnRepo.EXPECT().Update(gomock.Any(), gomock.Any(), returns.StatusReceived).Return(nil)
+		mockWalletService.EXPECT().RefundOrder(gomock.Any(), uint(7), uint(42), int64(495), "return:5").Return(nil)
+		mockPaymentService.EXPECT().Refund(gomock.Any(), uint(1), uint(3), paymentsDto.RefundRequest{Amount: 495, Reason: "Return 5"}).
+			Return(&paymentsDto.PaymentResponse{ID: 3}, nil)
+
+		result, err := service.Refund(context.Background(), 1, 5, dto.RefundReturnRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(990), result.RefundedAmount)
+		assert.Equal(t, int64(495), result.StoreCredit)
+	})
+}
@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/reviews"
+	"bookstore-framework/internal/reviews/api/dto"
+	"bookstore-framework/internal/users"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestReviewService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockOrderRepo := mocks.NewMockOrderRepository(ctrl)
+	mockLoyaltyService := mocks.NewMockLoyaltyService(ctrl)
+	service := reviews.NewReviewService(mockReviewRepo, mockBookRepo, mockOrderRepo, mockLoyaltyService)
+
+	t.Run("CreateReview_VerifiedPurchase", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1}, nil)
+		mockOrderRepo.EXPECT().HasPurchased(gomock.Any(), uint(7), uint(1)).Return(true, nil)
+		mockReviewRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, review *reviews.Review) (*reviews.Review, error) {
+				assert.Equal(t, 5, review.Rating)
+				assert.Equal(t, "Loved it", review.Title)
+				assert.True(t, review.VerifiedPurchase)
+				review.ID = 3
+				return review, nil
+			})
+		mockLoyaltyService.EXPECT().AwardReview(gomock.Any(), uint(7), uint(1)).Return(nil)
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&reviews.Review{
+			ID: 3, BookID: 1, UserID: 7, User: users.User{ID: 7, Name: "Bilbo"}, Rating: 5, Title: "Loved it", VerifiedPurchase: true,
+		}, nil)
+
+		result, err := service.CreateReview(context.Background(), 7, 1, dto.ReviewRequest{Rating: 5, Title: " Loved it "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Bilbo", result.Reviewer)
+		assert.True(t, result.VerifiedPurchase)
+	})
+
+	t.Run("GetReviews_WithRatingSummary", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{
+			ID: 1, RatingCount: 3, RatingSum: 11, Rating3Count: 1, Rating4Count: 1, Rating5Count: 1,
+		}, nil)
+		mockReviewRepo.EXPECT().FindAll(gomock.Any(), reviews.ReviewFilter{BookID: 1, Rating: 5, Sort: reviews.SortRecent, Offset: 0, Limit: 20}).
+			Return([]reviews.Review{{ID: 3, BookID: 1, Rating: 5}}, int64(1), nil)
+
+		result, err := service.GetReviews(context.Background(), 1, dto.ReviewListQuery{
+			PaginationQuery: paginate(1, 20), Rating: 5, Sort: reviews.SortRecent,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3.67, result.Rating.Average)
+		assert.Equal(t, 3, result.Rating.Count)
+		assert.Equal(t, map[int]int{1: 0, 2: 0, 3: 1, 4: 1, 5: 1}, result.Rating.Histogram)
+		assert.Len(t, result.Reviews, 1)
+	})
+
+	t.Run("UpdateReview_RechecksPurchase", func(t *testing.T) {
+		review := &reviews.Review{ID: 3, BookID: 1, UserID: 7, Rating: 5, VerifiedPurchase: true}
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(review, nil)
+		mockOrderRepo.EXPECT().HasPurchased(gomock.Any(), uint(7), uint(1)).Return(false, nil)
+		mockReviewRepo.EXPECT().Update(gomock.Any(), review).Return(review, nil)
+
+		result, err := service.UpdateReview(context.Background(), reviews.Actor{UserID: 7}, 3, dto.ReviewRequest{Rating: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Rating)
+		assert.False(t, result.VerifiedPurchase)
+	})
+
+	t.Run("DeleteReview_ByStaff", func(t *testing.T) {
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&reviews.Review{ID: 3, UserID: 7}, nil)
+		mockReviewRepo.EXPECT().Delete(gomock.Any(), uint(3)).Return(nil)
+
+		err := service.DeleteReview(context.Background(), reviews.Actor{UserID: 1, Staff: true}, 3)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Vote", func(t *testing.T) {
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&reviews.Review{ID: 3, UserID: 7}, nil)
+		mockReviewRepo.EXPECT().Vote(gomock.Any(), uint(3), uint(8)).Return(true, nil)
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&reviews.Review{ID: 3, UserID: 7, HelpfulCount: 1}, nil)
+
+		result, err := service.Vote(context.Background(), 8, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.HelpfulCount)
+	})
+}
+
+func TestReviewService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockOrderRepo := mocks.NewMockOrderRepository(ctrl)
+	mockLoyaltyService := mocks.NewMockLoyaltyService(ctrl)
+	service := reviews.NewReviewService(mockReviewRepo, mockBookRepo, mockOrderRepo, mockLoyaltyService)
+
+	t.Run("CreateReview_BookNotFound", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.CreateReview(context.Background(), 7, 99, dto.ReviewRequest{Rating: 4})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reviews.ErrBookNotFound)
+	})
+
+	t.Run("CreateReview_AlreadyReviewed", func(t *testing.T) {
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1}, nil)
+		mockOrderRepo.EXPECT().HasPurchased(gomock.Any(), uint(7), uint(1)).Return(false, nil)
+		mockReviewRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreateReview(context.Background(), 7, 1, dto.ReviewRequest{Rating: 4})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reviews.ErrDuplicateReview)
+	})
+
+	t.Run("UpdateReview_NotOwner", func(t *testing.T) {
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&reviews.Review{ID: 3, UserID: 7}, nil)
+
+		result, err := service.UpdateReview(context.Background(), reviews.Actor{UserID: 1, Staff: true}, 3, dto.ReviewRequest{Rating: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reviews.ErrNotReviewOwner)
+	})
+
+	t.Run("Vote_OwnReview", func(t *testing.T) {
+		mockReviewRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&reviews.Review{ID: 3, UserID: 7}, nil)
+
+		result, err := service.Vote(context.Background(), 7, 3)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reviews.ErrOwnReview)
+	})
+}
@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/taxes"
+	"bookstore-framework/internal/taxes/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func franceTaxRegion(inclusive bool) *taxes.TaxRegion {
+	return &taxes.TaxRegion{ID: 1, Country: "FR", Name: "France", PricesIncludeTax: inclusive, Rates: []taxes.TaxRate{
+		{TaxClass: books.TaxClassBook, Name: "TVA", Rate: 550},
+		{TaxClass: taxes.ClassDefault, Name: "TVA", Rate: 2000},
+	}}
+}
+
+func TestTaxCalculator(t *testing.T) {
+	lines := []taxes.Line{
+		{BookID: 1, TaxClass: books.TaxClassBook, Amount: 2198},
+		{BookID: 2, TaxClass: books.TaxClassEbook, Amount: 999},
+	}
+
+	t.Run("PricesIncludeTax", func(t *testing.T) {
+		result := taxes.Calculate(franceTaxRegion(true), lines)
+
+		assert.True(t, result.PricesIncludeTax)
+		assert.Equal(t, int64(115), result.Lines[0].Tax)
+		assert.Equal(t, 550, result.Lines[0].Rate)
+		// The ebook has no rate of its own and takes the default one.
+		assert.Equal(t, int64(167), result.Lines[1].Tax)
+		assert.Equal(t, 2000, result.Lines[1].Rate)
+		assert.Equal(t, int64(282), result.Tax)
+	})
+
+	t.Run("TaxOnTop", func(t *testing.T) {
+		result := taxes.Calculate(franceTaxRegion(false), lines)
+
+		assert.False(t, result.PricesIncludeTax)
+		assert.Equal(t, int64(121), result.Lines[0].Tax)
+		assert.Equal(t, int64(200), result.Lines[1].Tax)
+		assert.Equal(t, int64(321), result.Tax)
+	})
+
+	t.Run("ClassNotTaxed", func(t *testing.T) {
+		region := &taxes.TaxRegion{ID: 2, Country: "GB", PricesIncludeTax: true, Rates: []taxes.TaxRate{
+			{TaxClass: books.TaxClassEbook, Name: "VAT", Rate: 2000},
+		}}
+
+		result := taxes.Calculate(region, lines)
+
+		assert.Zero(t, result.Lines[0].Tax)
+		assert.Empty(t, result.Lines[0].Name)
+		assert.Equal(t, int64(167), result.Tax)
+	})
+
+	t.Run("NoRegion", func(t *testing.T) {
+		result := taxes.Calculate(nil, lines)
+
+		assert.Nil(t, result.RegionID)
+		assert.Zero(t, result.Tax)
+		assert.Len(t, result.Lines, 2)
+	})
+}
+
+func TestTaxService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaxRepo := mocks.NewMockTaxRepository(ctrl)
+	service := taxes.NewTaxService(mockTaxRepo)
+
+	t.Run("CreateRegion", func(t *testing.T) {
+		mockTaxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, region *taxes.TaxRegion) (*taxes.TaxRegion, error) {
+				assert.Equal(t, "US", region.Country)
+				assert.Equal(t, "CA", region.Region)
+				assert.Equal(t, "book", region.Rates[0].TaxClass)
+				region.ID = 3
+				return region, nil
+			})
+
+		result, err := service.CreateRegion(context.Background(), dto.TaxRegionRequest{
+			Country: "US", Region: " ca ", Name: "California",
+			Rates: []dto.TaxRateRequest{{TaxClass: "Book", Name: "Sales tax", Rate: 725}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(3), result.ID)
+		assert.Equal(t, 725, result.Rates[0].Rate)
+	})
+
+	t.Run("Calculate", func(t *testing.T) {
+		mockTaxRepo.EXPECT().FindForDestination(gomock.Any(), "FR", "").Return(franceTaxRegion(true), nil)
+
+		result, err := service.Calculate(context.Background(), taxes.Destination{Country: "fr"}, []taxes.Line{
+			{BookID: 1, TaxClass: books.TaxClassBook, Amount: 2198},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(115), result.Tax)
+	})
+
+	t.Run("Calculate_UntaxedDestination", func(t *testing.T) {
+		mockTaxRepo.EXPECT().FindForDestination(gomock.Any(), "US", "OR").Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := service.Calculate(context.Background(), taxes.Destination{Country: "US", Region: "or"}, []taxes.Line{
+			{BookID: 1, TaxClass: books.TaxClassBook, Amount: 2198},
+		})
+
+		require.NoError(t, err)
+		assert.Zero(t, result.Tax)
+		assert.False(t, result.PricesIncludeTax)
+	})
+}
+
+func TestTaxService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaxRepo := mocks.NewMockTaxRepository(ctrl)
+	service := taxes.NewTaxService(mockTaxRepo)
+
+	t.Run("CreateRegion_ClassListedTwice", func(t *testing.T) {
+		result, err := service.CreateRegion(context.Background(), dto.TaxRegionRequest{
+			Country: "FR", Name: "France",
+			Rates: []dto.TaxRateRequest{{TaxClass: "book", Name: "TVA", Rate: 550}, {TaxClass: "BOOK", Name: "TVA", Rate: 2000}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, taxes.ErrInvalidTaxRegion)
+	})
+
+	t.Run("CreateRegion_Duplicate", func(t *testing.T) {
+		mockTaxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreateRegion(context.Background(), dto.TaxRegionRequest{
+			Country: "FR", Name: "France", Rates: []dto.TaxRateRequest{{TaxClass: "book", Name: "TVA", Rate: 550}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, taxes.ErrDuplicateRegion)
+	})
+
+	t.Run("DeleteRegion_NotFound", func(t *testing.T) {
+		mockTaxRepo.EXPECT().Delete(gomock.Any(), uint(9)).Return(gorm.ErrRecordNotFound)
+
+		err := service.DeleteRegion(context.Background(), 9)
+
+		assert.ErrorIs(t, err, taxes.ErrTaxRegionNotFound)
+	})
+}
@@ -21,7 +21,8 @@ func TestUserService_Success(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	jwtGen := mocks.NewMockJWTGenerator(ctrl)
-	service := users.NewUserService(mockRepo, jwtGen)
+	cartMerger := mocks.NewMockCartMerger(ctrl)
+	service := users.NewUserService(mockRepo, jwtGen, cartMerger)
 
 	t.Run("Register", func(t *testing.T) {
 		ctx := context.Background()
@@ -69,9 +70,10 @@ func TestUserService_Success(t *testing.T) {
 			Username: req.Username,
 			Email:    "test@example.com",
 			Password: string(hashedPassword),
+			Role:     users.RoleCustomer,
 		}
 		mockRepo.EXPECT().FindUserByUsername(gomock.Any(), req.Username).Return(mockUser, nil)
-		jwtGen.EXPECT().GenerateToken(mockUser.ID, mockUser.Username, mockUser.Email).Return(expectedToken, nil)
+		jwtGen.EXPECT().GenerateToken(mockUser.ID, mockUser.Username, mockUser.Email, mockUser.Role).Return(expectedToken, nil)
 
 		result, err := service.Login(ctx, req)
 
@@ -81,6 +83,34 @@ func TestUserService_Success(t *testing.T) {
 
 	})
 
+	t.Run("Login_MergesGuestCart", func(t *testing.T) {
+		ctx := context.Background()
+		password := "password"
+		req := dto.LoginRequest{
+			Username:       "test",
+			Password:       password,
+			GuestCartToken: "guest-token",
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		assert.NoError(t, err)
+
+		mockUser := &users.User{
+			ID:       1,
+			Username: req.Username,
+			Password: string(hashedPassword),
+			Role:     users.RoleCustomer,
+		}
+		mockRepo.EXPECT().FindUserByUsername(gomock.Any(), req.Username).Return(mockUser, nil)
+		jwtGen.EXPECT().GenerateToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("mocked-jwt-token", nil)
+		cartMerger.EXPECT().MergeGuestCart(gomock.Any(), "guest-token", uint(1)).Return(errors.New("database is down"))
+
+		result, err := service.Login(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "mocked-jwt-token", result.TokenAccess)
+	})
+
 	t.Run("GetProfile", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -112,7 +142,7 @@ func TestUserService_Error(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	jwtGen := mocks.NewMockJWTGenerator(ctrl)
-	service := users.NewUserService(mockRepo, jwtGen)
+	service := users.NewUserService(mockRepo, jwtGen, nil)
 
 	t.Run("Register", func(t *testing.T) {
 		ctx := context.Background()
@@ -0,0 +1,231 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/wallet"
+	"bookstore-framework/internal/wallet/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func giftCard(expiresAt time.Time) *wallet.GiftCard {
+	return &wallet.GiftCard{
+		ID:        3,
+		CodeHash:  wallet.HashGiftCardCode("7KQM-X2PD-H9RT-4WLB"),
+		Last4:     "4WLB",
+		Currency:  "USD",
+		Amount:    5000,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func TestWalletService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWalletRepo := mocks.NewMockWalletRepository(ctrl)
+	mockTransactor := mocks.NewMockTransactor(ctrl)
+	service := wallet.NewWalletService(mockWalletRepo, mockTransactor)
+	future := time.Now().Add(24 * time.Hour)
+
+	t.Run("IssueGiftCard", func(t *testing.T) {
+		runInTransaction(mockTransactor)
+		mockWalletRepo.EXPECT().CreateGiftCard(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, card *wallet.GiftCard) (*wallet.GiftCard, error) {
+				assert.Len(t, card.CodeHash, 64)
+				assert.Equal(t, uint(1), *card.IssuedBy)
+				card.ID = 3
+				return card, nil
+			})
+		mockWalletRepo.EXPECT().Post(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, transaction *wallet.Transaction) error {
+				assert.Equal(t, wallet.TransactionGiftCardIssue, transaction.Type)
+				assert.Equal(t, "gift_card:3", transaction.Reference)
+				assert.Equal(t, "system:gift_card_sales:USD", transaction.Postings[0].Account.Key)
+				assert.Equal(t, int64(-5000), transaction.Postings[0].Amount)
+				assert.Equal(t, "gift_card:3", transaction.Postings[1].Account.Key)
+				assert.Equal(t, int64(5000), transaction.Postings[1].Amount)
+				return nil
+			})
+
+		result, err := service.IssueGiftCard(context.Background(), 1, dto.IssueGiftCardRequest{Amount: 5000, Currency: "USD"})
+
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^[A-Z2-9]{4}(-[A-Z2-9]{4}){3}$`), result.Code)
+		assert.Equal(t, result.Code[len(result.Code)-4:], result.Last4)
+		assert.Equal(t, int64(5000), result.Balance)
+		assert.WithinDuration(t, time.Now().Add(wallet.GiftCardLifetime), result.ExpiresAt, time.Minute)
+	})
+
+	t.Run("IssueGiftCard_UnsupportedCurrency", func(t *testing.T) {
+		_, err := service.IssueGiftCard(context.Background(), 1, dto.IssueGiftCardRequest{Amount: 5000, Currency: "XXX"})
+
+		assert.ErrorIs(t, err, wallet.ErrUnsupportedCurrency)
+	})
+
+	t.Run("CheckGiftCard_CodeAsTyped", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindGiftCardByCodeHash(gomock.Any(), wallet.HashGiftCardCode("7KQM-X2PD-H9RT-4WLB")).
+			Return(giftCard(future), nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), []string{"gift_card:3"}).
+			Return([]wallet.Account{{Key: "gift_card:3", Balance: 1200}}, nil)
+
+		result, err := service.CheckGiftCard(context.Background(), dto.GiftCardCodeRequest{Code: " 7kqm x2pd-h9rt4wlb "})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1200), result.Balance)
+		assert.False(t, result.Expired)
+	})
+
+	t.Run("CheckGiftCard_NotFound", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindGiftCardByCodeHash(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := service.CheckGiftCard(context.Background(), dto.GiftCardCodeRequest{Code: "AAAA-BBBB-CCCC-DDDD"})
+
+		assert.ErrorIs(t, err, wallet.ErrGiftCardNotFound)
+	})
+
+	t.Run("Funds_GiftCardThenStoreCredit", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindGiftCardByCodeHash(gomock.Any(), gomock.Any()).Return(giftCard(future), nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), []string{"gift_card:3"}).
+			Return([]wallet.Account{{Key: "gift_card:3", Balance: 1200}}, nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), []string{"wallet:7:USD"}).
+			Return([]wallet.Account{{Key: "wallet:7:USD", Balance: 5000}}, nil)
+
+		result, err := service.Funds(context.Background(), 7, wallet.FundsRequest{
+			Currency: "USD", Amount: 2198, Code: "7KQM-X2PD-H9RT-4WLB", UseStoreCredit: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1200), result.GiftCard)
+		assert.Equal(t, int64(998), result.StoreCredit)
+		assert.Equal(t, int64(2198), result.Total())
+	})
+
+	t.Run("Funds_GiftCardInOtherCurrency", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindGiftCardByCodeHash(gomock.Any(), gomock.Any()).Return(giftCard(future), nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), gomock.Any()).
+			Return([]wallet.Account{{Key: "gift_card:3", Balance: 1200}}, nil)
+
+		_, err := service.Funds(context.Background(), 7, wallet.FundsRequest{Currency: "EUR", Amount: 2198, Code: "7KQM-X2PD-H9RT-4WLB"})
+
+		assert.ErrorIs(t, err, wallet.ErrCurrencyMismatch)
+	})
+
+	t.Run("RedeemGiftCard_Expired", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindGiftCardByCodeHash(gomock.Any(), gomock.Any()).Return(giftCard(time.Now().Add(-time.Hour)), nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), gomock.Any()).
+			Return([]wallet.Account{{Key: "gift_card:3", Balance: 1200}}, nil)
+
+		_, err := service.RedeemGiftCard(context.Background(), 7, dto.GiftCardCodeRequest{Code: "7KQM-X2PD-H9RT-4WLB"})
+
+		assert.ErrorIs(t, err, wallet.ErrGiftCardExpired)
+	})
+
+	t.Run("RedeemGiftCard_MovesBalanceToWallet", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindGiftCardByCodeHash(gomock.Any(), gomock.Any()).Return(giftCard(future), nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), gomock.Any()).
+			Return([]wallet.Account{{Key: "gift_card:3", Balance: 1200}}, nil)
+		mockWalletRepo.EXPECT().Post(gomock.Any(), &wallet.Transaction{
+			Type:      wallet.TransactionGiftCardRedeem,
+			Reference: "gift_card:3",
+			Postings: wallet.Transfer(
+				wallet.GiftCardAccount(giftCard(future)),
+				wallet.WalletAccount(7, "USD"),
+				1200,
+			),
+		}).Return(nil)
+		mockWalletRepo.EXPECT().FindWallets(gomock.Any(), uint(7)).
+			Return([]wallet.Account{{Currency: "USD", Balance: 1200}}, nil)
+
+		result, err := service.RedeemGiftCard(context.Background(), 7, dto.GiftCardCodeRequest{Code: "7KQM-X2PD-H9RT-4WLB"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []dto.BalanceResponse{{Currency: "USD", Balance: 1200}}, result.Balances)
+	})
+
+	t.Run("ReverseOrder_CreditsWallet", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindTransaction(gomock.Any(), wallet.TransactionCheckout, "order:42").
+			Return(&wallet.Transaction{Reference: "order:42", Postings: []wallet.Posting{
+				{Account: wallet.Account{Key: "gift_card:3", Currency: "USD"}, Amount: -1200},
+				{Account: wallet.Account{Key: "system:orders:USD", Currency: "USD"}, Amount: 1200},
+				{Account: wallet.Account{Key: "wallet:7:USD", Currency: "USD"}, Amount: -998},
+				{Account: wallet.Account{Key: "system:orders:USD", Currency: "USD"}, Amount: 998},
+			}}, nil)
+		mockWalletRepo.EXPECT().FindTransactionsByPrefix(gomock.Any(), wallet.TransactionCheckoutReturn, "order:42:").Return(nil, nil)
+		mockWalletRepo.EXPECT().Post(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, transaction *wallet.Transaction) error {
+				assert.Equal(t, wallet.TransactionCheckoutReversal, transaction.Type)
+				assert.Equal(t, "wallet:7:USD", transaction.Postings[1].Account.Key)
+				assert.Equal(t, int64(2198), transaction.Postings[1].Amount)
+				return gorm.ErrDuplicatedKey
+			})
+
+		assert.NoError(t, service.ReverseOrder(context.Background(), 7, 42))
+	})
+
+	t.Run("ReverseOrder_LessReturned", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindTransaction(gomock.Any(), wallet.TransactionCheckout, "order:42").
+			Return(&wallet.Transaction{Reference: "order:42", Postings: wallet.Transfer(
+				wallet.Account{Key: "gift_card:3", Currency: "USD"},
+				wallet.SystemAccount(wallet.SystemOrders, "USD"),
+				1200,
+			)}, nil)
+		mockWalletRepo.EXPECT().FindTransactionsByPrefix(gomock.Any(), wallet.TransactionCheckoutReturn, "order:42:").
+			Return([]wallet.Transaction{{Reference: "order:42:return:5", Postings: wallet.Transfer(
+				wallet.SystemAccount(wallet.SystemOrders, "USD"),
+				wallet.WalletAccount(7, "USD"),
+				700,
+			)}}, nil)
+		mockWalletRepo.EXPECT().Post(gomock.Any(), &wallet.Transaction{
+			Type:      wallet.TransactionCheckoutReversal,
+			Reference: "order:42",
+			Postings:  wallet.Transfer(wallet.SystemAccount(wallet.SystemOrders, "USD"), wallet.WalletAccount(7, "USD"), 500),
+		}).Return(nil)
+
+		assert.NoError(t, service.ReverseOrder(context.Background(), 7, 42))
+	})
+
+	t.Run("ReverseOrder_NothingCharged", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindTransaction(gomock.Any(), wallet.TransactionCheckout, "order:43").
+			Return(nil, gorm.ErrRecordNotFound)
+
+		assert.NoError(t, service.ReverseOrder(context.Background(), 7, 43))
+	})
+
+	t.Run("RefundOrder", func(t *testing.T) {
+		mockWalletRepo.EXPECT().FindTransaction(gomock.Any(), wallet.TransactionCheckout, "order:42").
+			Return(&wallet.Transaction{Reference: "order:42", Postings: wallet.Transfer(
+				wallet.WalletAccount(7, "USD"),
+				wallet.SystemAccount(wallet.SystemOrders, "USD"),
+				998,
+			)}, nil)
+		mockWalletRepo.EXPECT().Post(gomock.Any(), &wallet.Transaction{
+			Type:      wallet.TransactionCheckoutReturn,
+			Reference: "order:42:return:5",
+			Postings:  wallet.Transfer(wallet.SystemAccount(wallet.SystemOrders, "USD"), wallet.WalletAccount(7, "USD"), 495),
+		}).Return(gorm.ErrDuplicatedKey)
+
+		assert.NoError(t, service.RefundOrder(context.Background(), 7, 42, 495, "return:5"))
+	})
+
+	t.Run("ExpireGiftCards", func(t *testing.T) {
+		card := giftCard(time.Now().Add(-time.Hour))
+		mockWalletRepo.EXPECT().FindExpiredGiftCards(gomock.Any(), gomock.Any(), 100).Return([]wallet.GiftCard{*card}, nil)
+		mockWalletRepo.EXPECT().FindAccounts(gomock.Any(), []string{"gift_card:3"}).
+			Return([]wallet.Account{{Key: "gift_card:3", Balance: 300}}, nil)
+		mockWalletRepo.EXPECT().Post(gomock.Any(), &wallet.Transaction{
+			Type:      wallet.TransactionGiftCardExpiry,
+			Reference: "gift_card:3",
+			Postings:  wallet.Transfer(wallet.GiftCardAccount(card), wallet.SystemAccount(wallet.SystemBreakage, "USD"), 300),
+		}).Return(nil)
+
+		assert.NoError(t, service.ExpireGiftCards(context.Background()))
+	})
+}
@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"bookstore-framework/internal/books"
+	"bookstore-framework/internal/carts"
+	cartsDto "bookstore-framework/internal/carts/api/dto"
+	"bookstore-framework/internal/wishlists"
+	"bookstore-framework/internal/wishlists/api/dto"
+	mocks "bookstore-framework/test/mock"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestWishlistService_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWishlistRepo := mocks.NewMockWishlistRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockCartService := mocks.NewMockCartService(ctrl)
+	service := wishlists.NewWishlistService(mockWishlistRepo, mockBookRepo, mockCartService)
+
+	t.Run("CreateWishlist_PrivateByDefault", func(t *testing.T) {
+		var created *wishlists.Wishlist
+		mockWishlistRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, wishlist *wishlists.Wishlist) (*wishlists.Wishlist, error) {
+				assert.Equal(t, uint(7), wishlist.UserID)
+				assert.Equal(t, "Summer reading", wishlist.Name)
+				assert.Equal(t, wishlists.PrivacyPrivate, wishlist.Privacy)
+				assert.Len(t, wishlist.ShareToken, 48)
+				wishlist.ID = 2
+				created = wishlist
+				return wishlist, nil
+			})
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).
+			DoAndReturn(func(context.Context, uint) (*wishlists.Wishlist, error) { return created, nil })
+
+		result, err := service.CreateWishlist(context.Background(), 7, dto.WishlistRequest{Name: " Summer reading "})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), result.ID)
+		assert.Equal(t, created.ShareToken, result.ShareToken)
+	})
+
+	t.Run("GetWishlist_PublicForGuests", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&wishlists.Wishlist{
+			ID: 2, UserID: 7, Privacy: wishlists.PrivacyPublic, ShareToken: "secret",
+			Items: []wishlists.WishlistItem{{BookID: 1, Book: books.Book{ID: 1, Title: "The Hobbit", Price: 999, Currency: "USD"}}},
+		}, nil)
+
+		result, err := service.GetWishlist(context.Background(), nil, 2)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.ShareToken)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, int64(999), result.Items[0].Price)
+		assert.True(t, result.Items[0].Available)
+	})
+
+	t.Run("GetSharedWishlist", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByShareToken(gomock.Any(), "secret").
+			Return(&wishlists.Wishlist{ID: 2, UserID: 7, Privacy: wishlists.PrivacyLink, ShareToken: "secret"}, nil)
+
+		result, err := service.GetSharedWishlist(context.Background(), "secret")
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), result.ID)
+		assert.Empty(t, result.ShareToken)
+	})
+
+	t.Run("UpdateWishlist_PrivateRevokesLink", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).
+			Return(&wishlists.Wishlist{ID: 2, UserID: 7, Name: "Summer reading", Privacy: wishlists.PrivacyLink, ShareToken: "secret"}, nil)
+		mockWishlistRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, wishlist *wishlists.Wishlist) error {
+				assert.Equal(t, wishlists.PrivacyPrivate, wishlist.Privacy)
+				assert.NotEqual(t, "secret", wishlist.ShareToken)
+				return nil
+			})
+
+		result, err := service.UpdateWishlist(context.Background(), 7, 2, dto.WishlistRequest{Name: "Summer reading", Privacy: wishlists.PrivacyPrivate})
+
+		require.NoError(t, err)
+		assert.Equal(t, wishlists.PrivacyPrivate, result.Privacy)
+	})
+
+	t.Run("AddItem_RemembersPrice", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&wishlists.Wishlist{ID: 2, UserID: 7}, nil)
+		mockBookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&books.Book{ID: 1, Price: 1299, Currency: "USD"}, nil)
+		mockWishlistRepo.EXPECT().SaveItem(gomock.Any(), &wishlists.WishlistItem{
+			WishlistID: 2, BookID: 1, Note: "For the beach", Price: 1299, Currency: "USD",
+		}).Return(nil)
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&wishlists.Wishlist{
+			ID: 2, UserID: 7, Items: []wishlists.WishlistItem{{BookID: 1, Note: "For the beach"}},
+		}, nil)
+
+		result, err := service.AddItem(context.Background(), 7, 2, dto.AddWishlistItemRequest{BookID: 1, Note: "For the beach"})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+	})
+
+	t.Run("MoveToCart", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).
+			Return(&wishlists.Wishlist{ID: 2, UserID: 7, Items: []wishlists.WishlistItem{{BookID: 1}}}, nil)
+		mockCartService.EXPECT().AddItem(gomock.Any(), carts.Owner{UserID: uintPtr(7)}, "", cartsDto.AddCartItemRequest{BookID: 1, Quantity: 2}).
+			Return(&cartsDto.CartResponse{ID: 4}, nil)
+		mockWishlistRepo.EXPECT().DeleteItem(gomock.Any(), uint(2), uint(1)).Return(nil)
+
+		result, err := service.MoveToCart(context.Background(), 7, 2, 1, dto.MoveToCartRequest{Quantity: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(4), result.ID)
+	})
+
+	t.Run("NotifyPriceDrops", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindRepriced(gomock.Any(), uint(0), 500).Return([]wishlists.RepricedItem{
+			{ID: 5, WishlistID: 2, UserID: 7, BookID: 1, Price: 1299, Currency: "USD", NewPrice: 999, NewCurrency: "USD"},
+			{ID: 6, WishlistID: 3, UserID: 7, BookID: 1, Price: 1299, Currency: "USD", NewPrice: 999, NewCurrency: "USD"},
+			{ID: 7, WishlistID: 3, UserID: 7, BookID: 2, Price: 500, Currency: "USD", NewPrice: 700, NewCurrency: "USD"},
+			{ID: 8, WishlistID: 4, UserID: 8, BookID: 3, Price: 1500, Currency: "USD", NewPrice: 1000, NewCurrency: "EUR"},
+		}, nil)
+		gomock.InOrder(
+			mockWishlistRepo.EXPECT().Reprice(gomock.Any(), gomock.Any(), &wishlists.PriceDropNotification{
+				UserID: 7, WishlistID: 2, BookID: 1, OldPrice: 1299, NewPrice: 999, Currency: "USD",
+			}).Return(nil),
+			mockWishlistRepo.EXPECT().Reprice(gomock.Any(), gomock.Any(), nil).Times(3).Return(nil),
+		)
+
+		err := service.NotifyPriceDrops(context.Background())
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestWishlistService_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWishlistRepo := mocks.NewMockWishlistRepository(ctrl)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	mockCartService := mocks.NewMockCartService(ctrl)
+	service := wishlists.NewWishlistService(mockWishlistRepo, mockBookRepo, mockCartService)
+
+	t.Run("GetWishlist_PrivateOfAnotherUser", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&wishlists.Wishlist{ID: 2, UserID: 7, Privacy: wishlists.PrivacyLink}, nil)
+
+		result, err := service.GetWishlist(context.Background(), uintPtr(8), 2)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, wishlists.ErrWishlistNotFound)
+	})
+
+	t.Run("GetSharedWishlist_Private", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByShareToken(gomock.Any(), "secret").
+			Return(&wishlists.Wishlist{ID: 2, UserID: 7, Privacy: wishlists.PrivacyPrivate}, nil)
+
+		result, err := service.GetSharedWishlist(context.Background(), "secret")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, wishlists.ErrWishlistNotFound)
+	})
+
+	t.Run("CreateWishlist_DuplicateName", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		result, err := service.CreateWishlist(context.Background(), 7, dto.WishlistRequest{Name: "Summer reading"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, wishlists.ErrDuplicateName)
+	})
+
+	t.Run("AddItem_OtherUsersList", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&wishlists.Wishlist{ID: 2, UserID: 7}, nil)
+
+		result, err := service.AddItem(context.Background(), 8, 2, dto.AddWishlistItemRequest{BookID: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, wishlists.ErrWishlistNotFound)
+	})
+
+	t.Run("MoveToCart_OutOfStockKeepsBook", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).
+			Return(&wishlists.Wishlist{ID: 2, UserID: 7, Items: []wishlists.WishlistItem{{BookID: 1}}}, nil)
+		mockCartService.EXPECT().AddItem(gomock.Any(), gomock.Any(), "", gomock.Any()).Return(nil, carts.ErrInsufficientStock)
+
+		result, err := service.MoveToCart(context.Background(), 7, 2, 1, dto.MoveToCartRequest{Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, carts.ErrInsufficientStock)
+	})
+
+	t.Run("MoveToCart_NotOnList", func(t *testing.T) {
+		mockWishlistRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&wishlists.Wishlist{ID: 2, UserID: 7}, nil)
+
+		result, err := service.MoveToCart(context.Background(), 7, 2, 1, dto.MoveToCartRequest{Quantity: 1})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, wishlists.ErrItemNotFound)
+	})
+}